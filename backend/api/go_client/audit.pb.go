@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/audit.proto
+
+package go_client
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuditEvent_Outcome int32
+
+const (
+	// Default value if not present.
+	AuditEvent_UNSPECIFIED AuditEvent_Outcome = 0
+	// Indicates that the operation succeeded.
+	AuditEvent_SUCCEEDED AuditEvent_Outcome = 1
+	// Indicates that the operation failed.
+	AuditEvent_FAILED AuditEvent_Outcome = 2
+	// Indicates that the user was not authenticated or not allowed to do the
+	// operation.
+	AuditEvent_DENIED AuditEvent_Outcome = 3
+)
+
+var AuditEvent_Outcome_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "DENIED",
+}
+
+var AuditEvent_Outcome_value = map[string]int32{
+	"UNSPECIFIED": 0,
+	"SUCCEEDED":   1,
+	"FAILED":      2,
+	"DENIED":      3,
+}
+
+func (x AuditEvent_Outcome) String() string {
+	return proto.EnumName(AuditEvent_Outcome_name, int32(x))
+}
+
+func (AuditEvent_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b5c776e03e045a5, []int{2, 0}
+}
+
+type ListAuditEventsRequest struct {
+	// A page token to request the next page of results. The token is acquried
+	// from the nextPageToken field of the response from the previous
+	// ListAuditEvents call or can be omitted when fetching the first page.
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The number of audit events to be listed per page. If there are more
+	// audit events than this number, the response message will contain a
+	// nextPageToken field you can use to fetch the next page.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Can be format of "field_name", "field_name asc" or "field_name desc"
+	// Ascending by default.
+	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// A url-encoded, JSON-serialized Filter protocol buffer (see
+	// [filter.proto](https://github.com/kubeflow/pipelines/
+	// blob/master/backend/api/filter.proto)).
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// What resource reference to filter on.
+	// For audit events, the only valid resource type is Namespace. An sample
+	// query string could be
+	// resource_reference_key.type=NAMESPACE&resource_reference_key.id=ns1
+	ResourceReferenceKey *ResourceKey `protobuf:"bytes,5,opt,name=resource_reference_key,json=resourceReferenceKey,proto3" json:"resource_reference_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b5c776e03e045a5, []int{0}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetResourceReferenceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceReferenceKey
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	// A list of audit events returned.
+	AuditEvents []*AuditEvent `protobuf:"bytes,1,rep,name=audit_events,json=auditEvents,proto3" json:"audit_events,omitempty"`
+	// The total number of audit events for the given query.
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The token to list the next page of audit events.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b5c776e03e045a5, []int{1}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
+	if m != nil {
+		return m.AuditEvents
+	}
+	return nil
+}
+
+func (m *ListAuditEventsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ListAuditEventsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	// Output. Unique audit event ID. Generated by API server.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Output. The time the operation was done.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Output. The user who requested the operation. Empty if the request was
+	// not authenticated, e.g. in single-user mode.
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Output. The full name of the gRPC method of the operation, e.g.
+	// /api.RunService/DeleteRun, or the path of the HTTP endpoint of pipeline
+	// uploads.
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// Output. The type of the target resource of the operation, e.g. runs,
+	// jobs, experiments, pipelines or pipelineversions.
+	ResourceType string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// Output. The ID of the target resource of the operation. Empty if the
+	// operation failed before the resource was created.
+	ResourceId string `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// Output. The namespace of the target resource, if any.
+	Namespace string `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Output. The outcome of the operation.
+	Outcome AuditEvent_Outcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=api.AuditEvent_Outcome" json:"outcome,omitempty"`
+	// Output. In case the operation did not succeed, the error message.
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b5c776e03e045a5, []int{2}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetOutcome() AuditEvent_Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return AuditEvent_UNSPECIFIED
+}
+
+func (m *AuditEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("api.AuditEvent_Outcome", AuditEvent_Outcome_name, AuditEvent_Outcome_value)
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "api.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "api.ListAuditEventsResponse")
+	proto.RegisterType((*AuditEvent)(nil), "api.AuditEvent")
+}
+
+func init() { proto.RegisterFile("backend/api/audit.proto", fileDescriptor_9b5c776e03e045a5) }
+
+var fileDescriptor_9b5c776e03e045a5 = []byte{
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x0d, 0xa9, 0x58, 0x32, 0x47, 0x56, 0xa4, 0x6e, 0x03, 0x8b, 0x50, 0x14, 0x58, 0x50, 0x8b,
+	0x42, 0x87, 0x86, 0x84, 0x95, 0x53, 0x8f, 0xb2, 0x45, 0x03, 0x42, 0xd2, 0x34, 0xa0, 0x9c, 0x4b,
+	0x2e, 0xc4, 0x92, 0x1c, 0xd1, 0x0b, 0x49, 0x5c, 0x76, 0x77, 0xe9, 0x54, 0x3e, 0x15, 0x05, 0xfa,
+	0x03, 0x6d, 0x7f, 0xab, 0xa7, 0xfe, 0x42, 0xff, 0xa0, 0x3f, 0x50, 0x70, 0x49, 0xca, 0x4a, 0xec,
+	0x93, 0x76, 0xde, 0xbc, 0x7d, 0xab, 0x79, 0x33, 0x43, 0xe8, 0x87, 0x34, 0x5a, 0x63, 0x1a, 0xbb,
+	0x34, 0x63, 0x2e, 0xcd, 0x63, 0xa6, 0x9c, 0x4c, 0x70, 0xc5, 0x49, 0x83, 0x66, 0x6c, 0xf0, 0x59,
+	0x16, 0x85, 0xe0, 0xa2, 0xcc, 0x0e, 0xbe, 0x3d, 0x4c, 0x08, 0x94, 0x3c, 0x17, 0x11, 0x06, 0x02,
+	0x57, 0x28, 0x30, 0x8d, 0xb0, 0x62, 0x0d, 0x13, 0xce, 0x93, 0x0d, 0x96, 0xda, 0x69, 0xca, 0x15,
+	0x55, 0x8c, 0xa7, 0xb2, 0xca, 0x9e, 0x55, 0x59, 0x1d, 0x85, 0xf9, 0xca, 0x55, 0x6c, 0x8b, 0x52,
+	0xd1, 0x6d, 0x56, 0x11, 0xbe, 0xd7, 0x3f, 0xd1, 0xab, 0x04, 0xd3, 0x57, 0xf2, 0x13, 0x4d, 0x12,
+	0x14, 0x2e, 0xcf, 0xb4, 0xc4, 0x43, 0xb9, 0xf1, 0xdf, 0x06, 0x9c, 0xbe, 0x65, 0x52, 0xcd, 0x8a,
+	0x22, 0xbc, 0x5b, 0x4c, 0x95, 0xf4, 0xf1, 0xe7, 0x1c, 0xa5, 0x22, 0x2f, 0x01, 0x32, 0x9a, 0x60,
+	0xa0, 0xf8, 0x1a, 0x53, 0xdb, 0x18, 0x19, 0x13, 0xcb, 0xb7, 0x0a, 0xe4, 0xba, 0x00, 0xc8, 0x0b,
+	0xd0, 0x41, 0x20, 0xd9, 0x1d, 0xda, 0xe6, 0xc8, 0x98, 0x1c, 0xf9, 0xc7, 0x05, 0xb0, 0x64, 0x77,
+	0x48, 0xfa, 0xd0, 0x92, 0x5c, 0xa8, 0x20, 0xdc, 0xd9, 0x0d, 0x7d, 0xb1, 0x59, 0x84, 0x17, 0x3b,
+	0x72, 0x0a, 0xcd, 0x15, 0xdb, 0x28, 0x14, 0xf6, 0xd3, 0x12, 0x2f, 0x23, 0x72, 0x05, 0xa7, 0x0f,
+	0x0d, 0x09, 0xd6, 0xb8, 0xb3, 0x8f, 0x46, 0xc6, 0xa4, 0x3d, 0xed, 0x39, 0x34, 0x63, 0x8e, 0x5f,
+	0x51, 0xde, 0xe0, 0xce, 0x7f, 0x5e, 0xf3, 0xfd, 0x9a, 0xfe, 0x06, 0x77, 0xe3, 0xbf, 0x0c, 0xe8,
+	0x3f, 0xa8, 0x47, 0x66, 0x3c, 0x95, 0x48, 0xa6, 0x70, 0xa2, 0x7b, 0x15, 0xa0, 0xc6, 0x6d, 0x63,
+	0xd4, 0x98, 0xb4, 0xa7, 0x5d, 0xad, 0x7c, 0xcf, 0xf7, 0xdb, 0xf4, 0xfe, 0x6e, 0x61, 0x82, 0xe2,
+	0x8a, 0x6e, 0xca, 0x32, 0x1b, 0xba, 0x4c, 0x4b, 0x23, 0xba, 0xce, 0xef, 0xa0, 0x9b, 0xe2, 0x2f,
+	0x2a, 0x38, 0x30, 0xca, 0xd4, 0x75, 0x75, 0x0a, 0xf8, 0x7d, 0x6d, 0xd6, 0xf8, 0x3f, 0x13, 0xe0,
+	0xfe, 0x09, 0xf2, 0x0c, 0x4c, 0x16, 0x57, 0x96, 0x9a, 0x2c, 0x26, 0x3f, 0x00, 0x44, 0x02, 0xa9,
+	0xc2, 0x38, 0xa0, 0x4a, 0x2b, 0xb4, 0xa7, 0x03, 0xa7, 0xec, 0xb4, 0x53, 0x77, 0xda, 0xb9, 0xae,
+	0x3b, 0xed, 0x5b, 0x15, 0x7b, 0xa6, 0x08, 0x81, 0xa7, 0xb9, 0x44, 0x51, 0xd9, 0xac, 0xcf, 0x85,
+	0xc9, 0x5b, 0x54, 0x37, 0x3c, 0xae, 0x4d, 0x2e, 0x23, 0xf2, 0x0d, 0x74, 0xf6, 0x26, 0xab, 0x5d,
+	0x86, 0xda, 0x5b, 0xcb, 0x3f, 0xa9, 0xc1, 0xeb, 0x5d, 0x86, 0xe4, 0x0c, 0xda, 0x7b, 0x12, 0x8b,
+	0xed, 0xa6, 0xa6, 0x40, 0x0d, 0x2d, 0x62, 0x32, 0x04, 0x2b, 0xa5, 0x5b, 0x94, 0x19, 0x8d, 0xd0,
+	0x6e, 0x95, 0x63, 0xb1, 0x07, 0xc8, 0x39, 0xb4, 0x78, 0xae, 0x22, 0xbe, 0x45, 0xfb, 0x78, 0x64,
+	0x4c, 0x9e, 0x4d, 0xfb, 0x5f, 0xf8, 0xeb, 0xfc, 0x54, 0xa6, 0xfd, 0x9a, 0x47, 0x9e, 0xc3, 0x91,
+	0xde, 0x12, 0xdb, 0xd2, 0x62, 0x65, 0x30, 0x9e, 0x41, 0xab, 0x62, 0x92, 0x2e, 0xb4, 0x3f, 0xbc,
+	0x5b, 0xbe, 0xf7, 0x2e, 0x17, 0x57, 0x0b, 0x6f, 0xde, 0x7b, 0x42, 0x3a, 0x60, 0x2d, 0x3f, 0x5c,
+	0x5e, 0x7a, 0xde, 0xdc, 0x9b, 0xf7, 0x0c, 0x02, 0xd0, 0xbc, 0x9a, 0x2d, 0xde, 0x7a, 0xf3, 0x9e,
+	0x59, 0x9c, 0xe7, 0xde, 0xbb, 0x82, 0xd6, 0x98, 0xfe, 0x6a, 0xc0, 0x89, 0x7e, 0x78, 0x89, 0xe2,
+	0x96, 0x45, 0x48, 0x32, 0xe8, 0x7e, 0x31, 0x1c, 0xe4, 0x85, 0xfe, 0x7b, 0x8f, 0xaf, 0xc0, 0x60,
+	0xf8, 0x78, 0xb2, 0x9c, 0xa7, 0xf1, 0xf8, 0xb7, 0x7f, 0xfe, 0xfd, 0xd3, 0x1c, 0x92, 0x41, 0xb1,
+	0xaa, 0xd2, 0xbd, 0x3d, 0x0f, 0x51, 0xd1, 0x73, 0xf7, 0x70, 0xc6, 0x2e, 0x7e, 0x37, 0xfe, 0x98,
+	0xfd, 0xe8, 0x0f, 0xa1, 0x15, 0xe3, 0x8a, 0xe6, 0x1b, 0x45, 0xbe, 0x22, 0x5d, 0xe8, 0x0c, 0xda,
+	0x5a, 0x78, 0xa9, 0xa8, 0xca, 0xe5, 0xc7, 0x33, 0x78, 0x09, 0xcd, 0x0b, 0xa4, 0x02, 0x05, 0xf9,
+	0xfa, 0xd8, 0x1c, 0x74, 0x68, 0xae, 0x6e, 0xb8, 0x60, 0x77, 0x7a, 0x53, 0x47, 0x66, 0x78, 0x02,
+	0xb0, 0x27, 0x3c, 0xf9, 0xf8, 0x3a, 0x61, 0xea, 0x26, 0x0f, 0x9d, 0x88, 0x6f, 0xdd, 0x75, 0x1e,
+	0xe2, 0x6a, 0xc3, 0x3f, 0xb9, 0x19, 0xcb, 0x70, 0xc3, 0x52, 0x94, 0xee, 0xe1, 0x37, 0x26, 0xe1,
+	0x41, 0xb4, 0x61, 0x98, 0xaa, 0xb0, 0xa9, 0xa7, 0xe8, 0xf5, 0xff, 0x03, 0x00, 0x70, 0xe8, 0xd3,
+	0x98, 0xba, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	// Finds the audit events recorded for the mutating API operations, e.g.
+	// creating, deleting, terminating or archiving runs, pipelines, jobs and
+	// experiments.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditServiceClient(cc *grpc.ClientConn) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.AuditService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+type AuditServiceServer interface {
+	// Finds the audit events recorded for the mutating API operations, e.g.
+	// creating, deleting, terminating or archiving runs, pipelines, jobs and
+	// experiments.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+// UnimplementedAuditServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAuditServiceServer struct {
+}
+
+func (*UnimplementedAuditServiceServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+
+func RegisterAuditServiceServer(s *grpc.Server, srv AuditServiceServer) {
+	s.RegisterService(&_AuditService_serviceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.AuditService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/api/audit.proto",
+}
@@ -332,10 +332,13 @@ type Experiment struct {
 	// For Experiment, the only valid resource reference is a single Namespace.
 	ResourceReferences []*ResourceReference `protobuf:"bytes,5,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
 	// Output. Specifies whether this experiment is in archived or available state.
-	StorageState         Experiment_StorageState `protobuf:"varint,6,opt,name=storage_state,json=storageState,proto3,enum=api.Experiment_StorageState" json:"storage_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	StorageState Experiment_StorageState `protobuf:"varint,6,opt,name=storage_state,json=storageState,proto3,enum=api.Experiment_StorageState" json:"storage_state,omitempty"`
+	// Optional input field. User-defined labels of the experiment. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels               map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Experiment) Reset()         { *m = Experiment{} }
@@ -405,6 +408,13 @@ func (m *Experiment) GetStorageState() Experiment_StorageState {
 	return Experiment_STORAGESTATE_UNSPECIFIED
 }
 
+func (m *Experiment) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type ArchiveExperimentRequest struct {
 	// The ID of the experiment to be archived.
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -493,6 +503,7 @@ func init() {
 	proto.RegisterType((*ListExperimentsResponse)(nil), "api.ListExperimentsResponse")
 	proto.RegisterType((*DeleteExperimentRequest)(nil), "api.DeleteExperimentRequest")
 	proto.RegisterType((*Experiment)(nil), "api.Experiment")
+	proto.RegisterMapType((map[string]string)(nil), "api.Experiment.LabelsEntry")
 	proto.RegisterType((*ArchiveExperimentRequest)(nil), "api.ArchiveExperimentRequest")
 	proto.RegisterType((*UnarchiveExperimentRequest)(nil), "api.UnarchiveExperimentRequest")
 }
@@ -500,63 +511,66 @@ func init() {
 func init() { proto.RegisterFile("backend/api/experiment.proto", fileDescriptor_2acb5110e2ac785b) }
 
 var fileDescriptor_2acb5110e2ac785b = []byte{
-	// 889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x51, 0x53, 0xdb, 0x46,
-	0x10, 0x8e, 0x4c, 0x70, 0xc2, 0x1a, 0x83, 0x39, 0x52, 0x5b, 0x08, 0x53, 0x5c, 0x4d, 0x87, 0xba,
-	0x4c, 0xb0, 0x0a, 0x79, 0x6a, 0xde, 0x0c, 0x38, 0x94, 0x86, 0xb6, 0x19, 0xd9, 0xc9, 0x43, 0x5e,
-	0x3c, 0x67, 0x79, 0x6d, 0x6e, 0xb0, 0x75, 0xea, 0xdd, 0x89, 0xc4, 0x74, 0x3a, 0xd3, 0xe9, 0x4c,
-	0xff, 0x40, 0xf3, 0xb3, 0x3a, 0x7d, 0xea, 0x5f, 0xe8, 0xef, 0xe8, 0x74, 0x74, 0x96, 0x41, 0xb6,
-	0xec, 0x84, 0x27, 0xb8, 0xdd, 0xcf, 0xb7, 0xf7, 0x7d, 0xfb, 0xed, 0x0a, 0xca, 0x1d, 0xea, 0x5d,
-	0xa1, 0xdf, 0x75, 0x68, 0xc0, 0x1c, 0x7c, 0x1f, 0xa0, 0x60, 0x43, 0xf4, 0x55, 0x2d, 0x10, 0x5c,
-	0x71, 0xb2, 0x44, 0x03, 0x66, 0x95, 0xa6, 0x20, 0x42, 0x70, 0x31, 0xce, 0x5a, 0x5f, 0x26, 0x13,
-	0x02, 0x25, 0x0f, 0x85, 0x87, 0x6d, 0x81, 0x3d, 0x14, 0xe8, 0x7b, 0x18, 0xa3, 0xca, 0x7d, 0xce,
-	0xfb, 0x03, 0xd4, 0x20, 0xea, 0xfb, 0x5c, 0x51, 0xc5, 0xb8, 0x2f, 0xe3, 0xec, 0x76, 0x9c, 0xd5,
-	0xa7, 0x4e, 0xd8, 0x73, 0x70, 0x18, 0xa8, 0x51, 0x9c, 0xdc, 0x9d, 0x4d, 0x2a, 0x36, 0x44, 0xa9,
-	0xe8, 0x30, 0x88, 0x01, 0x4f, 0xf5, 0x1f, 0xef, 0xa0, 0x8f, 0xfe, 0x81, 0x7c, 0x47, 0xfb, 0x7d,
-	0x14, 0x0e, 0x0f, 0xf4, 0xfd, 0xe9, 0x5a, 0xf6, 0xf7, 0x50, 0x3a, 0x11, 0x48, 0x15, 0x36, 0x6e,
-	0x79, 0xba, 0xf8, 0x73, 0x88, 0x52, 0x11, 0x07, 0xe0, 0x8e, 0xbc, 0x69, 0x54, 0x8c, 0x6a, 0xee,
-	0x68, 0xbd, 0x46, 0x03, 0x56, 0x4b, 0x60, 0x13, 0x10, 0x7b, 0x0f, 0x9e, 0x9c, 0xa1, 0x4a, 0x5f,
-	0xb4, 0x06, 0x19, 0xd6, 0xd5, 0x17, 0xac, 0xb8, 0x19, 0xd6, 0xb5, 0xff, 0x36, 0xa0, 0x78, 0xc1,
-	0x64, 0x02, 0x29, 0x27, 0xd0, 0x1d, 0x80, 0x80, 0xf6, 0xb1, 0xad, 0xf8, 0x15, 0xfa, 0xf1, 0x4f,
-	0x56, 0xa2, 0x48, 0x2b, 0x0a, 0x90, 0x6d, 0xd0, 0x87, 0xb6, 0x64, 0x37, 0x68, 0x66, 0x2a, 0x46,
-	0x75, 0xd9, 0x7d, 0x1c, 0x05, 0x9a, 0xec, 0x06, 0x49, 0x09, 0x1e, 0x49, 0x2e, 0x54, 0xbb, 0x33,
-	0x32, 0x97, 0xf4, 0x0f, 0xb3, 0xd1, 0xf1, 0x78, 0x44, 0x8a, 0x90, 0xed, 0xb1, 0x81, 0x42, 0x61,
-	0x3e, 0x1c, 0xc7, 0xc7, 0x27, 0xf2, 0x02, 0x8a, 0xe9, 0x0e, 0xb5, 0xaf, 0x70, 0x64, 0x2e, 0x6b,
-	0xb2, 0x05, 0x4d, 0xd6, 0x8d, 0x21, 0x2f, 0x71, 0xe4, 0x3e, 0x99, 0xe0, 0xdd, 0x09, 0xfc, 0x25,
-	0x8e, 0xec, 0x0f, 0x06, 0x94, 0x52, 0x7c, 0x64, 0xc0, 0x7d, 0x89, 0xe4, 0x10, 0x72, 0x77, 0x0a,
-	0x49, 0xd3, 0xa8, 0x2c, 0xcd, 0x53, 0x31, 0x89, 0x89, 0x34, 0x50, 0x5c, 0xd1, 0xc1, 0x98, 0xe5,
-	0x92, 0x66, 0xb9, 0xa2, 0x23, 0x9a, 0xe6, 0x1e, 0xac, 0xfb, 0xf8, 0x5e, 0xb5, 0x13, 0x3a, 0x65,
-	0x34, 0xad, 0x7c, 0x14, 0x7e, 0x35, 0xd1, 0xca, 0xfe, 0x1a, 0x4a, 0xa7, 0x38, 0xc0, 0x79, 0x9d,
-	0x9d, 0x6d, 0xc8, 0x7f, 0x19, 0x80, 0x3b, 0xd4, 0x6c, 0x9a, 0x10, 0x78, 0xe8, 0xd3, 0x21, 0xc6,
-	0x65, 0xf4, 0xff, 0xa4, 0x02, 0xb9, 0x2e, 0x4a, 0x4f, 0x30, 0xed, 0xac, 0x58, 0xf0, 0x64, 0x88,
-	0x7c, 0x0b, 0xe0, 0x69, 0x67, 0x75, 0xdb, 0x54, 0x69, 0xe5, 0x73, 0x47, 0x56, 0x6d, 0xec, 0xde,
-	0xda, 0xc4, 0xbd, 0xb5, 0xd6, 0xc4, 0xbd, 0xee, 0x4a, 0x8c, 0xae, 0x2b, 0x72, 0x06, 0x9b, 0xe9,
-	0xc6, 0x48, 0x73, 0x59, 0x8b, 0x57, 0x9c, 0xea, 0xca, 0x6d, 0x23, 0x5c, 0x92, 0xea, 0x8d, 0x24,
-	0x75, 0xc8, 0x4b, 0xc5, 0x85, 0xb6, 0x8c, 0xa2, 0x0a, 0xcd, 0x6c, 0xc5, 0xa8, 0xae, 0x1d, 0x95,
-	0x67, 0xf4, 0xaf, 0x35, 0xc7, 0xa0, 0x66, 0x84, 0x71, 0x57, 0x65, 0xe2, 0x64, 0x7b, 0xb0, 0x9a,
-	0xcc, 0x92, 0x32, 0x98, 0xcd, 0xd6, 0x4f, 0x6e, 0xfd, 0xac, 0xd1, 0x6c, 0xd5, 0x5b, 0x8d, 0xf6,
-	0xeb, 0x1f, 0x9b, 0xaf, 0x1a, 0x27, 0xe7, 0x2f, 0xce, 0x1b, 0xa7, 0x85, 0x07, 0xc4, 0x82, 0xe2,
-	0x54, 0xb6, 0xfe, 0xa6, 0x7e, 0x7e, 0x51, 0x3f, 0xbe, 0x68, 0x14, 0x0c, 0xb2, 0x05, 0x9f, 0x4d,
-	0xe7, 0xdc, 0x93, 0xef, 0xce, 0xdf, 0x34, 0x4e, 0x0b, 0x19, 0x7b, 0x1f, 0xcc, 0xba, 0xf0, 0x2e,
-	0xd9, 0xf5, 0x3d, 0x9a, 0xf5, 0x14, 0xac, 0xd7, 0x3e, 0xbd, 0x27, 0xfa, 0xe8, 0xaf, 0x65, 0xd8,
-	0xb8, 0x43, 0x35, 0x51, 0x5c, 0x33, 0x0f, 0x49, 0x00, 0x85, 0xd9, 0xa9, 0x27, 0x63, 0x51, 0x16,
-	0x2c, 0x03, 0x6b, 0xd6, 0xb2, 0xf6, 0xc1, 0xef, 0xff, 0xfc, 0xfb, 0x21, 0xf3, 0x95, 0xbd, 0x15,
-	0x2d, 0x31, 0xe9, 0x5c, 0x1f, 0x76, 0x50, 0xd1, 0xc3, 0xc4, 0xba, 0x94, 0xcf, 0x13, 0xbb, 0x81,
-	0x78, 0x90, 0x9f, 0xda, 0x0d, 0x64, 0x4b, 0x5f, 0x38, 0x6f, 0x5f, 0xa4, 0x6b, 0xed, 0xe9, 0x5a,
-	0x15, 0xf2, 0xf9, 0xc2, 0x5a, 0xce, 0x2f, 0xac, 0xfb, 0x2b, 0xf1, 0x61, 0x6d, 0x7a, 0x0e, 0xc9,
-	0xb6, 0xbe, 0x6a, 0xfe, 0xb2, 0xb1, 0xca, 0xf3, 0x93, 0xe3, 0xc9, 0xb5, 0xbf, 0xd0, 0x45, 0xb7,
-	0xc9, 0x62, 0x82, 0x91, 0x8c, 0xb3, 0x23, 0x16, 0xcb, 0xb8, 0x60, 0xf2, 0xac, 0x62, 0x6a, 0x00,
-	0x1a, 0xd1, 0x6e, 0x9f, 0x30, 0xdc, 0xff, 0x14, 0xc3, 0x1b, 0xd8, 0x48, 0x19, 0x85, 0xec, 0xe8,
-	0x92, 0x8b, 0x0c, 0xb4, 0xb0, 0x66, 0x4d, 0xd7, 0xac, 0xda, 0x7b, 0x1f, 0xaf, 0xf9, 0x3c, 0xf6,
-	0x1a, 0xf9, 0xcd, 0x80, 0xcd, 0x39, 0xce, 0x23, 0xbb, 0xba, 0xfc, 0x62, 0x4f, 0x2e, 0x7c, 0xc0,
-	0x37, 0xfa, 0x01, 0xfb, 0x76, 0xf5, 0x13, 0x0f, 0x08, 0x27, 0x57, 0x1f, 0xff, 0x61, 0xfc, 0x59,
-	0xff, 0xc1, 0x2d, 0xc3, 0xa3, 0x2e, 0xf6, 0x68, 0x38, 0x50, 0x64, 0x83, 0xac, 0x43, 0xde, 0xca,
-	0xe9, 0x17, 0x44, 0xf3, 0x19, 0xca, 0xb7, 0xbb, 0xb0, 0x03, 0xd9, 0x63, 0xa4, 0x02, 0x05, 0xd9,
-	0x7c, 0x9c, 0xb1, 0xf2, 0x34, 0x54, 0x97, 0x5c, 0xb0, 0x1b, 0xfd, 0xdd, 0xab, 0x64, 0x3a, 0xab,
-	0x00, 0xb7, 0x80, 0x07, 0x6f, 0x9f, 0xf5, 0x99, 0xba, 0x0c, 0x3b, 0x35, 0x8f, 0x0f, 0x9d, 0xab,
-	0xb0, 0x83, 0xbd, 0x01, 0x7f, 0xe7, 0x04, 0x2c, 0xc0, 0x01, 0xf3, 0x51, 0x3a, 0xc9, 0xcf, 0x79,
-	0x9f, 0xb7, 0xbd, 0x01, 0x43, 0x5f, 0x75, 0xb2, 0x9a, 0xc9, 0xb3, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0x8f, 0xfa, 0xad, 0x2a, 0x08, 0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdf, 0x52, 0xdb, 0xc6,
+	0x17, 0x8e, 0xec, 0x60, 0xc2, 0x31, 0x7f, 0xcc, 0xc2, 0xcf, 0x16, 0xc2, 0xfc, 0x70, 0x35, 0x1d,
+	0xea, 0x32, 0xc1, 0x2a, 0x70, 0xd3, 0x70, 0x67, 0xc0, 0xa1, 0x34, 0xb4, 0xcd, 0xc8, 0x4e, 0x2e,
+	0x72, 0xe3, 0x59, 0xcb, 0xc7, 0x66, 0x07, 0x59, 0x52, 0x77, 0x57, 0x24, 0xa6, 0xd3, 0x99, 0x4e,
+	0x67, 0xfa, 0x02, 0xcd, 0x63, 0x75, 0x7a, 0xd5, 0x57, 0xe8, 0x75, 0x9f, 0xa1, 0xa3, 0x95, 0x0c,
+	0xf2, 0xbf, 0x92, 0x2b, 0x6b, 0xcf, 0xf9, 0xb4, 0x67, 0xbf, 0xef, 0x7c, 0x7b, 0x64, 0x28, 0x77,
+	0xa8, 0x73, 0x83, 0x5e, 0xd7, 0xa2, 0x01, 0xb3, 0xf0, 0x43, 0x80, 0x9c, 0x0d, 0xd0, 0x93, 0xb5,
+	0x80, 0xfb, 0xd2, 0x27, 0x59, 0x1a, 0x30, 0xa3, 0x34, 0x06, 0xe1, 0xdc, 0xe7, 0x71, 0xd6, 0xf8,
+	0x3c, 0x9d, 0xe0, 0x28, 0xfc, 0x90, 0x3b, 0xd8, 0xe6, 0xd8, 0x43, 0x8e, 0x9e, 0x83, 0x09, 0xaa,
+	0xdc, 0xf7, 0xfd, 0xbe, 0x8b, 0x0a, 0x44, 0x3d, 0xcf, 0x97, 0x54, 0x32, 0xdf, 0x13, 0x49, 0x76,
+	0x3b, 0xc9, 0xaa, 0x55, 0x27, 0xec, 0x59, 0x38, 0x08, 0xe4, 0x30, 0x49, 0xee, 0x4e, 0x26, 0x25,
+	0x1b, 0xa0, 0x90, 0x74, 0x10, 0x24, 0x80, 0xe7, 0xea, 0xc7, 0x39, 0xe8, 0xa3, 0x77, 0x20, 0xde,
+	0xd3, 0x7e, 0x1f, 0xb9, 0xe5, 0x07, 0x6a, 0xff, 0xe9, 0x5a, 0xe6, 0xb7, 0x50, 0x3a, 0xe3, 0x48,
+	0x25, 0x36, 0xee, 0x79, 0xda, 0xf8, 0x63, 0x88, 0x42, 0x12, 0x0b, 0xe0, 0x81, 0xbc, 0xae, 0x55,
+	0xb4, 0x6a, 0xfe, 0x68, 0xad, 0x46, 0x03, 0x56, 0x4b, 0x61, 0x53, 0x10, 0x73, 0x0f, 0x36, 0x2f,
+	0x50, 0x4e, 0x6f, 0xb4, 0x0a, 0x19, 0xd6, 0x55, 0x1b, 0x2c, 0xd9, 0x19, 0xd6, 0x35, 0xff, 0xd4,
+	0xa0, 0x78, 0xc5, 0x44, 0x0a, 0x29, 0x46, 0xd0, 0x1d, 0x80, 0x80, 0xf6, 0xb1, 0x2d, 0xfd, 0x1b,
+	0xf4, 0x92, 0x57, 0x96, 0xa2, 0x48, 0x2b, 0x0a, 0x90, 0x6d, 0x50, 0x8b, 0xb6, 0x60, 0x77, 0xa8,
+	0x67, 0x2a, 0x5a, 0x75, 0xc1, 0x7e, 0x16, 0x05, 0x9a, 0xec, 0x0e, 0x49, 0x09, 0x16, 0x85, 0xcf,
+	0x65, 0xbb, 0x33, 0xd4, 0xb3, 0xea, 0xc5, 0x5c, 0xb4, 0x3c, 0x1d, 0x92, 0x22, 0xe4, 0x7a, 0xcc,
+	0x95, 0xc8, 0xf5, 0xa7, 0x71, 0x3c, 0x5e, 0x91, 0x97, 0x50, 0x9c, 0xee, 0x50, 0xfb, 0x06, 0x87,
+	0xfa, 0x82, 0x22, 0x5b, 0x50, 0x64, 0xed, 0x04, 0xf2, 0x0a, 0x87, 0xf6, 0xe6, 0x08, 0x6f, 0x8f,
+	0xe0, 0xaf, 0x70, 0x68, 0x7e, 0xd4, 0xa0, 0x34, 0xc5, 0x47, 0x04, 0xbe, 0x27, 0x90, 0x1c, 0x42,
+	0xfe, 0x41, 0x21, 0xa1, 0x6b, 0x95, 0xec, 0x2c, 0x15, 0xd3, 0x98, 0x48, 0x03, 0xe9, 0x4b, 0xea,
+	0xc6, 0x2c, 0xb3, 0x8a, 0xe5, 0x92, 0x8a, 0x28, 0x9a, 0x7b, 0xb0, 0xe6, 0xe1, 0x07, 0xd9, 0x4e,
+	0xe9, 0x94, 0x51, 0xb4, 0x56, 0xa2, 0xf0, 0xeb, 0x91, 0x56, 0xe6, 0x97, 0x50, 0x3a, 0x47, 0x17,
+	0x25, 0x3e, 0xde, 0x90, 0x7f, 0xb2, 0x00, 0x0f, 0xa8, 0xc9, 0x34, 0x21, 0xf0, 0xd4, 0xa3, 0x03,
+	0x4c, 0xca, 0xa8, 0x67, 0x52, 0x81, 0x7c, 0x17, 0x85, 0xc3, 0x99, 0x72, 0x56, 0x22, 0x78, 0x3a,
+	0x44, 0x5e, 0x00, 0x38, 0xca, 0x59, 0xdd, 0x36, 0x95, 0x4a, 0xf9, 0xfc, 0x91, 0x51, 0x8b, 0xdd,
+	0x5b, 0x1b, 0xb9, 0xb7, 0xd6, 0x1a, 0xb9, 0xd7, 0x5e, 0x4a, 0xd0, 0x75, 0x49, 0x2e, 0x60, 0x63,
+	0xba, 0x31, 0x42, 0x5f, 0x50, 0xe2, 0x15, 0xc7, 0xba, 0x72, 0xdf, 0x08, 0x9b, 0x4c, 0xf5, 0x46,
+	0x90, 0x3a, 0xac, 0x08, 0xe9, 0x73, 0x65, 0x19, 0x49, 0x25, 0xea, 0xb9, 0x8a, 0x56, 0x5d, 0x3d,
+	0x2a, 0x4f, 0xe8, 0x5f, 0x6b, 0xc6, 0xa0, 0x66, 0x84, 0xb1, 0x97, 0x45, 0x6a, 0x45, 0x8e, 0x21,
+	0xe7, 0xd2, 0x0e, 0xba, 0x42, 0x5f, 0x54, 0xe5, 0xb7, 0x27, 0xdf, 0xbd, 0x52, 0xd9, 0x86, 0x27,
+	0xf9, 0xd0, 0x4e, 0xa0, 0xc6, 0x0b, 0xc8, 0xa7, 0xc2, 0xa4, 0x00, 0xd9, 0xc8, 0x55, 0xb1, 0xa2,
+	0xd1, 0x23, 0xd9, 0x84, 0x85, 0x5b, 0xea, 0x86, 0x23, 0x4d, 0xe3, 0xc5, 0x49, 0xe6, 0x6b, 0xcd,
+	0x74, 0x60, 0x39, 0x7d, 0x1a, 0x52, 0x06, 0xbd, 0xd9, 0xfa, 0xc1, 0xae, 0x5f, 0x34, 0x9a, 0xad,
+	0x7a, 0xab, 0xd1, 0x7e, 0xf3, 0x7d, 0xf3, 0x75, 0xe3, 0xec, 0xf2, 0xe5, 0x65, 0xe3, 0xbc, 0xf0,
+	0x84, 0x18, 0x50, 0x1c, 0xcb, 0xd6, 0xdf, 0xd6, 0x2f, 0xaf, 0xea, 0xa7, 0x57, 0x8d, 0x82, 0x46,
+	0xb6, 0xe0, 0x7f, 0xe3, 0x39, 0xfb, 0xec, 0x9b, 0xcb, 0xb7, 0x8d, 0xf3, 0x42, 0xc6, 0xdc, 0x07,
+	0xbd, 0xce, 0x9d, 0x6b, 0x76, 0xfb, 0x09, 0xe6, 0x78, 0x0e, 0xc6, 0x1b, 0x8f, 0x7e, 0x22, 0xfa,
+	0xe8, 0x8f, 0x05, 0x58, 0x7f, 0x40, 0x35, 0x91, 0xdf, 0x32, 0x07, 0x49, 0x00, 0x85, 0xc9, 0x29,
+	0x43, 0xe2, 0x26, 0xcc, 0x19, 0x3e, 0xc6, 0xe4, 0x15, 0x31, 0x0f, 0x7e, 0xfd, 0xeb, 0xef, 0x8f,
+	0x99, 0x2f, 0xcc, 0xad, 0x68, 0x68, 0x0a, 0xeb, 0xf6, 0xb0, 0x83, 0x92, 0x1e, 0xa6, 0xc6, 0xb3,
+	0x38, 0x49, 0xcd, 0x22, 0xe2, 0xc0, 0xca, 0xd8, 0x2c, 0x22, 0x5b, 0x6a, 0xc3, 0x59, 0xf3, 0x69,
+	0xba, 0xd6, 0x9e, 0xaa, 0x55, 0x21, 0xff, 0x9f, 0x5b, 0xcb, 0xfa, 0x89, 0x75, 0x7f, 0x26, 0x1e,
+	0xac, 0x8e, 0xdf, 0x7b, 0x12, 0xbb, 0x63, 0xf6, 0x70, 0x33, 0xca, 0xb3, 0x93, 0xf1, 0xa4, 0x30,
+	0x3f, 0x53, 0x45, 0xb7, 0xc9, 0x7c, 0x82, 0x91, 0x8c, 0x93, 0x57, 0x3a, 0x91, 0x71, 0xce, 0x4d,
+	0x37, 0x8a, 0x53, 0x17, 0xae, 0x11, 0x7d, 0x4b, 0x46, 0x0c, 0xf7, 0x1f, 0x63, 0x78, 0x07, 0xeb,
+	0x53, 0x46, 0x21, 0x3b, 0xaa, 0xe4, 0x3c, 0x03, 0xcd, 0xad, 0x59, 0x53, 0x35, 0xab, 0xe6, 0xde,
+	0x7f, 0xd7, 0x3c, 0x49, 0xbc, 0x46, 0x7e, 0xd1, 0x60, 0x63, 0x86, 0xf3, 0xc8, 0xae, 0x2a, 0x3f,
+	0xdf, 0x93, 0x73, 0x0f, 0xf0, 0x95, 0x3a, 0xc0, 0xbe, 0x59, 0x7d, 0xe4, 0x00, 0xe1, 0x68, 0xeb,
+	0xd3, 0xdf, 0xb4, 0xdf, 0xeb, 0xdf, 0xd9, 0x65, 0x58, 0xec, 0x62, 0x8f, 0x86, 0xae, 0x24, 0xeb,
+	0x64, 0x0d, 0x56, 0x8c, 0xbc, 0x3a, 0x41, 0x74, 0x3f, 0x43, 0xf1, 0x6e, 0x17, 0x76, 0x20, 0x77,
+	0x8a, 0x94, 0x23, 0x27, 0x1b, 0xcf, 0x32, 0xc6, 0x0a, 0x0d, 0xe5, 0xb5, 0xcf, 0xd9, 0x9d, 0xfa,
+	0xce, 0x56, 0x32, 0x9d, 0x65, 0x80, 0x7b, 0xc0, 0x93, 0x77, 0xc7, 0x7d, 0x26, 0xaf, 0xc3, 0x4e,
+	0xcd, 0xf1, 0x07, 0xd6, 0x4d, 0xd8, 0xc1, 0x9e, 0xeb, 0xbf, 0xb7, 0x02, 0x16, 0xa0, 0xcb, 0x3c,
+	0x14, 0x56, 0xfa, 0xef, 0x43, 0xdf, 0x6f, 0x3b, 0x2e, 0x43, 0x4f, 0x76, 0x72, 0x8a, 0xc9, 0xf1,
+	0xbf, 0x03, 0x00, 0x50, 0x92, 0x84, 0x88, 0x9a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -32,7 +32,7 @@ type Predicate_Op int32
 const (
 	Predicate_UNKNOWN Predicate_Op = 0
 	// Operators on scalar values. Only applies to one of |int_value|,
-	// |long_value|, |string_value| or |timestamp_value|.
+	// |long_value|, |string_value|, |timestamp_value| or |double_value|.
 	Predicate_EQUALS              Predicate_Op = 1
 	Predicate_NOT_EQUALS          Predicate_Op = 2
 	Predicate_GREATER_THAN        Predicate_Op = 3
@@ -79,6 +79,33 @@ func (Predicate_Op) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab96529e99c2762, []int{0, 0}
 }
 
+// Combinator is the boolean operation used to join the conditions of a
+// filter.
+type Filter_Combinator int32
+
+const (
+	Filter_AND Filter_Combinator = 0
+	Filter_OR  Filter_Combinator = 1
+)
+
+var Filter_Combinator_name = map[int32]string{
+	0: "AND",
+	1: "OR",
+}
+
+var Filter_Combinator_value = map[string]int32{
+	"AND": 0,
+	"OR":  1,
+}
+
+func (x Filter_Combinator) String() string {
+	return proto.EnumName(Filter_Combinator_name, int32(x))
+}
+
+func (Filter_Combinator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab96529e99c2762, []int{4, 0}
+}
+
 // Predicate captures individual conditions that must be true for a resource
 // being filtered.
 type Predicate struct {
@@ -92,6 +119,7 @@ type Predicate struct {
 	//	*Predicate_IntValues
 	//	*Predicate_LongValues
 	//	*Predicate_StringValues
+	//	*Predicate_DoubleValue
 	Value                isPredicate_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -169,6 +197,10 @@ type Predicate_StringValues struct {
 	StringValues *StringValues `protobuf:"bytes,9,opt,name=string_values,json=stringValues,proto3,oneof"`
 }
 
+type Predicate_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,10,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
 func (*Predicate_IntValue) isPredicate_Value() {}
 
 func (*Predicate_LongValue) isPredicate_Value() {}
@@ -183,6 +215,8 @@ func (*Predicate_LongValues) isPredicate_Value() {}
 
 func (*Predicate_StringValues) isPredicate_Value() {}
 
+func (*Predicate_DoubleValue) isPredicate_Value() {}
+
 func (m *Predicate) GetValue() isPredicate_Value {
 	if m != nil {
 		return m.Value
@@ -239,6 +273,13 @@ func (m *Predicate) GetStringValues() *StringValues {
 	return nil
 }
 
+func (m *Predicate) GetDoubleValue() float64 {
+	if x, ok := m.GetValue().(*Predicate_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Predicate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -249,6 +290,7 @@ func (*Predicate) XXX_OneofWrappers() []interface{} {
 		(*Predicate_IntValues)(nil),
 		(*Predicate_LongValues)(nil),
 		(*Predicate_StringValues)(nil),
+		(*Predicate_DoubleValue)(nil),
 	}
 }
 
@@ -409,12 +451,62 @@ func (m *LongValues) GetValues() []int64 {
 //     }
 //   }
 // }
+//
+// 4) Filter runs with parameter 'learning_rate' set to '0.01' and metric
+// 'accuracy' greater than 0.9
+//
+// filter {
+//   predicates {
+//     key: "param:learning_rate"
+//     op: EQUALS
+//     string_value: "0.01"
+//   }
+//   predicates {
+//     key: "metric:accuracy"
+//     op: GREATER_THAN
+//     double_value: 0.9
+//   }
+// }
+//
+// 5) Filter runs that failed or errored, and whose name does not contain 'tmp'
+//
+// filter {
+//   filters {
+//     combinator: OR
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Failed"
+//     }
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Error"
+//     }
+//   }
+//   filters {
+//     negate: true
+//     predicates {
+//       key: "name"
+//       op: IS_SUBSTRING
+//       string_value: "tmp"
+//     }
+//   }
+// }
 type Filter struct {
-	// All predicates are AND-ed when this filter is applied.
-	Predicates           []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	// All predicates are AND-ed when this filter is applied, unless a different
+	// |combinator| is specified.
+	Predicates []*Predicate `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	// Nested filters, each of which is evaluated as a single condition and
+	// combined with |predicates| using |combinator|. Nested filters must contain
+	// at least one predicate or nested filter.
+	Filters    []*Filter         `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	Combinator Filter_Combinator `protobuf:"varint,3,opt,name=combinator,proto3,enum=api.Filter_Combinator" json:"combinator,omitempty"`
+	// If true, the combined result of |predicates| and |filters| is negated.
+	Negate               bool     `protobuf:"varint,4,opt,name=negate,proto3" json:"negate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Filter) Reset()         { *m = Filter{} }
@@ -449,8 +541,30 @@ func (m *Filter) GetPredicates() []*Predicate {
 	return nil
 }
 
+func (m *Filter) GetFilters() []*Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *Filter) GetCombinator() Filter_Combinator {
+	if m != nil {
+		return m.Combinator
+	}
+	return Filter_AND
+}
+
+func (m *Filter) GetNegate() bool {
+	if m != nil {
+		return m.Negate
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("api.Predicate_Op", Predicate_Op_name, Predicate_Op_value)
+	proto.RegisterEnum("api.Filter_Combinator", Filter_Combinator_name, Filter_Combinator_value)
 	proto.RegisterType((*Predicate)(nil), "api.Predicate")
 	proto.RegisterType((*IntValues)(nil), "api.IntValues")
 	proto.RegisterType((*StringValues)(nil), "api.StringValues")
@@ -461,42 +575,47 @@ func init() {
 func init() { proto.RegisterFile("backend/api/filter.proto", fileDescriptor_aab96529e99c2762) }
 
 var fileDescriptor_aab96529e99c2762 = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x93, 0xdf, 0x8f, 0xd2, 0x40,
-	0x10, 0xc7, 0xfb, 0xe3, 0x28, 0xd7, 0x81, 0xe3, 0xea, 0x6a, 0xb4, 0x21, 0x9a, 0xab, 0x9c, 0xd1,
-	0x3e, 0xb5, 0x09, 0x17, 0x93, 0x7b, 0xf1, 0x01, 0x22, 0x02, 0x91, 0x14, 0x6d, 0x41, 0x13, 0x5f,
-	0x48, 0xe1, 0x16, 0xdc, 0x50, 0xba, 0x1b, 0xba, 0x9c, 0xb9, 0xbf, 0xc4, 0xff, 0xc2, 0xbf, 0xd1,
-	0xb4, 0xdb, 0x2e, 0x7d, 0xeb, 0xcc, 0x7c, 0xbe, 0xd3, 0xf9, 0x4e, 0xa7, 0x60, 0xaf, 0xe3, 0xcd,
-	0x1e, 0xa7, 0x0f, 0x7e, 0xcc, 0x88, 0xbf, 0x25, 0x09, 0xc7, 0x47, 0x8f, 0x1d, 0x29, 0xa7, 0x48,
-	0x8f, 0x19, 0xe9, 0xbe, 0xde, 0x51, 0xba, 0x4b, 0x70, 0x51, 0x8d, 0xd3, 0x94, 0xf2, 0x98, 0x13,
-	0x9a, 0x66, 0x02, 0xe9, 0xde, 0x94, 0xd5, 0x22, 0x5a, 0x9f, 0xb6, 0x3e, 0x27, 0x07, 0x9c, 0xf1,
-	0xf8, 0xc0, 0x04, 0xd0, 0xfb, 0x77, 0x01, 0xe6, 0xb7, 0x23, 0x7e, 0x20, 0x9b, 0x98, 0x63, 0xf4,
-	0x16, 0x34, 0xca, 0x6c, 0xd5, 0x51, 0xdd, 0x4e, 0xff, 0x99, 0x17, 0x33, 0xe2, 0xc9, 0x9a, 0x37,
-	0x67, 0xa1, 0x46, 0x19, 0xb2, 0x40, 0xdf, 0xe3, 0x27, 0x5b, 0x73, 0x54, 0xd7, 0x0c, 0xf3, 0x47,
-	0xf4, 0x06, 0x4c, 0x92, 0xf2, 0xd5, 0x63, 0x9c, 0x9c, 0xb0, 0xad, 0x3b, 0xaa, 0xdb, 0x98, 0x28,
-	0xe1, 0x25, 0x49, 0xf9, 0x8f, 0x3c, 0x83, 0x6e, 0x00, 0x12, 0x9a, 0xee, 0xca, 0xfa, 0x85, 0xa3,
-	0xba, 0xfa, 0x44, 0x09, 0xcd, 0x3c, 0x27, 0x80, 0x5b, 0x68, 0x67, 0xfc, 0x48, 0x24, 0xd2, 0xc8,
-	0x5b, 0x4f, 0x94, 0xb0, 0x25, 0xb2, 0x02, 0x1a, 0xc1, 0xb5, 0x1c, 0xbd, 0xe4, 0x0c, 0x47, 0x75,
-	0x5b, 0xfd, 0xae, 0x27, 0x2c, 0x7a, 0x95, 0x45, 0x6f, 0x51, 0x71, 0x13, 0x25, 0xec, 0x48, 0x91,
-	0x68, 0xe3, 0x03, 0xc8, 0x59, 0x33, 0xbb, 0x59, 0x74, 0xe8, 0x14, 0x46, 0xa7, 0xe5, 0xbc, 0x59,
-	0x3e, 0x5c, 0x35, 0x7c, 0x86, 0xfa, 0xd0, 0x3a, 0x4f, 0x9f, 0xd9, 0x97, 0x85, 0xe2, 0xba, 0x50,
-	0xcc, 0x2a, 0x07, 0xb9, 0x04, 0xa4, 0x9f, 0x0c, 0xdd, 0xc3, 0x55, 0xdd, 0x50, 0x66, 0x9b, 0x85,
-	0x4a, 0x2c, 0x34, 0x3a, 0x9b, 0xca, 0x75, 0xed, 0x9a, 0xc9, 0xac, 0xf7, 0x57, 0x05, 0x6d, 0xce,
-	0x50, 0x0b, 0x9a, 0xcb, 0xe0, 0x6b, 0x30, 0xff, 0x19, 0x58, 0x0a, 0x02, 0x30, 0x46, 0xdf, 0x97,
-	0x83, 0x59, 0x64, 0xa9, 0xa8, 0x03, 0x10, 0xcc, 0x17, 0xab, 0x32, 0xd6, 0x90, 0x05, 0xed, 0x71,
-	0x38, 0x1a, 0x2c, 0x46, 0xe1, 0x6a, 0x31, 0x19, 0x04, 0x96, 0x8e, 0x5e, 0xc1, 0xf3, 0x7a, 0xa6,
-	0x42, 0x1b, 0xe8, 0x0a, 0xcc, 0xd9, 0x28, 0x8a, 0x04, 0x67, 0xa0, 0x17, 0x60, 0xc9, 0xb0, 0x82,
-	0x9a, 0xc8, 0x00, 0x6d, 0x1a, 0x58, 0x97, 0x79, 0xdf, 0x69, 0xb4, 0x8a, 0x96, 0xc3, 0x68, 0x11,
-	0x4e, 0x83, 0xb1, 0x65, 0x0e, 0x9b, 0xd0, 0x28, 0xcc, 0xf4, 0x6e, 0xc1, 0x94, 0xab, 0x42, 0x2f,
-	0xc1, 0x28, 0x2d, 0xaa, 0x8e, 0xee, 0x36, 0xc2, 0x32, 0xea, 0xbd, 0x87, 0x76, 0xdd, 0x67, 0x8d,
-	0xd3, 0x1c, 0xdd, 0x35, 0x25, 0xf7, 0x0e, 0xe0, 0xbc, 0xc5, 0x1a, 0xa5, 0x3b, 0xba, 0xab, 0x4b,
-	0xea, 0x1e, 0x8c, 0x2f, 0xc5, 0xdd, 0x23, 0x0f, 0x80, 0x55, 0x07, 0x29, 0xde, 0x59, 0x7d, 0x3e,
-	0x79, 0xa7, 0x61, 0x8d, 0xe8, 0x7f, 0x02, 0xf4, 0xf9, 0x74, 0x38, 0x3c, 0x09, 0x79, 0x84, 0x8f,
-	0x8f, 0x64, 0x83, 0xd1, 0x07, 0x30, 0xc7, 0x98, 0x97, 0x2d, 0x5b, 0x85, 0x5c, 0x04, 0xdd, 0x7a,
-	0xd0, 0x53, 0x86, 0x1f, 0x7f, 0xdd, 0xed, 0x08, 0xff, 0x7d, 0x5a, 0x7b, 0x1b, 0x7a, 0xf0, 0xf7,
-	0xa7, 0x35, 0xde, 0x26, 0xf4, 0x8f, 0xcf, 0x08, 0xc3, 0x09, 0x49, 0x71, 0xe6, 0xd7, 0x7f, 0xcd,
-	0x1d, 0x5d, 0x6d, 0x12, 0x82, 0x53, 0xbe, 0x36, 0x8a, 0x53, 0xbc, 0xfb, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xf8, 0x38, 0x00, 0xb0, 0xba, 0x03, 0x00, 0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xfd, 0xd2, 0x38, 0xf1, 0x24, 0x4d, 0xfd, 0xec, 0x83, 0x8a, 0x15, 0x51, 0xd5, 0xa4,
+	0xbc, 0xf8, 0x64, 0x4b, 0xa9, 0x40, 0x5c, 0x38, 0xa4, 0x34, 0x34, 0x11, 0x91, 0x03, 0xeb, 0x14,
+	0x24, 0x2e, 0x91, 0x9d, 0x6e, 0xc3, 0xaa, 0x8e, 0xd7, 0x8a, 0x37, 0x45, 0xfd, 0x24, 0x7c, 0x2b,
+	0x2e, 0x7c, 0x21, 0xe4, 0x5d, 0xdb, 0xf5, 0x81, 0x5b, 0x66, 0xe6, 0x37, 0xb3, 0xff, 0xff, 0x64,
+	0xd7, 0x60, 0xc7, 0xd1, 0xfa, 0x8e, 0xa4, 0x37, 0x7e, 0x94, 0x51, 0xff, 0x96, 0x26, 0x9c, 0xec,
+	0xbc, 0x6c, 0xc7, 0x38, 0x43, 0x7a, 0x94, 0xd1, 0xc1, 0xb3, 0x0d, 0x63, 0x9b, 0x84, 0x88, 0x6a,
+	0x94, 0xa6, 0x8c, 0x47, 0x9c, 0xb2, 0x34, 0x97, 0xc8, 0xe0, 0xb4, 0xac, 0x8a, 0x28, 0xde, 0xdf,
+	0xfa, 0x9c, 0x6e, 0x49, 0xce, 0xa3, 0x6d, 0x26, 0x81, 0xe1, 0x9f, 0x03, 0x30, 0x3f, 0xef, 0xc8,
+	0x0d, 0x5d, 0x47, 0x9c, 0xa0, 0xe7, 0xa0, 0xb1, 0xcc, 0x56, 0x1d, 0xd5, 0xed, 0x8f, 0xfe, 0xf3,
+	0xa2, 0x8c, 0x7a, 0x75, 0xcd, 0x5b, 0x64, 0x58, 0x63, 0x19, 0xb2, 0x40, 0xbf, 0x23, 0x0f, 0xb6,
+	0xe6, 0xa8, 0xae, 0x89, 0x8b, 0x9f, 0xe8, 0x04, 0x4c, 0x9a, 0xf2, 0xd5, 0x7d, 0x94, 0xec, 0x89,
+	0xad, 0x3b, 0xaa, 0xdb, 0x9a, 0x2a, 0xb8, 0x43, 0x53, 0xfe, 0xb5, 0xc8, 0xa0, 0x53, 0x80, 0x84,
+	0xa5, 0x9b, 0xb2, 0x7e, 0xe0, 0xa8, 0xae, 0x3e, 0x55, 0xb0, 0x59, 0xe4, 0x24, 0x70, 0x06, 0xbd,
+	0x9c, 0xef, 0x68, 0x8d, 0xb4, 0x8a, 0xd1, 0x53, 0x05, 0x77, 0x65, 0x56, 0x42, 0x13, 0x38, 0xaa,
+	0xa5, 0x97, 0x9c, 0xe1, 0xa8, 0x6e, 0x77, 0x34, 0xf0, 0xa4, 0x45, 0xaf, 0xb2, 0xe8, 0x2d, 0x2b,
+	0x6e, 0xaa, 0xe0, 0x7e, 0xdd, 0x24, 0xc7, 0xf8, 0x00, 0xb5, 0xd6, 0xdc, 0x6e, 0x8b, 0x09, 0x7d,
+	0x61, 0x74, 0x56, 0xea, 0xcd, 0x0b, 0x71, 0x95, 0xf8, 0x1c, 0x8d, 0xa0, 0xfb, 0xa8, 0x3e, 0xb7,
+	0x3b, 0xa2, 0xe3, 0x48, 0x74, 0xcc, 0x2b, 0x07, 0x45, 0x0b, 0xd4, 0x7e, 0x72, 0xf4, 0x0e, 0x0e,
+	0x9b, 0x86, 0x72, 0xdb, 0x14, 0x5d, 0x72, 0xa1, 0xe1, 0xa3, 0xa9, 0xa2, 0xaf, 0xd7, 0x30, 0x99,
+	0x17, 0xab, 0xb8, 0x61, 0xfb, 0x38, 0x21, 0xa5, 0x45, 0x70, 0x54, 0x57, 0x2d, 0x56, 0x21, 0xb3,
+	0x82, 0x1a, 0xfe, 0x52, 0x41, 0x5b, 0x64, 0xa8, 0x0b, 0xed, 0xeb, 0xe0, 0x53, 0xb0, 0xf8, 0x16,
+	0x58, 0x0a, 0x02, 0x30, 0x26, 0x5f, 0xae, 0xc7, 0xf3, 0xd0, 0x52, 0x51, 0x1f, 0x20, 0x58, 0x2c,
+	0x57, 0x65, 0xac, 0x21, 0x0b, 0x7a, 0x57, 0x78, 0x32, 0x5e, 0x4e, 0xf0, 0x6a, 0x39, 0x1d, 0x07,
+	0x96, 0x8e, 0x9e, 0xc2, 0xff, 0xcd, 0x4c, 0x85, 0xb6, 0xd0, 0x21, 0x98, 0xf3, 0x49, 0x18, 0x4a,
+	0xce, 0x40, 0x4f, 0xc0, 0xaa, 0xc3, 0x0a, 0x6a, 0x23, 0x03, 0xb4, 0x59, 0x60, 0x75, 0x8a, 0xb9,
+	0xb3, 0x70, 0x15, 0x5e, 0x5f, 0x84, 0x4b, 0x3c, 0x0b, 0xae, 0x2c, 0xf3, 0xa2, 0x0d, 0x2d, 0xa1,
+	0x7b, 0x78, 0x06, 0x66, 0xbd, 0x4f, 0x74, 0x0c, 0x46, 0xb9, 0x07, 0xd5, 0xd1, 0xdd, 0x16, 0x2e,
+	0xa3, 0xe1, 0x2b, 0xe8, 0x35, 0x97, 0xd1, 0xe0, 0x34, 0x47, 0x77, 0xcd, 0x9a, 0x7b, 0x01, 0x30,
+	0x67, 0xff, 0xa0, 0x74, 0x47, 0x77, 0xf5, 0x9a, 0xfa, 0xad, 0x82, 0xf1, 0x51, 0xbc, 0x0e, 0xe4,
+	0x01, 0x64, 0xd5, 0xb5, 0x95, 0x87, 0x56, 0x7f, 0x72, 0x7d, 0x9b, 0x71, 0x83, 0x40, 0x2f, 0xa1,
+	0x2d, 0xdf, 0x95, 0x3c, 0xb9, 0x3b, 0xea, 0x0a, 0x58, 0x4e, 0xc3, 0x55, 0x0d, 0xbd, 0x05, 0x58,
+	0xb3, 0x6d, 0x4c, 0xd3, 0x88, 0xb3, 0x9d, 0xb8, 0xe8, 0xfd, 0xd1, 0x71, 0x83, 0xf4, 0x3e, 0xd4,
+	0x55, 0xdc, 0x20, 0x0b, 0xc5, 0x29, 0xd9, 0x44, 0x5c, 0x5e, 0xfe, 0x0e, 0x2e, 0xa3, 0xe1, 0x09,
+	0xc0, 0x63, 0x07, 0x6a, 0x83, 0x3e, 0x0e, 0x2e, 0x2d, 0xa5, 0x58, 0xef, 0x02, 0x5b, 0xea, 0xe8,
+	0x3d, 0xa0, 0xcb, 0xfd, 0x76, 0xfb, 0x20, 0x87, 0x87, 0x64, 0x77, 0x4f, 0xd7, 0x04, 0xbd, 0x06,
+	0xf3, 0x8a, 0xf0, 0xd2, 0x68, 0x53, 0xe7, 0xa0, 0x19, 0x0c, 0x95, 0x8b, 0x37, 0xdf, 0xcf, 0x37,
+	0x94, 0xff, 0xd8, 0xc7, 0xde, 0x9a, 0x6d, 0xfd, 0xbb, 0x7d, 0x4c, 0x6e, 0x13, 0xf6, 0xd3, 0xcf,
+	0x68, 0x46, 0x12, 0x9a, 0x92, 0xdc, 0x6f, 0x7e, 0x56, 0x36, 0x6c, 0xb5, 0x4e, 0x28, 0x49, 0x79,
+	0x6c, 0x88, 0x67, 0x74, 0xfe, 0x77, 0x00, 0x84, 0x67, 0xad, 0x0d, 0x76, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
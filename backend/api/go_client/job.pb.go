@@ -60,7 +60,12 @@ func (Job_Mode) EnumDescriptor() ([]byte, []int) {
 
 type CreateJobRequest struct {
 	// The job to be created
-	Job                  *Job     `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Optional. A client-supplied key of at most 128 characters identifying the
+	// request. A retried request with the same key and job returns the job
+	// created by the original request instead of creating another one, and a
+	// request with the same key but a different job is rejected.
+	IdempotencyKey       string   `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -98,6 +103,13 @@ func (m *CreateJobRequest) GetJob() *Job {
 	return nil
 }
 
+func (m *CreateJobRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type GetJobRequest struct {
 	// The ID of the job to be retrieved
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -157,7 +169,10 @@ type ListJobsRequest struct {
 	// A url-encoded, JSON-serialized Filter protocol buffer (see
 	// [filter.proto](https://github.com/kubeflow/pipelines/
 	// blob/master/backend/api/filter.proto)).
-	Filter               string   `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// BASIC leaves out the pipeline and workflow manifests of the jobs. FULL by
+	// default.
+	View                 ListView `protobuf:"varint,6,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -223,6 +238,13 @@ func (m *ListJobsRequest) GetFilter() string {
 	return ""
 }
 
+func (m *ListJobsRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_LIST_VIEW_UNSPECIFIED
+}
+
 type ListJobsResponse struct {
 	// A list of jobs returned.
 	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
@@ -639,10 +661,13 @@ type Job struct {
 	// Optional input field. Whether the job should catch up if behind schedule.
 	// If true, the job will only schedule the latest interval if behind schedule.
 	// If false, the job will catch up on each past interval.
-	NoCatchup            bool     `protobuf:"varint,17,opt,name=no_catchup,json=noCatchup,proto3" json:"no_catchup,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NoCatchup bool `protobuf:"varint,17,opt,name=no_catchup,json=noCatchup,proto3" json:"no_catchup,omitempty"`
+	// Optional input field. User-defined labels of the job. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels               map[string]string `protobuf:"bytes,19,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Job) Reset()         { *m = Job{} }
@@ -775,6 +800,13 @@ func (m *Job) GetNoCatchup() bool {
 	return false
 }
 
+func (m *Job) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("api.Job_Mode", Job_Mode_name, Job_Mode_value)
 	proto.RegisterType((*CreateJobRequest)(nil), "api.CreateJobRequest")
@@ -788,84 +820,91 @@ func init() {
 	proto.RegisterType((*PeriodicSchedule)(nil), "api.PeriodicSchedule")
 	proto.RegisterType((*Trigger)(nil), "api.Trigger")
 	proto.RegisterType((*Job)(nil), "api.Job")
+	proto.RegisterMapType((map[string]string)(nil), "api.Job.LabelsEntry")
 }
 
 func init() { proto.RegisterFile("backend/api/job.proto", fileDescriptor_03bbe6c301716cc7) }
 
 var fileDescriptor_03bbe6c301716cc7 = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0x8f, 0x3f, 0x12, 0xdb, 0x27, 0x76, 0xe2, 0x4c, 0x93, 0x74, 0xff, 0x6e, 0xfb, 0x8f, 0xbb,
-	0xa0, 0x36, 0x42, 0xd4, 0x56, 0x5b, 0x81, 0x80, 0xbb, 0x24, 0x0e, 0x2d, 0x6d, 0x93, 0x56, 0xeb,
-	0x22, 0xa4, 0x72, 0xb1, 0x9a, 0xdd, 0x3d, 0x71, 0xa6, 0xb1, 0x77, 0x96, 0x99, 0xd9, 0xb6, 0x0e,
-	0xe2, 0x06, 0x89, 0x17, 0x00, 0x5e, 0x80, 0x07, 0xe0, 0x35, 0x78, 0x01, 0x5e, 0x81, 0xb7, 0xe0,
-	0x06, 0xcd, 0xec, 0xac, 0xe3, 0x8f, 0x86, 0x5c, 0x72, 0x65, 0x9f, 0xdf, 0xfc, 0xce, 0xcc, 0xf9,
-	0x3e, 0x0b, 0x5b, 0x01, 0x0d, 0xcf, 0x30, 0x8e, 0xba, 0x34, 0x61, 0xdd, 0xd7, 0x3c, 0xe8, 0x24,
-	0x82, 0x2b, 0x4e, 0x4a, 0x34, 0x61, 0xad, 0x9b, 0x03, 0xce, 0x07, 0x43, 0x34, 0x47, 0x34, 0x8e,
-	0xb9, 0xa2, 0x8a, 0xf1, 0x58, 0x66, 0x94, 0xd6, 0x8e, 0x3d, 0x35, 0x52, 0x90, 0x9e, 0x74, 0x15,
-	0x1b, 0xa1, 0x54, 0x74, 0x94, 0x58, 0xc2, 0x8d, 0x79, 0x02, 0x8e, 0x12, 0x35, 0xce, 0xb5, 0xa7,
-	0xdf, 0x4d, 0x58, 0x82, 0x43, 0x16, 0xa3, 0x2f, 0x13, 0x0c, 0x2d, 0xe1, 0xc3, 0x69, 0x82, 0x40,
-	0xc9, 0x53, 0x11, 0xa2, 0x2f, 0xf0, 0x04, 0x05, 0xc6, 0x21, 0x5a, 0xd6, 0xc7, 0xe6, 0x27, 0xbc,
-	0x37, 0xc0, 0xf8, 0x9e, 0x7c, 0x4b, 0x07, 0x03, 0x14, 0x5d, 0x9e, 0x18, 0x33, 0xdf, 0x63, 0xf2,
-	0xf5, 0xe9, 0x3b, 0x51, 0x08, 0x2e, 0xb2, 0x03, 0xb7, 0x03, 0xcd, 0x03, 0x81, 0x54, 0xe1, 0x13,
-	0x1e, 0x78, 0xf8, 0x5d, 0x8a, 0x52, 0x91, 0x16, 0x94, 0x5e, 0xf3, 0xc0, 0x29, 0xb4, 0x0b, 0xbb,
-	0xab, 0x0f, 0xaa, 0x1d, 0x9a, 0xb0, 0x8e, 0x3e, 0xd5, 0xa0, 0xbb, 0x03, 0x8d, 0x47, 0xa8, 0xa6,
-	0xc8, 0x6b, 0x50, 0x64, 0x91, 0xe1, 0xd6, 0xbc, 0x22, 0x8b, 0xdc, 0x3f, 0x0a, 0xb0, 0xfe, 0x8c,
-	0x49, 0x4d, 0x91, 0x39, 0xe7, 0x16, 0x40, 0x42, 0x07, 0xe8, 0x2b, 0x7e, 0x86, 0xb1, 0xe5, 0xd6,
-	0x34, 0xf2, 0x52, 0x03, 0xe4, 0x06, 0x18, 0xc1, 0x97, 0xec, 0x1c, 0x9d, 0x62, 0xbb, 0xb0, 0xbb,
-	0xec, 0x55, 0x35, 0xd0, 0x67, 0xe7, 0x48, 0xae, 0x43, 0x45, 0x72, 0xa1, 0xfc, 0x60, 0xec, 0x94,
-	0x8c, 0xe2, 0x8a, 0x16, 0xf7, 0xc7, 0xe4, 0x4b, 0xd8, 0x5e, 0x0c, 0x8e, 0x7f, 0x86, 0x63, 0xa7,
-	0x6c, 0x0c, 0x6f, 0x1a, 0xc3, 0x3d, 0x4b, 0x79, 0x8a, 0x63, 0x6f, 0x33, 0xe7, 0x7b, 0x39, 0xfd,
-	0x29, 0x8e, 0xc9, 0x36, 0xac, 0x9c, 0xb0, 0xa1, 0x42, 0xe1, 0x2c, 0x67, 0xf7, 0x67, 0x92, 0xfb,
-	0x16, 0x9a, 0x17, 0x7e, 0xc8, 0x84, 0xc7, 0x12, 0xc9, 0x4d, 0x28, 0xbf, 0xe6, 0x81, 0x74, 0x0a,
-	0xed, 0xd2, 0x4c, 0x68, 0x0c, 0xaa, 0xdd, 0x54, 0x5c, 0xd1, 0x61, 0xe6, 0x48, 0xc9, 0x38, 0x52,
-	0x33, 0x88, 0xf1, 0xe4, 0x0e, 0xac, 0xc7, 0xf8, 0x4e, 0xf9, 0x53, 0xa1, 0x28, 0x9a, 0x17, 0x1b,
-	0x1a, 0x7e, 0x91, 0x87, 0xc3, 0x75, 0xa1, 0xd9, 0xc3, 0x21, 0xce, 0xa4, 0x64, 0x3e, 0xca, 0x2e,
-	0x34, 0x0f, 0x63, 0x1a, 0x0c, 0xff, 0x8d, 0xf3, 0x01, 0x6c, 0xf4, 0x98, 0xbc, 0x82, 0xf4, 0x6b,
-	0x01, 0xea, 0x07, 0x82, 0xc7, 0xfd, 0xf0, 0x14, 0xa3, 0x74, 0x88, 0xe4, 0x73, 0x00, 0xa9, 0xa8,
-	0x50, 0xbe, 0x2e, 0x6a, 0x5b, 0x03, 0xad, 0x4e, 0x56, 0xd0, 0x9d, 0xbc, 0xa0, 0x3b, 0x2f, 0xf3,
-	0x8a, 0xf7, 0x6a, 0x86, 0xad, 0x65, 0xf2, 0x09, 0x54, 0x31, 0x8e, 0x32, 0xc5, 0xe2, 0x95, 0x8a,
-	0x15, 0x8c, 0x23, 0xa3, 0x46, 0xa0, 0x1c, 0x0a, 0x1e, 0xdb, 0xf4, 0x9a, 0xff, 0xee, 0xef, 0x05,
-	0x68, 0xbe, 0x40, 0xc1, 0x78, 0xc4, 0xc2, 0xff, 0xd0, 0xb4, 0xbb, 0xb0, 0xce, 0x62, 0x85, 0xe2,
-	0x8d, 0x4e, 0x2a, 0x86, 0x3c, 0x8e, 0x8c, 0x95, 0x25, 0x6f, 0x2d, 0x87, 0xfb, 0x06, 0xd5, 0x61,
-	0xac, 0xbc, 0x14, 0x4c, 0x77, 0x21, 0xf9, 0x0c, 0x1a, 0xda, 0x07, 0x5f, 0x5a, 0xbb, 0xad, 0xa5,
-	0x1b, 0xa6, 0x5a, 0xa6, 0x63, 0xfd, 0x78, 0xc9, 0xab, 0x87, 0xd3, 0xb1, 0xef, 0xc1, 0x46, 0x62,
-	0x9d, 0xbe, 0xd0, 0xce, 0xcc, 0xdd, 0x32, 0xda, 0xf3, 0x21, 0x79, 0xbc, 0xe4, 0x35, 0x93, 0x39,
-	0x6c, 0xbf, 0x06, 0x15, 0x95, 0x99, 0xe2, 0xfe, 0x5d, 0x86, 0xd2, 0x13, 0x1e, 0xcc, 0x67, 0x5d,
-	0x87, 0x3c, 0xa6, 0x36, 0x14, 0x35, 0xcf, 0xfc, 0x27, 0x6d, 0x58, 0x8d, 0x50, 0x86, 0x82, 0x99,
-	0x21, 0x62, 0xb3, 0x31, 0x0d, 0x91, 0x4f, 0xa1, 0x31, 0x33, 0xaf, 0x6c, 0xa3, 0x65, 0x8e, 0xbd,
-	0xb0, 0x27, 0xfd, 0x04, 0x43, 0xaf, 0x9e, 0x4c, 0x49, 0xe4, 0x11, 0x5c, 0x5b, 0xec, 0x54, 0xe9,
-	0x2c, 0x9b, 0x26, 0xda, 0x9e, 0x69, 0xd3, 0x49, 0x67, 0x7a, 0x64, 0xa1, 0x59, 0xa5, 0x4e, 0x87,
-	0x44, 0xf1, 0x86, 0x85, 0xe8, 0xd3, 0x30, 0xe4, 0x69, 0xac, 0x1c, 0x62, 0xcc, 0x5c, 0xb3, 0xf0,
-	0x5e, 0x86, 0x6a, 0xe2, 0x88, 0xbe, 0xf3, 0x43, 0x1e, 0x87, 0xa9, 0xd0, 0xca, 0x63, 0x67, 0x25,
-	0xcb, 0xdb, 0x88, 0xbe, 0x3b, 0xb8, 0x40, 0xc9, 0x9d, 0x49, 0xac, 0x9c, 0x8a, 0x71, 0xa6, 0x6e,
-	0xcc, 0xb1, 0xa9, 0xf4, 0xf2, 0x43, 0x72, 0x1b, 0xca, 0x23, 0x1e, 0xa1, 0x53, 0x6d, 0x17, 0x76,
-	0xd7, 0x1e, 0x34, 0xf2, 0xc6, 0xef, 0x1c, 0xf1, 0x08, 0x3d, 0x73, 0xa4, 0xab, 0x33, 0x34, 0x93,
-	0x34, 0xf2, 0xa9, 0x72, 0x6a, 0x57, 0x57, 0xa7, 0x65, 0xef, 0x29, 0xad, 0x9a, 0x26, 0x51, 0xae,
-	0x0a, 0x57, 0xab, 0x5a, 0xf6, 0x9e, 0xd2, 0xd3, 0x4b, 0x2a, 0xaa, 0x52, 0xe9, 0xac, 0xda, 0xe9,
-	0x68, 0x24, 0xb2, 0x09, 0xcb, 0x66, 0xcc, 0x3b, 0x75, 0x03, 0x67, 0x02, 0x71, 0xa0, 0x82, 0x66,
-	0x6c, 0x44, 0x4e, 0xb3, 0x5d, 0xd8, 0xad, 0x7a, 0xb9, 0xa8, 0x67, 0x57, 0xcc, 0xfd, 0x90, 0xaa,
-	0xf0, 0x34, 0x4d, 0x9c, 0x0d, 0x73, 0x58, 0x8b, 0xf9, 0x41, 0x06, 0xb8, 0x0f, 0xa1, 0xac, 0x5d,
-	0x25, 0x4d, 0xa8, 0x7f, 0x7d, 0xfc, 0xf4, 0xf8, 0xf9, 0x37, 0xc7, 0xfe, 0xd1, 0xf3, 0xde, 0x61,
-	0x73, 0x89, 0xac, 0x42, 0xe5, 0xf0, 0x78, 0x6f, 0xff, 0xd9, 0x61, 0xaf, 0x59, 0x20, 0x75, 0xa8,
-	0xf6, 0xbe, 0xea, 0x67, 0x52, 0xf1, 0xc1, 0x6f, 0x65, 0x80, 0x27, 0x3c, 0xe8, 0x67, 0xb9, 0x21,
-	0x47, 0x50, 0x9b, 0xac, 0x1a, 0xb2, 0x65, 0xbb, 0x61, 0x76, 0xf5, 0xb4, 0x26, 0x23, 0xd5, 0xdd,
-	0xf9, 0xf1, 0xcf, 0xbf, 0x7e, 0x29, 0xfe, 0xcf, 0x25, 0x7a, 0x65, 0xc9, 0xee, 0x9b, 0xfb, 0x01,
-	0x2a, 0x7a, 0x5f, 0x2f, 0x6a, 0xf9, 0x85, 0xde, 0x44, 0xe4, 0x11, 0xac, 0x64, 0x9b, 0x88, 0x10,
-	0xa3, 0x34, 0xb3, 0x96, 0x16, 0x2f, 0x22, 0xd7, 0x17, 0x2f, 0xea, 0x7e, 0xcf, 0xa2, 0x1f, 0x48,
-	0x1f, 0xaa, 0xf9, 0xa0, 0x27, 0x9b, 0x46, 0x6d, 0x6e, 0x7f, 0xb5, 0xb6, 0xe6, 0xd0, 0x6c, 0x1b,
-	0xb8, 0x2d, 0x73, 0xf3, 0x26, 0x79, 0x8f, 0x89, 0x24, 0x80, 0xda, 0x64, 0x40, 0x5b, 0x67, 0xe7,
-	0x07, 0x76, 0x6b, 0x7b, 0x21, 0xc5, 0x87, 0xfa, 0x3b, 0xc1, 0xbd, 0x63, 0xee, 0x6d, 0xbb, 0xff,
-	0xbf, 0xc4, 0xe2, 0x6e, 0x96, 0x34, 0x82, 0x00, 0x17, 0x03, 0x9e, 0x64, 0x8d, 0xb4, 0x30, 0xf1,
-	0x2f, 0x7d, 0xe5, 0xae, 0x79, 0xe5, 0xb6, 0xbb, 0x73, 0xd9, 0x2b, 0x51, 0x76, 0x15, 0xf9, 0x16,
-	0x6a, 0x93, 0x7d, 0x64, 0x5d, 0x99, 0xdf, 0x4f, 0x97, 0x3e, 0x62, 0x83, 0xff, 0xd1, 0x65, 0xc1,
-	0xdf, 0xff, 0xa9, 0xf0, 0xf3, 0xde, 0x91, 0x77, 0x13, 0x2a, 0x11, 0x9e, 0xd0, 0x74, 0xa8, 0xc8,
-	0x06, 0x59, 0x87, 0x46, 0x6b, 0xd5, 0x3c, 0xd3, 0x37, 0xb5, 0xfc, 0x6a, 0x07, 0x6e, 0xc1, 0xca,
-	0x3e, 0x52, 0x81, 0x82, 0x5c, 0xab, 0x16, 0x5b, 0x0d, 0x9a, 0xaa, 0x53, 0x2e, 0xd8, 0xb9, 0xf9,
-	0xc4, 0x69, 0x17, 0x83, 0x3a, 0xc0, 0x84, 0xb0, 0xf4, 0xea, 0xe1, 0x80, 0xa9, 0xd3, 0x34, 0xe8,
-	0x84, 0x7c, 0xd4, 0x3d, 0x4b, 0x03, 0x3c, 0x19, 0xf2, 0xb7, 0x93, 0x0f, 0x2d, 0xd9, 0x9d, 0xfe,
-	0x12, 0x1a, 0x70, 0x3f, 0x1c, 0x32, 0x8c, 0x55, 0xb0, 0x62, 0x0c, 0x7f, 0xf8, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x68, 0xc4, 0x54, 0x3d, 0x16, 0x0a, 0x00, 0x00,
+	// 1238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x72, 0xd3, 0x46,
+	0x14, 0x8e, 0x7f, 0xe2, 0x9f, 0x13, 0x3b, 0x71, 0x96, 0x24, 0xa8, 0x06, 0x1a, 0xa3, 0x76, 0x20,
+	0xd3, 0x01, 0x7b, 0x80, 0x69, 0x07, 0xb8, 0xcb, 0x8f, 0x0b, 0x4d, 0x48, 0x60, 0x64, 0x5a, 0x66,
+	0xe8, 0x85, 0x66, 0x25, 0x9d, 0x38, 0x4b, 0x64, 0xad, 0xaa, 0x5d, 0x25, 0x38, 0x9d, 0xde, 0x74,
+	0xa6, 0x2f, 0xd0, 0xf6, 0x05, 0xfa, 0x00, 0x7d, 0x9a, 0xbe, 0x02, 0x37, 0x7d, 0x8b, 0xce, 0xae,
+	0x24, 0x47, 0xb1, 0x09, 0xb9, 0xec, 0x95, 0x7d, 0xbe, 0xfd, 0xce, 0xd9, 0x73, 0xce, 0x9e, 0x1f,
+	0xc1, 0xaa, 0x43, 0xdd, 0x63, 0x0c, 0xbc, 0x1e, 0x0d, 0x59, 0xef, 0x1d, 0x77, 0xba, 0x61, 0xc4,
+	0x25, 0x27, 0x25, 0x1a, 0xb2, 0xf6, 0xcd, 0x21, 0xe7, 0x43, 0x1f, 0xf5, 0x11, 0x0d, 0x02, 0x2e,
+	0xa9, 0x64, 0x3c, 0x10, 0x09, 0xa5, 0xbd, 0x9e, 0x9e, 0x6a, 0xc9, 0x89, 0x0f, 0x7b, 0x92, 0x8d,
+	0x50, 0x48, 0x3a, 0x0a, 0x53, 0xc2, 0x8d, 0x69, 0x02, 0x8e, 0x42, 0x39, 0xce, 0xb4, 0xf3, 0xf7,
+	0x86, 0x2c, 0x44, 0x9f, 0x05, 0x68, 0x8b, 0x10, 0xdd, 0x94, 0xf0, 0x65, 0x9e, 0x10, 0xa1, 0xe0,
+	0x71, 0xe4, 0xa2, 0x1d, 0xe1, 0x21, 0x46, 0x18, 0xb8, 0x98, 0xb2, 0xd6, 0xf2, 0xac, 0x13, 0x86,
+	0xa7, 0x29, 0x7e, 0x4f, 0xff, 0xb8, 0xf7, 0x87, 0x18, 0xdc, 0x17, 0xa7, 0x74, 0x38, 0xc4, 0xa8,
+	0xc7, 0x43, 0xed, 0xfe, 0x47, 0x42, 0xb9, 0x9e, 0xb7, 0x82, 0x51, 0xc4, 0xa3, 0xe4, 0xc0, 0x7c,
+	0x03, 0xad, 0xed, 0x08, 0xa9, 0xc4, 0x5d, 0xee, 0x58, 0xf8, 0x53, 0x8c, 0x42, 0x92, 0x36, 0x94,
+	0xde, 0x71, 0xc7, 0x28, 0x74, 0x0a, 0x1b, 0x0b, 0x0f, 0x6b, 0x5d, 0x1a, 0xb2, 0xae, 0x3a, 0x55,
+	0x20, 0xb9, 0x0b, 0x4b, 0xcc, 0xc3, 0x51, 0xc8, 0x25, 0x06, 0xee, 0xd8, 0x3e, 0xc6, 0xb1, 0x51,
+	0xec, 0x14, 0x36, 0xea, 0xd6, 0x62, 0x0e, 0xde, 0xc3, 0xb1, 0xb9, 0x0e, 0xcd, 0x67, 0x28, 0x73,
+	0x56, 0x17, 0xa1, 0xc8, 0x3c, 0x6d, 0xb4, 0x6e, 0x15, 0x99, 0x67, 0xfe, 0x5b, 0x80, 0xa5, 0x17,
+	0x4c, 0x28, 0x8a, 0xc8, 0x38, 0xb7, 0x00, 0x42, 0x3a, 0x44, 0x5b, 0xf2, 0x63, 0x0c, 0x52, 0x6e,
+	0x5d, 0x21, 0xaf, 0x15, 0x40, 0x6e, 0x80, 0x16, 0x6c, 0xc1, 0xce, 0x50, 0x5f, 0x3b, 0x6f, 0xd5,
+	0x14, 0x30, 0x60, 0x67, 0x48, 0xae, 0x43, 0x55, 0xf0, 0x48, 0xda, 0xce, 0xd8, 0x28, 0x69, 0xc5,
+	0x8a, 0x12, 0xb7, 0xc6, 0xe4, 0x5b, 0x58, 0x9b, 0xcd, 0xae, 0xf6, 0xbc, 0xac, 0x23, 0x6c, 0xe9,
+	0x08, 0xad, 0x94, 0xb2, 0x87, 0x63, 0x6b, 0x25, 0xe3, 0x5b, 0x19, 0x7d, 0x0f, 0xc7, 0x64, 0x0d,
+	0x2a, 0x87, 0xcc, 0x97, 0x18, 0x19, 0xf3, 0x89, 0xfd, 0x44, 0x22, 0xb7, 0xa1, 0xac, 0xde, 0xc5,
+	0xa8, 0x74, 0x0a, 0x1b, 0x8b, 0x0f, 0x9b, 0xda, 0x9a, 0x0a, 0xec, 0x07, 0x86, 0xa7, 0x96, 0x3e,
+	0x32, 0x4f, 0xa1, 0x75, 0x1e, 0xaa, 0x08, 0x79, 0x20, 0x90, 0xdc, 0x84, 0xf2, 0x3b, 0xee, 0x08,
+	0xa3, 0xd0, 0x29, 0x5d, 0x48, 0xb3, 0x46, 0x55, 0x26, 0x24, 0x97, 0xd4, 0x4f, 0x62, 0x2d, 0xe9,
+	0x58, 0xeb, 0x1a, 0xd1, 0xc1, 0xde, 0x81, 0xa5, 0x00, 0xdf, 0x4b, 0x3b, 0x97, 0xad, 0xe4, 0x19,
+	0x9a, 0x0a, 0x7e, 0x95, 0x65, 0xcc, 0x34, 0xa1, 0xb5, 0x83, 0x3e, 0x4a, 0xfc, 0xc4, 0x43, 0x98,
+	0xd0, 0xea, 0x07, 0xd4, 0xf1, 0x3f, 0xc5, 0xf9, 0x02, 0x96, 0x77, 0x98, 0xb8, 0x82, 0xf4, 0x67,
+	0x01, 0x1a, 0xdb, 0x11, 0x0f, 0x06, 0xee, 0x11, 0x7a, 0xb1, 0x8f, 0xe4, 0x09, 0x80, 0x90, 0x34,
+	0x92, 0xb6, 0x6a, 0x9c, 0xb4, 0x9e, 0xda, 0xdd, 0xa4, 0x69, 0xba, 0x59, 0xd3, 0x74, 0x5f, 0x67,
+	0x5d, 0x65, 0xd5, 0x35, 0x5b, 0xc9, 0xe4, 0x6b, 0xa8, 0x61, 0xe0, 0x25, 0x8a, 0xc5, 0x2b, 0x15,
+	0xab, 0x18, 0x78, 0x5a, 0x8d, 0x40, 0xd9, 0x8d, 0x78, 0x90, 0x56, 0x80, 0xfe, 0x6f, 0xfe, 0x5d,
+	0x80, 0xd6, 0x2b, 0x8c, 0x18, 0xf7, 0x98, 0xfb, 0x3f, 0xba, 0xa6, 0x3a, 0x27, 0x90, 0x18, 0x9d,
+	0xa8, 0x47, 0x45, 0x97, 0x07, 0x9e, 0xf6, 0xb2, 0x64, 0x2d, 0x66, 0xf0, 0x40, 0xa3, 0x2a, 0x8d,
+	0xd5, 0xd7, 0x11, 0x53, 0x1d, 0x4d, 0x1e, 0x43, 0x53, 0xc5, 0x60, 0x8b, 0xd4, 0xef, 0xd4, 0xd3,
+	0x65, 0x5d, 0x2d, 0xf9, 0x5c, 0x3f, 0x9f, 0xb3, 0x1a, 0x6e, 0x3e, 0xf7, 0x3b, 0xb0, 0x1c, 0xa6,
+	0x41, 0x9f, 0x6b, 0x27, 0xee, 0xae, 0x6a, 0xed, 0xe9, 0x94, 0x3c, 0x9f, 0xb3, 0x5a, 0xe1, 0x14,
+	0xb6, 0x55, 0x87, 0xaa, 0x4c, 0x5c, 0x31, 0x3f, 0xcc, 0x43, 0x69, 0x97, 0x3b, 0xd3, 0xaf, 0xae,
+	0x52, 0x1e, 0xd0, 0x34, 0x15, 0x75, 0x4b, 0xff, 0x27, 0x1d, 0x58, 0xf0, 0x50, 0xb8, 0x11, 0xd3,
+	0x03, 0x29, 0x7d, 0x8d, 0x3c, 0x44, 0xbe, 0x81, 0xe6, 0x85, 0x99, 0x68, 0x94, 0x73, 0x81, 0xbd,
+	0x4a, 0x4f, 0x06, 0x21, 0xba, 0x56, 0x23, 0xcc, 0x49, 0xe4, 0x19, 0x5c, 0x9b, 0x6d, 0x66, 0x61,
+	0xcc, 0xeb, 0x26, 0x5a, 0xbb, 0xd0, 0xc9, 0x93, 0xe6, 0xb5, 0xc8, 0x4c, 0x3f, 0x0b, 0xf5, 0x1c,
+	0x02, 0xa3, 0x13, 0xe6, 0xa2, 0x4d, 0x5d, 0x97, 0xc7, 0x81, 0x34, 0x48, 0x32, 0xc8, 0x52, 0x78,
+	0x33, 0x41, 0x15, 0x71, 0x44, 0xdf, 0xdb, 0x2e, 0x0f, 0xdc, 0x38, 0x52, 0xca, 0x63, 0xdd, 0xe9,
+	0x25, 0x6b, 0x71, 0x44, 0xdf, 0x6f, 0x9f, 0xa3, 0xe4, 0xce, 0x24, 0x57, 0x46, 0x55, 0x07, 0xd3,
+	0xd0, 0xee, 0xa4, 0x4f, 0x69, 0x65, 0x87, 0x6a, 0x5e, 0x8c, 0xb8, 0x87, 0x46, 0x2d, 0x37, 0x2f,
+	0x76, 0xb9, 0xd3, 0xdd, 0xe7, 0x1e, 0x5a, 0xfa, 0x48, 0x55, 0xa7, 0xab, 0xa7, 0xb2, 0x67, 0x53,
+	0x69, 0xd4, 0xaf, 0xae, 0xce, 0x94, 0xbd, 0x29, 0x95, 0x6a, 0x1c, 0x7a, 0x99, 0x2a, 0x5c, 0xad,
+	0x9a, 0xb2, 0x37, 0xa5, 0x1a, 0x70, 0x42, 0x52, 0x19, 0x0b, 0x63, 0x21, 0x1d, 0xa0, 0x5a, 0x22,
+	0x2b, 0x30, 0xaf, 0x57, 0x86, 0xd1, 0xd0, 0x70, 0x22, 0x10, 0x03, 0xaa, 0xa8, 0xc7, 0x86, 0x67,
+	0xb4, 0x3a, 0x85, 0x8d, 0x9a, 0x95, 0x89, 0x6a, 0x76, 0x05, 0xdc, 0x76, 0xa9, 0x74, 0x8f, 0xe2,
+	0xd0, 0x58, 0xd6, 0x87, 0xf5, 0x80, 0x6f, 0x27, 0x00, 0xb9, 0x07, 0x15, 0x9f, 0x3a, 0xe8, 0x0b,
+	0xe3, 0x9a, 0x7e, 0xb5, 0x95, 0x49, 0x06, 0x5e, 0x68, 0xb8, 0x1f, 0xc8, 0x68, 0x6c, 0xa5, 0x9c,
+	0xf6, 0x13, 0x58, 0xc8, 0xc1, 0xa4, 0x05, 0x25, 0x35, 0xb9, 0x93, 0xf2, 0x53, 0x7f, 0x95, 0x77,
+	0x27, 0xd4, 0x8f, 0xb3, 0x02, 0x4c, 0x84, 0xa7, 0xc5, 0xc7, 0x05, 0xf3, 0x11, 0x94, 0x55, 0x4e,
+	0x49, 0x0b, 0x1a, 0xdf, 0x1f, 0xec, 0x1d, 0xbc, 0x7c, 0x73, 0x60, 0xef, 0xbf, 0xdc, 0xe9, 0xb7,
+	0xe6, 0xc8, 0x02, 0x54, 0xfb, 0x07, 0x9b, 0x5b, 0x2f, 0xfa, 0x3b, 0xad, 0x02, 0x69, 0x40, 0x6d,
+	0xe7, 0xbb, 0x41, 0x22, 0x15, 0x1f, 0xfe, 0x55, 0x06, 0xd8, 0xe5, 0xce, 0x20, 0x29, 0x02, 0xb2,
+	0x0f, 0xf5, 0xc9, 0x7e, 0x24, 0xab, 0x69, 0xdb, 0x5d, 0xdc, 0x97, 0xed, 0xc9, 0xec, 0x36, 0xd7,
+	0x7f, 0xfd, 0xe7, 0xc3, 0x1f, 0xc5, 0xcf, 0x4c, 0xa2, 0xf6, 0xac, 0xe8, 0x9d, 0x3c, 0x70, 0x50,
+	0xd2, 0x07, 0xea, 0xab, 0x43, 0x3c, 0xd5, 0xeb, 0xf3, 0x19, 0x54, 0x92, 0xad, 0x48, 0x88, 0x56,
+	0xba, 0xb0, 0x22, 0x67, 0x0d, 0x91, 0xeb, 0xb3, 0x86, 0x7a, 0x3f, 0x33, 0xef, 0x17, 0x32, 0x80,
+	0x5a, 0xb6, 0x51, 0xc8, 0xca, 0x64, 0xe5, 0xe4, 0x76, 0x69, 0x7b, 0x75, 0x0a, 0x4d, 0xd6, 0x8e,
+	0xd9, 0xd6, 0x96, 0x57, 0xc8, 0x47, 0x5c, 0x24, 0x0e, 0xd4, 0x27, 0x9b, 0x20, 0x0d, 0x76, 0x7a,
+	0x33, 0xb4, 0xd7, 0x66, 0x6a, 0xa9, 0xaf, 0x3e, 0x7a, 0xcc, 0x3b, 0xda, 0x6e, 0xc7, 0xfc, 0xfc,
+	0x12, 0x8f, 0x7b, 0x49, 0x75, 0x10, 0x04, 0x38, 0xdf, 0x24, 0x24, 0xe9, 0xd8, 0x99, 0xd5, 0x72,
+	0xe9, 0x2d, 0x77, 0xf5, 0x2d, 0xb7, 0xcd, 0xf5, 0xcb, 0x6e, 0xf1, 0x12, 0x53, 0xe4, 0x47, 0xa8,
+	0x4f, 0x16, 0x5f, 0x1a, 0xca, 0xf4, 0x22, 0xbc, 0xf4, 0x92, 0x34, 0xf9, 0x5f, 0x5d, 0x96, 0xfc,
+	0xad, 0xdf, 0x0a, 0xbf, 0x6f, 0xee, 0x5b, 0x37, 0xa1, 0xea, 0xe1, 0x21, 0x8d, 0x7d, 0x49, 0x96,
+	0xc9, 0x12, 0x34, 0xdb, 0x0b, 0xfa, 0x9a, 0x81, 0x6e, 0x9a, 0xb7, 0xeb, 0x70, 0x0b, 0x2a, 0x5b,
+	0x48, 0x23, 0x8c, 0xc8, 0xb5, 0x5a, 0xb1, 0xdd, 0xa4, 0xb1, 0x3c, 0xe2, 0x11, 0x3b, 0xd3, 0xdf,
+	0x65, 0x9d, 0xa2, 0xd3, 0x00, 0x98, 0x10, 0xe6, 0xde, 0x3e, 0x1a, 0x32, 0x79, 0x14, 0x3b, 0x5d,
+	0x97, 0x8f, 0x7a, 0xc7, 0xb1, 0x83, 0x87, 0x3e, 0x3f, 0x9d, 0x7c, 0x35, 0x8a, 0x5e, 0xfe, 0xf3,
+	0x6d, 0xc8, 0x6d, 0xd7, 0x67, 0x18, 0x48, 0xa7, 0xa2, 0x1d, 0x7f, 0xf4, 0xdf, 0x00, 0x72, 0x2c,
+	0xe6, 0x8f, 0xe3, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
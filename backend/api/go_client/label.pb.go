@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/label.proto
+
+package go_client
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type UpdateLabelsRequest struct {
+	// The resource whose labels are updated. The type must be one of RUN, JOB,
+	// EXPERIMENT or PIPELINE_VERSION.
+	ResourceKey *ResourceKey `protobuf:"bytes,1,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	// Labels to add to the resource. Existing labels with the same keys are
+	// overwritten.
+	AddLabels map[string]string `protobuf:"bytes,2,rep,name=add_labels,json=addLabels,proto3" json:"add_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Keys of the labels to remove from the resource. Keys that are not set on
+	// the resource are ignored. A key can not be both added and removed.
+	RemoveLabelKeys      []string `protobuf:"bytes,3,rep,name=remove_label_keys,json=removeLabelKeys,proto3" json:"remove_label_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateLabelsRequest) Reset()         { *m = UpdateLabelsRequest{} }
+func (m *UpdateLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLabelsRequest) ProtoMessage()    {}
+func (*UpdateLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5bf8ff23f303fbbd, []int{0}
+}
+
+func (m *UpdateLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLabelsRequest.Unmarshal(m, b)
+}
+func (m *UpdateLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLabelsRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLabelsRequest.Merge(m, src)
+}
+func (m *UpdateLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLabelsRequest.Size(m)
+}
+func (m *UpdateLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLabelsRequest proto.InternalMessageInfo
+
+func (m *UpdateLabelsRequest) GetResourceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceKey
+	}
+	return nil
+}
+
+func (m *UpdateLabelsRequest) GetAddLabels() map[string]string {
+	if m != nil {
+		return m.AddLabels
+	}
+	return nil
+}
+
+func (m *UpdateLabelsRequest) GetRemoveLabelKeys() []string {
+	if m != nil {
+		return m.RemoveLabelKeys
+	}
+	return nil
+}
+
+type UpdateLabelsResponse struct {
+	// The labels of the resource after the update.
+	Labels               map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateLabelsResponse) Reset()         { *m = UpdateLabelsResponse{} }
+func (m *UpdateLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateLabelsResponse) ProtoMessage()    {}
+func (*UpdateLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5bf8ff23f303fbbd, []int{1}
+}
+
+func (m *UpdateLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLabelsResponse.Unmarshal(m, b)
+}
+func (m *UpdateLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLabelsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLabelsResponse.Merge(m, src)
+}
+func (m *UpdateLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateLabelsResponse.Size(m)
+}
+func (m *UpdateLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLabelsResponse proto.InternalMessageInfo
+
+func (m *UpdateLabelsResponse) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UpdateLabelsRequest)(nil), "api.UpdateLabelsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateLabelsRequest.AddLabelsEntry")
+	proto.RegisterType((*UpdateLabelsResponse)(nil), "api.UpdateLabelsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateLabelsResponse.LabelsEntry")
+}
+
+func init() { proto.RegisterFile("backend/api/label.proto", fileDescriptor_5bf8ff23f303fbbd) }
+
+var fileDescriptor_5bf8ff23f303fbbd = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x8e, 0x08, 0x64, 0x9c, 0xd2, 0x76, 0x5b, 0x09, 0x63, 0x8a, 0xb0, 0x22, 0x3e, 0xa2,
+	0x8a, 0xda, 0x6a, 0x72, 0x81, 0x08, 0x0e, 0xad, 0x04, 0x97, 0xc2, 0xc5, 0x15, 0x97, 0x5e, 0xa2,
+	0xb5, 0x3d, 0x71, 0x57, 0x71, 0x77, 0xcd, 0x7e, 0xa4, 0x0a, 0xdc, 0x90, 0xb8, 0x23, 0xf8, 0x69,
+	0xfc, 0x05, 0x7e, 0x06, 0x07, 0x94, 0xb5, 0x5b, 0x12, 0xd1, 0x5e, 0x7a, 0xb2, 0x67, 0xde, 0x9b,
+	0x99, 0xf7, 0x56, 0x0f, 0xee, 0xa7, 0x34, 0x9b, 0x22, 0xcf, 0x63, 0x5a, 0xb1, 0xb8, 0xa4, 0x29,
+	0x96, 0x51, 0x25, 0x85, 0x16, 0xa4, 0x45, 0x2b, 0x16, 0xac, 0xa0, 0x28, 0xa5, 0x90, 0x35, 0x1a,
+	0x3c, 0x59, 0x06, 0x24, 0x2a, 0x61, 0x64, 0x86, 0x63, 0x89, 0x13, 0x94, 0xc8, 0x33, 0x6c, 0x58,
+	0x3b, 0x85, 0x10, 0x45, 0x89, 0x96, 0x44, 0x39, 0x17, 0x9a, 0x6a, 0x26, 0xb8, 0x6a, 0xd0, 0x17,
+	0xf6, 0x93, 0xed, 0x15, 0xc8, 0xf7, 0xd4, 0x39, 0x2d, 0x0a, 0x94, 0xb1, 0xa8, 0x2c, 0xe3, 0x7f,
+	0x76, 0xef, 0x8f, 0x03, 0x5b, 0x1f, 0xab, 0x9c, 0x6a, 0x7c, 0xbf, 0x50, 0xa9, 0x12, 0xfc, 0x64,
+	0x50, 0x69, 0x32, 0x84, 0xee, 0xe5, 0xfd, 0x29, 0xce, 0x7d, 0x27, 0x74, 0xfa, 0xde, 0x60, 0x23,
+	0xa2, 0x15, 0x8b, 0x92, 0x06, 0x38, 0xc2, 0x79, 0xe2, 0xc9, 0x7f, 0x05, 0x79, 0x07, 0x40, 0xf3,
+	0x7c, 0x6c, 0xfd, 0x2a, 0xdf, 0x0d, 0x5b, 0x7d, 0x6f, 0xf0, 0xdc, 0x8e, 0x5c, 0x71, 0x22, 0x3a,
+	0xc8, 0xf3, 0xba, 0xf1, 0x96, 0x6b, 0x39, 0x4f, 0x3a, 0xf4, 0xa2, 0x26, 0xbb, 0xb0, 0x29, 0xf1,
+	0x4c, 0xcc, 0xb0, 0x5e, 0xb5, 0x10, 0xa0, 0xfc, 0x56, 0xd8, 0xea, 0x77, 0x92, 0xf5, 0x1a, 0xb0,
+	0xc4, 0x23, 0x9c, 0xab, 0xe0, 0x35, 0xdc, 0x5b, 0x5d, 0x44, 0x36, 0xa0, 0x75, 0xa1, 0xb8, 0x93,
+	0x2c, 0x7e, 0xc9, 0x36, 0xdc, 0x9e, 0xd1, 0xd2, 0xa0, 0xef, 0xda, 0x5e, 0x5d, 0x8c, 0xdc, 0x97,
+	0x4e, 0xef, 0xbb, 0x03, 0xdb, 0xab, 0xda, 0x54, 0x25, 0xb8, 0x42, 0xf2, 0x06, 0xda, 0x8d, 0x0d,
+	0xc7, 0xda, 0x78, 0x7a, 0x85, 0x8d, 0x9a, 0x1a, 0x2d, 0x9b, 0x68, 0x86, 0x82, 0x57, 0xe0, 0xdd,
+	0x50, 0xd2, 0xe0, 0x0b, 0x74, 0xed, 0xe8, 0x31, 0xca, 0x19, 0xcb, 0x90, 0x4c, 0xa1, 0xbb, 0x7c,
+	0x96, 0xf8, 0xd7, 0x3d, 0x68, 0xf0, 0xe0, 0x5a, 0x8d, 0xbd, 0x67, 0x5f, 0x7f, 0xfd, 0xfe, 0xe9,
+	0x86, 0xbd, 0x87, 0x8b, 0xd0, 0xa8, 0x78, 0xb6, 0x9f, 0xa2, 0xa6, 0xfb, 0x75, 0x32, 0xd5, 0xc8,
+	0xd8, 0x91, 0x91, 0xb3, 0x7b, 0xf8, 0xcd, 0xf9, 0x71, 0xf0, 0x21, 0xd9, 0x81, 0x3b, 0x39, 0x4e,
+	0xa8, 0x29, 0x35, 0xd9, 0x24, 0xeb, 0xb0, 0x16, 0x78, 0x76, 0xf3, 0xb1, 0xa6, 0xda, 0xa8, 0x93,
+	0xc7, 0xf0, 0x08, 0xda, 0x87, 0x48, 0x25, 0x4a, 0xb2, 0x75, 0xd7, 0x0d, 0xd6, 0xa8, 0xd1, 0xa7,
+	0x42, 0xb2, 0xcf, 0x36, 0x58, 0xa1, 0x9b, 0x76, 0x01, 0x2e, 0x09, 0xb7, 0x4e, 0x86, 0x05, 0xd3,
+	0xa7, 0x26, 0x8d, 0x32, 0x71, 0x16, 0x4f, 0x4d, 0x8a, 0x93, 0x52, 0x9c, 0xc7, 0x15, 0xab, 0xb0,
+	0x64, 0x1c, 0x55, 0xbc, 0x9c, 0xf8, 0x42, 0x8c, 0xb3, 0x92, 0x21, 0xd7, 0x69, 0xdb, 0xa6, 0x73,
+	0xf8, 0x77, 0x00, 0x58, 0x44, 0x63, 0xd4, 0x48, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LabelServiceClient is the client API for LabelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LabelServiceClient interface {
+	// Adds labels to and removes labels from a run, job, experiment or pipeline
+	// version.
+	UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*UpdateLabelsResponse, error)
+}
+
+type labelServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLabelServiceClient(cc *grpc.ClientConn) LabelServiceClient {
+	return &labelServiceClient{cc}
+}
+
+func (c *labelServiceClient) UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*UpdateLabelsResponse, error) {
+	out := new(UpdateLabelsResponse)
+	err := c.cc.Invoke(ctx, "/api.LabelService/UpdateLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LabelServiceServer is the server API for LabelService service.
+type LabelServiceServer interface {
+	// Adds labels to and removes labels from a run, job, experiment or pipeline
+	// version.
+	UpdateLabels(context.Context, *UpdateLabelsRequest) (*UpdateLabelsResponse, error)
+}
+
+// UnimplementedLabelServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLabelServiceServer struct {
+}
+
+func (*UnimplementedLabelServiceServer) UpdateLabels(ctx context.Context, req *UpdateLabelsRequest) (*UpdateLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabels not implemented")
+}
+
+func RegisterLabelServiceServer(s *grpc.Server, srv LabelServiceServer) {
+	s.RegisterService(&_LabelService_serviceDesc, srv)
+}
+
+func _LabelService_UpdateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LabelServiceServer).UpdateLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.LabelService/UpdateLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LabelServiceServer).UpdateLabels(ctx, req.(*UpdateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LabelService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.LabelService",
+	HandlerType: (*LabelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateLabels",
+			Handler:    _LabelService_UpdateLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/api/label.proto",
+}
@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/lineage.proto
+
+package go_client
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetArtifactLineageRequest_Direction int32
+
+const (
+	// The executions which produced the artifacts, their inputs, and so on.
+	GetArtifactLineageRequest_UPSTREAM GetArtifactLineageRequest_Direction = 0
+	// The executions which consumed the artifacts, their outputs, and so on.
+	GetArtifactLineageRequest_DOWNSTREAM GetArtifactLineageRequest_Direction = 1
+	// Both upstream and downstream.
+	GetArtifactLineageRequest_BOTH GetArtifactLineageRequest_Direction = 2
+)
+
+var GetArtifactLineageRequest_Direction_name = map[int32]string{
+	0: "UPSTREAM",
+	1: "DOWNSTREAM",
+	2: "BOTH",
+}
+
+var GetArtifactLineageRequest_Direction_value = map[string]int32{
+	"UPSTREAM":   0,
+	"DOWNSTREAM": 1,
+	"BOTH":       2,
+}
+
+func (x GetArtifactLineageRequest_Direction) String() string {
+	return proto.EnumName(GetArtifactLineageRequest_Direction_name, int32(x))
+}
+
+func (GetArtifactLineageRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{1, 0}
+}
+
+type LineageNode_NodeType int32
+
+const (
+	LineageNode_UNKNOWN_NODE_TYPE LineageNode_NodeType = 0
+	LineageNode_EXECUTION         LineageNode_NodeType = 1
+	LineageNode_ARTIFACT          LineageNode_NodeType = 2
+)
+
+var LineageNode_NodeType_name = map[int32]string{
+	0: "UNKNOWN_NODE_TYPE",
+	1: "EXECUTION",
+	2: "ARTIFACT",
+}
+
+var LineageNode_NodeType_value = map[string]int32{
+	"UNKNOWN_NODE_TYPE": 0,
+	"EXECUTION":         1,
+	"ARTIFACT":          2,
+}
+
+func (x LineageNode_NodeType) String() string {
+	return proto.EnumName(LineageNode_NodeType_name, int32(x))
+}
+
+func (LineageNode_NodeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{3, 0}
+}
+
+type LineageEdge_EdgeType int32
+
+const (
+	LineageEdge_UNKNOWN_EDGE_TYPE LineageEdge_EdgeType = 0
+	// The source artifact is an input of the target execution.
+	LineageEdge_INPUT LineageEdge_EdgeType = 1
+	// The target artifact is an output of the source execution.
+	LineageEdge_OUTPUT LineageEdge_EdgeType = 2
+)
+
+var LineageEdge_EdgeType_name = map[int32]string{
+	0: "UNKNOWN_EDGE_TYPE",
+	1: "INPUT",
+	2: "OUTPUT",
+}
+
+var LineageEdge_EdgeType_value = map[string]int32{
+	"UNKNOWN_EDGE_TYPE": 0,
+	"INPUT":             1,
+	"OUTPUT":            2,
+}
+
+func (x LineageEdge_EdgeType) String() string {
+	return proto.EnumName(LineageEdge_EdgeType_name, int32(x))
+}
+
+func (LineageEdge_EdgeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{4, 0}
+}
+
+type GetRunLineageRequest struct {
+	// The ID of the run.
+	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRunLineageRequest) Reset()         { *m = GetRunLineageRequest{} }
+func (m *GetRunLineageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRunLineageRequest) ProtoMessage()    {}
+func (*GetRunLineageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{0}
+}
+
+func (m *GetRunLineageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRunLineageRequest.Unmarshal(m, b)
+}
+func (m *GetRunLineageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRunLineageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRunLineageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRunLineageRequest.Merge(m, src)
+}
+func (m *GetRunLineageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRunLineageRequest.Size(m)
+}
+func (m *GetRunLineageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRunLineageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRunLineageRequest proto.InternalMessageInfo
+
+func (m *GetRunLineageRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+type GetArtifactLineageRequest struct {
+	// The URI of the artifacts whose lineage is walked.
+	Uri       string                              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Direction GetArtifactLineageRequest_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=api.GetArtifactLineageRequest_Direction" json:"direction,omitempty"`
+	// The maximum number of executions walked through from the artifacts in
+	// each direction. Defaults to 3, and cannot be more than 10.
+	MaxDepth             int32    `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetArtifactLineageRequest) Reset()         { *m = GetArtifactLineageRequest{} }
+func (m *GetArtifactLineageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArtifactLineageRequest) ProtoMessage()    {}
+func (*GetArtifactLineageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{1}
+}
+
+func (m *GetArtifactLineageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArtifactLineageRequest.Unmarshal(m, b)
+}
+func (m *GetArtifactLineageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArtifactLineageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetArtifactLineageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArtifactLineageRequest.Merge(m, src)
+}
+func (m *GetArtifactLineageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArtifactLineageRequest.Size(m)
+}
+func (m *GetArtifactLineageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArtifactLineageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArtifactLineageRequest proto.InternalMessageInfo
+
+func (m *GetArtifactLineageRequest) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *GetArtifactLineageRequest) GetDirection() GetArtifactLineageRequest_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return GetArtifactLineageRequest_UPSTREAM
+}
+
+func (m *GetArtifactLineageRequest) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+type LineageGraph struct {
+	// The executions and artifacts, sorted by ID.
+	Nodes []*LineageNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The events linking the executions and the artifacts, in the direction of
+	// the data: from an input artifact to an execution, and from an execution
+	// to an output artifact.
+	Edges                []*LineageEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LineageGraph) Reset()         { *m = LineageGraph{} }
+func (m *LineageGraph) String() string { return proto.CompactTextString(m) }
+func (*LineageGraph) ProtoMessage()    {}
+func (*LineageGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{2}
+}
+
+func (m *LineageGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineageGraph.Unmarshal(m, b)
+}
+func (m *LineageGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineageGraph.Marshal(b, m, deterministic)
+}
+func (m *LineageGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineageGraph.Merge(m, src)
+}
+func (m *LineageGraph) XXX_Size() int {
+	return xxx_messageInfo_LineageGraph.Size(m)
+}
+func (m *LineageGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineageGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineageGraph proto.InternalMessageInfo
+
+func (m *LineageGraph) GetNodes() []*LineageNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *LineageGraph) GetEdges() []*LineageEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+type LineageNode struct {
+	// The ID of the node, which is the ML Metadata ID of the execution or the
+	// artifact prefixed by its node type, e.g. "execution/1" or "artifact/2".
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeType LineageNode_NodeType `protobuf:"varint,2,opt,name=node_type,json=nodeType,proto3,enum=api.LineageNode_NodeType" json:"node_type,omitempty"`
+	// The name of the ML Metadata type of the execution or the artifact, e.g.
+	// "system.Model".
+	TypeName string `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// The name of the execution or the artifact, if any.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The URI of the artifact.
+	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// The last known state of the execution or the state of the artifact, e.g.
+	// "COMPLETE" or "LIVE".
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// The properties and custom properties of the execution or the artifact,
+	// formatted as strings.
+	Properties map[string]string `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The number of executions between the node and the artifacts whose lineage
+	// is walked. Always 0 in the lineage of a run.
+	Depth                int32    `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LineageNode) Reset()         { *m = LineageNode{} }
+func (m *LineageNode) String() string { return proto.CompactTextString(m) }
+func (*LineageNode) ProtoMessage()    {}
+func (*LineageNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{3}
+}
+
+func (m *LineageNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineageNode.Unmarshal(m, b)
+}
+func (m *LineageNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineageNode.Marshal(b, m, deterministic)
+}
+func (m *LineageNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineageNode.Merge(m, src)
+}
+func (m *LineageNode) XXX_Size() int {
+	return xxx_messageInfo_LineageNode.Size(m)
+}
+func (m *LineageNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineageNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineageNode proto.InternalMessageInfo
+
+func (m *LineageNode) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LineageNode) GetNodeType() LineageNode_NodeType {
+	if m != nil {
+		return m.NodeType
+	}
+	return LineageNode_UNKNOWN_NODE_TYPE
+}
+
+func (m *LineageNode) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *LineageNode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LineageNode) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *LineageNode) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *LineageNode) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *LineageNode) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type LineageEdge struct {
+	// The ID of the source node.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The ID of the target node.
+	Target   string               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	EdgeType LineageEdge_EdgeType `protobuf:"varint,3,opt,name=edge_type,json=edgeType,proto3,enum=api.LineageEdge_EdgeType" json:"edge_type,omitempty"`
+	// The name of the input or output of the execution, if any.
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LineageEdge) Reset()         { *m = LineageEdge{} }
+func (m *LineageEdge) String() string { return proto.CompactTextString(m) }
+func (*LineageEdge) ProtoMessage()    {}
+func (*LineageEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9f31cf9fb12a14f, []int{4}
+}
+
+func (m *LineageEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LineageEdge.Unmarshal(m, b)
+}
+func (m *LineageEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LineageEdge.Marshal(b, m, deterministic)
+}
+func (m *LineageEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineageEdge.Merge(m, src)
+}
+func (m *LineageEdge) XXX_Size() int {
+	return xxx_messageInfo_LineageEdge.Size(m)
+}
+func (m *LineageEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineageEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineageEdge proto.InternalMessageInfo
+
+func (m *LineageEdge) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *LineageEdge) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *LineageEdge) GetEdgeType() LineageEdge_EdgeType {
+	if m != nil {
+		return m.EdgeType
+	}
+	return LineageEdge_UNKNOWN_EDGE_TYPE
+}
+
+func (m *LineageEdge) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("api.GetArtifactLineageRequest_Direction", GetArtifactLineageRequest_Direction_name, GetArtifactLineageRequest_Direction_value)
+	proto.RegisterEnum("api.LineageNode_NodeType", LineageNode_NodeType_name, LineageNode_NodeType_value)
+	proto.RegisterEnum("api.LineageEdge_EdgeType", LineageEdge_EdgeType_name, LineageEdge_EdgeType_value)
+	proto.RegisterType((*GetRunLineageRequest)(nil), "api.GetRunLineageRequest")
+	proto.RegisterType((*GetArtifactLineageRequest)(nil), "api.GetArtifactLineageRequest")
+	proto.RegisterType((*LineageGraph)(nil), "api.LineageGraph")
+	proto.RegisterType((*LineageNode)(nil), "api.LineageNode")
+	proto.RegisterMapType((map[string]string)(nil), "api.LineageNode.PropertiesEntry")
+	proto.RegisterType((*LineageEdge)(nil), "api.LineageEdge")
+}
+
+func init() { proto.RegisterFile("backend/api/lineage.proto", fileDescriptor_b9f31cf9fb12a14f) }
+
+var fileDescriptor_b9f31cf9fb12a14f = []byte{
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x5e, 0x3b, 0x9b, 0xd4, 0x3e, 0xfb, 0xd3, 0xec, 0xd0, 0x42, 0x36, 0x14, 0x1a, 0x8c, 0x80,
+	0x48, 0xb0, 0xb1, 0xba, 0x2b, 0xa1, 0x0a, 0x09, 0x44, 0xb6, 0x49, 0x97, 0x15, 0xd4, 0x59, 0x79,
+	0x1d, 0x15, 0x7a, 0x41, 0x34, 0x89, 0xcf, 0x7a, 0x87, 0x24, 0x1e, 0x33, 0x1e, 0x6f, 0x1b, 0x10,
+	0x37, 0x48, 0x7d, 0x01, 0x78, 0x24, 0x2e, 0x78, 0x00, 0x5e, 0x81, 0x6b, 0x9e, 0x01, 0xcd, 0xd8,
+	0x4e, 0xd3, 0xcd, 0xc2, 0x8d, 0x3d, 0xe7, 0xf7, 0x3b, 0x73, 0xbe, 0x39, 0x07, 0xf6, 0xc7, 0x74,
+	0x32, 0xc5, 0x38, 0x74, 0x69, 0xc2, 0xdc, 0x19, 0x8b, 0x91, 0x46, 0xd8, 0x49, 0x04, 0x97, 0x9c,
+	0x54, 0x68, 0xc2, 0x9a, 0x6f, 0xad, 0xda, 0x51, 0x08, 0x2e, 0x72, 0x6b, 0xf3, 0x5e, 0xc4, 0x79,
+	0x34, 0x43, 0xad, 0xa7, 0x71, 0xcc, 0x25, 0x95, 0x8c, 0xc7, 0x69, 0x61, 0xfd, 0x44, 0xff, 0x26,
+	0x07, 0x11, 0xc6, 0x07, 0xe9, 0x73, 0x1a, 0x45, 0x28, 0x5c, 0x9e, 0x68, 0x8f, 0x75, 0x6f, 0xe7,
+	0x00, 0xee, 0x9c, 0xa0, 0xf4, 0xb3, 0xf8, 0x9b, 0xbc, 0x00, 0x1f, 0x7f, 0xcc, 0x30, 0x95, 0xe4,
+	0x2e, 0xd4, 0x44, 0x16, 0x8f, 0x58, 0xd8, 0x30, 0x5a, 0x46, 0xdb, 0xf6, 0xab, 0x22, 0x8b, 0x4f,
+	0x43, 0xe7, 0x4f, 0x03, 0xf6, 0x4f, 0x50, 0x76, 0x85, 0x64, 0x17, 0x74, 0x22, 0xaf, 0x05, 0xd5,
+	0xa1, 0x92, 0x09, 0x56, 0x44, 0xa8, 0x23, 0x79, 0x0c, 0x76, 0xc8, 0x04, 0x4e, 0x14, 0x64, 0xc3,
+	0x6c, 0x19, 0xed, 0xdd, 0xc3, 0x76, 0x87, 0x26, 0xac, 0xf3, 0x9f, 0x49, 0x3a, 0xbd, 0xd2, 0xdf,
+	0x7f, 0x15, 0x4a, 0xde, 0x06, 0x7b, 0x4e, 0x5f, 0x8c, 0x42, 0x4c, 0xe4, 0x65, 0xa3, 0xd2, 0x32,
+	0xda, 0x55, 0xdf, 0x9a, 0xd3, 0x17, 0x3d, 0x25, 0x3b, 0x47, 0x60, 0x2f, 0x83, 0xc8, 0x36, 0x58,
+	0xc3, 0xb3, 0xf3, 0xc0, 0xef, 0x77, 0x9f, 0xd4, 0x37, 0xc8, 0x2e, 0x40, 0x6f, 0xf0, 0xd4, 0x2b,
+	0x64, 0x83, 0x58, 0xb0, 0x79, 0x3c, 0x08, 0xbe, 0xaa, 0x9b, 0xce, 0xf7, 0xb0, 0x5d, 0x00, 0x9f,
+	0x08, 0x9a, 0x5c, 0x92, 0x0f, 0xa1, 0x1a, 0xf3, 0x10, 0xd3, 0x86, 0xd1, 0xaa, 0xb4, 0xb7, 0x0e,
+	0xeb, 0xba, 0xca, 0xc2, 0xc3, 0xe3, 0x21, 0xfa, 0xb9, 0x59, 0xf9, 0x61, 0x18, 0x61, 0xda, 0x30,
+	0xd7, 0xfd, 0xfa, 0x61, 0x84, 0x7e, 0x6e, 0x76, 0x5e, 0x56, 0x60, 0x6b, 0x25, 0x9c, 0xec, 0x82,
+	0xb9, 0x6c, 0xa6, 0xc9, 0x42, 0xf2, 0x29, 0xd8, 0x2a, 0xe1, 0x48, 0x2e, 0x12, 0x2c, 0x3a, 0xb3,
+	0x7f, 0x1d, 0xb3, 0xa3, 0x3e, 0xc1, 0x22, 0x41, 0xdf, 0x8a, 0x8b, 0x93, 0xea, 0x84, 0x0a, 0x19,
+	0xc5, 0x74, 0x8e, 0xba, 0x13, 0xb6, 0x6f, 0x29, 0x85, 0x47, 0xe7, 0x48, 0x08, 0x6c, 0x6a, 0xfd,
+	0xa6, 0xd6, 0xeb, 0x73, 0x49, 0x4a, 0xf5, 0x15, 0x29, 0x77, 0xa0, 0x9a, 0x4a, 0x2a, 0xb1, 0x51,
+	0xcb, 0xa9, 0xd5, 0x02, 0xf9, 0x12, 0x20, 0x11, 0x3c, 0x41, 0x21, 0x19, 0xa6, 0x8d, 0x5b, 0xfa,
+	0x76, 0xad, 0xb5, 0x8a, 0xce, 0x96, 0x2e, 0xfd, 0x58, 0x8a, 0x85, 0xbf, 0x12, 0xa3, 0xf2, 0xe6,
+	0x04, 0x59, 0x9a, 0xa0, 0x5c, 0x68, 0x7e, 0x0e, 0xb7, 0xaf, 0x05, 0xa9, 0x92, 0xa6, 0xb8, 0x28,
+	0xdf, 0xc9, 0x14, 0x17, 0x2a, 0xf4, 0x8a, 0xce, 0xb2, 0xbc, 0x13, 0xb6, 0x9f, 0x0b, 0x9f, 0x99,
+	0x0f, 0x0d, 0xe7, 0x0b, 0xb0, 0xca, 0x2e, 0x90, 0xbb, 0xb0, 0x37, 0xf4, 0xbe, 0xf6, 0x06, 0x4f,
+	0xbd, 0x91, 0x37, 0xe8, 0xf5, 0x47, 0xc1, 0x77, 0x67, 0xfd, 0xfa, 0x06, 0xd9, 0x01, 0xbb, 0xff,
+	0x6d, 0xff, 0xd1, 0x30, 0x38, 0x1d, 0x78, 0x75, 0x43, 0xbd, 0x80, 0xae, 0x1f, 0x9c, 0x3e, 0xee,
+	0x3e, 0x0a, 0xea, 0xa6, 0xf3, 0x87, 0x01, 0x5b, 0x2b, 0xf4, 0x90, 0x37, 0xa1, 0x96, 0xf2, 0x4c,
+	0x4c, 0xb0, 0x80, 0x2f, 0x24, 0xa5, 0x97, 0x54, 0x44, 0x28, 0x8b, 0x12, 0x0a, 0x49, 0xf1, 0xa4,
+	0x08, 0xcd, 0x79, 0xaa, 0xac, 0xf3, 0xa4, 0x92, 0x76, 0xd4, 0x27, 0xe7, 0x09, 0x8b, 0xd3, 0x4d,
+	0x54, 0x38, 0x0f, 0xc1, 0x2a, 0x3d, 0x57, 0xef, 0xd2, 0xef, 0x9d, 0x2c, 0xef, 0x62, 0x43, 0xf5,
+	0xd4, 0x3b, 0x1b, 0x06, 0x75, 0x83, 0x00, 0xd4, 0x06, 0xc3, 0x40, 0x9d, 0xcd, 0xc3, 0x7f, 0x0c,
+	0xd8, 0x2d, 0x00, 0xcf, 0x51, 0x5c, 0xb1, 0x09, 0x92, 0x1f, 0x60, 0xe7, 0xb5, 0xc9, 0x25, 0xfb,
+	0xe5, 0x60, 0xad, 0x4d, 0x73, 0x73, 0x6f, 0xb5, 0x62, 0xfd, 0xde, 0x9d, 0x8f, 0x7f, 0xfd, 0xeb,
+	0xef, 0xdf, 0xcd, 0x0f, 0xc8, 0xfb, 0x6a, 0x8d, 0xa4, 0xee, 0xd5, 0x83, 0x31, 0x4a, 0xfa, 0xc0,
+	0x15, 0x59, 0x9c, 0xba, 0x3f, 0xe7, 0xa3, 0xff, 0x4b, 0xb9, 0x95, 0x48, 0x02, 0x64, 0x7d, 0x60,
+	0xc9, 0xbb, 0xff, 0x3f, 0xc9, 0x37, 0xa1, 0x7e, 0xa4, 0x51, 0xdf, 0x23, 0xf7, 0x5f, 0x47, 0xa5,
+	0x45, 0x82, 0xb4, 0x44, 0x3c, 0x7e, 0x69, 0xfc, 0xd6, 0x7d, 0xe2, 0xdf, 0x83, 0x5b, 0x21, 0x5e,
+	0xd0, 0x6c, 0x26, 0xc9, 0x1e, 0xb9, 0x0d, 0x3b, 0xcd, 0x2d, 0x9d, 0xf2, 0x5c, 0x52, 0x99, 0xa5,
+	0xcf, 0xee, 0xc3, 0x3b, 0x50, 0x3b, 0x46, 0x2a, 0x50, 0x90, 0x37, 0x2c, 0xb3, 0xb9, 0x43, 0x33,
+	0x79, 0xc9, 0x05, 0xfb, 0x49, 0x6f, 0xb8, 0x96, 0x39, 0xde, 0x06, 0x58, 0x3a, 0x6c, 0x3c, 0x3b,
+	0x8a, 0x98, 0xbc, 0xcc, 0xc6, 0x9d, 0x09, 0x9f, 0xbb, 0xd3, 0x6c, 0x8c, 0x17, 0x33, 0xfe, 0xdc,
+	0x4d, 0x58, 0x82, 0x0a, 0x33, 0x75, 0x57, 0xb7, 0x6d, 0xc4, 0x47, 0x93, 0x19, 0xc3, 0x58, 0x8e,
+	0x6b, 0x7a, 0x4d, 0x1e, 0xfd, 0x3b, 0x00, 0x91, 0x82, 0xd0, 0x38, 0xad, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LineageServiceClient is the client API for LineageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LineageServiceClient interface {
+	// Finds the executions of a run recorded in ML Metadata, the artifacts they
+	// consumed and produced, and the events linking them.
+	GetRunLineage(ctx context.Context, in *GetRunLineageRequest, opts ...grpc.CallOption) (*LineageGraph, error)
+	// Walks the lineage of the artifacts with a URI in ML Metadata, upstream to
+	// the executions and artifacts they were produced from, downstream to the
+	// ones which consumed them, or both.
+	GetArtifactLineage(ctx context.Context, in *GetArtifactLineageRequest, opts ...grpc.CallOption) (*LineageGraph, error)
+}
+
+type lineageServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLineageServiceClient(cc *grpc.ClientConn) LineageServiceClient {
+	return &lineageServiceClient{cc}
+}
+
+func (c *lineageServiceClient) GetRunLineage(ctx context.Context, in *GetRunLineageRequest, opts ...grpc.CallOption) (*LineageGraph, error) {
+	out := new(LineageGraph)
+	err := c.cc.Invoke(ctx, "/api.LineageService/GetRunLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lineageServiceClient) GetArtifactLineage(ctx context.Context, in *GetArtifactLineageRequest, opts ...grpc.CallOption) (*LineageGraph, error) {
+	out := new(LineageGraph)
+	err := c.cc.Invoke(ctx, "/api.LineageService/GetArtifactLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LineageServiceServer is the server API for LineageService service.
+type LineageServiceServer interface {
+	// Finds the executions of a run recorded in ML Metadata, the artifacts they
+	// consumed and produced, and the events linking them.
+	GetRunLineage(context.Context, *GetRunLineageRequest) (*LineageGraph, error)
+	// Walks the lineage of the artifacts with a URI in ML Metadata, upstream to
+	// the executions and artifacts they were produced from, downstream to the
+	// ones which consumed them, or both.
+	GetArtifactLineage(context.Context, *GetArtifactLineageRequest) (*LineageGraph, error)
+}
+
+// UnimplementedLineageServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedLineageServiceServer struct {
+}
+
+func (*UnimplementedLineageServiceServer) GetRunLineage(ctx context.Context, req *GetRunLineageRequest) (*LineageGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunLineage not implemented")
+}
+func (*UnimplementedLineageServiceServer) GetArtifactLineage(ctx context.Context, req *GetArtifactLineageRequest) (*LineageGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactLineage not implemented")
+}
+
+func RegisterLineageServiceServer(s *grpc.Server, srv LineageServiceServer) {
+	s.RegisterService(&_LineageService_serviceDesc, srv)
+}
+
+func _LineageService_GetRunLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineageServiceServer).GetRunLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.LineageService/GetRunLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineageServiceServer).GetRunLineage(ctx, req.(*GetRunLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LineageService_GetArtifactLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LineageServiceServer).GetArtifactLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.LineageService/GetArtifactLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LineageServiceServer).GetArtifactLineage(ctx, req.(*GetArtifactLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LineageService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.LineageService",
+	HandlerType: (*LineageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRunLineage",
+			Handler:    _LineageService_GetRunLineage_Handler,
+		},
+		{
+			MethodName: "GetArtifactLineage",
+			Handler:    _LineageService_GetArtifactLineage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/api/lineage.proto",
+}
@@ -599,7 +599,9 @@ type ListPipelineVersionsRequest struct {
 	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// A base-64 encoded, JSON-serialized Filter protocol buffer (see
 	// filter.proto).
-	Filter               string   `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// BASIC leaves out the parameters of the pipeline versions. FULL by default.
+	View                 ListView `protobuf:"varint,6,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -665,6 +667,13 @@ func (m *ListPipelineVersionsRequest) GetFilter() string {
 	return ""
 }
 
+func (m *ListPipelineVersionsRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_LIST_VIEW_UNSPECIFIED
+}
+
 type ListPipelineVersionsResponse struct {
 	Versions []*PipelineVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	// The token to list the next page of pipeline versions.
@@ -904,10 +913,13 @@ type PipelineVersion struct {
 	PackageUrl *Url `protobuf:"bytes,6,opt,name=package_url,json=packageUrl,proto3" json:"package_url,omitempty"`
 	// Input field. Specify which resource this pipeline version belongs to.
 	// For Experiment, the only valid resource reference is a single Namespace.
-	ResourceReferences   []*ResourceReference `protobuf:"bytes,7,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	ResourceReferences []*ResourceReference `protobuf:"bytes,7,rep,name=resource_references,json=resourceReferences,proto3" json:"resource_references,omitempty"`
+	// Optional input field. User-defined labels of the pipeline version. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels               map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *PipelineVersion) Reset()         { *m = PipelineVersion{} }
@@ -984,6 +996,13 @@ func (m *PipelineVersion) GetResourceReferences() []*ResourceReference {
 	return nil
 }
 
+func (m *PipelineVersion) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Url)(nil), "api.Url")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "api.CreatePipelineRequest")
@@ -1002,92 +1021,98 @@ func init() {
 	proto.RegisterType((*DeletePipelineVersionRequest)(nil), "api.DeletePipelineVersionRequest")
 	proto.RegisterType((*Pipeline)(nil), "api.Pipeline")
 	proto.RegisterType((*PipelineVersion)(nil), "api.PipelineVersion")
+	proto.RegisterMapType((map[string]string)(nil), "api.PipelineVersion.LabelsEntry")
 }
 
 func init() { proto.RegisterFile("backend/api/pipeline.proto", fileDescriptor_f11ac7285c35cf45) }
 
 var fileDescriptor_f11ac7285c35cf45 = []byte{
-	// 1272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xfe, 0xad, 0x9d, 0x38, 0xf6, 0x71, 0x9d, 0xfc, 0x98, 0xa6, 0x89, 0xbb, 0x71, 0x9a, 0x64,
-	0x1b, 0xa5, 0x49, 0x68, 0xed, 0x26, 0x41, 0x08, 0x22, 0x15, 0xa9, 0x26, 0x50, 0x95, 0x02, 0xaa,
-	0x9c, 0x84, 0x8b, 0x72, 0xb1, 0x5a, 0x7b, 0x8f, 0xdd, 0x25, 0xeb, 0xdd, 0x65, 0x76, 0x9c, 0xe2,
-	0x54, 0x91, 0x50, 0x05, 0x02, 0x89, 0x3b, 0xb8, 0xe0, 0x8e, 0x27, 0xe0, 0x11, 0x90, 0x78, 0x00,
-	0x2e, 0x79, 0x05, 0x5e, 0x81, 0x7b, 0xb4, 0xb3, 0x33, 0x9b, 0xfd, 0x63, 0x3b, 0xc9, 0x95, 0x3d,
-	0x67, 0xbe, 0xd9, 0x73, 0xce, 0x77, 0xbe, 0x3d, 0x67, 0x16, 0xd4, 0xb6, 0xd1, 0x39, 0x41, 0xc7,
-	0x6c, 0x18, 0x9e, 0xd5, 0xf0, 0x2c, 0x0f, 0x6d, 0xcb, 0xc1, 0xba, 0x47, 0x5d, 0xe6, 0x92, 0xbc,
-	0xe1, 0x59, 0x6a, 0xad, 0xe7, 0xba, 0x3d, 0x1b, 0xf9, 0xbe, 0xe1, 0x38, 0x2e, 0x33, 0x98, 0xe5,
-	0x3a, 0x7e, 0x08, 0x51, 0x57, 0xc4, 0x2e, 0x5f, 0xb5, 0x07, 0xdd, 0x06, 0xb3, 0xfa, 0xe8, 0x33,
-	0xa3, 0xef, 0x09, 0xc0, 0x52, 0x1a, 0x80, 0x7d, 0x8f, 0x0d, 0xc5, 0xe6, 0x62, 0xdc, 0x39, 0x52,
-	0xea, 0x52, 0x79, 0x2a, 0x11, 0x95, 0x41, 0x8d, 0x3e, 0x32, 0x94, 0x9b, 0xeb, 0xf1, 0x4d, 0x8a,
-	0xbe, 0x3b, 0xa0, 0x1d, 0xd4, 0x29, 0x76, 0x91, 0xa2, 0xd3, 0x11, 0xc1, 0xab, 0xf7, 0xf9, 0x4f,
-	0xe7, 0x41, 0x0f, 0x9d, 0x07, 0xfe, 0x2b, 0xa3, 0xd7, 0x43, 0xda, 0x70, 0x3d, 0x1e, 0x7b, 0x36,
-	0x0f, 0x6d, 0x13, 0xf2, 0xc7, 0xd4, 0x26, 0x6b, 0x70, 0x43, 0x72, 0xa0, 0x0f, 0xa8, 0x5d, 0x55,
-	0x56, 0x95, 0xcd, 0x52, 0xab, 0x2c, 0x6d, 0xc7, 0xd4, 0xd6, 0x9a, 0x70, 0xeb, 0x43, 0x8a, 0x06,
-	0xc3, 0xe7, 0xc2, 0xd8, 0xc2, 0xaf, 0x07, 0xe8, 0x33, 0xb2, 0x05, 0x45, 0x89, 0xe3, 0xe7, 0xca,
-	0xbb, 0x95, 0xba, 0xe1, 0x59, 0xf5, 0x08, 0x17, 0x6d, 0x6b, 0x08, 0x77, 0x8f, 0x3d, 0x33, 0xf6,
-	0x8c, 0x03, 0xec, 0x1a, 0x03, 0x9b, 0x7d, 0x81, 0xd4, 0xb7, 0x5c, 0x47, 0x3e, 0x71, 0x05, 0x22,
-	0xcf, 0xba, 0x65, 0x8a, 0x60, 0x40, 0x9a, 0x9e, 0x9a, 0x64, 0x19, 0xe0, 0x34, 0x3c, 0x12, 0xec,
-	0xe7, 0xf8, 0x7e, 0x49, 0x58, 0x9e, 0x9a, 0xda, 0x3a, 0x90, 0x27, 0xc8, 0xd2, 0x71, 0xce, 0x42,
-	0x2e, 0x7a, 0x58, 0xce, 0x32, 0xb5, 0xbf, 0x14, 0x98, 0xff, 0xd4, 0xf2, 0x23, 0x9c, 0x2f, 0x81,
-	0xcb, 0x00, 0x9e, 0xd1, 0x43, 0x9d, 0xb9, 0x27, 0xe8, 0x88, 0x03, 0xa5, 0xc0, 0x72, 0x14, 0x18,
-	0xc8, 0x12, 0xf0, 0x85, 0xee, 0x5b, 0x67, 0xc8, 0x7d, 0x4f, 0xb7, 0x8a, 0x81, 0xe1, 0xd0, 0x3a,
-	0x43, 0xb2, 0x08, 0x33, 0xbe, 0x4b, 0x99, 0xde, 0x1e, 0x56, 0xf3, 0xfc, 0x60, 0x21, 0x58, 0x36,
-	0x87, 0x64, 0x01, 0x0a, 0x5d, 0xcb, 0x66, 0x48, 0xab, 0x53, 0xa1, 0x3d, 0x5c, 0x91, 0x8f, 0x61,
-	0x21, 0x5b, 0x4a, 0xfd, 0x04, 0x87, 0xd5, 0x69, 0xce, 0xe5, 0xff, 0x39, 0x97, 0x2d, 0x01, 0x79,
-	0x86, 0xc3, 0xd6, 0xbc, 0xc4, 0xb7, 0x24, 0xfc, 0x19, 0x0e, 0xb5, 0x9f, 0x14, 0xb8, 0x95, 0xca,
-	0xc6, 0xf7, 0x5c, 0xc7, 0x47, 0xf2, 0x36, 0x94, 0x24, 0x75, 0x7e, 0x55, 0x59, 0xcd, 0x67, 0x0b,
-	0x74, 0xb1, 0x1f, 0xe4, 0xce, 0x5c, 0x66, 0xd8, 0x61, 0x76, 0x79, 0x9e, 0x5d, 0x89, 0x5b, 0x78,
-	0x7a, 0x1b, 0x30, 0xe7, 0xe0, 0x37, 0x4c, 0x8f, 0xf1, 0x13, 0xb2, 0x5f, 0x09, 0xcc, 0xcf, 0x25,
-	0x47, 0xda, 0x3d, 0xb8, 0x75, 0x80, 0x36, 0x66, 0xc5, 0x92, 0x2e, 0x42, 0x58, 0xaa, 0x23, 0xec,
-	0x7b, 0xb6, 0xc1, 0xc6, 0xa2, 0x76, 0xe0, 0x66, 0x02, 0x25, 0x32, 0x53, 0xa1, 0xc8, 0x84, 0x4d,
-	0x80, 0xa3, 0xb5, 0xd6, 0x84, 0xb5, 0x98, 0x06, 0x84, 0xc0, 0xd2, 0x7e, 0x92, 0x3a, 0x52, 0xd2,
-	0x3a, 0xfa, 0x1c, 0x6a, 0x49, 0xc9, 0xa7, 0x74, 0x5a, 0x87, 0x19, 0x01, 0x16, 0xc2, 0x9f, 0x4f,
-	0xf0, 0x2a, 0xd1, 0x12, 0xa4, 0xed, 0xc3, 0xed, 0x6c, 0x4c, 0x57, 0x8c, 0xe5, 0x4f, 0x05, 0x96,
-	0xe2, 0xf5, 0x15, 0xa7, 0x23, 0xd1, 0xee, 0xc1, 0x8d, 0x48, 0x47, 0x81, 0x7a, 0x94, 0x31, 0xea,
-	0x29, 0xd3, 0x8b, 0xc5, 0x64, 0x29, 0x27, 0x5f, 0x83, 0x7c, 0xfa, 0x35, 0x88, 0x29, 0x7d, 0x6a,
-	0x8c, 0xd2, 0xa7, 0xe3, 0x4a, 0xd7, 0x7e, 0x55, 0xa0, 0x36, 0x3a, 0x03, 0x51, 0xce, 0x87, 0x50,
-	0x14, 0xf9, 0x4a, 0x9d, 0x8e, 0xe6, 0x33, 0x42, 0x5d, 0x55, 0x8e, 0x97, 0xa8, 0x5a, 0x7b, 0x04,
-	0xb5, 0xa4, 0x5a, 0xaf, 0x57, 0x9a, 0x7f, 0x73, 0x50, 0x94, 0x27, 0xd3, 0xd2, 0x25, 0xef, 0x03,
-	0x74, 0xb8, 0x86, 0x4c, 0xdd, 0x60, 0x3c, 0xba, 0xf2, 0xae, 0x5a, 0x0f, 0x87, 0x43, 0x5d, 0x0e,
-	0x87, 0xfa, 0x91, 0x9c, 0x1e, 0xad, 0x92, 0x40, 0x3f, 0x66, 0x84, 0xc0, 0x94, 0x63, 0xf4, 0x51,
-	0x50, 0xcf, 0xff, 0x93, 0x55, 0x28, 0x9b, 0xe8, 0x77, 0xa8, 0xc5, 0x3b, 0xba, 0x60, 0x3e, 0x6e,
-	0x22, 0xf5, 0xa0, 0x6c, 0x62, 0x70, 0xf8, 0xd5, 0x69, 0xce, 0xe3, 0x6c, 0xc8, 0xa3, 0x34, 0xb7,
-	0x62, 0x08, 0xa2, 0x42, 0x3e, 0xe8, 0xf8, 0x33, 0x3c, 0xb2, 0x22, 0x07, 0x1e, 0x53, 0xbb, 0x15,
-	0x18, 0xc9, 0x3c, 0x4c, 0xf3, 0xe9, 0x54, 0x2d, 0x70, 0x3f, 0xe1, 0x82, 0x3c, 0x82, 0x39, 0x33,
-	0xec, 0xdb, 0xba, 0x94, 0x7f, 0x71, 0x82, 0xfc, 0x67, 0xcd, 0x44, 0x93, 0x27, 0x4f, 0xe0, 0x66,
-	0xb6, 0xe3, 0xf9, 0xd5, 0x12, 0x8f, 0x74, 0x21, 0x21, 0xd8, 0xa8, 0xc3, 0xb5, 0x48, 0xa6, 0xe9,
-	0xf9, 0xda, 0x1f, 0x39, 0x98, 0x4b, 0x39, 0xcb, 0xd0, 0x2f, 0x39, 0xcc, 0xc5, 0x38, 0x4c, 0x96,
-	0x24, 0x7f, 0x9d, 0x92, 0x24, 0xc9, 0x9d, 0xba, 0x94, 0xdc, 0x0d, 0x98, 0xeb, 0xb8, 0x26, 0xea,
-	0x22, 0xdd, 0x80, 0xe8, 0xf0, 0xa5, 0xa8, 0x04, 0xe6, 0x43, 0x6e, 0x0d, 0xe6, 0xef, 0x16, 0x94,
-	0x3d, 0xa3, 0x73, 0x12, 0xc8, 0x38, 0xc0, 0x14, 0x52, 0xc5, 0x00, 0xb1, 0x19, 0x40, 0xc7, 0xd0,
-	0x37, 0x73, 0x5d, 0xfa, 0x76, 0x7f, 0x28, 0x5f, 0xd0, 0x77, 0x88, 0xf4, 0xd4, 0xea, 0x20, 0xe9,
-	0xc2, 0x6c, 0xb2, 0xe3, 0x11, 0x95, 0x3f, 0x71, 0xe4, 0xe4, 0x57, 0x93, 0x63, 0x44, 0xdb, 0x7a,
-	0xf3, 0xf7, 0x3f, 0xbf, 0xe4, 0xee, 0x6a, 0x8b, 0xc1, 0x05, 0xc5, 0x6f, 0x9c, 0xee, 0xb4, 0x91,
-	0x19, 0x3b, 0xd1, 0xe5, 0xca, 0xdf, 0x8f, 0x2e, 0x02, 0xe4, 0x4b, 0x28, 0xc7, 0x3a, 0x21, 0x59,
-	0xe4, 0x0f, 0xca, 0xce, 0xec, 0xb4, 0x87, 0x75, 0xee, 0xe1, 0x0e, 0xa9, 0x8d, 0xf1, 0xd0, 0x78,
-	0x6d, 0x99, 0xe7, 0xa4, 0x07, 0x95, 0xc4, 0x24, 0x24, 0xb7, 0xf9, 0x53, 0x46, 0xcd, 0x7a, 0x55,
-	0x1d, 0xb5, 0x15, 0xf6, 0x23, 0x6d, 0x85, 0x7b, 0xbb, 0x4d, 0xc6, 0xe5, 0x43, 0xbe, 0x82, 0xd9,
-	0x64, 0xdf, 0x10, 0x6c, 0x8d, 0x1c, 0x7d, 0xea, 0x42, 0x46, 0x62, 0x1f, 0x05, 0x57, 0x42, 0x99,
-	0xd4, 0xf6, 0xe4, 0xa4, 0x3c, 0xce, 0x98, 0x1c, 0x60, 0x17, 0x8c, 0xa5, 0x46, 0x9a, 0x5a, 0xcd,
-	0x6e, 0x88, 0x74, 0xea, 0xdc, 0xcf, 0x26, 0xd9, 0x98, 0xe4, 0xa7, 0x21, 0x07, 0xa8, 0x4f, 0xde,
-	0x28, 0xe9, 0x1b, 0x9f, 0x7c, 0xc9, 0xd6, 0x46, 0x68, 0x22, 0xd9, 0x32, 0xd5, 0x91, 0xad, 0x40,
-	0x7b, 0xc8, 0x43, 0xd8, 0xd6, 0x56, 0x46, 0x87, 0x20, 0xdb, 0x89, 0xbf, 0x2f, 0x47, 0x26, 0xf9,
-	0x56, 0x49, 0xdc, 0xe5, 0x64, 0x04, 0x77, 0xd2, 0x82, 0xb9, 0x92, 0xfb, 0x77, 0xb8, 0xfb, 0x3a,
-	0xb9, 0x7f, 0x89, 0xfb, 0xc6, 0xeb, 0x8b, 0x76, 0x7f, 0x4e, 0xbe, 0x4b, 0xdd, 0x13, 0xe5, 0xdc,
-	0x22, 0xab, 0x19, 0xed, 0xa4, 0x86, 0xb2, 0xba, 0x36, 0x01, 0x21, 0xaa, 0x72, 0x8f, 0xc7, 0xb4,
-	0x46, 0x2e, 0xa3, 0x84, 0xfc, 0xa8, 0xa4, 0xef, 0x54, 0xc9, 0x72, 0x4c, 0x9a, 0x60, 0x63, 0xb5,
-	0x27, 0x18, 0xd9, 0xbe, 0x1e, 0x23, 0xbf, 0x29, 0xa0, 0x8e, 0xbf, 0x5c, 0x91, 0x8d, 0x31, 0xc5,
-	0xb9, 0xba, 0x54, 0x3f, 0xe0, 0x61, 0xbd, 0x47, 0xde, 0xbd, 0x4e, 0x58, 0x31, 0xe9, 0xfe, 0xae,
-	0x40, 0x6d, 0xd2, 0x87, 0x06, 0xd9, 0x0c, 0x5b, 0xeb, 0xe5, 0xdf, 0x22, 0x63, 0x99, 0xfb, 0x84,
-	0x87, 0x78, 0xa0, 0x35, 0xc7, 0xbe, 0x4d, 0xb1, 0x4f, 0x98, 0xf3, 0x46, 0x6a, 0x60, 0x26, 0x02,
-	0x6f, 0x7e, 0xaf, 0xfc, 0xfc, 0xf8, 0xb3, 0x56, 0x0d, 0x66, 0x04, 0x8a, 0xbc, 0x45, 0xe6, 0xa0,
-	0xa2, 0x96, 0x79, 0x90, 0x87, 0xcc, 0x60, 0x03, 0xff, 0xc5, 0x0a, 0x2c, 0x43, 0xa1, 0x89, 0x06,
-	0x45, 0x4a, 0x6e, 0x16, 0x73, 0x6a, 0xc5, 0x18, 0xb0, 0x97, 0x2e, 0xb5, 0xce, 0xf8, 0xe7, 0xdc,
-	0x6a, 0xae, 0x7d, 0x03, 0x20, 0x02, 0xfc, 0xef, 0xc5, 0x5e, 0xcf, 0x62, 0x2f, 0x07, 0xed, 0x7a,
-	0xc7, 0xed, 0x37, 0x4e, 0x06, 0x6d, 0xec, 0xda, 0xee, 0xab, 0x58, 0x70, 0xf1, 0x2f, 0xc9, 0x9e,
-	0xab, 0x77, 0x6c, 0x0b, 0x1d, 0xd6, 0x2e, 0xf0, 0x1c, 0xf7, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0x92, 0xcc, 0x35, 0x1c, 0x0f, 0x00, 0x00,
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0xfe, 0x51, 0xb2, 0x65, 0x69, 0x14, 0xdb, 0xf9, 0x6d, 0x1c, 0x5b, 0x61, 0x94, 0x58, 0x66,
+	0x02, 0xc7, 0x49, 0x13, 0x29, 0x7f, 0x8a, 0x22, 0x31, 0x90, 0x02, 0x71, 0x93, 0x06, 0x69, 0xd2,
+	0x22, 0xa0, 0xe3, 0x1c, 0xd2, 0x83, 0xb0, 0x12, 0xc7, 0x0a, 0x6b, 0x8a, 0x64, 0x77, 0x57, 0x76,
+	0x95, 0x20, 0x40, 0x11, 0xb4, 0x68, 0x81, 0xde, 0xda, 0x43, 0x6f, 0x7d, 0x82, 0x5e, 0xfa, 0x1c,
+	0x3d, 0xf6, 0x0d, 0x8a, 0xbe, 0x42, 0xef, 0x05, 0x97, 0xbb, 0x34, 0x49, 0xfd, 0xb1, 0x7d, 0x92,
+	0x76, 0xf6, 0x5b, 0xce, 0xcc, 0x37, 0x1f, 0x67, 0x96, 0x60, 0x76, 0x68, 0x77, 0x0f, 0x7d, 0xa7,
+	0x45, 0x43, 0xb7, 0x15, 0xba, 0x21, 0x7a, 0xae, 0x8f, 0xcd, 0x90, 0x05, 0x22, 0x20, 0x45, 0x1a,
+	0xba, 0x66, 0xbd, 0x17, 0x04, 0x3d, 0x0f, 0xe5, 0x3e, 0xf5, 0xfd, 0x40, 0x50, 0xe1, 0x06, 0x3e,
+	0x8f, 0x21, 0xe6, 0xaa, 0xda, 0x95, 0xab, 0xce, 0x60, 0xb7, 0x25, 0xdc, 0x3e, 0x72, 0x41, 0xfb,
+	0xa1, 0x02, 0x9c, 0xcf, 0x03, 0xb0, 0x1f, 0x8a, 0xa1, 0xda, 0x5c, 0x49, 0x3b, 0x47, 0xc6, 0x02,
+	0xa6, 0x4f, 0x65, 0xa2, 0xa2, 0x8c, 0xf6, 0x51, 0xa0, 0xde, 0xbc, 0x9c, 0xde, 0x64, 0xc8, 0x83,
+	0x01, 0xeb, 0x62, 0x9b, 0xe1, 0x2e, 0x32, 0xf4, 0xbb, 0x2a, 0x78, 0x73, 0x39, 0x8d, 0xda, 0x77,
+	0xf1, 0x40, 0xd9, 0xaf, 0xcb, 0x9f, 0xee, 0x8d, 0x1e, 0xfa, 0x37, 0xf8, 0x01, 0xed, 0xf5, 0x90,
+	0xb5, 0x82, 0x50, 0xe6, 0x34, 0x9a, 0x9f, 0xb5, 0x01, 0xc5, 0x1d, 0xe6, 0x91, 0x35, 0x38, 0xa5,
+	0xb9, 0x69, 0x0f, 0x98, 0x57, 0x33, 0x1a, 0xc6, 0x46, 0xc5, 0xae, 0x6a, 0xdb, 0x0e, 0xf3, 0xac,
+	0x2d, 0x38, 0xfb, 0x09, 0x43, 0x2a, 0xf0, 0xb9, 0x32, 0xda, 0xf8, 0xf5, 0x00, 0xb9, 0x20, 0x57,
+	0xa1, 0xac, 0x71, 0xf2, 0x5c, 0xf5, 0xf6, 0x7c, 0x93, 0x86, 0x6e, 0x33, 0xc1, 0x25, 0xdb, 0x16,
+	0xc2, 0xa5, 0x9d, 0xd0, 0x49, 0x3d, 0xe3, 0x21, 0xee, 0xd2, 0x81, 0x27, 0x5e, 0x22, 0xe3, 0x6e,
+	0xe0, 0xeb, 0x27, 0xae, 0x42, 0xe2, 0xb9, 0xed, 0x3a, 0x2a, 0x18, 0xd0, 0xa6, 0x27, 0x0e, 0xb9,
+	0x00, 0xb0, 0x1f, 0x1f, 0x89, 0xf6, 0x0b, 0x72, 0xbf, 0xa2, 0x2c, 0x4f, 0x1c, 0xeb, 0x32, 0x90,
+	0xc7, 0x28, 0xf2, 0x71, 0x2e, 0x40, 0x21, 0x79, 0x58, 0xc1, 0x75, 0xac, 0x3f, 0x0d, 0x58, 0x7a,
+	0xe6, 0xf2, 0x04, 0xc7, 0x35, 0xf0, 0x02, 0x40, 0x48, 0x7b, 0xd8, 0x16, 0xc1, 0x1e, 0xfa, 0xea,
+	0x40, 0x25, 0xb2, 0xbc, 0x88, 0x0c, 0xe4, 0x3c, 0xc8, 0x45, 0x9b, 0xbb, 0x6f, 0x50, 0xfa, 0x9e,
+	0xb5, 0xcb, 0x91, 0x61, 0xdb, 0x7d, 0x83, 0x64, 0x05, 0xe6, 0x78, 0xc0, 0x44, 0xbb, 0x33, 0xac,
+	0x15, 0xe5, 0xc1, 0x52, 0xb4, 0xdc, 0x1a, 0x92, 0x65, 0x28, 0xed, 0xba, 0x9e, 0x40, 0x56, 0x9b,
+	0x89, 0xed, 0xf1, 0x8a, 0x7c, 0x0a, 0xcb, 0xa3, 0x25, 0x6e, 0xef, 0xe1, 0xb0, 0x36, 0x2b, 0xb9,
+	0x3c, 0x2d, 0xb9, 0xb4, 0x15, 0xe4, 0x29, 0x0e, 0xed, 0x25, 0x8d, 0xb7, 0x35, 0xfc, 0x29, 0x0e,
+	0xad, 0x9f, 0x0c, 0x38, 0x9b, 0xcb, 0x86, 0x87, 0x81, 0xcf, 0x91, 0x7c, 0x00, 0x15, 0x4d, 0x1d,
+	0xaf, 0x19, 0x8d, 0xe2, 0x68, 0x81, 0x0e, 0xf7, 0xa3, 0xdc, 0x45, 0x20, 0xa8, 0x17, 0x67, 0x57,
+	0x94, 0xd9, 0x55, 0xa4, 0x45, 0xa6, 0xb7, 0x0e, 0x8b, 0x3e, 0x7e, 0x23, 0xda, 0x29, 0x7e, 0x62,
+	0xf6, 0xe7, 0x23, 0xf3, 0x73, 0xcd, 0x91, 0x75, 0x05, 0xce, 0x3e, 0x44, 0x0f, 0x05, 0x1e, 0x55,
+	0x84, 0xb8, 0x54, 0x2f, 0xb0, 0x1f, 0x7a, 0x54, 0x4c, 0x44, 0xdd, 0x82, 0x33, 0x19, 0x94, 0xca,
+	0xcc, 0x84, 0xb2, 0x50, 0x36, 0x05, 0x4e, 0xd6, 0xd6, 0x16, 0xac, 0xa5, 0x34, 0xa0, 0x04, 0x96,
+	0xf7, 0x93, 0xd5, 0x91, 0x91, 0xd7, 0xd1, 0x17, 0x50, 0xcf, 0x4a, 0x3e, 0xa7, 0xd3, 0x26, 0xcc,
+	0x29, 0xb0, 0x12, 0xfe, 0x52, 0x86, 0x57, 0x8d, 0xd6, 0x20, 0x6b, 0x13, 0xce, 0x8d, 0xc6, 0x74,
+	0xcc, 0x58, 0xfe, 0x36, 0xe0, 0x7c, 0xba, 0xbe, 0xea, 0x74, 0x22, 0xda, 0x3b, 0x70, 0x2a, 0xd1,
+	0x51, 0xa4, 0x1e, 0x63, 0x82, 0x7a, 0xaa, 0xec, 0x70, 0x31, 0x5d, 0xca, 0xd9, 0xd7, 0xa0, 0x98,
+	0x7f, 0x0d, 0x52, 0x4a, 0x9f, 0x99, 0xa0, 0xf4, 0xd9, 0x8c, 0xd2, 0xd7, 0x60, 0x26, 0x6a, 0x53,
+	0xb5, 0x52, 0xc3, 0xd8, 0x58, 0x50, 0x12, 0x8c, 0x32, 0x7a, 0xe9, 0xe2, 0x81, 0x2d, 0xb7, 0xac,
+	0x5f, 0x0d, 0xa8, 0x8f, 0x4f, 0x52, 0x55, 0xfc, 0x26, 0x94, 0x15, 0x25, 0x5a, 0xca, 0xe3, 0x29,
+	0x4f, 0x50, 0xc7, 0x55, 0xec, 0x11, 0xc2, 0xb7, 0xee, 0x43, 0x3d, 0x2b, 0xe8, 0x93, 0x55, 0xef,
+	0xdf, 0x02, 0x94, 0xf5, 0xc9, 0xbc, 0xba, 0xc9, 0x3d, 0x80, 0xae, 0x94, 0x99, 0xd3, 0xa6, 0x42,
+	0x46, 0x57, 0xbd, 0x6d, 0x36, 0xe3, 0xb9, 0xd2, 0xd4, 0x73, 0xa5, 0xf9, 0x42, 0x0f, 0x1e, 0xbb,
+	0xa2, 0xd0, 0x0f, 0x04, 0x21, 0x30, 0xe3, 0xd3, 0x3e, 0xaa, 0xea, 0xc8, 0xff, 0xa4, 0x01, 0x55,
+	0x07, 0x79, 0x97, 0xb9, 0xb2, 0xe9, 0xab, 0xe2, 0xa4, 0x4d, 0xa4, 0x09, 0x90, 0xcc, 0x1c, 0x5e,
+	0x9b, 0x95, 0x3c, 0x2e, 0xc4, 0x3c, 0x6a, 0xb3, 0x9d, 0x42, 0x10, 0x13, 0x8a, 0xd1, 0x50, 0x98,
+	0x93, 0x91, 0x95, 0x25, 0x70, 0x87, 0x79, 0x76, 0x64, 0x24, 0x4b, 0x30, 0x2b, 0x07, 0x9b, 0x2c,
+	0x6b, 0xc5, 0x8e, 0x17, 0xe4, 0x3e, 0x2c, 0x3a, 0x71, 0x6b, 0x6f, 0xeb, 0x37, 0xa4, 0x3c, 0xe5,
+	0x0d, 0x59, 0x70, 0x32, 0x73, 0x80, 0x3c, 0x86, 0x33, 0xa3, 0x4d, 0x91, 0xd7, 0x2a, 0x32, 0xd2,
+	0xe5, 0x8c, 0xa6, 0x93, 0x26, 0x68, 0x93, 0x91, 0xbe, 0xc8, 0xad, 0x3f, 0x8a, 0xb0, 0x98, 0x73,
+	0x36, 0x42, 0xbf, 0xe6, 0xb0, 0x90, 0xe2, 0x30, 0x5b, 0x92, 0xe2, 0x49, 0x4a, 0x92, 0x25, 0x77,
+	0xe6, 0x48, 0x72, 0xd7, 0x61, 0xb1, 0x1b, 0x38, 0xd8, 0x56, 0xe9, 0x46, 0x44, 0xc7, 0xef, 0xcd,
+	0x7c, 0x64, 0xde, 0x96, 0xd6, 0x68, 0x44, 0x5f, 0x85, 0x6a, 0x48, 0xbb, 0x7b, 0xb4, 0x17, 0x63,
+	0x4a, 0xb9, 0x62, 0x80, 0xda, 0x8c, 0xa0, 0x13, 0xe8, 0x9b, 0x3b, 0x29, 0x7d, 0xe4, 0x2e, 0x94,
+	0x3c, 0xda, 0x41, 0x8f, 0xd7, 0xca, 0xf2, 0x6c, 0x63, 0x5c, 0xf5, 0x9a, 0xcf, 0x24, 0xe4, 0x91,
+	0x2f, 0xd8, 0xd0, 0x56, 0x78, 0xf3, 0x1e, 0x54, 0x53, 0x66, 0x72, 0x1a, 0x8a, 0xba, 0x29, 0x55,
+	0xec, 0xe8, 0x6f, 0xa4, 0x9b, 0x7d, 0xea, 0x0d, 0x34, 0xed, 0xf1, 0x62, 0xb3, 0x70, 0xd7, 0xb8,
+	0xfd, 0x43, 0xf5, 0xb0, 0x66, 0xdb, 0xc8, 0xf6, 0xdd, 0x2e, 0x92, 0x5d, 0x58, 0xc8, 0x76, 0x62,
+	0x62, 0xca, 0x50, 0xc6, 0xde, 0x48, 0xcc, 0xec, 0x78, 0xb3, 0xae, 0xbe, 0xff, 0xeb, 0x9f, 0x5f,
+	0x0a, 0x97, 0xac, 0x95, 0xe8, 0xaa, 0xc4, 0x5b, 0xfb, 0xb7, 0x3a, 0x28, 0xe8, 0xad, 0xe4, 0x32,
+	0xc8, 0x37, 0x93, 0x0b, 0x0a, 0xf9, 0x12, 0xaa, 0xa9, 0x0e, 0x4d, 0x56, 0xe4, 0x83, 0x46, 0xef,
+	0x12, 0x79, 0x0f, 0x97, 0xa5, 0x87, 0x8b, 0xa4, 0x3e, 0xc1, 0x43, 0xeb, 0xad, 0xeb, 0xbc, 0x23,
+	0x3d, 0x98, 0xcf, 0x4c, 0x68, 0x72, 0x2e, 0xe9, 0x81, 0xf9, 0x3b, 0x88, 0x69, 0x8e, 0xdb, 0x8a,
+	0x9b, 0xa0, 0xb5, 0x2a, 0xbd, 0x9d, 0x23, 0x93, 0xf2, 0x21, 0x5f, 0xc1, 0x42, 0xb6, 0x59, 0x29,
+	0xb6, 0xc6, 0x8e, 0x64, 0x73, 0x79, 0x44, 0xd7, 0x8f, 0xa2, 0x2b, 0xac, 0x4e, 0xea, 0xda, 0xf4,
+	0xa4, 0x42, 0xc9, 0x98, 0x1e, 0xac, 0x87, 0x8c, 0xe5, 0x46, 0xad, 0x59, 0x1b, 0xdd, 0x50, 0xe9,
+	0x34, 0xa5, 0x9f, 0x0d, 0xb2, 0x3e, 0xcd, 0x4f, 0x4b, 0x0f, 0x76, 0x4e, 0xde, 0x1b, 0xf9, 0x9b,
+	0xa8, 0x7e, 0xb3, 0xd7, 0xc6, 0x68, 0x22, 0xdb, 0xa7, 0xcd, 0xb1, 0xfd, 0xc7, 0xba, 0x29, 0x43,
+	0xb8, 0x66, 0xad, 0x8e, 0x0f, 0x41, 0xf7, 0x30, 0xbe, 0xa9, 0x47, 0x39, 0xf9, 0xd6, 0xc8, 0xdc,
+	0x31, 0x75, 0x04, 0x17, 0xf3, 0x82, 0x39, 0x96, 0xfb, 0x0f, 0xa5, 0xfb, 0x26, 0xb9, 0x7e, 0x84,
+	0xfb, 0xd6, 0xdb, 0xc3, 0x19, 0xf3, 0x8e, 0x7c, 0x97, 0xbb, 0xbf, 0xaa, 0xa7, 0x71, 0xd2, 0x18,
+	0xd1, 0x4e, 0xee, 0xb2, 0x60, 0xae, 0x4d, 0x41, 0xa8, 0xaa, 0x5c, 0x91, 0x31, 0xad, 0x91, 0xa3,
+	0x28, 0x21, 0x3f, 0x1a, 0xf9, 0xbb, 0x5e, 0xb6, 0x1c, 0xd3, 0xc6, 0xe6, 0x44, 0xed, 0x29, 0x46,
+	0xae, 0x9d, 0x8c, 0x91, 0xdf, 0x0c, 0x30, 0x27, 0x5f, 0xfa, 0xc8, 0xfa, 0x84, 0xe2, 0x1c, 0x5f,
+	0xaa, 0x1f, 0xcb, 0xb0, 0xee, 0x92, 0x8f, 0x4e, 0x12, 0x56, 0x4a, 0xba, 0xbf, 0x1b, 0x50, 0x9f,
+	0xf6, 0x01, 0x44, 0x36, 0xe2, 0x7e, 0x7e, 0xf4, 0x37, 0xd2, 0x44, 0xe6, 0x3e, 0x93, 0x21, 0x3e,
+	0xb4, 0xb6, 0x26, 0xbe, 0x4d, 0xa9, 0x4f, 0xab, 0x77, 0xad, 0xdc, 0x94, 0xce, 0x04, 0xbe, 0xf5,
+	0xbd, 0xf1, 0xf3, 0x83, 0xcf, 0xed, 0x3a, 0xcc, 0x29, 0x14, 0xf9, 0x3f, 0x59, 0x84, 0x79, 0xb3,
+	0x2a, 0x83, 0xdc, 0x16, 0x54, 0x0c, 0xf8, 0xab, 0x55, 0xb8, 0x00, 0xa5, 0x2d, 0xa4, 0x0c, 0x19,
+	0x39, 0x53, 0x2e, 0x98, 0xf3, 0x74, 0x20, 0x5e, 0x07, 0xcc, 0x7d, 0x23, 0x3f, 0x33, 0x1b, 0x85,
+	0xce, 0x29, 0x80, 0x04, 0xf0, 0xbf, 0x57, 0x77, 0x7a, 0xae, 0x78, 0x3d, 0xe8, 0x34, 0xbb, 0x41,
+	0xbf, 0xb5, 0x37, 0xe8, 0xe0, 0xae, 0x17, 0x1c, 0xa4, 0x82, 0x4b, 0x7f, 0xd3, 0xf6, 0x82, 0x76,
+	0xd7, 0x73, 0xd1, 0x17, 0x9d, 0x92, 0xcc, 0xf1, 0xce, 0x7f, 0x03, 0x00, 0x2d, 0xc2, 0x5b, 0x2e,
+	0xcc, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
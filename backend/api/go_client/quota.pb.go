@@ -0,0 +1,411 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/quota.proto
+
+package go_client
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type UpdateNamespaceQuotaRequest struct {
+	// The namespace whose quota is updated.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The new limits of the namespace. The usage is ignored.
+	Quota                *NamespaceQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateNamespaceQuotaRequest) Reset()         { *m = UpdateNamespaceQuotaRequest{} }
+func (m *UpdateNamespaceQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNamespaceQuotaRequest) ProtoMessage()    {}
+func (*UpdateNamespaceQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e9941f79008c72, []int{0}
+}
+
+func (m *UpdateNamespaceQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNamespaceQuotaRequest.Unmarshal(m, b)
+}
+func (m *UpdateNamespaceQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNamespaceQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateNamespaceQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNamespaceQuotaRequest.Merge(m, src)
+}
+func (m *UpdateNamespaceQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateNamespaceQuotaRequest.Size(m)
+}
+func (m *UpdateNamespaceQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNamespaceQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNamespaceQuotaRequest proto.InternalMessageInfo
+
+func (m *UpdateNamespaceQuotaRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *UpdateNamespaceQuotaRequest) GetQuota() *NamespaceQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type GetNamespaceQuotaRequest struct {
+	// The namespace whose quota is retrieved.
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNamespaceQuotaRequest) Reset()         { *m = GetNamespaceQuotaRequest{} }
+func (m *GetNamespaceQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNamespaceQuotaRequest) ProtoMessage()    {}
+func (*GetNamespaceQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e9941f79008c72, []int{1}
+}
+
+func (m *GetNamespaceQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNamespaceQuotaRequest.Unmarshal(m, b)
+}
+func (m *GetNamespaceQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNamespaceQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNamespaceQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNamespaceQuotaRequest.Merge(m, src)
+}
+func (m *GetNamespaceQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNamespaceQuotaRequest.Size(m)
+}
+func (m *GetNamespaceQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNamespaceQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNamespaceQuotaRequest proto.InternalMessageInfo
+
+func (m *GetNamespaceQuotaRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type NamespaceQuota struct {
+	// Output. The namespace the quota applies to.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The maximum number of runs of the namespace that are active at the same
+	// time. Zero means there is no limit.
+	MaxActiveRuns int32 `protobuf:"varint,2,opt,name=max_active_runs,json=maxActiveRuns,proto3" json:"max_active_runs,omitempty"`
+	// The maximum number of runs of the namespace that are started within an
+	// hour. Zero means there is no limit.
+	MaxRunsPerHour int32 `protobuf:"varint,3,opt,name=max_runs_per_hour,json=maxRunsPerHour,proto3" json:"max_runs_per_hour,omitempty"`
+	// Output. The current usage of the quota.
+	Usage                *QuotaUsage `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *NamespaceQuota) Reset()         { *m = NamespaceQuota{} }
+func (m *NamespaceQuota) String() string { return proto.CompactTextString(m) }
+func (*NamespaceQuota) ProtoMessage()    {}
+func (*NamespaceQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e9941f79008c72, []int{2}
+}
+
+func (m *NamespaceQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceQuota.Unmarshal(m, b)
+}
+func (m *NamespaceQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceQuota.Marshal(b, m, deterministic)
+}
+func (m *NamespaceQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceQuota.Merge(m, src)
+}
+func (m *NamespaceQuota) XXX_Size() int {
+	return xxx_messageInfo_NamespaceQuota.Size(m)
+}
+func (m *NamespaceQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceQuota proto.InternalMessageInfo
+
+func (m *NamespaceQuota) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceQuota) GetMaxActiveRuns() int32 {
+	if m != nil {
+		return m.MaxActiveRuns
+	}
+	return 0
+}
+
+func (m *NamespaceQuota) GetMaxRunsPerHour() int32 {
+	if m != nil {
+		return m.MaxRunsPerHour
+	}
+	return 0
+}
+
+func (m *NamespaceQuota) GetUsage() *QuotaUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type QuotaUsage struct {
+	// The number of runs of the namespace that are currently active.
+	ActiveRuns int32 `protobuf:"varint,1,opt,name=active_runs,json=activeRuns,proto3" json:"active_runs,omitempty"`
+	// The number of runs of the namespace started within the last hour.
+	RunsLastHour int32 `protobuf:"varint,2,opt,name=runs_last_hour,json=runsLastHour,proto3" json:"runs_last_hour,omitempty"`
+	// The number of runs of the namespace waiting in the queue.
+	QueuedRuns           int32    `protobuf:"varint,3,opt,name=queued_runs,json=queuedRuns,proto3" json:"queued_runs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e9941f79008c72, []int{3}
+}
+
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaUsage.Unmarshal(m, b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return xxx_messageInfo_QuotaUsage.Size(m)
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetActiveRuns() int32 {
+	if m != nil {
+		return m.ActiveRuns
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetRunsLastHour() int32 {
+	if m != nil {
+		return m.RunsLastHour
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetQueuedRuns() int32 {
+	if m != nil {
+		return m.QueuedRuns
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UpdateNamespaceQuotaRequest)(nil), "api.UpdateNamespaceQuotaRequest")
+	proto.RegisterType((*GetNamespaceQuotaRequest)(nil), "api.GetNamespaceQuotaRequest")
+	proto.RegisterType((*NamespaceQuota)(nil), "api.NamespaceQuota")
+	proto.RegisterType((*QuotaUsage)(nil), "api.QuotaUsage")
+}
+
+func init() { proto.RegisterFile("backend/api/quota.proto", fileDescriptor_91e9941f79008c72) }
+
+var fileDescriptor_91e9941f79008c72 = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x71, 0x4a, 0x0a, 0x9d, 0xfc, 0x53, 0xb6, 0x48, 0x44, 0x26, 0x55, 0xa2, 0x08, 0x50,
+	0x8b, 0xda, 0x58, 0x4d, 0x2e, 0xc0, 0xad, 0xbd, 0xc0, 0x01, 0x10, 0xb8, 0xea, 0xa5, 0x97, 0x68,
+	0xec, 0x4c, 0x9c, 0x55, 0x1d, 0xaf, 0xb3, 0x7f, 0xd2, 0x88, 0x8a, 0x0b, 0x12, 0x12, 0x67, 0x90,
+	0x78, 0x06, 0xde, 0x87, 0x57, 0xe0, 0x41, 0x90, 0xd7, 0x90, 0xb4, 0x34, 0x80, 0xe8, 0xc9, 0xd6,
+	0x37, 0x9f, 0xe7, 0xfb, 0xad, 0x67, 0x07, 0xee, 0x06, 0x18, 0x9e, 0x52, 0x32, 0xf4, 0x30, 0xe5,
+	0xde, 0xd4, 0x08, 0x8d, 0xdd, 0x54, 0x0a, 0x2d, 0xd8, 0x1a, 0xa6, 0xdc, 0xbd, 0x54, 0x25, 0x29,
+	0x85, 0xcc, 0xab, 0x6e, 0x33, 0x12, 0x22, 0x8a, 0xc9, 0xea, 0x98, 0x24, 0x42, 0xa3, 0xe6, 0x22,
+	0x51, 0x3f, 0xab, 0xbb, 0xf6, 0x11, 0xee, 0x45, 0x94, 0xec, 0xa9, 0x33, 0x8c, 0x22, 0x92, 0x9e,
+	0x48, 0xad, 0xe3, 0xaa, 0xbb, 0x33, 0x82, 0x7b, 0xc7, 0xe9, 0x10, 0x35, 0xbd, 0xc2, 0x09, 0xa9,
+	0x14, 0x43, 0x7a, 0x93, 0x71, 0xf8, 0x34, 0x35, 0xa4, 0x34, 0x6b, 0xc2, 0x46, 0xf2, 0xab, 0xd0,
+	0x70, 0xda, 0xce, 0xf6, 0x86, 0xbf, 0x14, 0xd8, 0x0e, 0x14, 0x2d, 0x75, 0xa3, 0xd0, 0x76, 0xb6,
+	0x4b, 0xbd, 0xcd, 0x2e, 0xa6, 0xbc, 0xfb, 0x5b, 0xa3, 0xdc, 0xd1, 0x79, 0x0c, 0x8d, 0x67, 0xa4,
+	0xaf, 0x11, 0xd2, 0xf9, 0xea, 0x40, 0xf5, 0xf2, 0x77, 0xff, 0xa0, 0x7a, 0x08, 0xb5, 0x09, 0xce,
+	0x07, 0x18, 0x6a, 0x3e, 0xa3, 0x81, 0x34, 0x89, 0xb2, 0x7c, 0x45, 0xbf, 0x32, 0xc1, 0xf9, 0x81,
+	0x55, 0x7d, 0x93, 0x28, 0xb6, 0x03, 0xf5, 0xcc, 0x97, 0x19, 0x06, 0x29, 0xc9, 0xc1, 0x58, 0x18,
+	0xd9, 0x58, 0xb3, 0xce, 0xea, 0x04, 0xe7, 0x99, 0xe7, 0x35, 0xc9, 0xe7, 0xc2, 0x48, 0xf6, 0x00,
+	0x8a, 0x46, 0x61, 0x44, 0x8d, 0x9b, 0xf6, 0xa0, 0x35, 0x7b, 0x50, 0xcb, 0x72, 0x9c, 0xc9, 0x7e,
+	0x5e, 0xed, 0x68, 0x80, 0xa5, 0xc8, 0x5a, 0x50, 0xba, 0xc8, 0xe0, 0xd8, 0xce, 0x80, 0x4b, 0x80,
+	0xfb, 0x50, 0xb5, 0xe1, 0x31, 0x2a, 0x9d, 0xa7, 0xe7, 0x9c, 0xe5, 0x4c, 0x7d, 0x81, 0x4a, 0xdb,
+	0xec, 0x16, 0x94, 0xa6, 0x86, 0x0c, 0x0d, 0xf3, 0x36, 0x39, 0x20, 0xe4, 0x52, 0xd6, 0xa6, 0xf7,
+	0xa5, 0x00, 0x65, 0x1b, 0x7b, 0x44, 0x72, 0xc6, 0x43, 0x62, 0x1f, 0x1d, 0xb8, 0xb3, 0x6a, 0xa8,
+	0xac, 0x6d, 0xb9, 0xff, 0x32, 0x6f, 0x77, 0xd5, 0x08, 0x3b, 0x4f, 0xde, 0x7f, 0xfb, 0xfe, 0xb9,
+	0xd0, 0x77, 0x1f, 0x65, 0x37, 0x4e, 0x79, 0xb3, 0xfd, 0x80, 0x34, 0xee, 0x7b, 0x8b, 0x3f, 0xae,
+	0xbc, 0xf3, 0xc5, 0xfb, 0xbb, 0xfc, 0x1e, 0x3f, 0xcd, 0xc7, 0xce, 0xce, 0xa1, 0x7e, 0x65, 0xec,
+	0x6c, 0xcb, 0x86, 0xfc, 0xe9, 0x3a, 0xac, 0x66, 0xe8, 0x59, 0x86, 0x5d, 0xf6, 0x1f, 0x0c, 0x87,
+	0x1f, 0x9c, 0x4f, 0x07, 0x2f, 0xfd, 0x26, 0xdc, 0x1a, 0xd2, 0x08, 0x4d, 0xac, 0x59, 0x9d, 0xd5,
+	0xa0, 0xe2, 0x96, 0x6c, 0xff, 0x23, 0x8d, 0xda, 0xa8, 0x93, 0x16, 0x6c, 0xc1, 0xfa, 0x21, 0xa1,
+	0x24, 0xc9, 0x36, 0x6f, 0x17, 0xdc, 0x0a, 0x1a, 0x3d, 0x16, 0x92, 0xbf, 0xb5, 0x5b, 0xd2, 0x2e,
+	0x04, 0x65, 0x80, 0x85, 0xe1, 0xc6, 0x49, 0x3f, 0xe2, 0x7a, 0x6c, 0x82, 0x6e, 0x28, 0x26, 0xde,
+	0xa9, 0x09, 0x68, 0x14, 0x8b, 0x33, 0x2f, 0xe5, 0x29, 0xc5, 0x3c, 0x21, 0xe5, 0x5d, 0xdc, 0xd8,
+	0x48, 0x0c, 0xc2, 0x98, 0x53, 0xa2, 0x83, 0x75, 0xbb, 0x6a, 0xfd, 0x1f, 0x03, 0x00, 0xc5, 0x00,
+	0xe2, 0x9d, 0xef, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QuotaServiceClient is the client API for QuotaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QuotaServiceClient interface {
+	// Sets the limits on the runs of a namespace. Runs created over the limits
+	// are queued until the namespace has capacity again.
+	UpdateNamespaceQuota(ctx context.Context, in *UpdateNamespaceQuotaRequest, opts ...grpc.CallOption) (*NamespaceQuota, error)
+	// Finds the limits on the runs of a namespace and their current usage.
+	GetNamespaceQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*NamespaceQuota, error)
+}
+
+type quotaServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQuotaServiceClient(cc *grpc.ClientConn) QuotaServiceClient {
+	return &quotaServiceClient{cc}
+}
+
+func (c *quotaServiceClient) UpdateNamespaceQuota(ctx context.Context, in *UpdateNamespaceQuotaRequest, opts ...grpc.CallOption) (*NamespaceQuota, error) {
+	out := new(NamespaceQuota)
+	err := c.cc.Invoke(ctx, "/api.QuotaService/UpdateNamespaceQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaServiceClient) GetNamespaceQuota(ctx context.Context, in *GetNamespaceQuotaRequest, opts ...grpc.CallOption) (*NamespaceQuota, error) {
+	out := new(NamespaceQuota)
+	err := c.cc.Invoke(ctx, "/api.QuotaService/GetNamespaceQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotaServiceServer is the server API for QuotaService service.
+type QuotaServiceServer interface {
+	// Sets the limits on the runs of a namespace. Runs created over the limits
+	// are queued until the namespace has capacity again.
+	UpdateNamespaceQuota(context.Context, *UpdateNamespaceQuotaRequest) (*NamespaceQuota, error)
+	// Finds the limits on the runs of a namespace and their current usage.
+	GetNamespaceQuota(context.Context, *GetNamespaceQuotaRequest) (*NamespaceQuota, error)
+}
+
+// UnimplementedQuotaServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQuotaServiceServer struct {
+}
+
+func (*UnimplementedQuotaServiceServer) UpdateNamespaceQuota(ctx context.Context, req *UpdateNamespaceQuotaRequest) (*NamespaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceQuota not implemented")
+}
+func (*UnimplementedQuotaServiceServer) GetNamespaceQuota(ctx context.Context, req *GetNamespaceQuotaRequest) (*NamespaceQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceQuota not implemented")
+}
+
+func RegisterQuotaServiceServer(s *grpc.Server, srv QuotaServiceServer) {
+	s.RegisterService(&_QuotaService_serviceDesc, srv)
+}
+
+func _QuotaService_UpdateNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).UpdateNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.QuotaService/UpdateNamespaceQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).UpdateNamespaceQuota(ctx, req.(*UpdateNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotaService_GetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).GetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.QuotaService/GetNamespaceQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).GetNamespaceQuota(ctx, req.(*GetNamespaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QuotaService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.QuotaService",
+	HandlerType: (*QuotaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateNamespaceQuota",
+			Handler:    _QuotaService_UpdateNamespaceQuota_Handler,
+		},
+		{
+			MethodName: "GetNamespaceQuota",
+			Handler:    _QuotaService_GetNamespaceQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/api/quota.proto",
+}
@@ -29,6 +29,7 @@ const (
 	ResourceType_PIPELINE              ResourceType = 3
 	ResourceType_PIPELINE_VERSION      ResourceType = 4
 	ResourceType_NAMESPACE             ResourceType = 5
+	ResourceType_RUN                   ResourceType = 6
 )
 
 var ResourceType_name = map[int32]string{
@@ -38,6 +39,7 @@ var ResourceType_name = map[int32]string{
 	3: "PIPELINE",
 	4: "PIPELINE_VERSION",
 	5: "NAMESPACE",
+	6: "RUN",
 }
 
 var ResourceType_value = map[string]int32{
@@ -47,6 +49,7 @@ var ResourceType_value = map[string]int32{
 	"PIPELINE":              3,
 	"PIPELINE_VERSION":      4,
 	"NAMESPACE":             5,
+	"RUN":                   6,
 }
 
 func (x ResourceType) String() string {
@@ -203,28 +206,29 @@ func init() {
 }
 
 var fileDescriptor_465b210f45a041b3 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x6b, 0x9c, 0x40,
-	0x14, 0xc6, 0xa3, 0x6e, 0x9a, 0xee, 0xdb, 0xed, 0x32, 0x79, 0xa4, 0x60, 0x6f, 0x61, 0x69, 0x21,
-	0xe4, 0xa0, 0x90, 0x90, 0x7b, 0xcd, 0x76, 0xa0, 0x76, 0x93, 0x51, 0x46, 0xd3, 0xb4, 0xbd, 0x88,
-	0xba, 0x2f, 0xc9, 0xb0, 0x46, 0x07, 0x57, 0x29, 0x5e, 0xfb, 0x97, 0x97, 0x48, 0xc4, 0xec, 0x6d,
-	0x86, 0xdf, 0xc7, 0xf7, 0xfb, 0xe0, 0xc1, 0xe7, 0x2c, 0xcd, 0xb7, 0x54, 0x6e, 0xdc, 0x54, 0x2b,
-	0xb7, 0xa6, 0x5d, 0xd5, 0xd6, 0x39, 0x25, 0x35, 0x3d, 0x50, 0x4d, 0x65, 0x4e, 0x8e, 0xae, 0xab,
-	0xa6, 0x42, 0x2b, 0xd5, 0x6a, 0xf9, 0x0d, 0x66, 0xf2, 0x35, 0xb0, 0xa6, 0x0e, 0xbf, 0xc0, 0xa4,
-	0xe9, 0x34, 0xd9, 0xc6, 0xa9, 0x71, 0xb6, 0xb8, 0x38, 0x76, 0x52, 0xad, 0x9c, 0x81, 0xc7, 0x9d,
-	0x26, 0xd9, 0x63, 0x5c, 0x80, 0xa9, 0x36, 0xb6, 0x79, 0x6a, 0x9c, 0x4d, 0xa5, 0xa9, 0x36, 0xcb,
-	0x7f, 0x06, 0x1c, 0x0f, 0x31, 0x39, 0x68, 0x70, 0x09, 0xd6, 0x96, 0xba, 0xbe, 0x6b, 0x76, 0xc1,
-	0xf6, 0xba, 0xd6, 0xd4, 0xc9, 0x17, 0x88, 0x08, 0x93, 0x32, 0x7d, 0x26, 0xdb, 0xea, 0xbb, 0xfa,
-	0x37, 0x5e, 0xc1, 0xbc, 0xa6, 0x22, 0x6d, 0x54, 0x55, 0xee, 0x9e, 0x94, 0xee, 0x3d, 0xe3, 0x98,
-	0x11, 0xc8, 0xbd, 0xd8, 0x79, 0x0b, 0xf3, 0xb7, 0x53, 0xf1, 0x13, 0x7c, 0xbc, 0x13, 0x6b, 0x11,
-	0xdc, 0x8b, 0x44, 0xf2, 0x28, 0xb8, 0x93, 0x2b, 0x9e, 0xc4, 0xbf, 0x43, 0xce, 0x0e, 0x70, 0x01,
-	0xc0, 0x7f, 0x85, 0x5c, 0xfa, 0xb7, 0x5c, 0xc4, 0xcc, 0xc0, 0x23, 0xb0, 0x7e, 0x04, 0xd7, 0xcc,
-	0xc4, 0x39, 0xbc, 0x0f, 0xfd, 0x90, 0xdf, 0xf8, 0x82, 0x33, 0x0b, 0x4f, 0x80, 0x0d, 0xbf, 0xe4,
-	0x27, 0x97, 0x91, 0x1f, 0x08, 0x36, 0xc1, 0x0f, 0x30, 0x15, 0xde, 0x2d, 0x8f, 0x42, 0x6f, 0xc5,
-	0xd9, 0xe1, 0xf9, 0xd7, 0x17, 0xed, 0x38, 0x03, 0x6d, 0x38, 0x19, 0xb5, 0x37, 0x5e, 0xec, 0x07,
-	0x22, 0xfa, 0xee, 0x87, 0xec, 0x00, 0xa7, 0x70, 0x18, 0xdc, 0x0b, 0x2e, 0x99, 0x81, 0x33, 0x38,
-	0x5a, 0x49, 0xee, 0xc5, 0x81, 0x64, 0xe6, 0xf5, 0xd5, 0x9f, 0xcb, 0x47, 0xd5, 0x3c, 0xb5, 0x99,
-	0x93, 0x57, 0xcf, 0xee, 0xb6, 0xcd, 0xe8, 0xa1, 0xa8, 0xfe, 0xba, 0x5a, 0x69, 0x2a, 0x54, 0x49,
-	0x3b, 0xf7, 0xed, 0x39, 0x1f, 0xab, 0x24, 0x2f, 0x14, 0x95, 0x4d, 0xf6, 0xae, 0x3f, 0xe3, 0xe5,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x58, 0x92, 0x1b, 0xee, 0x01, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x41, 0x6b, 0xdb, 0x40,
+	0x14, 0x84, 0x23, 0xc9, 0x49, 0xea, 0x67, 0xd7, 0x6c, 0x1e, 0x29, 0xa8, 0xb7, 0x60, 0x5a, 0x08,
+	0x39, 0x48, 0x90, 0x90, 0x7b, 0x15, 0x77, 0xa1, 0xaa, 0x93, 0x95, 0x58, 0xc9, 0x4d, 0xdb, 0x8b,
+	0x90, 0xe4, 0x97, 0x64, 0xb1, 0x22, 0x2d, 0xb2, 0x4c, 0xd1, 0xa5, 0x87, 0xfe, 0xf2, 0x62, 0x11,
+	0x61, 0xfb, 0xb6, 0xcb, 0x37, 0x6f, 0x66, 0x60, 0xe0, 0x53, 0x96, 0xe6, 0x2b, 0x2a, 0x97, 0x6e,
+	0xaa, 0x95, 0x5b, 0xd3, 0xba, 0xda, 0xd4, 0x39, 0x25, 0x35, 0x3d, 0x51, 0x4d, 0x65, 0x4e, 0x8e,
+	0xae, 0xab, 0xa6, 0x42, 0x2b, 0xd5, 0x6a, 0xfa, 0x15, 0x46, 0xf2, 0x4d, 0x30, 0xa7, 0x16, 0x3f,
+	0xc3, 0xa0, 0x69, 0x35, 0xd9, 0xc6, 0x85, 0x71, 0x39, 0xb9, 0x3e, 0x73, 0x52, 0xad, 0x9c, 0x9e,
+	0xc7, 0xad, 0x26, 0xd9, 0x61, 0x9c, 0x80, 0xa9, 0x96, 0xb6, 0x79, 0x61, 0x5c, 0x0e, 0xa5, 0xa9,
+	0x96, 0xd3, 0x7f, 0x06, 0x9c, 0xf5, 0x32, 0xd9, 0xc7, 0xe0, 0x14, 0xac, 0x15, 0xb5, 0x9d, 0xd7,
+	0xe8, 0x9a, 0x1d, 0x78, 0xcd, 0xa9, 0x95, 0x5b, 0x88, 0x08, 0x83, 0x32, 0x7d, 0x25, 0xdb, 0xea,
+	0xbc, 0xba, 0x37, 0xde, 0xc2, 0xb8, 0xa6, 0x22, 0x6d, 0x54, 0x55, 0xae, 0x5f, 0x94, 0xb6, 0xcd,
+	0x83, 0x32, 0x3b, 0x20, 0x0f, 0x64, 0x57, 0x7f, 0x61, 0xbc, 0x5f, 0x15, 0x3f, 0xc2, 0x87, 0x85,
+	0x98, 0x8b, 0xe0, 0x51, 0x24, 0x92, 0x47, 0xc1, 0x42, 0xce, 0x78, 0x12, 0xff, 0x0a, 0x39, 0x3b,
+	0xc2, 0x09, 0x00, 0xff, 0x19, 0x72, 0xe9, 0x3f, 0x70, 0x11, 0x33, 0x03, 0x4f, 0xc1, 0xfa, 0x1e,
+	0xdc, 0x31, 0x13, 0xc7, 0xf0, 0x2e, 0xf4, 0x43, 0x7e, 0xef, 0x0b, 0xce, 0x2c, 0x3c, 0x07, 0xd6,
+	0xff, 0x92, 0x1f, 0x5c, 0x46, 0x7e, 0x20, 0xd8, 0x00, 0xdf, 0xc3, 0x50, 0x78, 0x0f, 0x3c, 0x0a,
+	0xbd, 0x19, 0x67, 0xc7, 0xdb, 0x5b, 0xb9, 0x10, 0xec, 0xe4, 0xea, 0xcb, 0x36, 0x7f, 0xd7, 0x07,
+	0x6d, 0x38, 0xdf, 0xe5, 0xdf, 0x7b, 0xb1, 0x1f, 0x88, 0xe8, 0x9b, 0x1f, 0xb2, 0x23, 0x1c, 0xc2,
+	0x71, 0xf0, 0x28, 0xb8, 0x64, 0x06, 0x8e, 0xe0, 0x74, 0x26, 0xb9, 0x17, 0x07, 0x92, 0x99, 0x77,
+	0xb7, 0xbf, 0x6f, 0x9e, 0x55, 0xf3, 0xb2, 0xc9, 0x9c, 0xbc, 0x7a, 0x75, 0x57, 0x9b, 0x8c, 0x9e,
+	0x8a, 0xea, 0x8f, 0xab, 0x95, 0xa6, 0x42, 0x95, 0xb4, 0x76, 0xf7, 0x77, 0x7d, 0xae, 0x92, 0xbc,
+	0x50, 0x54, 0x36, 0xd9, 0x49, 0xb7, 0xe7, 0xcd, 0xff, 0x01, 0x00, 0x61, 0x0a, 0x75, 0xe2, 0xf7,
+	0x01, 0x00, 0x00,
 }
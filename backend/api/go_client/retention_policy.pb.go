@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/retention_policy.proto
+
+package go_client
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CreateRetentionPolicyRequest struct {
+	// The retention policy to be created.
+	RetentionPolicy      *RetentionPolicy `protobuf:"bytes,1,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateRetentionPolicyRequest) Reset()         { *m = CreateRetentionPolicyRequest{} }
+func (m *CreateRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRetentionPolicyRequest) ProtoMessage()    {}
+func (*CreateRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{0}
+}
+
+func (m *CreateRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *CreateRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRetentionPolicyRequest.Merge(m, src)
+}
+func (m *CreateRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRetentionPolicyRequest.Size(m)
+}
+func (m *CreateRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *CreateRetentionPolicyRequest) GetRetentionPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return nil
+}
+
+type GetRetentionPolicyRequest struct {
+	// The ID of the retention policy to be retrieved.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRetentionPolicyRequest) Reset()         { *m = GetRetentionPolicyRequest{} }
+func (m *GetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRetentionPolicyRequest) ProtoMessage()    {}
+func (*GetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{1}
+}
+
+func (m *GetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *GetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRetentionPolicyRequest.Merge(m, src)
+}
+func (m *GetRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Size(m)
+}
+func (m *GetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *GetRetentionPolicyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListRetentionPoliciesRequest struct {
+	// A page token to request the next page of results. The token is acquried
+	// from the nextPageToken field of the response from the previous
+	// ListRetentionPolicies call or can be omitted when fetching the first page.
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The number of retention policies to be listed per page. If there are more
+	// retention policies than this number, the response message will contain a
+	// nextPageToken field you can use to fetch the next page.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Can be format of "field_name", "field_name asc" or "field_name desc"
+	// Ascending by default.
+	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// A url-encoded, JSON-serialized Filter protocol buffer (see
+	// [filter.proto](https://github.com/kubeflow/pipelines/
+	// blob/master/backend/api/filter.proto)).
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// What resource reference to filter on.
+	// For retention policies, the valid resource types are Experiment and
+	// Namespace. An sample query string could be
+	// resource_reference_key.type=NAMESPACE&resource_reference_key.id=ns1
+	ResourceReferenceKey *ResourceKey `protobuf:"bytes,5,opt,name=resource_reference_key,json=resourceReferenceKey,proto3" json:"resource_reference_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListRetentionPoliciesRequest) Reset()         { *m = ListRetentionPoliciesRequest{} }
+func (m *ListRetentionPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRetentionPoliciesRequest) ProtoMessage()    {}
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{2}
+}
+
+func (m *ListRetentionPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Unmarshal(m, b)
+}
+func (m *ListRetentionPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRetentionPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetentionPoliciesRequest.Merge(m, src)
+}
+func (m *ListRetentionPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRetentionPoliciesRequest.Size(m)
+}
+func (m *ListRetentionPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetentionPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetentionPoliciesRequest proto.InternalMessageInfo
+
+func (m *ListRetentionPoliciesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListRetentionPoliciesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListRetentionPoliciesRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListRetentionPoliciesRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *ListRetentionPoliciesRequest) GetResourceReferenceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceReferenceKey
+	}
+	return nil
+}
+
+type ListRetentionPoliciesResponse struct {
+	// A list of retention policies returned.
+	RetentionPolicies []*RetentionPolicy `protobuf:"bytes,1,rep,name=retention_policies,json=retentionPolicies,proto3" json:"retention_policies,omitempty"`
+	// The total number of retention policies for the given query.
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The token to list the next page of retention policies.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRetentionPoliciesResponse) Reset()         { *m = ListRetentionPoliciesResponse{} }
+func (m *ListRetentionPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRetentionPoliciesResponse) ProtoMessage()    {}
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{3}
+}
+
+func (m *ListRetentionPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Unmarshal(m, b)
+}
+func (m *ListRetentionPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRetentionPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRetentionPoliciesResponse.Merge(m, src)
+}
+func (m *ListRetentionPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRetentionPoliciesResponse.Size(m)
+}
+func (m *ListRetentionPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRetentionPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRetentionPoliciesResponse proto.InternalMessageInfo
+
+func (m *ListRetentionPoliciesResponse) GetRetentionPolicies() []*RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicies
+	}
+	return nil
+}
+
+func (m *ListRetentionPoliciesResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ListRetentionPoliciesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DeleteRetentionPolicyRequest struct {
+	// The ID of the retention policy to be deleted.
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRetentionPolicyRequest) Reset()         { *m = DeleteRetentionPolicyRequest{} }
+func (m *DeleteRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRetentionPolicyRequest) ProtoMessage()    {}
+func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{4}
+}
+
+func (m *DeleteRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRetentionPolicyRequest.Merge(m, src)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRetentionPolicyRequest.Size(m)
+}
+func (m *DeleteRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *DeleteRetentionPolicyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RetentionPolicy struct {
+	// Output. Unique policy ID. Generated by API server.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The experiment or namespace the policy applies to. At most one policy can
+	// be created for each experiment or namespace. The policy of an experiment
+	// takes precedence over the policy of its namespace.
+	ResourceKey *ResourceKey `protobuf:"bytes,2,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	// Runs that finished more than this number of days ago are archived.
+	// Zero means runs are never archived by the policy.
+	ArchiveAfterDays int32 `protobuf:"varint,3,opt,name=archive_after_days,json=archiveAfterDays,proto3" json:"archive_after_days,omitempty"`
+	// Runs that finished more than this number of days ago are deleted, along
+	// with their artifacts and archived logs. Zero means runs are never deleted
+	// by the policy.
+	DeleteAfterDays int32 `protobuf:"varint,4,opt,name=delete_after_days,json=deleteAfterDays,proto3" json:"delete_after_days,omitempty"`
+	// Output. The time this policy was created.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Output. The last time this policy was enforced.
+	LastEnforcedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_enforced_at,json=lastEnforcedAt,proto3" json:"last_enforced_at,omitempty"`
+	// Output. The total number of runs archived by this policy.
+	ArchivedRunCount int64 `protobuf:"varint,7,opt,name=archived_run_count,json=archivedRunCount,proto3" json:"archived_run_count,omitempty"`
+	// Output. The total number of runs deleted by this policy.
+	DeletedRunCount int64 `protobuf:"varint,8,opt,name=deleted_run_count,json=deletedRunCount,proto3" json:"deleted_run_count,omitempty"`
+	// Output. The total number of artifact and log objects deleted by this
+	// policy.
+	DeletedObjectCount int64 `protobuf:"varint,9,opt,name=deleted_object_count,json=deletedObjectCount,proto3" json:"deleted_object_count,omitempty"`
+	// Output. In case the last enforcement of the policy failed, this field
+	// contains the error message.
+	Error                string   `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_228dbfabba64cef8, []int{5}
+}
+
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetentionPolicy.Unmarshal(m, b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return xxx_messageInfo_RetentionPolicy.Size(m)
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
+func (m *RetentionPolicy) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RetentionPolicy) GetResourceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceKey
+	}
+	return nil
+}
+
+func (m *RetentionPolicy) GetArchiveAfterDays() int32 {
+	if m != nil {
+		return m.ArchiveAfterDays
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetDeleteAfterDays() int32 {
+	if m != nil {
+		return m.DeleteAfterDays
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *RetentionPolicy) GetLastEnforcedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastEnforcedAt
+	}
+	return nil
+}
+
+func (m *RetentionPolicy) GetArchivedRunCount() int64 {
+	if m != nil {
+		return m.ArchivedRunCount
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetDeletedRunCount() int64 {
+	if m != nil {
+		return m.DeletedRunCount
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetDeletedObjectCount() int64 {
+	if m != nil {
+		return m.DeletedObjectCount
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreateRetentionPolicyRequest)(nil), "api.CreateRetentionPolicyRequest")
+	proto.RegisterType((*GetRetentionPolicyRequest)(nil), "api.GetRetentionPolicyRequest")
+	proto.RegisterType((*ListRetentionPoliciesRequest)(nil), "api.ListRetentionPoliciesRequest")
+	proto.RegisterType((*ListRetentionPoliciesResponse)(nil), "api.ListRetentionPoliciesResponse")
+	proto.RegisterType((*DeleteRetentionPolicyRequest)(nil), "api.DeleteRetentionPolicyRequest")
+	proto.RegisterType((*RetentionPolicy)(nil), "api.RetentionPolicy")
+}
+
+func init() {
+	proto.RegisterFile("backend/api/retention_policy.proto", fileDescriptor_228dbfabba64cef8)
+}
+
+var fileDescriptor_228dbfabba64cef8 = []byte{
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x72, 0xdc, 0x44,
+	0x10, 0x46, 0xbb, 0xf6, 0xda, 0xdb, 0x8e, 0xb3, 0xf6, 0x60, 0x3b, 0x8b, 0xb2, 0x26, 0x8b, 0x8a,
+	0x1f, 0x57, 0x88, 0x57, 0x24, 0xe6, 0x42, 0x2e, 0x94, 0x7f, 0x02, 0x87, 0x40, 0x91, 0x92, 0x73,
+	0xca, 0x45, 0x35, 0x92, 0x7a, 0xe5, 0xc1, 0xb2, 0x46, 0xcc, 0x8c, 0x1c, 0x64, 0x8a, 0x03, 0x54,
+	0x51, 0xc5, 0x81, 0x13, 0x3c, 0x06, 0x27, 0x9e, 0x25, 0xaf, 0xc0, 0x83, 0xa4, 0x34, 0x1a, 0xd9,
+	0x1b, 0xad, 0x77, 0xed, 0x93, 0x6a, 0xba, 0xbf, 0xee, 0xe9, 0xef, 0x6b, 0x75, 0x0f, 0x38, 0x01,
+	0x0d, 0x4f, 0x31, 0x8d, 0x5c, 0x9a, 0x31, 0x57, 0xa0, 0xc2, 0x54, 0x31, 0x9e, 0xfa, 0x19, 0x4f,
+	0x58, 0x58, 0x8c, 0x32, 0xc1, 0x15, 0x27, 0x6d, 0x9a, 0x31, 0xfb, 0xde, 0x24, 0x10, 0x85, 0xe0,
+	0xa2, 0xf2, 0xda, 0x1f, 0xbf, 0x9b, 0x41, 0xf2, 0x5c, 0x84, 0xe8, 0x0b, 0x1c, 0xa3, 0xc0, 0x34,
+	0x44, 0x83, 0x1a, 0xc4, 0x9c, 0xc7, 0x09, 0x6a, 0x10, 0x4d, 0x53, 0xae, 0x68, 0x79, 0x8f, 0x34,
+	0xde, 0xfb, 0xc6, 0xab, 0x4f, 0x41, 0x3e, 0x76, 0xf1, 0x2c, 0x53, 0xe6, 0x7a, 0xfb, 0x41, 0xd3,
+	0xa9, 0xd8, 0x19, 0x4a, 0x45, 0xcf, 0x32, 0x03, 0x78, 0xa4, 0x3f, 0xe1, 0x6e, 0x8c, 0xe9, 0xae,
+	0x7c, 0x4d, 0xe3, 0x18, 0x85, 0xcb, 0x33, 0x9d, 0x7f, 0xfa, 0x2e, 0xc7, 0x87, 0xc1, 0xa1, 0x40,
+	0xaa, 0xd0, 0xab, 0xd9, 0xbe, 0xd0, 0x64, 0x3d, 0xfc, 0x29, 0x47, 0xa9, 0xc8, 0xd7, 0xb0, 0xd6,
+	0xd4, 0xa1, 0x6f, 0x0d, 0xad, 0x9d, 0x95, 0x27, 0x1b, 0x23, 0x9a, 0xb1, 0x51, 0x33, 0xac, 0x27,
+	0xde, 0x35, 0x38, 0x9f, 0xc3, 0x07, 0xdf, 0xa2, 0x9a, 0x91, 0xfd, 0x2e, 0xb4, 0x58, 0xa4, 0xf3,
+	0x75, 0xbd, 0x16, 0x8b, 0x9c, 0x37, 0x16, 0x0c, 0xbe, 0x63, 0xb2, 0x01, 0x67, 0x28, 0xeb, 0x80,
+	0x6d, 0x80, 0x8c, 0xc6, 0xe8, 0x2b, 0x7e, 0x8a, 0xa9, 0x09, 0xec, 0x96, 0x96, 0x97, 0xa5, 0x81,
+	0xdc, 0x07, 0x7d, 0xf0, 0x25, 0xbb, 0xc0, 0x7e, 0x6b, 0x68, 0xed, 0x2c, 0x7a, 0xcb, 0xa5, 0xe1,
+	0x98, 0x5d, 0x20, 0xb9, 0x07, 0x4b, 0x92, 0x0b, 0xe5, 0x07, 0x45, 0xbf, 0xad, 0x03, 0x3b, 0xe5,
+	0xf1, 0xa0, 0x20, 0x5b, 0xd0, 0x19, 0xb3, 0x44, 0xa1, 0xe8, 0x2f, 0x54, 0xf6, 0xea, 0x44, 0xbe,
+	0x81, 0xad, 0xe9, 0x0e, 0xfa, 0xa7, 0x58, 0xf4, 0x17, 0xb5, 0x02, 0x6b, 0x46, 0x81, 0x0a, 0xf2,
+	0x1c, 0x0b, 0x6f, 0xa3, 0xc6, 0x7b, 0x35, 0xfc, 0x39, 0x16, 0xce, 0xbf, 0x16, 0x6c, 0xcf, 0x60,
+	0x25, 0x33, 0x9e, 0x4a, 0x24, 0x87, 0x40, 0x1a, 0x2a, 0x33, 0x94, 0x7d, 0x6b, 0xd8, 0x9e, 0xa9,
+	0xf3, 0xba, 0x68, 0x26, 0x2b, 0xb5, 0x51, 0x5c, 0xd1, 0xa4, 0x62, 0xdf, 0xd6, 0xec, 0xbb, 0xda,
+	0xa2, 0xe9, 0x7f, 0x0a, 0xbd, 0x14, 0x7f, 0x56, 0xfe, 0x84, 0x7e, 0x2d, 0x4d, 0x77, 0xb5, 0x34,
+	0xbf, 0xa8, 0x35, 0x74, 0x46, 0x30, 0x38, 0xc2, 0x04, 0x15, 0xde, 0xb6, 0x67, 0x6d, 0xe8, 0x35,
+	0xa0, 0x4d, 0x0c, 0xd9, 0x83, 0x3b, 0x97, 0x4a, 0x96, 0xfa, 0xb5, 0x66, 0xe8, 0xb7, 0x22, 0xae,
+	0x0e, 0xe4, 0x11, 0x10, 0x2a, 0xc2, 0x13, 0x76, 0x8e, 0x3e, 0x1d, 0x2b, 0x14, 0x7e, 0x44, 0x0b,
+	0x69, 0x78, 0xad, 0x19, 0xcf, 0x7e, 0xe9, 0x38, 0xa2, 0x85, 0x24, 0x0f, 0x61, 0x3d, 0xd2, 0x65,
+	0x4f, 0x82, 0x17, 0x34, 0xb8, 0x57, 0x39, 0xae, 0xb0, 0x5f, 0x01, 0x84, 0xfa, 0xa7, 0x8f, 0x7c,
+	0xaa, 0x4c, 0x33, 0xed, 0x51, 0x35, 0x58, 0xa3, 0x7a, 0xb0, 0x46, 0x2f, 0xeb, 0xc1, 0xf2, 0xba,
+	0x06, 0xbd, 0xaf, 0xc8, 0x11, 0xac, 0x25, 0x54, 0x2a, 0x1f, 0xd3, 0x31, 0x17, 0x61, 0x95, 0xa0,
+	0x73, 0x63, 0x82, 0xbb, 0x65, 0xcc, 0x33, 0x13, 0xb2, 0xaf, 0x26, 0xa8, 0x45, 0xbe, 0xc8, 0x53,
+	0x3f, 0xe4, 0x79, 0xaa, 0xfa, 0x4b, 0x43, 0x6b, 0xa7, 0x7d, 0x49, 0x2d, 0xf2, 0xf2, 0xf4, 0xb0,
+	0xb4, 0x5f, 0x51, 0x9b, 0x04, 0x2f, 0x6b, 0xb0, 0xa1, 0x76, 0x85, 0xfd, 0x02, 0x36, 0x6a, 0x2c,
+	0x0f, 0x7e, 0xc4, 0x50, 0x19, 0x78, 0x57, 0xc3, 0x89, 0xf1, 0xfd, 0xa0, 0x5d, 0x55, 0xc4, 0x06,
+	0x2c, 0xea, 0x05, 0xd6, 0x07, 0xdd, 0xae, 0xea, 0xf0, 0xe4, 0xbf, 0x05, 0xd8, 0x6a, 0x74, 0xf5,
+	0x18, 0xc5, 0x39, 0x0b, 0x91, 0xfc, 0x65, 0xc1, 0xe6, 0xb5, 0x3b, 0x83, 0x7c, 0xa4, 0x1b, 0x3a,
+	0x6f, 0x9f, 0xd8, 0xd7, 0xfe, 0xcd, 0xce, 0xd3, 0xdf, 0xdf, 0xfc, 0xff, 0x4f, 0xeb, 0x4b, 0x67,
+	0x58, 0x6e, 0x44, 0xe9, 0x9e, 0x3f, 0x0e, 0x50, 0xd1, 0xc7, 0xee, 0xf4, 0x4c, 0x3c, 0x9d, 0xda,
+	0x46, 0xa4, 0x00, 0x32, 0xbd, 0x60, 0xc8, 0x87, 0xfa, 0x9e, 0x99, 0x9b, 0x67, 0x46, 0x1d, 0xbb,
+	0xba, 0x8e, 0xcf, 0xc8, 0x27, 0x37, 0xd5, 0xe1, 0xfe, 0xc2, 0xa2, 0x5f, 0xc9, 0x9f, 0x16, 0x6c,
+	0x5e, 0x3b, 0xd8, 0x46, 0x89, 0x79, 0xab, 0xcc, 0x76, 0xe6, 0x41, 0xaa, 0xbd, 0xe0, 0xec, 0xe8,
+	0x7a, 0x1c, 0x72, 0xa3, 0x2e, 0xe4, 0x37, 0x0b, 0x36, 0xaf, 0x1d, 0x5b, 0x53, 0xca, 0xbc, 0x91,
+	0xb6, 0xb7, 0xa6, 0x7e, 0xdd, 0x67, 0xe5, 0x8b, 0x53, 0xcb, 0xf1, 0xf0, 0x76, 0x72, 0x1c, 0xfc,
+	0x61, 0xfd, 0xbd, 0xff, 0xbd, 0x37, 0x80, 0xa5, 0x08, 0xc7, 0x34, 0x4f, 0x14, 0x59, 0x27, 0x3d,
+	0x58, 0xb5, 0x57, 0x74, 0x01, 0xc7, 0x8a, 0xaa, 0x5c, 0xbe, 0x7a, 0x00, 0xdb, 0xd0, 0x39, 0x40,
+	0x2a, 0x50, 0x90, 0xf7, 0x97, 0x5b, 0xf6, 0x2a, 0xcd, 0xd5, 0x09, 0x17, 0xec, 0x42, 0xbf, 0x4a,
+	0xc3, 0x56, 0x70, 0x07, 0xe0, 0x12, 0xf0, 0xde, 0xab, 0xbd, 0x98, 0xa9, 0x93, 0x3c, 0x18, 0x85,
+	0xfc, 0xcc, 0x3d, 0xcd, 0x03, 0x1c, 0x27, 0xfc, 0xb5, 0x9b, 0xb1, 0x0c, 0x13, 0x96, 0xa2, 0x74,
+	0x27, 0x1f, 0xdb, 0x98, 0xfb, 0x61, 0xc2, 0x30, 0x55, 0x41, 0x47, 0xd3, 0xd8, 0x7b, 0x3b, 0x00,
+	0x2e, 0xe7, 0x92, 0xde, 0xce, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RetentionPolicyServiceClient is the client API for RetentionPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RetentionPolicyServiceClient interface {
+	// Creates a retention policy for an experiment or a namespace.
+	CreateRetentionPolicy(ctx context.Context, in *CreateRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	// Finds a specific retention policy by ID.
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	// Finds all retention policies.
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	// Deletes a retention policy. Runs that were already purged are not
+	// restored.
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type retentionPolicyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRetentionPolicyServiceClient(cc *grpc.ClientConn) RetentionPolicyServiceClient {
+	return &retentionPolicyServiceClient{cc}
+}
+
+func (c *retentionPolicyServiceClient) CreateRetentionPolicy(ctx context.Context, in *CreateRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, "/api.RetentionPolicyService/CreateRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retentionPolicyServiceClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, "/api.RetentionPolicyService/GetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retentionPolicyServiceClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/api.RetentionPolicyService/ListRetentionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retentionPolicyServiceClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.RetentionPolicyService/DeleteRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RetentionPolicyServiceServer is the server API for RetentionPolicyService service.
+type RetentionPolicyServiceServer interface {
+	// Creates a retention policy for an experiment or a namespace.
+	CreateRetentionPolicy(context.Context, *CreateRetentionPolicyRequest) (*RetentionPolicy, error)
+	// Finds a specific retention policy by ID.
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*RetentionPolicy, error)
+	// Finds all retention policies.
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	// Deletes a retention policy. Runs that were already purged are not
+	// restored.
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*empty.Empty, error)
+}
+
+// UnimplementedRetentionPolicyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRetentionPolicyServiceServer struct {
+}
+
+func (*UnimplementedRetentionPolicyServiceServer) CreateRetentionPolicy(ctx context.Context, req *CreateRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRetentionPolicy not implemented")
+}
+func (*UnimplementedRetentionPolicyServiceServer) GetRetentionPolicy(ctx context.Context, req *GetRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
+func (*UnimplementedRetentionPolicyServiceServer) ListRetentionPolicies(ctx context.Context, req *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (*UnimplementedRetentionPolicyServiceServer) DeleteRetentionPolicy(ctx context.Context, req *DeleteRetentionPolicyRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+
+func RegisterRetentionPolicyServiceServer(s *grpc.Server, srv RetentionPolicyServiceServer) {
+	s.RegisterService(&_RetentionPolicyService_serviceDesc, srv)
+}
+
+func _RetentionPolicyService_CreateRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetentionPolicyServiceServer).CreateRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetentionPolicyService/CreateRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetentionPolicyServiceServer).CreateRetentionPolicy(ctx, req.(*CreateRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RetentionPolicyService_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetentionPolicyServiceServer).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetentionPolicyService/GetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetentionPolicyServiceServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RetentionPolicyService_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetentionPolicyServiceServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetentionPolicyService/ListRetentionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetentionPolicyServiceServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RetentionPolicyService_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetentionPolicyServiceServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetentionPolicyService/DeleteRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetentionPolicyServiceServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RetentionPolicyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RetentionPolicyService",
+	HandlerType: (*RetentionPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRetentionPolicy",
+			Handler:    _RetentionPolicyService_CreateRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _RetentionPolicyService_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _RetentionPolicyService_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _RetentionPolicyService_DeleteRetentionPolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend/api/retention_policy.proto",
+}
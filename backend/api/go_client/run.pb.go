@@ -28,6 +28,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type BatchRunsResponse_BatchRunResult_Status int32
+
+const (
+	// Default value if not present.
+	BatchRunsResponse_BatchRunResult_UNSPECIFIED BatchRunsResponse_BatchRunResult_Status = 0
+	// Indicates that the operation succeeded, or would succeed in a dry run.
+	BatchRunsResponse_BatchRunResult_OK BatchRunsResponse_BatchRunResult_Status = 1
+	// Indicates that the run does not exist.
+	BatchRunsResponse_BatchRunResult_NOT_FOUND BatchRunsResponse_BatchRunResult_Status = 2
+	// Indicates that the caller is not allowed to operate on the run.
+	BatchRunsResponse_BatchRunResult_PERMISSION_DENIED BatchRunsResponse_BatchRunResult_Status = 3
+	// Indicates that the operation is not valid for the run.
+	BatchRunsResponse_BatchRunResult_INVALID_ARGUMENT BatchRunsResponse_BatchRunResult_Status = 4
+	// Indicates that something went wrong in the server.
+	BatchRunsResponse_BatchRunResult_INTERNAL_ERROR BatchRunsResponse_BatchRunResult_Status = 5
+)
+
+var BatchRunsResponse_BatchRunResult_Status_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "OK",
+	2: "NOT_FOUND",
+	3: "PERMISSION_DENIED",
+	4: "INVALID_ARGUMENT",
+	5: "INTERNAL_ERROR",
+}
+
+var BatchRunsResponse_BatchRunResult_Status_value = map[string]int32{
+	"UNSPECIFIED":       0,
+	"OK":                1,
+	"NOT_FOUND":         2,
+	"PERMISSION_DENIED": 3,
+	"INVALID_ARGUMENT":  4,
+	"INTERNAL_ERROR":    5,
+}
+
+func (x BatchRunsResponse_BatchRunResult_Status) String() string {
+	return proto.EnumName(BatchRunsResponse_BatchRunResult_Status_name, int32(x))
+}
+
+func (BatchRunsResponse_BatchRunResult_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{12, 0, 0}
+}
+
 type Run_StorageState int32
 
 const (
@@ -50,7 +93,7 @@ func (x Run_StorageState) String() string {
 }
 
 func (Run_StorageState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{9, 0}
+	return fileDescriptor_50e61ed8e40fd87e, []int{13, 0}
 }
 
 type RunMetric_Format int32
@@ -81,7 +124,7 @@ func (x RunMetric_Format) String() string {
 }
 
 func (RunMetric_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{12, 0}
+	return fileDescriptor_50e61ed8e40fd87e, []int{16, 0}
 }
 
 type ReportRunMetricsResponse_ReportRunMetricResult_Status int32
@@ -120,11 +163,54 @@ func (x ReportRunMetricsResponse_ReportRunMetricResult_Status) String() string {
 }
 
 func (ReportRunMetricsResponse_ReportRunMetricResult_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{14, 0, 0}
+	return fileDescriptor_50e61ed8e40fd87e, []int{18, 0, 0}
+}
+
+type RunArtifact_Type int32
+
+const (
+	RunArtifact_UNKNOWN_TYPE RunArtifact_Type = 0
+	// An output declared by the task.
+	RunArtifact_DATA RunArtifact_Type = 1
+	// The metrics reported by the task.
+	RunArtifact_METRICS RunArtifact_Type = 2
+	// The metadata of the visualizations of the task in the UI.
+	RunArtifact_UI_METADATA RunArtifact_Type = 3
+	// The archived log of the task.
+	RunArtifact_LOG RunArtifact_Type = 4
+)
+
+var RunArtifact_Type_name = map[int32]string{
+	0: "UNKNOWN_TYPE",
+	1: "DATA",
+	2: "METRICS",
+	3: "UI_METADATA",
+	4: "LOG",
+}
+
+var RunArtifact_Type_value = map[string]int32{
+	"UNKNOWN_TYPE": 0,
+	"DATA":         1,
+	"METRICS":      2,
+	"UI_METADATA":  3,
+	"LOG":          4,
+}
+
+func (x RunArtifact_Type) String() string {
+	return proto.EnumName(RunArtifact_Type_name, int32(x))
+}
+
+func (RunArtifact_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{23, 0}
 }
 
 type CreateRunRequest struct {
-	Run                  *Run     `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Run *Run `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	// Optional. A client-supplied key of at most 128 characters identifying the
+	// request. A retried request with the same key and run returns the run
+	// created by the original request instead of creating another one, and a
+	// request with the same key but a different run is rejected.
+	IdempotencyKey       string   `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -162,6 +248,13 @@ func (m *CreateRunRequest) GetRun() *Run {
 	return nil
 }
 
+func (m *CreateRunRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type GetRunRequest struct {
 	// The ID of the run to be retrieved.
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
@@ -213,6 +306,8 @@ type ListRunsRequest struct {
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Can be format of "field_name", "field_name asc" or "field_name desc"
 	// (Example, "name asc" or "id desc"). Ascending by default.
+	// Runs can also be sorted by a metric or a parameter value, e.g.
+	// "metric:accuracy desc" or "param:learning_rate".
 	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// What resource reference to filter on.
 	// E.g. If listing run for an experiment, the query string would be
@@ -221,7 +316,12 @@ type ListRunsRequest struct {
 	// A url-encoded, JSON-serialized Filter protocol buffer (see
 	// [filter.proto](https://github.com/kubeflow/pipelines/
 	// blob/master/backend/api/filter.proto)).
-	Filter               string   `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Besides run fields, predicates can use keys "param:<name>" and
+	// "metric:<name>" to filter on parameter values and metrics of runs.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// BASIC leaves out the pipeline and workflow manifests of the runs. FULL by
+	// default.
+	View                 ListView `protobuf:"varint,6,opt,name=view,proto3,enum=api.ListView" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -287,6 +387,13 @@ func (m *ListRunsRequest) GetFilter() string {
 	return ""
 }
 
+func (m *ListRunsRequest) GetView() ListView {
+	if m != nil {
+		return m.View
+	}
+	return ListView_LIST_VIEW_UNSPECIFIED
+}
+
 type TerminateRunRequest struct {
 	// The ID of the run to be terminated.
 	RunId                string   `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
@@ -544,6 +651,345 @@ func (m *DeleteRunRequest) GetId() string {
 	return ""
 }
 
+type BatchArchiveRunsRequest struct {
+	// The IDs of the runs to be archived. Exactly one of ids and filter must be
+	// specified.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// A filter selecting the runs to be archived. Predicates can use the same
+	// keys as in ListRuns.
+	Filter *Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If true, the runs are not archived, and the response lists the runs that
+	// would be archived.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The namespace of the runs selected by the filter, with type NAMESPACE.
+	// Required in multi-user mode when the runs are selected by a filter.
+	ResourceReferenceKey *ResourceKey `protobuf:"bytes,4,opt,name=resource_reference_key,json=resourceReferenceKey,proto3" json:"resource_reference_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchArchiveRunsRequest) Reset()         { *m = BatchArchiveRunsRequest{} }
+func (m *BatchArchiveRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchArchiveRunsRequest) ProtoMessage()    {}
+func (*BatchArchiveRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{9}
+}
+
+func (m *BatchArchiveRunsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchArchiveRunsRequest.Unmarshal(m, b)
+}
+func (m *BatchArchiveRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchArchiveRunsRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchArchiveRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchArchiveRunsRequest.Merge(m, src)
+}
+func (m *BatchArchiveRunsRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchArchiveRunsRequest.Size(m)
+}
+func (m *BatchArchiveRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchArchiveRunsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchArchiveRunsRequest proto.InternalMessageInfo
+
+func (m *BatchArchiveRunsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *BatchArchiveRunsRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *BatchArchiveRunsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BatchArchiveRunsRequest) GetResourceReferenceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceReferenceKey
+	}
+	return nil
+}
+
+type BatchDeleteRunsRequest struct {
+	// The IDs of the runs to be deleted. Exactly one of ids and filter must be
+	// specified.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// A filter selecting the runs to be deleted. Predicates can use the same
+	// keys as in ListRuns.
+	Filter *Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If true, the runs are not deleted, and the response lists the runs that
+	// would be deleted.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The namespace of the runs selected by the filter, with type NAMESPACE.
+	// Required in multi-user mode when the runs are selected by a filter.
+	ResourceReferenceKey *ResourceKey `protobuf:"bytes,4,opt,name=resource_reference_key,json=resourceReferenceKey,proto3" json:"resource_reference_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchDeleteRunsRequest) Reset()         { *m = BatchDeleteRunsRequest{} }
+func (m *BatchDeleteRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteRunsRequest) ProtoMessage()    {}
+func (*BatchDeleteRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{10}
+}
+
+func (m *BatchDeleteRunsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteRunsRequest.Unmarshal(m, b)
+}
+func (m *BatchDeleteRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteRunsRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchDeleteRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteRunsRequest.Merge(m, src)
+}
+func (m *BatchDeleteRunsRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteRunsRequest.Size(m)
+}
+func (m *BatchDeleteRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteRunsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteRunsRequest proto.InternalMessageInfo
+
+func (m *BatchDeleteRunsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *BatchDeleteRunsRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *BatchDeleteRunsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BatchDeleteRunsRequest) GetResourceReferenceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceReferenceKey
+	}
+	return nil
+}
+
+type BatchTerminateRunsRequest struct {
+	// The IDs of the runs to be terminated. Exactly one of ids and filter must
+	// be specified.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// A filter selecting the runs to be terminated. Predicates can use the same
+	// keys as in ListRuns.
+	Filter *Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If true, the runs are not terminated, and the response lists the runs
+	// that would be terminated.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// The namespace of the runs selected by the filter, with type NAMESPACE.
+	// Required in multi-user mode when the runs are selected by a filter.
+	ResourceReferenceKey *ResourceKey `protobuf:"bytes,4,opt,name=resource_reference_key,json=resourceReferenceKey,proto3" json:"resource_reference_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchTerminateRunsRequest) Reset()         { *m = BatchTerminateRunsRequest{} }
+func (m *BatchTerminateRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchTerminateRunsRequest) ProtoMessage()    {}
+func (*BatchTerminateRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{11}
+}
+
+func (m *BatchTerminateRunsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchTerminateRunsRequest.Unmarshal(m, b)
+}
+func (m *BatchTerminateRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchTerminateRunsRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchTerminateRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTerminateRunsRequest.Merge(m, src)
+}
+func (m *BatchTerminateRunsRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchTerminateRunsRequest.Size(m)
+}
+func (m *BatchTerminateRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTerminateRunsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTerminateRunsRequest proto.InternalMessageInfo
+
+func (m *BatchTerminateRunsRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *BatchTerminateRunsRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *BatchTerminateRunsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *BatchTerminateRunsRequest) GetResourceReferenceKey() *ResourceKey {
+	if m != nil {
+		return m.ResourceReferenceKey
+	}
+	return nil
+}
+
+type BatchRunsResponse struct {
+	Results []*BatchRunsResponse_BatchRunResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// The total number of runs selected by the request. At most 1000 runs are
+	// processed per request, so if total_size is larger than the number of
+	// results, the request can be repeated to process the remaining runs.
+	TotalSize int32 `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Whether the request was a dry run.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchRunsResponse) Reset()         { *m = BatchRunsResponse{} }
+func (m *BatchRunsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchRunsResponse) ProtoMessage()    {}
+func (*BatchRunsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{12}
+}
+
+func (m *BatchRunsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRunsResponse.Unmarshal(m, b)
+}
+func (m *BatchRunsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRunsResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchRunsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRunsResponse.Merge(m, src)
+}
+func (m *BatchRunsResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchRunsResponse.Size(m)
+}
+func (m *BatchRunsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRunsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRunsResponse proto.InternalMessageInfo
+
+func (m *BatchRunsResponse) GetResults() []*BatchRunsResponse_BatchRunResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *BatchRunsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *BatchRunsResponse) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type BatchRunsResponse_BatchRunResult struct {
+	// Output. The ID of the run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Output. The name of the run.
+	RunName string `protobuf:"bytes,2,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
+	// Output. The status of the operation on the run.
+	Status BatchRunsResponse_BatchRunResult_Status `protobuf:"varint,3,opt,name=status,proto3,enum=api.BatchRunsResponse_BatchRunResult_Status" json:"status,omitempty"`
+	// Output. The detailed message of the error of the operation.
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchRunsResponse_BatchRunResult) Reset()         { *m = BatchRunsResponse_BatchRunResult{} }
+func (m *BatchRunsResponse_BatchRunResult) String() string { return proto.CompactTextString(m) }
+func (*BatchRunsResponse_BatchRunResult) ProtoMessage()    {}
+func (*BatchRunsResponse_BatchRunResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{12, 0}
+}
+
+func (m *BatchRunsResponse_BatchRunResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRunsResponse_BatchRunResult.Unmarshal(m, b)
+}
+func (m *BatchRunsResponse_BatchRunResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRunsResponse_BatchRunResult.Marshal(b, m, deterministic)
+}
+func (m *BatchRunsResponse_BatchRunResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRunsResponse_BatchRunResult.Merge(m, src)
+}
+func (m *BatchRunsResponse_BatchRunResult) XXX_Size() int {
+	return xxx_messageInfo_BatchRunsResponse_BatchRunResult.Size(m)
+}
+func (m *BatchRunsResponse_BatchRunResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRunsResponse_BatchRunResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRunsResponse_BatchRunResult proto.InternalMessageInfo
+
+func (m *BatchRunsResponse_BatchRunResult) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *BatchRunsResponse_BatchRunResult) GetRunName() string {
+	if m != nil {
+		return m.RunName
+	}
+	return ""
+}
+
+func (m *BatchRunsResponse_BatchRunResult) GetStatus() BatchRunsResponse_BatchRunResult_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BatchRunsResponse_BatchRunResult_UNSPECIFIED
+}
+
+func (m *BatchRunsResponse_BatchRunResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type Run struct {
 	// Output. Unique run ID. Generated by API server.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -573,7 +1019,7 @@ type Run struct {
 	// Output. The time this run is finished.
 	FinishedAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	// Output. The status of the run.
-	// One of [Pending, Running, Succeeded, Skipped, Failed, Error]
+	// One of [Queued, Pending, Running, Succeeded, Skipped, Failed, Error]
 	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 	// In case any error happens retrieving a run field, only run ID
 	// and the error message is returned. Client has the flexibility of choosing
@@ -581,17 +1027,28 @@ type Run struct {
 	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
 	// Output. The metrics of the run. The metrics are reported by ReportMetrics
 	// API.
-	Metrics              []*RunMetric `protobuf:"bytes,9,rep,name=metrics,proto3" json:"metrics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Metrics []*RunMetric `protobuf:"bytes,9,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// Optional input field. User-defined labels of the run. Label keys and values
+	// follow the syntax of Kubernetes labels. The labels are also set on the
+	// PipelineRun created for the run.
+	Labels map[string]string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional input field. The priority of the run when it is queued because
+	// its namespace is over quota. Runs with a higher priority leave the queue
+	// first if the API server dispatches runs in priority order.
+	Priority int32 `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Output. The position of the run in the queue of its namespace, starting
+	// from 1, while the run is Queued. Zero otherwise.
+	QueuePosition        int32    `protobuf:"varint,17,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Run) Reset()         { *m = Run{} }
 func (m *Run) String() string { return proto.CompactTextString(m) }
 func (*Run) ProtoMessage()    {}
 func (*Run) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{9}
+	return fileDescriptor_50e61ed8e40fd87e, []int{13}
 }
 
 func (m *Run) XXX_Unmarshal(b []byte) error {
@@ -703,6 +1160,27 @@ func (m *Run) GetMetrics() []*RunMetric {
 	return nil
 }
 
+func (m *Run) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Run) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Run) GetQueuePosition() int32 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
 type PipelineRuntime struct {
 	// Output. The runtime JSON manifest of the pipeline, including the status
 	// of pipeline steps and fields need for UI visualization etc.
@@ -719,7 +1197,7 @@ func (m *PipelineRuntime) Reset()         { *m = PipelineRuntime{} }
 func (m *PipelineRuntime) String() string { return proto.CompactTextString(m) }
 func (*PipelineRuntime) ProtoMessage()    {}
 func (*PipelineRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{10}
+	return fileDescriptor_50e61ed8e40fd87e, []int{14}
 }
 
 func (m *PipelineRuntime) XXX_Unmarshal(b []byte) error {
@@ -766,7 +1244,7 @@ func (m *RunDetail) Reset()         { *m = RunDetail{} }
 func (m *RunDetail) String() string { return proto.CompactTextString(m) }
 func (*RunDetail) ProtoMessage()    {}
 func (*RunDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{11}
+	return fileDescriptor_50e61ed8e40fd87e, []int{15}
 }
 
 func (m *RunDetail) XXX_Unmarshal(b []byte) error {
@@ -825,7 +1303,7 @@ func (m *RunMetric) Reset()         { *m = RunMetric{} }
 func (m *RunMetric) String() string { return proto.CompactTextString(m) }
 func (*RunMetric) ProtoMessage()    {}
 func (*RunMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{12}
+	return fileDescriptor_50e61ed8e40fd87e, []int{16}
 }
 
 func (m *RunMetric) XXX_Unmarshal(b []byte) error {
@@ -912,7 +1390,7 @@ func (m *ReportRunMetricsRequest) Reset()         { *m = ReportRunMetricsRequest
 func (m *ReportRunMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportRunMetricsRequest) ProtoMessage()    {}
 func (*ReportRunMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{13}
+	return fileDescriptor_50e61ed8e40fd87e, []int{17}
 }
 
 func (m *ReportRunMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -958,7 +1436,7 @@ func (m *ReportRunMetricsResponse) Reset()         { *m = ReportRunMetricsRespon
 func (m *ReportRunMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportRunMetricsResponse) ProtoMessage()    {}
 func (*ReportRunMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{14}
+	return fileDescriptor_50e61ed8e40fd87e, []int{18}
 }
 
 func (m *ReportRunMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -1008,7 +1486,7 @@ func (m *ReportRunMetricsResponse_ReportRunMetricResult) String() string {
 }
 func (*ReportRunMetricsResponse_ReportRunMetricResult) ProtoMessage() {}
 func (*ReportRunMetricsResponse_ReportRunMetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{14, 0}
+	return fileDescriptor_50e61ed8e40fd87e, []int{18, 0}
 }
 
 func (m *ReportRunMetricsResponse_ReportRunMetricResult) XXX_Unmarshal(b []byte) error {
@@ -1073,7 +1551,7 @@ func (m *ReadArtifactRequest) Reset()         { *m = ReadArtifactRequest{} }
 func (m *ReadArtifactRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadArtifactRequest) ProtoMessage()    {}
 func (*ReadArtifactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{15}
+	return fileDescriptor_50e61ed8e40fd87e, []int{19}
 }
 
 func (m *ReadArtifactRequest) XXX_Unmarshal(b []byte) error {
@@ -1127,7 +1605,7 @@ func (m *ReadArtifactResponse) Reset()         { *m = ReadArtifactResponse{} }
 func (m *ReadArtifactResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadArtifactResponse) ProtoMessage()    {}
 func (*ReadArtifactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50e61ed8e40fd87e, []int{16}
+	return fileDescriptor_50e61ed8e40fd87e, []int{20}
 }
 
 func (m *ReadArtifactResponse) XXX_Unmarshal(b []byte) error {
@@ -1155,10 +1633,197 @@ func (m *ReadArtifactResponse) GetData() []byte {
 	return nil
 }
 
+type ListRunArtifactsRequest struct {
+	// The ID of the run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Optional. Only lists the artifacts of this task if set.
+	TaskName             string   `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRunArtifactsRequest) Reset()         { *m = ListRunArtifactsRequest{} }
+func (m *ListRunArtifactsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRunArtifactsRequest) ProtoMessage()    {}
+func (*ListRunArtifactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{21}
+}
+
+func (m *ListRunArtifactsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRunArtifactsRequest.Unmarshal(m, b)
+}
+func (m *ListRunArtifactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRunArtifactsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRunArtifactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRunArtifactsRequest.Merge(m, src)
+}
+func (m *ListRunArtifactsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRunArtifactsRequest.Size(m)
+}
+func (m *ListRunArtifactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRunArtifactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRunArtifactsRequest proto.InternalMessageInfo
+
+func (m *ListRunArtifactsRequest) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *ListRunArtifactsRequest) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
+type ListRunArtifactsResponse struct {
+	// The artifacts of the run, sorted by task and name.
+	Artifacts            []*RunArtifact `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListRunArtifactsResponse) Reset()         { *m = ListRunArtifactsResponse{} }
+func (m *ListRunArtifactsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRunArtifactsResponse) ProtoMessage()    {}
+func (*ListRunArtifactsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{22}
+}
+
+func (m *ListRunArtifactsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRunArtifactsResponse.Unmarshal(m, b)
+}
+func (m *ListRunArtifactsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRunArtifactsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRunArtifactsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRunArtifactsResponse.Merge(m, src)
+}
+func (m *ListRunArtifactsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRunArtifactsResponse.Size(m)
+}
+func (m *ListRunArtifactsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRunArtifactsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRunArtifactsResponse proto.InternalMessageInfo
+
+func (m *ListRunArtifactsResponse) GetArtifacts() []*RunArtifact {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+type RunArtifact struct {
+	// The name of the artifact, which is passed as artifact_name to
+	// ReadArtifact.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the task which produced the artifact, which is passed as
+	// node_id to ReadArtifact.
+	TaskName string `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// The key of the artifact in the object store.
+	ObjectKey string `protobuf:"bytes,3,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// The size of the stored artifact archive in bytes.
+	SizeBytes int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The last time the artifact was stored.
+	LastModifiedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_modified_at,json=lastModifiedAt,proto3" json:"last_modified_at,omitempty"`
+	// The type of the artifact, detected from its name.
+	Type RunArtifact_Type `protobuf:"varint,6,opt,name=type,proto3,enum=api.RunArtifact_Type" json:"type,omitempty"`
+	// The path of the output in the task container, if the task declared it.
+	SourcePath           string   `protobuf:"bytes,7,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunArtifact) Reset()         { *m = RunArtifact{} }
+func (m *RunArtifact) String() string { return proto.CompactTextString(m) }
+func (*RunArtifact) ProtoMessage()    {}
+func (*RunArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50e61ed8e40fd87e, []int{23}
+}
+
+func (m *RunArtifact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunArtifact.Unmarshal(m, b)
+}
+func (m *RunArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunArtifact.Marshal(b, m, deterministic)
+}
+func (m *RunArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunArtifact.Merge(m, src)
+}
+func (m *RunArtifact) XXX_Size() int {
+	return xxx_messageInfo_RunArtifact.Size(m)
+}
+func (m *RunArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunArtifact proto.InternalMessageInfo
+
+func (m *RunArtifact) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetObjectKey() string {
+	if m != nil {
+		return m.ObjectKey
+	}
+	return ""
+}
+
+func (m *RunArtifact) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *RunArtifact) GetLastModifiedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastModifiedAt
+	}
+	return nil
+}
+
+func (m *RunArtifact) GetType() RunArtifact_Type {
+	if m != nil {
+		return m.Type
+	}
+	return RunArtifact_UNKNOWN_TYPE
+}
+
+func (m *RunArtifact) GetSourcePath() string {
+	if m != nil {
+		return m.SourcePath
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("api.BatchRunsResponse_BatchRunResult_Status", BatchRunsResponse_BatchRunResult_Status_name, BatchRunsResponse_BatchRunResult_Status_value)
 	proto.RegisterEnum("api.Run_StorageState", Run_StorageState_name, Run_StorageState_value)
 	proto.RegisterEnum("api.RunMetric_Format", RunMetric_Format_name, RunMetric_Format_value)
 	proto.RegisterEnum("api.ReportRunMetricsResponse_ReportRunMetricResult_Status", ReportRunMetricsResponse_ReportRunMetricResult_Status_name, ReportRunMetricsResponse_ReportRunMetricResult_Status_value)
+	proto.RegisterEnum("api.RunArtifact_Type", RunArtifact_Type_name, RunArtifact_Type_value)
 	proto.RegisterType((*CreateRunRequest)(nil), "api.CreateRunRequest")
 	proto.RegisterType((*GetRunRequest)(nil), "api.GetRunRequest")
 	proto.RegisterType((*ListRunsRequest)(nil), "api.ListRunsRequest")
@@ -1168,7 +1833,13 @@ func init() {
 	proto.RegisterType((*ArchiveRunRequest)(nil), "api.ArchiveRunRequest")
 	proto.RegisterType((*UnarchiveRunRequest)(nil), "api.UnarchiveRunRequest")
 	proto.RegisterType((*DeleteRunRequest)(nil), "api.DeleteRunRequest")
+	proto.RegisterType((*BatchArchiveRunsRequest)(nil), "api.BatchArchiveRunsRequest")
+	proto.RegisterType((*BatchDeleteRunsRequest)(nil), "api.BatchDeleteRunsRequest")
+	proto.RegisterType((*BatchTerminateRunsRequest)(nil), "api.BatchTerminateRunsRequest")
+	proto.RegisterType((*BatchRunsResponse)(nil), "api.BatchRunsResponse")
+	proto.RegisterType((*BatchRunsResponse_BatchRunResult)(nil), "api.BatchRunsResponse.BatchRunResult")
 	proto.RegisterType((*Run)(nil), "api.Run")
+	proto.RegisterMapType((map[string]string)(nil), "api.Run.LabelsEntry")
 	proto.RegisterType((*PipelineRuntime)(nil), "api.PipelineRuntime")
 	proto.RegisterType((*RunDetail)(nil), "api.RunDetail")
 	proto.RegisterType((*RunMetric)(nil), "api.RunMetric")
@@ -1177,111 +1848,155 @@ func init() {
 	proto.RegisterType((*ReportRunMetricsResponse_ReportRunMetricResult)(nil), "api.ReportRunMetricsResponse.ReportRunMetricResult")
 	proto.RegisterType((*ReadArtifactRequest)(nil), "api.ReadArtifactRequest")
 	proto.RegisterType((*ReadArtifactResponse)(nil), "api.ReadArtifactResponse")
+	proto.RegisterType((*ListRunArtifactsRequest)(nil), "api.ListRunArtifactsRequest")
+	proto.RegisterType((*ListRunArtifactsResponse)(nil), "api.ListRunArtifactsResponse")
+	proto.RegisterType((*RunArtifact)(nil), "api.RunArtifact")
 }
 
 func init() { proto.RegisterFile("backend/api/run.proto", fileDescriptor_50e61ed8e40fd87e) }
 
 var fileDescriptor_50e61ed8e40fd87e = []byte{
-	// 1580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xe3, 0xc8,
-	0x11, 0x36, 0x25, 0x5b, 0xb2, 0x4a, 0x7f, 0x74, 0xfb, 0x8f, 0xa3, 0xb1, 0x61, 0x2f, 0xbd, 0xbb,
-	0xe3, 0x9d, 0xac, 0x25, 0xac, 0x27, 0x08, 0x10, 0x07, 0x41, 0x40, 0xdb, 0x1a, 0xaf, 0x32, 0xb6,
-	0xec, 0xb4, 0x64, 0x07, 0x98, 0x1c, 0x08, 0x8a, 0x6a, 0xc9, 0x8c, 0x25, 0x92, 0xe9, 0x6e, 0xda,
-	0xf1, 0x0c, 0xe6, 0x12, 0x60, 0x91, 0x7b, 0x72, 0xc8, 0x2d, 0x0f, 0xb1, 0x0f, 0x11, 0x20, 0xe7,
-	0xbc, 0x42, 0x1e, 0x64, 0xc1, 0x6e, 0x52, 0x43, 0xfd, 0x58, 0x06, 0xf6, 0x24, 0x75, 0xd5, 0xd7,
-	0x55, 0xc5, 0xaa, 0xfa, 0x8a, 0x45, 0x58, 0xef, 0x58, 0xf6, 0x1d, 0x71, 0xbb, 0x35, 0xcb, 0x77,
-	0x6a, 0x34, 0x70, 0xab, 0x3e, 0xf5, 0xb8, 0x87, 0xd2, 0x96, 0xef, 0x54, 0x36, 0x93, 0x3a, 0x42,
-	0xa9, 0x47, 0xa5, 0xb6, 0xf2, 0xb2, 0xef, 0x79, 0xfd, 0x01, 0xa9, 0x89, 0x53, 0x27, 0xe8, 0xd5,
-	0xc8, 0xd0, 0xe7, 0x8f, 0x91, 0x72, 0x2b, 0x52, 0x86, 0x97, 0x2c, 0xd7, 0xf5, 0xb8, 0xc5, 0x1d,
-	0xcf, 0x65, 0x91, 0x76, 0x67, 0xf2, 0x2a, 0x77, 0x86, 0x84, 0x71, 0x6b, 0xe8, 0xc7, 0x80, 0xa4,
-	0x53, 0xdf, 0xf1, 0xc9, 0xc0, 0x71, 0x89, 0xc9, 0x7c, 0x62, 0x47, 0x80, 0x2f, 0xc7, 0x22, 0x26,
-	0xcc, 0x0b, 0xa8, 0x4d, 0x4c, 0x4a, 0x7a, 0x84, 0x12, 0xd7, 0x26, 0x11, 0xea, 0x5b, 0xf1, 0x63,
-	0x1f, 0xf4, 0x89, 0x7b, 0xc0, 0x1e, 0xac, 0x7e, 0x9f, 0xd0, 0x9a, 0xe7, 0x8b, 0x48, 0xa6, 0xa3,
-	0xd2, 0xab, 0xa0, 0x9e, 0x50, 0x62, 0x71, 0x82, 0x03, 0x17, 0x93, 0xbf, 0x04, 0x84, 0x71, 0x54,
-	0x81, 0x34, 0x0d, 0x5c, 0x4d, 0xd9, 0x55, 0xf6, 0xf3, 0x87, 0xcb, 0x55, 0xcb, 0x77, 0xaa, 0xa1,
-	0x36, 0x14, 0xea, 0x5f, 0x43, 0xf1, 0x8c, 0xf0, 0x04, 0x78, 0x1d, 0x32, 0x34, 0x70, 0x4d, 0xa7,
-	0x2b, 0xf0, 0x39, 0xbc, 0x44, 0x03, 0xb7, 0xd1, 0xd5, 0xff, 0xa3, 0x40, 0xf9, 0xdc, 0x61, 0x21,
-	0x92, 0xc5, 0xd0, 0x6d, 0x00, 0xdf, 0xea, 0x13, 0x93, 0x7b, 0x77, 0xc4, 0x8d, 0xe0, 0xb9, 0x50,
-	0xd2, 0x0e, 0x05, 0xe8, 0x25, 0x88, 0x83, 0xc9, 0x9c, 0x0f, 0x44, 0x4b, 0xed, 0x2a, 0xfb, 0x4b,
-	0x78, 0x39, 0x14, 0xb4, 0x9c, 0x0f, 0x04, 0x6d, 0x42, 0x96, 0x79, 0x94, 0x9b, 0x9d, 0x47, 0x2d,
-	0x2d, 0x2e, 0x66, 0xc2, 0xe3, 0xf1, 0x23, 0x7a, 0x0b, 0x1b, 0xd3, 0xa9, 0x30, 0xef, 0xc8, 0xa3,
-	0xb6, 0x28, 0xe2, 0x57, 0x65, 0xfc, 0x11, 0xe4, 0x1d, 0x79, 0xc4, 0x6b, 0x31, 0x1e, 0xc7, 0xf0,
-	0x77, 0xe4, 0x11, 0x6d, 0x40, 0xa6, 0xe7, 0x0c, 0x38, 0xa1, 0xda, 0x92, 0xb4, 0x2f, 0x4f, 0xfa,
-	0xb7, 0xb0, 0xda, 0x26, 0x74, 0xe8, 0xb8, 0xe3, 0x39, 0x7a, 0xe2, 0xb1, 0xf7, 0xa1, 0x8c, 0x09,
-	0xa7, 0x8f, 0xcf, 0x23, 0x1f, 0x40, 0xfd, 0x9c, 0x1f, 0xe6, 0x7b, 0x2e, 0x23, 0x68, 0x0b, 0x16,
-	0x69, 0xe0, 0x32, 0x4d, 0xd9, 0x4d, 0x8f, 0x65, 0x5e, 0x48, 0xc3, 0xf4, 0x71, 0x8f, 0x5b, 0x03,
-	0x99, 0xa0, 0xb4, 0x48, 0x50, 0x4e, 0x48, 0x44, 0x86, 0xbe, 0x86, 0xb2, 0x4b, 0xfe, 0xca, 0xcd,
-	0x44, 0x8a, 0x53, 0xc2, 0x61, 0x31, 0x14, 0x5f, 0xc5, 0x69, 0xd6, 0xf7, 0x60, 0xc5, 0xa0, 0xf6,
-	0xad, 0x73, 0x9f, 0x7c, 0x9c, 0x12, 0xa4, 0x46, 0x01, 0xa6, 0x9c, 0xae, 0xfe, 0x15, 0xac, 0x5e,
-	0xbb, 0xd6, 0xb3, 0x30, 0x1d, 0xd4, 0x53, 0x32, 0x20, 0x7c, 0x1e, 0xe6, 0xef, 0x4b, 0x90, 0xc6,
-	0x81, 0x3b, 0x29, 0x47, 0x08, 0x16, 0x5d, 0x6b, 0x48, 0xa2, 0x20, 0xc5, 0x7f, 0x74, 0x04, 0x45,
-	0xc6, 0x3d, 0x2a, 0xba, 0x80, 0x5b, 0x9c, 0x68, 0xb0, 0xab, 0xec, 0x97, 0x0e, 0xd7, 0xe3, 0x4c,
-	0x54, 0x5b, 0x52, 0xdb, 0x0a, 0x95, 0xb8, 0xc0, 0x12, 0x27, 0xb4, 0x0b, 0xf9, 0x2e, 0x61, 0x36,
-	0x75, 0x44, 0xaf, 0x47, 0x5d, 0x92, 0x14, 0xa1, 0x5f, 0x41, 0x71, 0x8c, 0x56, 0x51, 0x87, 0xac,
-	0x08, 0xeb, 0x57, 0x91, 0xa6, 0xe5, 0x13, 0x1b, 0x17, 0xfc, 0xc4, 0x09, 0x9d, 0xc1, 0xea, 0x74,
-	0x8b, 0x31, 0x6d, 0x49, 0x54, 0x69, 0x63, 0xac, 0xbf, 0x46, 0x2d, 0x85, 0xd1, 0x54, 0x97, 0x31,
-	0xf4, 0x0a, 0xca, 0x8c, 0xd0, 0x7b, 0xc7, 0x26, 0xa6, 0x65, 0xdb, 0x5e, 0xe0, 0x72, 0xad, 0x24,
-	0xc2, 0x2c, 0x45, 0x62, 0x43, 0x4a, 0xd1, 0xaf, 0x01, 0x6c, 0xc1, 0xca, 0xae, 0x69, 0x71, 0x2d,
-	0x23, 0xc2, 0xac, 0x54, 0xe5, 0x00, 0xa9, 0xc6, 0x03, 0xa4, 0xda, 0x8e, 0x07, 0x08, 0xce, 0x45,
-	0x68, 0x83, 0xa3, 0xdf, 0x42, 0x81, 0xd9, 0xb7, 0xa4, 0x1b, 0x0c, 0xe4, 0xe5, 0xec, 0xb3, 0x97,
-	0xf3, 0x23, 0xbc, 0xc1, 0xd1, 0x6f, 0x20, 0xdf, 0x73, 0x5c, 0x87, 0xdd, 0xca, 0xdb, 0xc5, 0x67,
-	0x6f, 0x43, 0x0c, 0x37, 0x78, 0xc8, 0xa1, 0xb0, 0x6c, 0x01, 0xd3, 0x96, 0x23, 0x8e, 0x8a, 0x13,
-	0x5a, 0x83, 0x25, 0x31, 0x44, 0xb5, 0x82, 0x64, 0x80, 0x38, 0xa0, 0x7d, 0xc8, 0x0e, 0x09, 0xa7,
-	0x8e, 0xcd, 0xb4, 0x9c, 0x48, 0x65, 0x29, 0x2e, 0xf3, 0x85, 0x10, 0xe3, 0x58, 0xad, 0xd7, 0xa1,
-	0x90, 0x2c, 0x3c, 0xaa, 0xc0, 0x46, 0xab, 0x7d, 0x89, 0x8d, 0xb3, 0x7a, 0xab, 0x6d, 0xb4, 0xeb,
-	0xa6, 0x71, 0x63, 0x34, 0xce, 0x8d, 0xe3, 0xf3, 0xba, 0xba, 0x80, 0x5e, 0xc0, 0xfa, 0xb8, 0x0e,
-	0x9f, 0x7c, 0xdf, 0xb8, 0xa9, 0x9f, 0xaa, 0x8a, 0x7e, 0x07, 0xe5, 0xb8, 0xca, 0x38, 0x70, 0xc3,
-	0xf1, 0x8b, 0x7e, 0x01, 0x2b, 0xa3, 0x96, 0x18, 0x5a, 0xae, 0xd3, 0x23, 0x8c, 0x8b, 0xa6, 0xcb,
-	0x61, 0x35, 0x56, 0x5c, 0x44, 0xf2, 0x10, 0xfc, 0xe0, 0xd1, 0xbb, 0xde, 0xc0, 0x7b, 0xf8, 0x0c,
-	0xce, 0x4b, 0x70, 0xac, 0x88, 0xc1, 0xfa, 0x2d, 0xe4, 0x70, 0xe0, 0x9e, 0x12, 0x6e, 0x39, 0x83,
-	0x79, 0x13, 0x15, 0xfd, 0x0e, 0x46, 0x9e, 0x4c, 0x2a, 0xc3, 0x12, 0x9c, 0xc8, 0x1f, 0xae, 0x8d,
-	0x35, 0x66, 0x14, 0x32, 0x2e, 0xfb, 0xe3, 0x02, 0xfd, 0xbf, 0x8a, 0x70, 0x25, 0x93, 0x36, 0xa2,
-	0x95, 0x92, 0xa0, 0xd5, 0x26, 0x64, 0x5d, 0xaf, 0x4b, 0xc2, 0x19, 0x24, 0xd9, 0x96, 0x09, 0x8f,
-	0x8d, 0x2e, 0xda, 0x83, 0x82, 0x1b, 0x0c, 0x3b, 0x84, 0x9a, 0xf7, 0xd6, 0x20, 0x90, 0x43, 0x45,
-	0xf9, 0x7e, 0x01, 0xe7, 0xa5, 0xf4, 0x26, 0x14, 0xa2, 0x03, 0xc8, 0xf4, 0x3c, 0x3a, 0xb4, 0xb8,
-	0xe0, 0x4b, 0x82, 0x8d, 0xd2, 0x63, 0xf5, 0xad, 0x50, 0xe2, 0x08, 0xa4, 0x1f, 0x42, 0x46, 0x4a,
-	0x50, 0x19, 0xf2, 0xd7, 0xcd, 0xd6, 0x55, 0xfd, 0xa4, 0xf1, 0xb6, 0x51, 0x3f, 0x55, 0x17, 0x50,
-	0x16, 0xd2, 0xd8, 0xf8, 0xa3, 0xaa, 0xa0, 0x12, 0xc0, 0x55, 0x1d, 0x9f, 0xd4, 0x9b, 0x6d, 0xe3,
-	0xac, 0xae, 0xa6, 0x8e, 0xb3, 0xb0, 0x24, 0x02, 0xd0, 0xdf, 0xc3, 0x26, 0x26, 0xbe, 0x47, 0xf9,
-	0xc8, 0x3c, 0x9b, 0x3f, 0x47, 0x93, 0x5d, 0x94, 0x9a, 0xdf, 0x45, 0xff, 0x4e, 0x83, 0x36, 0x6d,
-	0x3c, 0x1a, 0xbd, 0x17, 0x90, 0xa5, 0x84, 0x05, 0x03, 0x1e, 0x4f, 0xdf, 0x37, 0x11, 0xaf, 0x67,
-	0xe3, 0x27, 0x15, 0x58, 0xdc, 0xc5, 0xb1, 0x8d, 0xca, 0x8f, 0x29, 0x58, 0x9f, 0x09, 0x41, 0x3b,
-	0x90, 0x97, 0x01, 0x99, 0x89, 0x32, 0x81, 0x14, 0x35, 0xc3, 0x62, 0x7d, 0x09, 0xa5, 0x18, 0x30,
-	0x56, 0xb3, 0x42, 0x84, 0x91, 0x95, 0xc3, 0x23, 0xaa, 0xa5, 0x45, 0x51, 0x8e, 0x7e, 0x46, 0xb8,
-	0xd5, 0x96, 0xb0, 0x30, 0xa2, 0xa9, 0x16, 0xa6, 0x92, 0x31, 0xab, 0x4f, 0x44, 0xa5, 0x73, 0x38,
-	0x3e, 0xea, 0x5d, 0xc8, 0x48, 0xec, 0x74, 0x4d, 0x33, 0x90, 0xba, 0x7c, 0xa7, 0x2a, 0x68, 0x0d,
-	0xd4, 0x46, 0xf3, 0xc6, 0x38, 0x6f, 0x9c, 0x9a, 0x06, 0x3e, 0xbb, 0xbe, 0xa8, 0x37, 0xdb, 0x6a,
-	0x0a, 0x6d, 0xc2, 0xea, 0xe9, 0xf5, 0xd5, 0x79, 0xe3, 0x24, 0xa4, 0x22, 0xae, 0x5f, 0x5d, 0xe2,
-	0x76, 0xa3, 0x79, 0xa6, 0xa6, 0x11, 0x82, 0x52, 0xa3, 0xd9, 0xae, 0xe3, 0xa6, 0x71, 0x6e, 0xd6,
-	0x31, 0xbe, 0xc4, 0xea, 0xa2, 0xfe, 0x67, 0x58, 0xc5, 0xc4, 0xea, 0x1a, 0x94, 0x3b, 0x3d, 0xcb,
-	0xe6, 0xcf, 0x14, 0x7e, 0x4e, 0x53, 0x17, 0xad, 0xc8, 0x84, 0xcc, 0xb1, 0x7c, 0x15, 0x14, 0x62,
-	0x61, 0x98, 0x65, 0xfd, 0x35, 0xac, 0x8d, 0xfb, 0x8a, 0xfa, 0x00, 0xc1, 0x62, 0xd7, 0xe2, 0x96,
-	0x70, 0x55, 0xc0, 0xe2, 0xff, 0xe1, 0x8f, 0xcb, 0x00, 0x38, 0x70, 0x5b, 0x72, 0x46, 0xa3, 0x16,
-	0xe4, 0x46, 0x2b, 0x13, 0x92, 0x64, 0x98, 0x5c, 0xa1, 0x2a, 0xa3, 0x26, 0x94, 0x03, 0x40, 0xdf,
-	0xf9, 0xdb, 0xff, 0xfe, 0xff, 0xcf, 0xd4, 0x0b, 0x1d, 0x85, 0xbb, 0x1b, 0xab, 0xdd, 0x7f, 0xd7,
-	0x21, 0xdc, 0xfa, 0x2e, 0x5c, 0x3b, 0xd9, 0x91, 0x98, 0x02, 0x7f, 0x80, 0x8c, 0xdc, 0xab, 0x10,
-	0x12, 0x57, 0xc7, 0x96, 0xac, 0x29, 0x73, 0x7b, 0xc2, 0xdc, 0x36, 0x7a, 0x39, 0x6d, 0xae, 0xf6,
-	0x51, 0x26, 0xeb, 0x13, 0x6a, 0xc1, 0x72, 0xbc, 0x61, 0x20, 0x39, 0x4a, 0x26, 0x16, 0xb2, 0xca,
-	0xfa, 0x84, 0x54, 0xe6, 0x40, 0xaf, 0x08, 0xeb, 0x6b, 0x68, 0x46, 0xb0, 0x88, 0x00, 0x7c, 0xde,
-	0x1e, 0x90, 0x7c, 0xf9, 0x4d, 0xad, 0x13, 0x95, 0x8d, 0xa9, 0x17, 0x46, 0x3d, 0xdc, 0x93, 0xf5,
-	0x57, 0xc2, 0xf2, 0x17, 0xfa, 0xce, 0xac, 0xb8, 0x9d, 0xee, 0xa7, 0xa3, 0x68, 0xe5, 0x40, 0x77,
-	0x50, 0x48, 0xee, 0x1f, 0x48, 0x13, 0x8e, 0x66, 0xac, 0x24, 0x4f, 0xba, 0xfa, 0x46, 0xb8, 0xda,
-	0xd3, 0xbf, 0x78, 0xca, 0x55, 0x10, 0x1b, 0x43, 0x7f, 0x82, 0xdc, 0x68, 0x8b, 0x89, 0x0a, 0x3a,
-	0xb9, 0xd5, 0x3c, 0xe9, 0x26, 0x2a, 0xec, 0xeb, 0xcd, 0x27, 0xdc, 0xa0, 0x1f, 0x14, 0x50, 0x27,
-	0x69, 0x89, 0xb6, 0x9e, 0x60, 0xab, 0xf4, 0xb5, 0x3d, 0x97, 0xcb, 0xfa, 0x2f, 0x85, 0xcb, 0xaa,
-	0xfe, 0xcd, 0x9c, 0xe2, 0x1f, 0x51, 0x71, 0x3b, 0xba, 0x7a, 0xa4, 0xbc, 0x46, 0xff, 0x52, 0xa0,
-	0x90, 0xec, 0xf8, 0x28, 0xa5, 0x33, 0x08, 0x57, 0x79, 0x31, 0x43, 0x13, 0xf9, 0xc6, 0xc2, 0xf7,
-	0x39, 0xfa, 0xfd, 0x1c, 0xdf, 0xb5, 0x90, 0x87, 0xac, 0xf6, 0x31, 0x62, 0xe7, 0xa7, 0x5a, 0x4c,
-	0x3c, 0x56, 0xfb, 0x38, 0x46, 0xcc, 0x30, 0x4a, 0xab, 0x8b, 0x3c, 0x28, 0x24, 0x37, 0xec, 0x28,
-	0xb0, 0x19, 0x4b, 0xf7, 0x93, 0x45, 0x38, 0x10, 0x51, 0xbd, 0xd2, 0xbf, 0x9a, 0x17, 0x15, 0x8f,
-	0x0d, 0x22, 0x1b, 0x96, 0xe3, 0x25, 0x3d, 0x22, 0xc6, 0xc4, 0xce, 0xfe, 0xf3, 0x9a, 0x2a, 0x76,
-	0x44, 0x43, 0x63, 0xc7, 0x3f, 0x28, 0xff, 0x30, 0x2e, 0xf0, 0x16, 0x64, 0xbb, 0xa4, 0x67, 0x85,
-	0x83, 0x7f, 0x05, 0x95, 0xa1, 0x58, 0xc9, 0x0b, 0x7f, 0x72, 0x98, 0xbe, 0xdf, 0x81, 0x6d, 0xc8,
-	0x1c, 0x13, 0x8b, 0x12, 0x8a, 0x56, 0x97, 0x53, 0x95, 0xa2, 0x15, 0xf0, 0x5b, 0x8f, 0x3a, 0x1f,
-	0xc4, 0x27, 0xda, 0x6e, 0xaa, 0x53, 0x00, 0x18, 0x01, 0x16, 0xde, 0xbf, 0xe9, 0x3b, 0xfc, 0x36,
-	0xe8, 0x54, 0x6d, 0x6f, 0x58, 0xbb, 0x0b, 0x3a, 0x24, 0xdc, 0x37, 0x46, 0x1f, 0x8a, 0xac, 0x96,
-	0xfc, 0x3a, 0xec, 0x7b, 0xa6, 0x3d, 0x70, 0x88, 0xcb, 0x3b, 0x19, 0xf1, 0x08, 0x6f, 0x7e, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xd6, 0x6b, 0x76, 0xde, 0xef, 0x0e, 0x00, 0x00,
+	// 2225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x73, 0xdb, 0xc8,
+	0xf1, 0x37, 0x48, 0x8a, 0x22, 0x9b, 0x0f, 0x41, 0xa3, 0x17, 0x4d, 0x5b, 0x6b, 0x19, 0x7e, 0xfb,
+	0x6f, 0x93, 0xb5, 0xf2, 0xbf, 0x52, 0x59, 0xa5, 0x52, 0x5b, 0x94, 0x48, 0x6b, 0xb9, 0x96, 0x28,
+	0x65, 0x48, 0xd9, 0x15, 0xe7, 0x80, 0x02, 0xc1, 0x91, 0x84, 0x15, 0x09, 0xc0, 0xc0, 0xc0, 0x0a,
+	0xed, 0x72, 0x0e, 0xa9, 0xda, 0x2f, 0x90, 0x54, 0x6a, 0x6f, 0x39, 0xe5, 0x03, 0x24, 0xd9, 0x4f,
+	0x91, 0x73, 0x4e, 0xb9, 0xe7, 0x92, 0x6f, 0x91, 0x9a, 0x07, 0x20, 0xf0, 0xa9, 0xad, 0xad, 0x1c,
+	0xf6, 0x44, 0xa2, 0xfb, 0x37, 0xdd, 0x3d, 0xdd, 0x3d, 0xdd, 0xd3, 0x03, 0x6b, 0x5d, 0xc3, 0xbc,
+	0x20, 0x76, 0xaf, 0x6a, 0xb8, 0x56, 0xd5, 0x0b, 0xec, 0x8a, 0xeb, 0x39, 0xd4, 0x41, 0x49, 0xc3,
+	0xb5, 0xca, 0x1b, 0x71, 0x1e, 0xf1, 0x3c, 0xc7, 0x13, 0xdc, 0x72, 0x29, 0xce, 0x38, 0xb5, 0xfa,
+	0x94, 0x84, 0x9c, 0x5b, 0x67, 0x8e, 0x73, 0xd6, 0x27, 0x55, 0xfe, 0xd5, 0x0d, 0x4e, 0xab, 0x64,
+	0xe0, 0xd2, 0xa1, 0x64, 0xde, 0x96, 0x4c, 0xb6, 0xca, 0xb0, 0x6d, 0x87, 0x1a, 0xd4, 0x72, 0x6c,
+	0x5f, 0x72, 0xef, 0x8c, 0x2f, 0xa5, 0xd6, 0x80, 0xf8, 0xd4, 0x18, 0xb8, 0x21, 0x20, 0xae, 0xd5,
+	0xb5, 0x5c, 0xd2, 0xb7, 0x6c, 0xa2, 0xfb, 0x2e, 0x31, 0x25, 0xe0, 0xfe, 0xc8, 0x5e, 0x88, 0xef,
+	0x04, 0x9e, 0x49, 0x74, 0x8f, 0x9c, 0x12, 0x8f, 0xd8, 0x26, 0x91, 0xa8, 0xf5, 0x38, 0xea, 0xbd,
+	0x45, 0x2e, 0x25, 0xfd, 0x19, 0xff, 0x31, 0x9f, 0x9f, 0x11, 0xfb, 0xb9, 0x7f, 0x69, 0x9c, 0x9d,
+	0x11, 0xaf, 0xea, 0xb8, 0xdc, 0xc2, 0x49, 0x6b, 0xb5, 0x37, 0xa0, 0xee, 0x79, 0xc4, 0xa0, 0x04,
+	0x07, 0x36, 0x26, 0xef, 0x02, 0xe2, 0x53, 0x54, 0x86, 0xa4, 0x17, 0xd8, 0x25, 0x65, 0x4b, 0x79,
+	0x9c, 0xdb, 0xce, 0x54, 0x0c, 0xd7, 0xaa, 0x30, 0x2e, 0x23, 0xa2, 0x47, 0xb0, 0x64, 0xf5, 0xc8,
+	0xc0, 0x75, 0x28, 0xb1, 0xcd, 0xa1, 0x7e, 0x41, 0x86, 0xa5, 0xc4, 0x96, 0xf2, 0x38, 0x8b, 0x8b,
+	0x31, 0xf2, 0x2b, 0x32, 0xd4, 0x1e, 0x42, 0x61, 0x9f, 0xd0, 0x98, 0xd4, 0x35, 0x48, 0x7b, 0x81,
+	0xad, 0x5b, 0x3d, 0x2e, 0x38, 0x8b, 0x17, 0xbc, 0xc0, 0x6e, 0xf6, 0xb4, 0xff, 0x28, 0xb0, 0x74,
+	0x60, 0xf9, 0x0c, 0xe9, 0x87, 0xd0, 0x4d, 0x00, 0xd7, 0x38, 0x23, 0x3a, 0x75, 0x2e, 0x88, 0x2d,
+	0xe1, 0x59, 0x46, 0xe9, 0x30, 0x02, 0xba, 0x05, 0xfc, 0x43, 0xf7, 0xad, 0x0f, 0x84, 0x6b, 0x5f,
+	0xc0, 0x19, 0x46, 0x68, 0x5b, 0x1f, 0x08, 0xda, 0x80, 0x45, 0xdf, 0xf1, 0xa8, 0xde, 0x1d, 0x96,
+	0x92, 0x7c, 0x61, 0x9a, 0x7d, 0xee, 0x0e, 0xd1, 0x4b, 0x58, 0x9f, 0xf4, 0x25, 0xdf, 0x40, 0x8a,
+	0x6f, 0x54, 0x15, 0x1b, 0x95, 0x90, 0x57, 0x64, 0x88, 0x57, 0x43, 0x3c, 0x0e, 0xe1, 0xaf, 0xc8,
+	0x10, 0xad, 0x43, 0x5a, 0xa4, 0x4a, 0x69, 0x41, 0xc8, 0x17, 0x5f, 0xe8, 0x2e, 0xa4, 0x58, 0x14,
+	0x4a, 0xe9, 0x2d, 0xe5, 0x71, 0x71, 0xbb, 0xc0, 0xa5, 0xb1, 0x8d, 0xbd, 0xb6, 0xc8, 0x25, 0xe6,
+	0x2c, 0xed, 0x19, 0xac, 0x74, 0x88, 0x37, 0xb0, 0xec, 0x51, 0x7f, 0xcf, 0xf0, 0xcc, 0x63, 0x58,
+	0xc2, 0x84, 0x7a, 0xc3, 0xeb, 0x91, 0x97, 0xa0, 0x5e, 0xb9, 0xd0, 0x77, 0x1d, 0xdb, 0x27, 0xe8,
+	0x36, 0xa4, 0xbc, 0xc0, 0xf6, 0x4b, 0xca, 0x56, 0x72, 0x24, 0x8a, 0x9c, 0xca, 0x3c, 0x4c, 0x1d,
+	0x6a, 0xf4, 0x85, 0x0f, 0x93, 0xdc, 0x87, 0x59, 0x4e, 0xe1, 0x4e, 0x7c, 0x08, 0x4b, 0x36, 0xf9,
+	0x2d, 0xd5, 0x63, 0x51, 0x10, 0x51, 0x2e, 0x30, 0xf2, 0x71, 0x18, 0x09, 0xed, 0x1e, 0x2c, 0xd7,
+	0x3c, 0xf3, 0xdc, 0x7a, 0x1f, 0xdf, 0x4e, 0x11, 0x12, 0x91, 0x81, 0x09, 0xab, 0xa7, 0x3d, 0x80,
+	0x95, 0x13, 0xdb, 0xb8, 0x16, 0xa6, 0x81, 0x5a, 0x27, 0x7d, 0x42, 0xe7, 0x61, 0xfe, 0xa6, 0xc0,
+	0xc6, 0xae, 0x41, 0xcd, 0xf3, 0x2b, 0xad, 0x51, 0xd2, 0xa8, 0x90, 0xb4, 0x7a, 0x62, 0xbf, 0x59,
+	0xcc, 0xfe, 0xa2, 0x7b, 0x51, 0xa4, 0x12, 0x3c, 0xc2, 0x39, 0xee, 0x84, 0x97, 0x9c, 0x14, 0x85,
+	0x6d, 0x03, 0x16, 0x7b, 0xde, 0x50, 0x67, 0x09, 0xcf, 0xdc, 0x90, 0xc1, 0xe9, 0x1e, 0x77, 0xf9,
+	0xff, 0x2a, 0x5f, 0xb4, 0xbf, 0x2a, 0xb0, 0xce, 0x6d, 0x8e, 0x76, 0xf7, 0x93, 0x37, 0xf9, 0x7b,
+	0x05, 0x6e, 0x72, 0x93, 0xe3, 0xd9, 0xfa, 0x93, 0xb7, 0xfa, 0x2f, 0x49, 0x58, 0xe6, 0x56, 0x8f,
+	0x9c, 0x83, 0x2f, 0x61, 0xd1, 0x23, 0x7e, 0xd0, 0xa7, 0xe1, 0x51, 0x78, 0xc0, 0xc5, 0x4d, 0x00,
+	0x23, 0x0a, 0xe6, 0x68, 0x1c, 0xae, 0x1a, 0x3b, 0x2a, 0x89, 0xf1, 0xa3, 0x32, 0x6b, 0x5b, 0xe5,
+	0x3f, 0x25, 0xa0, 0x38, 0x2a, 0x73, 0xc6, 0xf1, 0x45, 0x37, 0x21, 0xc3, 0xc8, 0xb6, 0x31, 0x20,
+	0xf2, 0x98, 0x2d, 0x7a, 0x81, 0xdd, 0x32, 0x06, 0x04, 0xd5, 0x21, 0xed, 0x53, 0x83, 0x06, 0x3e,
+	0x17, 0x5e, 0xdc, 0x7e, 0xf6, 0x83, 0x8c, 0xaf, 0xb4, 0xf9, 0x1a, 0x2c, 0xd7, 0xa2, 0x12, 0x2c,
+	0x0e, 0x88, 0xef, 0x1b, 0x67, 0x84, 0xbb, 0x34, 0x8b, 0xc3, 0x4f, 0xed, 0x1d, 0xa4, 0x05, 0x16,
+	0x2d, 0x41, 0xee, 0xa4, 0xd5, 0x3e, 0x6e, 0xec, 0x35, 0x5f, 0x36, 0x1b, 0x75, 0xf5, 0x06, 0x4a,
+	0x43, 0xe2, 0xe8, 0x95, 0xaa, 0xa0, 0x02, 0x64, 0x5b, 0x47, 0x1d, 0xfd, 0xe5, 0xd1, 0x49, 0xab,
+	0xae, 0x26, 0xd0, 0x1a, 0x2c, 0x1f, 0x37, 0xf0, 0x61, 0xb3, 0xdd, 0x6e, 0x1e, 0xb5, 0xf4, 0x7a,
+	0xa3, 0xc5, 0xd0, 0x49, 0xb4, 0x0a, 0x6a, 0xb3, 0xf5, 0xba, 0x76, 0xd0, 0xac, 0xeb, 0x35, 0xbc,
+	0x7f, 0x72, 0xd8, 0x68, 0x75, 0xd4, 0x14, 0x42, 0x50, 0x6c, 0xb6, 0x3a, 0x0d, 0xdc, 0xaa, 0x1d,
+	0xe8, 0x0d, 0x8c, 0x8f, 0xb0, 0xba, 0xa0, 0x7d, 0x9f, 0x86, 0x24, 0x0b, 0xfb, 0xd8, 0xd9, 0x46,
+	0x08, 0x52, 0x31, 0x0f, 0xf0, 0xff, 0x68, 0x07, 0x0a, 0x3e, 0x75, 0x3c, 0x5e, 0xec, 0xa9, 0x41,
+	0x49, 0x09, 0xb8, 0x17, 0xd6, 0xc2, 0x6a, 0x56, 0x69, 0x0b, 0x2e, 0xdb, 0x03, 0xc1, 0x79, 0x3f,
+	0xf6, 0x85, 0xb6, 0x20, 0xd7, 0x23, 0xbe, 0xe9, 0x59, 0xbc, 0xf7, 0xc9, 0x66, 0x10, 0x27, 0xa1,
+	0x9f, 0x41, 0x61, 0xa4, 0xfd, 0xca, 0x7c, 0x5b, 0xe6, 0xd2, 0x8f, 0x25, 0xa7, 0xed, 0x12, 0x13,
+	0xe7, 0xdd, 0xd8, 0x17, 0xda, 0x87, 0x95, 0xc9, 0x84, 0xf5, 0x4b, 0x0b, 0x3c, 0xbd, 0xd6, 0x47,
+	0xb2, 0x35, 0x4a, 0x50, 0x8c, 0x26, 0x72, 0xd6, 0x67, 0xcd, 0xd4, 0x27, 0xde, 0x7b, 0xcb, 0x24,
+	0xba, 0x61, 0x9a, 0x4e, 0x60, 0xd3, 0x52, 0x51, 0x34, 0x53, 0x49, 0xae, 0x09, 0x2a, 0xfa, 0x02,
+	0xc0, 0xe4, 0x5d, 0xba, 0xa7, 0x1b, 0x94, 0x77, 0x98, 0xdc, 0x76, 0xb9, 0x22, 0x2e, 0x1a, 0x95,
+	0xf0, 0xa2, 0x51, 0xe9, 0x84, 0x17, 0x0d, 0x9c, 0x95, 0xe8, 0x1a, 0x45, 0xbf, 0x84, 0xbc, 0x6f,
+	0x9e, 0x93, 0x5e, 0xd0, 0x17, 0x8b, 0x17, 0xaf, 0x5d, 0x9c, 0x8b, 0xf0, 0x35, 0x8a, 0x7e, 0x01,
+	0xb9, 0x53, 0xcb, 0xb6, 0xfc, 0x73, 0xb1, 0xba, 0x70, 0xed, 0x6a, 0x08, 0xe1, 0x35, 0xca, 0x5a,
+	0xa5, 0xcc, 0xde, 0x8c, 0x6c, 0xc5, 0xfc, 0x0b, 0xad, 0xc2, 0x02, 0xbf, 0x86, 0x95, 0xf2, 0xe2,
+	0x18, 0xf0, 0x0f, 0xf4, 0x98, 0x65, 0x29, 0xf5, 0x2c, 0xd3, 0x2f, 0x65, 0xb9, 0x2b, 0x8b, 0x61,
+	0x98, 0x0f, 0x39, 0x19, 0x87, 0x6c, 0xf4, 0x0c, 0xd2, 0x7d, 0xa3, 0x4b, 0xfa, 0x7e, 0x69, 0x89,
+	0x03, 0x57, 0xa3, 0x7c, 0x38, 0xe0, 0xe4, 0x86, 0xcd, 0xda, 0xa6, 0xc4, 0xa0, 0x32, 0x64, 0x5c,
+	0xcf, 0x72, 0x3c, 0x8b, 0x0e, 0x4b, 0xaa, 0xbc, 0x2d, 0xc8, 0x6f, 0xf4, 0x00, 0x8a, 0xef, 0x02,
+	0x12, 0x10, 0xdd, 0x75, 0x7c, 0x8b, 0xe7, 0xc9, 0x32, 0x47, 0x14, 0x38, 0xf5, 0x58, 0x12, 0xcb,
+	0x5f, 0x40, 0x2e, 0x26, 0x99, 0x55, 0x40, 0x56, 0x9e, 0x44, 0xee, 0xb2, 0xbf, 0x6c, 0x47, 0xef,
+	0x8d, 0x7e, 0x10, 0x66, 0xaf, 0xf8, 0xd8, 0x49, 0xfc, 0x5c, 0xd1, 0x1a, 0x90, 0x8f, 0x27, 0x29,
+	0x2a, 0xc3, 0x7a, 0xbb, 0x73, 0x84, 0x6b, 0xfb, 0x8d, 0x76, 0xa7, 0xd6, 0x69, 0xe8, 0xb5, 0xd7,
+	0xb5, 0xe6, 0x41, 0x6d, 0xf7, 0xa0, 0xa1, 0xde, 0x40, 0x37, 0x61, 0x6d, 0x94, 0x87, 0xf7, 0xbe,
+	0x6a, 0xbe, 0x6e, 0xd4, 0x55, 0x45, 0xbb, 0x80, 0xa5, 0x30, 0x23, 0x71, 0x60, 0xb3, 0x2b, 0x25,
+	0xfa, 0x3f, 0x58, 0x8e, 0xd2, 0x77, 0x60, 0xd8, 0xd6, 0x29, 0xf1, 0x29, 0x3f, 0x20, 0x59, 0xac,
+	0x86, 0x8c, 0x43, 0x49, 0x67, 0xe0, 0x4b, 0xc7, 0xbb, 0x38, 0xed, 0x3b, 0x97, 0x57, 0xe0, 0x9c,
+	0x00, 0x87, 0x8c, 0x10, 0xac, 0x9d, 0x43, 0x16, 0x07, 0x76, 0x9d, 0x50, 0xc3, 0xea, 0xcf, 0xbd,
+	0x0d, 0x7e, 0x09, 0x91, 0x26, 0xdd, 0x13, 0x66, 0xc9, 0x16, 0xb0, 0x3a, 0x72, 0x88, 0xa4, 0xc9,
+	0x78, 0xc9, 0x1d, 0x25, 0x68, 0xff, 0x50, 0x20, 0x1b, 0x05, 0x38, 0x2a, 0x01, 0x4a, 0xac, 0x04,
+	0x6c, 0xc0, 0xa2, 0xed, 0xf4, 0x08, 0x2b, 0x9a, 0xc2, 0xb7, 0x69, 0xf6, 0xd9, 0xec, 0xa1, 0x7b,
+	0x90, 0xb7, 0x83, 0x41, 0x97, 0x78, 0xba, 0xf0, 0x3c, 0x3b, 0xe0, 0xca, 0x57, 0x37, 0x70, 0x4e,
+	0x50, 0x5f, 0x33, 0x22, 0x7a, 0x0e, 0xe9, 0x53, 0xc7, 0x1b, 0x18, 0xb4, 0x94, 0x1a, 0xad, 0x1c,
+	0x42, 0x63, 0xe5, 0x25, 0x67, 0x62, 0x09, 0xd2, 0xb6, 0x21, 0x2d, 0x28, 0x93, 0xe5, 0x70, 0x11,
+	0x92, 0xb8, 0xf6, 0x46, 0x55, 0x50, 0x11, 0xe0, 0xb8, 0x81, 0xf7, 0x1a, 0xad, 0x4e, 0x6d, 0xbf,
+	0xa1, 0x26, 0x76, 0x17, 0x65, 0xe8, 0xb5, 0xb7, 0xb0, 0x81, 0x89, 0xeb, 0x78, 0x34, 0x12, 0xef,
+	0xcf, 0xbf, 0xb7, 0xc5, 0x33, 0x3e, 0x31, 0x37, 0xe3, 0xb5, 0x3f, 0x27, 0xa1, 0x34, 0x29, 0x5c,
+	0xb6, 0xb8, 0xc3, 0xf1, 0x16, 0xf7, 0x42, 0x88, 0x99, 0x81, 0x1f, 0x67, 0x8c, 0x35, 0xbc, 0xf2,
+	0xdf, 0x13, 0xb0, 0x36, 0x15, 0x82, 0xee, 0x40, 0x4e, 0x18, 0xa4, 0xc7, 0xc2, 0x04, 0x82, 0xc4,
+	0xdb, 0xd5, 0x7d, 0x28, 0x86, 0x80, 0x91, 0x98, 0xe5, 0x25, 0x46, 0x44, 0x0e, 0x8f, 0x35, 0xb5,
+	0x9d, 0x1f, 0x61, 0xee, 0x0f, 0x6f, 0x71, 0xbd, 0xeb, 0x5b, 0xdc, 0xb4, 0xe6, 0x95, 0x40, 0x1b,
+	0xb0, 0x52, 0x3f, 0x39, 0x3e, 0x68, 0xee, 0xb1, 0xa3, 0x88, 0x1b, 0xc7, 0x47, 0xb8, 0xd3, 0x6c,
+	0xed, 0xab, 0xc9, 0x29, 0x5d, 0x2d, 0xa5, 0x7d, 0x03, 0x2b, 0x98, 0x18, 0xbd, 0x9a, 0x47, 0xad,
+	0x53, 0xc3, 0xa4, 0xd7, 0x04, 0x7e, 0x4e, 0x52, 0x17, 0x0c, 0x29, 0x42, 0xf8, 0x58, 0xb4, 0xad,
+	0x7c, 0x48, 0x64, 0x5e, 0xd6, 0x9e, 0xc2, 0xea, 0xa8, 0x2e, 0x99, 0x07, 0x08, 0x52, 0x3d, 0x83,
+	0x1a, 0x5c, 0x55, 0x1e, 0xf3, 0xff, 0xda, 0x21, 0x6c, 0xc8, 0xd1, 0x20, 0x84, 0x5f, 0x97, 0x94,
+	0xb7, 0x20, 0x4b, 0x0d, 0xff, 0x22, 0x7e, 0x1d, 0xc9, 0x30, 0x02, 0x57, 0xfd, 0x35, 0x94, 0x26,
+	0xc5, 0x49, 0xf5, 0x15, 0xc8, 0x86, 0x66, 0x86, 0x89, 0xa8, 0x86, 0xf9, 0x1c, 0xd9, 0x7a, 0x05,
+	0xd1, 0xfe, 0x95, 0x80, 0x5c, 0x8c, 0x35, 0xf5, 0xf4, 0xcf, 0x33, 0x86, 0xdd, 0xcc, 0x9c, 0xee,
+	0x37, 0xc4, 0xa4, 0xfc, 0xb2, 0x28, 0x3c, 0x95, 0x15, 0x14, 0x36, 0xa8, 0x6d, 0x02, 0xb0, 0x2b,
+	0x9b, 0xde, 0x1d, 0x52, 0xe2, 0xf3, 0xac, 0x48, 0xe2, 0x2c, 0xa3, 0xec, 0x32, 0x02, 0xaa, 0x83,
+	0xda, 0x37, 0x7c, 0xaa, 0x0f, 0x9c, 0x9e, 0x75, 0x6a, 0x89, 0xf6, 0xb6, 0x70, 0x6d, 0x7b, 0x2b,
+	0xb2, 0x35, 0x87, 0x72, 0x49, 0x8d, 0xa2, 0x27, 0x90, 0xa2, 0x43, 0x97, 0x94, 0xd2, 0xa3, 0xe5,
+	0x25, 0xdc, 0x54, 0xa5, 0x33, 0x74, 0x09, 0xe6, 0x10, 0x76, 0x7a, 0xe4, 0xa5, 0xc1, 0x35, 0xe8,
+	0x39, 0x6f, 0xc4, 0x59, 0x0c, 0x82, 0x74, 0x6c, 0xd0, 0x73, 0xad, 0x09, 0x29, 0x06, 0x47, 0x2a,
+	0xe4, 0x4f, 0x5a, 0xaf, 0x5a, 0x47, 0x6f, 0x5a, 0x7a, 0xe7, 0xd7, 0xc7, 0xac, 0x31, 0x64, 0x20,
+	0x55, 0xaf, 0x75, 0x6a, 0xaa, 0x82, 0x72, 0xb0, 0x78, 0xd8, 0xe8, 0xe0, 0xe6, 0x5e, 0x5b, 0x4d,
+	0xf0, 0x84, 0x6e, 0xea, 0x87, 0x8d, 0x4e, 0x8d, 0x73, 0x93, 0xac, 0x48, 0x1d, 0x1c, 0xed, 0xab,
+	0xa9, 0xed, 0xef, 0xf2, 0x00, 0x38, 0xb0, 0xdb, 0xe2, 0x1a, 0x81, 0xda, 0x90, 0x8d, 0xa6, 0x7c,
+	0x24, 0x8c, 0x1c, 0x9f, 0xfa, 0xcb, 0x51, 0xed, 0x11, 0x75, 0x5f, 0xbb, 0xf3, 0xfb, 0x7f, 0xfe,
+	0xfb, 0x8f, 0x89, 0x9b, 0x1a, 0x62, 0x0f, 0x0c, 0x7e, 0xf5, 0xfd, 0xe7, 0x5d, 0x42, 0x8d, 0xcf,
+	0xd9, 0xdb, 0x8a, 0xbf, 0xc3, 0x8b, 0xff, 0xaf, 0x20, 0x2d, 0x26, 0x7c, 0x84, 0xf8, 0xd2, 0x91,
+	0x71, 0x7f, 0x42, 0xdc, 0x3d, 0x2e, 0x6e, 0x13, 0xdd, 0x9a, 0x14, 0x57, 0xfd, 0x28, 0xf2, 0xf0,
+	0x13, 0x6a, 0x43, 0x26, 0x1c, 0x64, 0xd1, 0x6a, 0x34, 0x41, 0xc7, 0x86, 0x8f, 0xf2, 0xda, 0x18,
+	0x55, 0xe4, 0x9e, 0x56, 0xe6, 0xd2, 0x57, 0xd1, 0x14, 0x63, 0x11, 0x01, 0xb8, 0x1a, 0x17, 0x91,
+	0xb8, 0x9f, 0x4d, 0x4c, 0xad, 0xe5, 0xf5, 0x89, 0xa0, 0x37, 0xd8, 0x93, 0x8f, 0xf6, 0x88, 0x4b,
+	0xbe, 0xab, 0xdd, 0x99, 0x66, 0xb7, 0xd5, 0xfb, 0xb4, 0x23, 0x27, 0x5b, 0x74, 0x01, 0xf9, 0xf8,
+	0x98, 0x8b, 0x4a, 0x5c, 0xd1, 0x94, 0xc9, 0x77, 0xa6, 0xaa, 0x27, 0x5c, 0xd5, 0x3d, 0xed, 0xee,
+	0x2c, 0x55, 0x41, 0x28, 0x0c, 0xfd, 0x06, 0xb2, 0xd1, 0x38, 0x29, 0x03, 0x3a, 0x3e, 0x3c, 0xcf,
+	0x54, 0x23, 0x03, 0xfb, 0x74, 0x63, 0x86, 0x1a, 0xf4, 0xad, 0x02, 0xea, 0x78, 0x35, 0x46, 0xb7,
+	0x67, 0x14, 0x69, 0xa1, 0x6b, 0x73, 0x6e, 0x09, 0xd7, 0xfe, 0x9f, 0xab, 0xac, 0x68, 0x4f, 0xe6,
+	0x04, 0x7f, 0xc7, 0xe3, 0xab, 0xe5, 0xd2, 0x1d, 0xe5, 0x29, 0xfa, 0x4e, 0x81, 0x7c, 0xbc, 0xd0,
+	0x49, 0x97, 0x4e, 0xa9, 0xb3, 0xe5, 0x9b, 0x53, 0x38, 0x52, 0x37, 0xe6, 0xba, 0x0f, 0xd0, 0xd7,
+	0x73, 0x74, 0x57, 0x59, 0xf9, 0xf5, 0xab, 0x1f, 0x65, 0x51, 0xfe, 0x54, 0x8d, 0xaa, 0x54, 0xf5,
+	0xe3, 0x48, 0x3d, 0x66, 0x56, 0x1a, 0x3d, 0xf4, 0xbb, 0xe8, 0xc1, 0x25, 0x2a, 0x83, 0xd2, 0x41,
+	0x33, 0x8a, 0x6d, 0x79, 0x73, 0x06, 0x57, 0x1a, 0xf9, 0x9c, 0x1b, 0xf9, 0x08, 0x3d, 0x98, 0x67,
+	0x64, 0x64, 0x14, 0x72, 0x20, 0x1f, 0x1f, 0xcd, 0xa5, 0x63, 0xa6, 0xbc, 0x2d, 0xcd, 0x4c, 0x02,
+	0xa9, 0x50, 0x9b, 0xab, 0x90, 0x86, 0x02, 0x91, 0x09, 0x99, 0xf0, 0x2d, 0x4a, 0x1e, 0xcc, 0xb1,
+	0xa7, 0xa9, 0x1f, 0x97, 0xd4, 0xa1, 0x22, 0x8f, 0x09, 0x43, 0x14, 0xd4, 0xf1, 0xc7, 0x1d, 0xe9,
+	0xd5, 0x19, 0x6f, 0x3e, 0xe5, 0xf5, 0xe9, 0xe3, 0xb0, 0xf6, 0x94, 0x2b, 0xbd, 0x3f, 0xed, 0xd0,
+	0xee, 0x74, 0x63, 0xb2, 0x58, 0x96, 0xbd, 0x83, 0xa5, 0xb1, 0xe7, 0x19, 0x74, 0xeb, 0x4a, 0xec,
+	0xc4, 0xa3, 0xcd, 0x4c, 0x9d, 0xe1, 0x46, 0x3f, 0x9b, 0xa5, 0x53, 0x88, 0x62, 0x2a, 0x3f, 0x00,
+	0x9a, 0x7c, 0x5e, 0x41, 0x9f, 0x5d, 0x09, 0x9e, 0xf6, 0xee, 0x32, 0x53, 0xf1, 0x33, 0xae, 0xf8,
+	0xa1, 0x76, 0x77, 0x96, 0xe2, 0x48, 0xda, 0x8e, 0xf2, 0x74, 0xf7, 0x5b, 0xe5, 0x0f, 0xb5, 0x43,
+	0x7c, 0x1b, 0x16, 0x7b, 0xe4, 0xd4, 0x60, 0x97, 0xba, 0x65, 0xb4, 0x04, 0x85, 0xb2, 0x78, 0xb2,
+	0x11, 0x17, 0xa5, 0xb7, 0x77, 0x60, 0x13, 0xd2, 0xbb, 0xc4, 0xf0, 0x88, 0x87, 0x56, 0x32, 0x89,
+	0x72, 0xc1, 0x08, 0xe8, 0xb9, 0xe3, 0x59, 0x1f, 0xf8, 0xd3, 0xf1, 0x56, 0xa2, 0x9b, 0x07, 0x88,
+	0x00, 0x37, 0xde, 0xbe, 0x38, 0xb3, 0xe8, 0x79, 0xd0, 0xad, 0x98, 0xce, 0xa0, 0x7a, 0x11, 0x74,
+	0x09, 0x9b, 0x25, 0xa2, 0x87, 0x6d, 0xbf, 0x1a, 0x7f, 0xa7, 0x3e, 0x73, 0x74, 0xb3, 0x6f, 0x11,
+	0x9b, 0x76, 0xd3, 0x3c, 0x4f, 0x5e, 0xfc, 0x77, 0x00, 0x75, 0x1a, 0xcf, 0xda, 0xb9, 0x17, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1315,10 +2030,18 @@ type RunServiceClient interface {
 	ReportRunMetrics(ctx context.Context, in *ReportRunMetricsRequest, opts ...grpc.CallOption) (*ReportRunMetricsResponse, error)
 	// Finds a run's artifact data.
 	ReadArtifact(ctx context.Context, in *ReadArtifactRequest, opts ...grpc.CallOption) (*ReadArtifactResponse, error)
+	// Finds the artifacts a run produced, per task.
+	ListRunArtifacts(ctx context.Context, in *ListRunArtifactsRequest, opts ...grpc.CallOption) (*ListRunArtifactsResponse, error)
 	// Terminates an active run.
 	TerminateRun(ctx context.Context, in *TerminateRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Re-initiates a failed or terminated run.
 	RetryRun(ctx context.Context, in *RetryRunRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Archives a set of runs selected by IDs or by a filter.
+	BatchArchiveRuns(ctx context.Context, in *BatchArchiveRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error)
+	// Deletes a set of runs selected by IDs or by a filter.
+	BatchDeleteRuns(ctx context.Context, in *BatchDeleteRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error)
+	// Terminates a set of active runs selected by IDs or by a filter.
+	BatchTerminateRuns(ctx context.Context, in *BatchTerminateRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error)
 }
 
 type runServiceClient struct {
@@ -1401,6 +2124,15 @@ func (c *runServiceClient) ReadArtifact(ctx context.Context, in *ReadArtifactReq
 	return out, nil
 }
 
+func (c *runServiceClient) ListRunArtifacts(ctx context.Context, in *ListRunArtifactsRequest, opts ...grpc.CallOption) (*ListRunArtifactsResponse, error) {
+	out := new(ListRunArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/ListRunArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runServiceClient) TerminateRun(ctx context.Context, in *TerminateRunRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/api.RunService/TerminateRun", in, out, opts...)
@@ -1419,6 +2151,33 @@ func (c *runServiceClient) RetryRun(ctx context.Context, in *RetryRunRequest, op
 	return out, nil
 }
 
+func (c *runServiceClient) BatchArchiveRuns(ctx context.Context, in *BatchArchiveRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error) {
+	out := new(BatchRunsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/BatchArchiveRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BatchDeleteRuns(ctx context.Context, in *BatchDeleteRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error) {
+	out := new(BatchRunsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/BatchDeleteRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runServiceClient) BatchTerminateRuns(ctx context.Context, in *BatchTerminateRunsRequest, opts ...grpc.CallOption) (*BatchRunsResponse, error) {
+	out := new(BatchRunsResponse)
+	err := c.cc.Invoke(ctx, "/api.RunService/BatchTerminateRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RunServiceServer is the server API for RunService service.
 type RunServiceServer interface {
 	// Creates a new run.
@@ -1440,10 +2199,18 @@ type RunServiceServer interface {
 	ReportRunMetrics(context.Context, *ReportRunMetricsRequest) (*ReportRunMetricsResponse, error)
 	// Finds a run's artifact data.
 	ReadArtifact(context.Context, *ReadArtifactRequest) (*ReadArtifactResponse, error)
+	// Finds the artifacts a run produced, per task.
+	ListRunArtifacts(context.Context, *ListRunArtifactsRequest) (*ListRunArtifactsResponse, error)
 	// Terminates an active run.
 	TerminateRun(context.Context, *TerminateRunRequest) (*empty.Empty, error)
 	// Re-initiates a failed or terminated run.
 	RetryRun(context.Context, *RetryRunRequest) (*empty.Empty, error)
+	// Archives a set of runs selected by IDs or by a filter.
+	BatchArchiveRuns(context.Context, *BatchArchiveRunsRequest) (*BatchRunsResponse, error)
+	// Deletes a set of runs selected by IDs or by a filter.
+	BatchDeleteRuns(context.Context, *BatchDeleteRunsRequest) (*BatchRunsResponse, error)
+	// Terminates a set of active runs selected by IDs or by a filter.
+	BatchTerminateRuns(context.Context, *BatchTerminateRunsRequest) (*BatchRunsResponse, error)
 }
 
 // UnimplementedRunServiceServer can be embedded to have forward compatible implementations.
@@ -1474,12 +2241,24 @@ func (*UnimplementedRunServiceServer) ReportRunMetrics(ctx context.Context, req
 func (*UnimplementedRunServiceServer) ReadArtifact(ctx context.Context, req *ReadArtifactRequest) (*ReadArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadArtifact not implemented")
 }
+func (*UnimplementedRunServiceServer) ListRunArtifacts(ctx context.Context, req *ListRunArtifactsRequest) (*ListRunArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunArtifacts not implemented")
+}
 func (*UnimplementedRunServiceServer) TerminateRun(ctx context.Context, req *TerminateRunRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateRun not implemented")
 }
 func (*UnimplementedRunServiceServer) RetryRun(ctx context.Context, req *RetryRunRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryRun not implemented")
 }
+func (*UnimplementedRunServiceServer) BatchArchiveRuns(ctx context.Context, req *BatchArchiveRunsRequest) (*BatchRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchArchiveRuns not implemented")
+}
+func (*UnimplementedRunServiceServer) BatchDeleteRuns(ctx context.Context, req *BatchDeleteRunsRequest) (*BatchRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteRuns not implemented")
+}
+func (*UnimplementedRunServiceServer) BatchTerminateRuns(ctx context.Context, req *BatchTerminateRunsRequest) (*BatchRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTerminateRuns not implemented")
+}
 
 func RegisterRunServiceServer(s *grpc.Server, srv RunServiceServer) {
 	s.RegisterService(&_RunService_serviceDesc, srv)
@@ -1629,6 +2408,24 @@ func _RunService_ReadArtifact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_ListRunArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).ListRunArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/ListRunArtifacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).ListRunArtifacts(ctx, req.(*ListRunArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RunService_TerminateRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateRunRequest)
 	if err := dec(in); err != nil {
@@ -1665,6 +2462,60 @@ func _RunService_RetryRun_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RunService_BatchArchiveRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchArchiveRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BatchArchiveRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/BatchArchiveRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BatchArchiveRuns(ctx, req.(*BatchArchiveRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BatchDeleteRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BatchDeleteRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/BatchDeleteRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BatchDeleteRuns(ctx, req.(*BatchDeleteRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RunService_BatchTerminateRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTerminateRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunServiceServer).BatchTerminateRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RunService/BatchTerminateRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunServiceServer).BatchTerminateRuns(ctx, req.(*BatchTerminateRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RunService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.RunService",
 	HandlerType: (*RunServiceServer)(nil),
@@ -1701,6 +2552,10 @@ var _RunService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadArtifact",
 			Handler:    _RunService_ReadArtifact_Handler,
 		},
+		{
+			MethodName: "ListRunArtifacts",
+			Handler:    _RunService_ListRunArtifacts_Handler,
+		},
 		{
 			MethodName: "TerminateRun",
 			Handler:    _RunService_TerminateRun_Handler,
@@ -1709,6 +2564,18 @@ var _RunService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RetryRun",
 			Handler:    _RunService_RetryRun_Handler,
 		},
+		{
+			MethodName: "BatchArchiveRuns",
+			Handler:    _RunService_BatchArchiveRuns_Handler,
+		},
+		{
+			MethodName: "BatchDeleteRuns",
+			Handler:    _RunService_BatchDeleteRuns_Handler,
+		},
+		{
+			MethodName: "BatchTerminateRuns",
+			Handler:    _RunService_BatchTerminateRuns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend/api/run.proto",
@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: backend/api/view.proto
+
+package go_client
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ListView specifies how much of each resource a list call returns.
+type ListView int32
+
+const (
+	// Same as FULL.
+	ListView_LIST_VIEW_UNSPECIFIED ListView = 0
+	// Leaves out the large fields of the resources: the pipeline and workflow
+	// manifests of runs and jobs, and the parameters of pipeline versions.
+	ListView_BASIC ListView = 1
+	// Returns the resources in full.
+	ListView_FULL ListView = 2
+)
+
+var ListView_name = map[int32]string{
+	0: "LIST_VIEW_UNSPECIFIED",
+	1: "BASIC",
+	2: "FULL",
+}
+
+var ListView_value = map[string]int32{
+	"LIST_VIEW_UNSPECIFIED": 0,
+	"BASIC":                 1,
+	"FULL":                  2,
+}
+
+func (x ListView) String() string {
+	return proto.EnumName(ListView_name, int32(x))
+}
+
+func (ListView) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c8f7b91a7d7bc084, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("api.ListView", ListView_name, ListView_value)
+}
+
+func init() { proto.RegisterFile("backend/api/view.proto", fileDescriptor_c8f7b91a7d7bc084) }
+
+var fileDescriptor_c8f7b91a7d7bc084 = []byte{
+	// 160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x4a, 0x4c, 0xce,
+	0x4e, 0xcd, 0x4b, 0xd1, 0x4f, 0x2c, 0xc8, 0xd4, 0x2f, 0xcb, 0x4c, 0x2d, 0xd7, 0x2b, 0x28, 0xca,
+	0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0xd4, 0xb2, 0xe2, 0xe2, 0xf0, 0xc9, 0x2c, 0x2e, 0x09,
+	0xcb, 0x4c, 0x2d, 0x17, 0x92, 0xe4, 0x12, 0xf5, 0xf1, 0x0c, 0x0e, 0x89, 0x0f, 0xf3, 0x74, 0x0d,
+	0x8f, 0x0f, 0xf5, 0x0b, 0x0e, 0x70, 0x75, 0xf6, 0x74, 0xf3, 0x74, 0x75, 0x11, 0x60, 0x10, 0xe2,
+	0xe4, 0x62, 0x75, 0x72, 0x0c, 0xf6, 0x74, 0x16, 0x60, 0x14, 0xe2, 0xe0, 0x62, 0x71, 0x0b, 0xf5,
+	0xf1, 0x11, 0x60, 0x72, 0x32, 0x8d, 0x32, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
+	0xcf, 0xd5, 0xcf, 0x2e, 0x4d, 0x4a, 0x4d, 0xcb, 0xc9, 0x2f, 0xd7, 0x2f, 0xc8, 0x2c, 0x48, 0xcd,
+	0xc9, 0xcc, 0x4b, 0x2d, 0xd6, 0x47, 0xb6, 0x38, 0x3d, 0x3f, 0x3e, 0x39, 0x27, 0x33, 0x35, 0xaf,
+	0x24, 0x89, 0x0d, 0x6c, 0xbd, 0x31, 0x60, 0x00, 0x4c, 0x54, 0x3a, 0xdc, 0x98, 0x00, 0x00, 0x00,
+}
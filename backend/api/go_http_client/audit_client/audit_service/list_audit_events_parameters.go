@@ -0,0 +1,327 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListAuditEventsParams creates a new ListAuditEventsParams object
+// with the default values initialized.
+func NewListAuditEventsParams() *ListAuditEventsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &ListAuditEventsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAuditEventsParamsWithTimeout creates a new ListAuditEventsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAuditEventsParamsWithTimeout(timeout time.Duration) *ListAuditEventsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &ListAuditEventsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewListAuditEventsParamsWithContext creates a new ListAuditEventsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAuditEventsParamsWithContext(ctx context.Context) *ListAuditEventsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &ListAuditEventsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewListAuditEventsParamsWithHTTPClient creates a new ListAuditEventsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAuditEventsParamsWithHTTPClient(client *http.Client) *ListAuditEventsParams {
+	var (
+		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+	)
+	return &ListAuditEventsParams{
+		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		HTTPClient:               client,
+	}
+}
+
+/*ListAuditEventsParams contains all the parameters to send to the API endpoint
+for the list audit events operation typically these are written to a http.Request
+*/
+type ListAuditEventsParams struct {
+
+	/*Filter
+	  A url-encoded, JSON-serialized Filter protocol buffer (see
+	[filter.proto](https://github.com/kubeflow/pipelines/
+	blob/master/backend/api/filter.proto)).
+
+	*/
+	Filter *string
+	/*PageSize
+	  The number of audit events to be listed per page. If there are more
+	audit events than this number, the response message will contain a
+	nextPageToken field you can use to fetch the next page.
+
+	*/
+	PageSize *int32
+	/*PageToken
+	  A page token to request the next page of results. The token is acquried
+	from the nextPageToken field of the response from the previous
+	ListAuditEvents call or can be omitted when fetching the first page.
+
+	*/
+	PageToken *string
+	/*ResourceReferenceKeyID
+	  The ID of the resource that referred to.
+
+	*/
+	ResourceReferenceKeyID *string
+	/*ResourceReferenceKeyType
+	  The type of the resource that referred to.
+
+	*/
+	ResourceReferenceKeyType *string
+	/*SortBy
+	  Can be format of "field_name", "field_name asc" or "field_name desc"
+	Ascending by default.
+
+	*/
+	SortBy *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list audit events params
+func (o *ListAuditEventsParams) WithTimeout(timeout time.Duration) *ListAuditEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list audit events params
+func (o *ListAuditEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list audit events params
+func (o *ListAuditEventsParams) WithContext(ctx context.Context) *ListAuditEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list audit events params
+func (o *ListAuditEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list audit events params
+func (o *ListAuditEventsParams) WithHTTPClient(client *http.Client) *ListAuditEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list audit events params
+func (o *ListAuditEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFilter adds the filter to the list audit events params
+func (o *ListAuditEventsParams) WithFilter(filter *string) *ListAuditEventsParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the list audit events params
+func (o *ListAuditEventsParams) SetFilter(filter *string) {
+	o.Filter = filter
+}
+
+// WithPageSize adds the pageSize to the list audit events params
+func (o *ListAuditEventsParams) WithPageSize(pageSize *int32) *ListAuditEventsParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list audit events params
+func (o *ListAuditEventsParams) SetPageSize(pageSize *int32) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list audit events params
+func (o *ListAuditEventsParams) WithPageToken(pageToken *string) *ListAuditEventsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list audit events params
+func (o *ListAuditEventsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
+// WithResourceReferenceKeyID adds the resourceReferenceKeyID to the list audit events params
+func (o *ListAuditEventsParams) WithResourceReferenceKeyID(resourceReferenceKeyID *string) *ListAuditEventsParams {
+	o.SetResourceReferenceKeyID(resourceReferenceKeyID)
+	return o
+}
+
+// SetResourceReferenceKeyID adds the resourceReferenceKeyId to the list audit events params
+func (o *ListAuditEventsParams) SetResourceReferenceKeyID(resourceReferenceKeyID *string) {
+	o.ResourceReferenceKeyID = resourceReferenceKeyID
+}
+
+// WithResourceReferenceKeyType adds the resourceReferenceKeyType to the list audit events params
+func (o *ListAuditEventsParams) WithResourceReferenceKeyType(resourceReferenceKeyType *string) *ListAuditEventsParams {
+	o.SetResourceReferenceKeyType(resourceReferenceKeyType)
+	return o
+}
+
+// SetResourceReferenceKeyType adds the resourceReferenceKeyType to the list audit events params
+func (o *ListAuditEventsParams) SetResourceReferenceKeyType(resourceReferenceKeyType *string) {
+	o.ResourceReferenceKeyType = resourceReferenceKeyType
+}
+
+// WithSortBy adds the sortBy to the list audit events params
+func (o *ListAuditEventsParams) WithSortBy(sortBy *string) *ListAuditEventsParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the list audit events params
+func (o *ListAuditEventsParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAuditEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Filter != nil {
+
+		// query param filter
+		var qrFilter string
+		if o.Filter != nil {
+			qrFilter = *o.Filter
+		}
+		qFilter := qrFilter
+		if qFilter != "" {
+			if err := r.SetQueryParam("filter", qFilter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int32
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt32(qrPageSize)
+		if qPageSize != "" {
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ResourceReferenceKeyID != nil {
+
+		// query param resource_reference_key.id
+		var qrResourceReferenceKeyID string
+		if o.ResourceReferenceKeyID != nil {
+			qrResourceReferenceKeyID = *o.ResourceReferenceKeyID
+		}
+		qResourceReferenceKeyID := qrResourceReferenceKeyID
+		if qResourceReferenceKeyID != "" {
+			if err := r.SetQueryParam("resource_reference_key.id", qResourceReferenceKeyID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ResourceReferenceKeyType != nil {
+
+		// query param resource_reference_key.type
+		var qrResourceReferenceKeyType string
+		if o.ResourceReferenceKeyType != nil {
+			qrResourceReferenceKeyType = *o.ResourceReferenceKeyType
+		}
+		qResourceReferenceKeyType := qrResourceReferenceKeyType
+		if qResourceReferenceKeyType != "" {
+			if err := r.SetQueryParam("resource_reference_key.type", qResourceReferenceKeyType); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIListAuditEventsResponse api list audit events response
+// swagger:model apiListAuditEventsResponse
+type APIListAuditEventsResponse struct {
+
+	// A list of audit events returned.
+	AuditEvents []*APIAuditEvent `json:"audit_events"`
+
+	// The token to list the next page of audit events.
+	NextPageToken string `json:"next_page_token,omitempty"`
+
+	// The total number of audit events for the given query.
+	TotalSize int32 `json:"total_size,omitempty"`
+}
+
+// Validate validates this api list audit events response
+func (m *APIListAuditEventsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuditEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIListAuditEventsResponse) validateAuditEvents(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AuditEvents) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AuditEvents); i++ {
+		if swag.IsZero(m.AuditEvents[i]) { // not required
+			continue
+		}
+
+		if m.AuditEvents[i] != nil {
+			if err := m.AuditEvents[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("audit_events" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIListAuditEventsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIListAuditEventsResponse) UnmarshalBinary(b []byte) error {
+	var res APIListAuditEventsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEventOutcome  - UNSPECIFIED: Default value if not present.
+//  - SUCCEEDED: Indicates that the operation succeeded.
+//  - FAILED: Indicates that the operation failed.
+//  - DENIED: Indicates that the user was not authenticated or not allowed to do the
+// operation.
+// swagger:model AuditEventOutcome
+type AuditEventOutcome string
+
+const (
+
+	// AuditEventOutcomeUNSPECIFIED captures enum value "UNSPECIFIED"
+	AuditEventOutcomeUNSPECIFIED AuditEventOutcome = "UNSPECIFIED"
+
+	// AuditEventOutcomeSUCCEEDED captures enum value "SUCCEEDED"
+	AuditEventOutcomeSUCCEEDED AuditEventOutcome = "SUCCEEDED"
+
+	// AuditEventOutcomeFAILED captures enum value "FAILED"
+	AuditEventOutcomeFAILED AuditEventOutcome = "FAILED"
+
+	// AuditEventOutcomeDENIED captures enum value "DENIED"
+	AuditEventOutcomeDENIED AuditEventOutcome = "DENIED"
+)
+
+// for schema
+var auditEventOutcomeEnum []interface{}
+
+func init() {
+	var res []AuditEventOutcome
+	if err := json.Unmarshal([]byte(`["UNSPECIFIED","SUCCEEDED","FAILED","DENIED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		auditEventOutcomeEnum = append(auditEventOutcomeEnum, v)
+	}
+}
+
+func (m AuditEventOutcome) validateAuditEventOutcomeEnum(path, location string, value AuditEventOutcome) error {
+	if err := validate.Enum(path, location, value, auditEventOutcomeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this audit event outcome
+func (m AuditEventOutcome) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAuditEventOutcomeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -29,6 +29,10 @@ type APIExperiment struct {
 	// Output. Unique experiment ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. User-defined labels of the experiment. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required input field. Unique experiment name provided by user.
 	Name string `json:"name,omitempty"`
 
@@ -37,6 +37,9 @@ const (
 
 	// APIResourceTypeNAMESPACE captures enum value "NAMESPACE"
 	APIResourceTypeNAMESPACE APIResourceType = "NAMESPACE"
+
+	// APIResourceTypeRUN captures enum value "RUN"
+	APIResourceTypeRUN APIResourceType = "RUN"
 )
 
 // for schema
@@ -44,7 +47,7 @@ var apiResourceTypeEnum []interface{}
 
 func init() {
 	var res []APIResourceType
-	if err := json.Unmarshal([]byte(`["UNKNOWN_RESOURCE_TYPE","EXPERIMENT","JOB","PIPELINE","PIPELINE_VERSION","NAMESPACE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["UNKNOWN_RESOURCE_TYPE","EXPERIMENT","JOB","PIPELINE","PIPELINE_VERSION","NAMESPACE","RUN"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
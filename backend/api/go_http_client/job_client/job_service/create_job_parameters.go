@@ -68,6 +68,11 @@ type CreateJobParams struct {
 
 	*/
 	Body *job_model.APIJob
+	/*IdempotencyKey
+	  Optional. A client-supplied key of at most 128 characters identifying the request. A retried request with the same key and job returns the job created by the original request instead of creating another one, and a request with the same key but a different job is rejected.
+
+	*/
+	IdempotencyKey *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -118,6 +123,17 @@ func (o *CreateJobParams) SetBody(body *job_model.APIJob) {
 	o.Body = body
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the create job params
+func (o *CreateJobParams) WithIdempotencyKey(idempotencyKey *string) *CreateJobParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the create job params
+func (o *CreateJobParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -132,6 +148,22 @@ func (o *CreateJobParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.IdempotencyKey != nil {
+
+		// query param idempotency_key
+		var qrIdempotencyKey string
+		if o.IdempotencyKey != nil {
+			qrIdempotencyKey = *o.IdempotencyKey
+		}
+		qIdempotencyKey := qrIdempotencyKey
+		if qIdempotencyKey != "" {
+			if err := r.SetQueryParam("idempotency_key", qIdempotencyKey); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -23,9 +23,11 @@ import (
 func NewListJobsParams() *ListJobsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListJobsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -36,9 +38,11 @@ func NewListJobsParams() *ListJobsParams {
 func NewListJobsParamsWithTimeout(timeout time.Duration) *ListJobsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListJobsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		timeout: timeout,
 	}
@@ -49,9 +53,11 @@ func NewListJobsParamsWithTimeout(timeout time.Duration) *ListJobsParams {
 func NewListJobsParamsWithContext(ctx context.Context) *ListJobsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListJobsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		Context: ctx,
 	}
@@ -62,9 +68,11 @@ func NewListJobsParamsWithContext(ctx context.Context) *ListJobsParams {
 func NewListJobsParamsWithHTTPClient(client *http.Client) *ListJobsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListJobsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 		HTTPClient:               client,
 	}
 }
@@ -111,6 +119,17 @@ type ListJobsParams struct {
 
 	*/
 	SortBy *string
+	/*View
+	  BASIC leaves out the pipeline and workflow manifests of the jobs. FULL by
+	default.
+
+	 - LIST_VIEW_UNSPECIFIED: Same as FULL.
+	 - BASIC: Leaves out the large fields of the resources: the pipeline and workflow
+	manifests of runs and jobs, and the parameters of pipeline versions.
+	 - FULL: Returns the resources in full.
+
+	*/
+	View *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -216,6 +235,17 @@ func (o *ListJobsParams) SetSortBy(sortBy *string) {
 	o.SortBy = sortBy
 }
 
+// WithView adds the view to the list jobs params
+func (o *ListJobsParams) WithView(view *string) *ListJobsParams {
+	o.SetView(view)
+	return o
+}
+
+// SetView adds the view to the list jobs params
+func (o *ListJobsParams) SetView(view *string) {
+	o.View = view
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListJobsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -320,6 +350,22 @@ func (o *ListJobsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 
 	}
 
+	if o.View != nil {
+
+		// query param view
+		var qrView string
+		if o.View != nil {
+			qrView = *o.View
+		}
+		qView := qrView
+		if qView != "" {
+			if err := r.SetQueryParam("view", qView); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
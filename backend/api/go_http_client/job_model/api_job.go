@@ -37,6 +37,10 @@ type APIJob struct {
 	// Output. Unique run ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. User-defined labels of the job. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Required input field.
 	// Specify how many runs can be executed concurrently. Rage [1-10]
 	MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`
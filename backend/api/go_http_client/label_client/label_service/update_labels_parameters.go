@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package label_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	label_model "github.com/kubeflow/pipelines/backend/api/go_http_client/label_model"
+)
+
+// NewUpdateLabelsParams creates a new UpdateLabelsParams object
+// with the default values initialized.
+func NewUpdateLabelsParams() *UpdateLabelsParams {
+	var ()
+	return &UpdateLabelsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateLabelsParamsWithTimeout creates a new UpdateLabelsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateLabelsParamsWithTimeout(timeout time.Duration) *UpdateLabelsParams {
+	var ()
+	return &UpdateLabelsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateLabelsParamsWithContext creates a new UpdateLabelsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateLabelsParamsWithContext(ctx context.Context) *UpdateLabelsParams {
+	var ()
+	return &UpdateLabelsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateLabelsParamsWithHTTPClient creates a new UpdateLabelsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateLabelsParamsWithHTTPClient(client *http.Client) *UpdateLabelsParams {
+	var ()
+	return &UpdateLabelsParams{
+		HTTPClient: client,
+	}
+}
+
+/*UpdateLabelsParams contains all the parameters to send to the API endpoint
+for the update labels operation typically these are written to a http.Request
+*/
+type UpdateLabelsParams struct {
+
+	/*Body*/
+	Body *label_model.APIUpdateLabelsRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update labels params
+func (o *UpdateLabelsParams) WithTimeout(timeout time.Duration) *UpdateLabelsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update labels params
+func (o *UpdateLabelsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update labels params
+func (o *UpdateLabelsParams) WithContext(ctx context.Context) *UpdateLabelsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update labels params
+func (o *UpdateLabelsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update labels params
+func (o *UpdateLabelsParams) WithHTTPClient(client *http.Client) *UpdateLabelsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update labels params
+func (o *UpdateLabelsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update labels params
+func (o *UpdateLabelsParams) WithBody(body *label_model.APIUpdateLabelsRequest) *UpdateLabelsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update labels params
+func (o *UpdateLabelsParams) SetBody(body *label_model.APIUpdateLabelsRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateLabelsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
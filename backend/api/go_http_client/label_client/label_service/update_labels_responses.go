@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package label_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	label_model "github.com/kubeflow/pipelines/backend/api/go_http_client/label_model"
+)
+
+// UpdateLabelsReader is a Reader for the UpdateLabels structure.
+type UpdateLabelsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateLabelsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdateLabelsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewUpdateLabelsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateLabelsOK creates a UpdateLabelsOK with default headers values
+func NewUpdateLabelsOK() *UpdateLabelsOK {
+	return &UpdateLabelsOK{}
+}
+
+/*UpdateLabelsOK handles this case with default header values.
+
+A successful response.
+*/
+type UpdateLabelsOK struct {
+	Payload *label_model.APIUpdateLabelsResponse
+}
+
+func (o *UpdateLabelsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/labels:update][%d] updateLabelsOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateLabelsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(label_model.APIUpdateLabelsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateLabelsDefault creates a UpdateLabelsDefault with default headers values
+func NewUpdateLabelsDefault(code int) *UpdateLabelsDefault {
+	return &UpdateLabelsDefault{
+		_statusCode: code,
+	}
+}
+
+/*UpdateLabelsDefault handles this case with default header values.
+
+UpdateLabelsDefault update labels default
+*/
+type UpdateLabelsDefault struct {
+	_statusCode int
+
+	Payload *label_model.APIStatus
+}
+
+// Code gets the status code for the update labels default response
+func (o *UpdateLabelsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateLabelsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/labels:update][%d] UpdateLabels default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateLabelsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(label_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
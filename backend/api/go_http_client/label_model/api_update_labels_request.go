@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package label_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIUpdateLabelsRequest api update labels request
+// swagger:model apiUpdateLabelsRequest
+type APIUpdateLabelsRequest struct {
+
+	// Labels to add to the resource. Existing labels with the same keys are
+	// overwritten.
+	AddLabels map[string]string `json:"add_labels,omitempty"`
+
+	// Keys of the labels to remove from the resource. Keys that are not set on
+	// the resource are ignored. A key can not be both added and removed.
+	RemoveLabelKeys []string `json:"remove_label_keys"`
+
+	// The resource whose labels are updated. The type must be one of RUN, JOB,
+	// EXPERIMENT or PIPELINE_VERSION.
+	ResourceKey *APIResourceKey `json:"resource_key,omitempty"`
+}
+
+// Validate validates this api update labels request
+func (m *APIUpdateLabelsRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateResourceKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIUpdateLabelsRequest) validateResourceKey(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ResourceKey) { // not required
+		return nil
+	}
+
+	if m.ResourceKey != nil {
+		if err := m.ResourceKey.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("resource_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIUpdateLabelsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIUpdateLabelsRequest) UnmarshalBinary(b []byte) error {
+	var res APIUpdateLabelsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
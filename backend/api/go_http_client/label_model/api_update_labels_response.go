@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package label_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIUpdateLabelsResponse api update labels response
+// swagger:model apiUpdateLabelsResponse
+type APIUpdateLabelsResponse struct {
+
+	// The labels of the resource after the update.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Validate validates this api update labels response
+func (m *APIUpdateLabelsResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIUpdateLabelsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIUpdateLabelsResponse) UnmarshalBinary(b []byte) error {
+	var res APIUpdateLabelsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetArtifactLineageParams creates a new GetArtifactLineageParams object
+// with the default values initialized.
+func NewGetArtifactLineageParams() *GetArtifactLineageParams {
+	var (
+		directionDefault = string("UPSTREAM")
+	)
+	return &GetArtifactLineageParams{
+		Direction: &directionDefault,
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetArtifactLineageParamsWithTimeout creates a new GetArtifactLineageParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetArtifactLineageParamsWithTimeout(timeout time.Duration) *GetArtifactLineageParams {
+	var (
+		directionDefault = string("UPSTREAM")
+	)
+	return &GetArtifactLineageParams{
+		Direction: &directionDefault,
+
+		timeout: timeout,
+	}
+}
+
+// NewGetArtifactLineageParamsWithContext creates a new GetArtifactLineageParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetArtifactLineageParamsWithContext(ctx context.Context) *GetArtifactLineageParams {
+	var (
+		directionDefault = string("UPSTREAM")
+	)
+	return &GetArtifactLineageParams{
+		Direction: &directionDefault,
+
+		Context: ctx,
+	}
+}
+
+// NewGetArtifactLineageParamsWithHTTPClient creates a new GetArtifactLineageParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetArtifactLineageParamsWithHTTPClient(client *http.Client) *GetArtifactLineageParams {
+	var (
+		directionDefault = string("UPSTREAM")
+	)
+	return &GetArtifactLineageParams{
+		Direction:  &directionDefault,
+		HTTPClient: client,
+	}
+}
+
+/*GetArtifactLineageParams contains all the parameters to send to the API endpoint
+for the get artifact lineage operation typically these are written to a http.Request
+*/
+type GetArtifactLineageParams struct {
+
+	/*Direction
+	  - UPSTREAM: The executions which produced the artifacts, their inputs, and so on.
+	- DOWNSTREAM: The executions which consumed the artifacts, their outputs, and so on.
+	- BOTH: Both upstream and downstream.
+
+	*/
+	Direction *string
+	/*MaxDepth
+	  The maximum number of executions walked through from the artifacts in
+	each direction. Defaults to 3, and cannot be more than 10.
+
+	*/
+	MaxDepth *int32
+	/*URI
+	  The URI of the artifacts whose lineage is walked.
+
+	*/
+	URI *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithTimeout(timeout time.Duration) *GetArtifactLineageParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithContext(ctx context.Context) *GetArtifactLineageParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithHTTPClient(client *http.Client) *GetArtifactLineageParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDirection adds the direction to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithDirection(direction *string) *GetArtifactLineageParams {
+	o.SetDirection(direction)
+	return o
+}
+
+// SetDirection adds the direction to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetDirection(direction *string) {
+	o.Direction = direction
+}
+
+// WithMaxDepth adds the maxDepth to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithMaxDepth(maxDepth *int32) *GetArtifactLineageParams {
+	o.SetMaxDepth(maxDepth)
+	return o
+}
+
+// SetMaxDepth adds the maxDepth to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetMaxDepth(maxDepth *int32) {
+	o.MaxDepth = maxDepth
+}
+
+// WithURI adds the uri to the get artifact lineage params
+func (o *GetArtifactLineageParams) WithURI(uri *string) *GetArtifactLineageParams {
+	o.SetURI(uri)
+	return o
+}
+
+// SetURI adds the uri to the get artifact lineage params
+func (o *GetArtifactLineageParams) SetURI(uri *string) {
+	o.URI = uri
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetArtifactLineageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Direction != nil {
+
+		// query param direction
+		var qrDirection string
+		if o.Direction != nil {
+			qrDirection = *o.Direction
+		}
+		qDirection := qrDirection
+		if qDirection != "" {
+			if err := r.SetQueryParam("direction", qDirection); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxDepth != nil {
+
+		// query param max_depth
+		var qrMaxDepth int32
+		if o.MaxDepth != nil {
+			qrMaxDepth = *o.MaxDepth
+		}
+		qMaxDepth := swag.FormatInt32(qrMaxDepth)
+		if qMaxDepth != "" {
+			if err := r.SetQueryParam("max_depth", qMaxDepth); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.URI != nil {
+
+		// query param uri
+		var qrURI string
+		if o.URI != nil {
+			qrURI = *o.URI
+		}
+		qURI := qrURI
+		if qURI != "" {
+			if err := r.SetQueryParam("uri", qURI); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
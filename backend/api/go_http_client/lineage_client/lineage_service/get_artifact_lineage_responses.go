@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	lineage_model "github.com/kubeflow/pipelines/backend/api/go_http_client/lineage_model"
+)
+
+// GetArtifactLineageReader is a Reader for the GetArtifactLineage structure.
+type GetArtifactLineageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetArtifactLineageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetArtifactLineageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewGetArtifactLineageDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetArtifactLineageOK creates a GetArtifactLineageOK with default headers values
+func NewGetArtifactLineageOK() *GetArtifactLineageOK {
+	return &GetArtifactLineageOK{}
+}
+
+/*GetArtifactLineageOK handles this case with default header values.
+
+A successful response.
+*/
+type GetArtifactLineageOK struct {
+	Payload *lineage_model.APILineageGraph
+}
+
+func (o *GetArtifactLineageOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/artifacts/lineage][%d] getArtifactLineageOK  %+v", 200, o.Payload)
+}
+
+func (o *GetArtifactLineageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lineage_model.APILineageGraph)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetArtifactLineageDefault creates a GetArtifactLineageDefault with default headers values
+func NewGetArtifactLineageDefault(code int) *GetArtifactLineageDefault {
+	return &GetArtifactLineageDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetArtifactLineageDefault handles this case with default header values.
+
+GetArtifactLineageDefault get artifact lineage default
+*/
+type GetArtifactLineageDefault struct {
+	_statusCode int
+
+	Payload *lineage_model.APIStatus
+}
+
+// Code gets the status code for the get artifact lineage default response
+func (o *GetArtifactLineageDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetArtifactLineageDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/artifacts/lineage][%d] GetArtifactLineage default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetArtifactLineageDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lineage_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
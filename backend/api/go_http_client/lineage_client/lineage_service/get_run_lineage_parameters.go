@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetRunLineageParams creates a new GetRunLineageParams object
+// with the default values initialized.
+func NewGetRunLineageParams() *GetRunLineageParams {
+	var ()
+	return &GetRunLineageParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRunLineageParamsWithTimeout creates a new GetRunLineageParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRunLineageParamsWithTimeout(timeout time.Duration) *GetRunLineageParams {
+	var ()
+	return &GetRunLineageParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRunLineageParamsWithContext creates a new GetRunLineageParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRunLineageParamsWithContext(ctx context.Context) *GetRunLineageParams {
+	var ()
+	return &GetRunLineageParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRunLineageParamsWithHTTPClient creates a new GetRunLineageParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRunLineageParamsWithHTTPClient(client *http.Client) *GetRunLineageParams {
+	var ()
+	return &GetRunLineageParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetRunLineageParams contains all the parameters to send to the API endpoint
+for the get run lineage operation typically these are written to a http.Request
+*/
+type GetRunLineageParams struct {
+
+	/*RunID
+	  The ID of the run.
+
+	*/
+	RunID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get run lineage params
+func (o *GetRunLineageParams) WithTimeout(timeout time.Duration) *GetRunLineageParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get run lineage params
+func (o *GetRunLineageParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get run lineage params
+func (o *GetRunLineageParams) WithContext(ctx context.Context) *GetRunLineageParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get run lineage params
+func (o *GetRunLineageParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get run lineage params
+func (o *GetRunLineageParams) WithHTTPClient(client *http.Client) *GetRunLineageParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get run lineage params
+func (o *GetRunLineageParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRunID adds the runID to the get run lineage params
+func (o *GetRunLineageParams) WithRunID(runID string) *GetRunLineageParams {
+	o.SetRunID(runID)
+	return o
+}
+
+// SetRunID adds the runId to the get run lineage params
+func (o *GetRunLineageParams) SetRunID(runID string) {
+	o.RunID = runID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRunLineageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param run_id
+	if err := r.SetPathParam("run_id", o.RunID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
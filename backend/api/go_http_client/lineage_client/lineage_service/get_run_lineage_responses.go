@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	lineage_model "github.com/kubeflow/pipelines/backend/api/go_http_client/lineage_model"
+)
+
+// GetRunLineageReader is a Reader for the GetRunLineage structure.
+type GetRunLineageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRunLineageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRunLineageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewGetRunLineageDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetRunLineageOK creates a GetRunLineageOK with default headers values
+func NewGetRunLineageOK() *GetRunLineageOK {
+	return &GetRunLineageOK{}
+}
+
+/*GetRunLineageOK handles this case with default header values.
+
+A successful response.
+*/
+type GetRunLineageOK struct {
+	Payload *lineage_model.APILineageGraph
+}
+
+func (o *GetRunLineageOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/lineage][%d] getRunLineageOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRunLineageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lineage_model.APILineageGraph)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRunLineageDefault creates a GetRunLineageDefault with default headers values
+func NewGetRunLineageDefault(code int) *GetRunLineageDefault {
+	return &GetRunLineageDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetRunLineageDefault handles this case with default header values.
+
+GetRunLineageDefault get run lineage default
+*/
+type GetRunLineageDefault struct {
+	_statusCode int
+
+	Payload *lineage_model.APIStatus
+}
+
+// Code gets the status code for the get run lineage default response
+func (o *GetRunLineageDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetRunLineageDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/lineage][%d] GetRunLineage default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetRunLineageDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lineage_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
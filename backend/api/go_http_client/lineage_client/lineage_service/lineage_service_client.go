@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new lineage service API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for lineage service API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+GetArtifactLineage walks the lineage of the artifacts with a URI in m l metadata upstream to the executions and artifacts they were produced from downstream to the ones which consumed them or both
+*/
+func (a *Client) GetArtifactLineage(params *GetArtifactLineageParams, authInfo runtime.ClientAuthInfoWriter) (*GetArtifactLineageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetArtifactLineageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetArtifactLineage",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/artifacts/lineage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetArtifactLineageReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetArtifactLineageOK), nil
+
+}
+
+/*
+GetRunLineage finds the executions of a run recorded in m l metadata the artifacts they consumed and produced and the events linking them
+*/
+func (a *Client) GetRunLineage(params *GetRunLineageParams, authInfo runtime.ClientAuthInfoWriter) (*GetRunLineageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRunLineageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetRunLineage",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/lineage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetRunLineageReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetRunLineageOK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
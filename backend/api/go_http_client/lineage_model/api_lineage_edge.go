@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APILineageEdge api lineage edge
+// swagger:model apiLineageEdge
+type APILineageEdge struct {
+
+	// edge type
+	EdgeType LineageEdgeEdgeType `json:"edge_type,omitempty"`
+
+	// The name of the input or output of the execution, if any.
+	Name string `json:"name,omitempty"`
+
+	// The ID of the source node.
+	Source string `json:"source,omitempty"`
+
+	// The ID of the target node.
+	Target string `json:"target,omitempty"`
+}
+
+// Validate validates this api lineage edge
+func (m *APILineageEdge) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEdgeType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APILineageEdge) validateEdgeType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EdgeType) { // not required
+		return nil
+	}
+
+	if err := m.EdgeType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("edge_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILineageEdge) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILineageEdge) UnmarshalBinary(b []byte) error {
+	var res APILineageEdge
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
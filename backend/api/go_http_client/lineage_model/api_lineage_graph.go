@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APILineageGraph api lineage graph
+// swagger:model apiLineageGraph
+type APILineageGraph struct {
+
+	// The events linking the executions and the artifacts, in the direction of
+	// the data: from an input artifact to an execution, and from an execution
+	// to an output artifact.
+	Edges []*APILineageEdge `json:"edges"`
+
+	// The executions and artifacts, sorted by ID.
+	Nodes []*APILineageNode `json:"nodes"`
+}
+
+// Validate validates this api lineage graph
+func (m *APILineageGraph) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEdges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APILineageGraph) validateEdges(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Edges) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Edges); i++ {
+		if swag.IsZero(m.Edges[i]) { // not required
+			continue
+		}
+
+		if m.Edges[i] != nil {
+			if err := m.Edges[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("edges" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APILineageGraph) validateNodes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILineageGraph) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILineageGraph) UnmarshalBinary(b []byte) error {
+	var res APILineageGraph
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
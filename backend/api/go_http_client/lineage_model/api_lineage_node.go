@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APILineageNode api lineage node
+// swagger:model apiLineageNode
+type APILineageNode struct {
+
+	// The number of executions between the node and the artifacts whose lineage
+	// is walked. Always 0 in the lineage of a run.
+	Depth int32 `json:"depth,omitempty"`
+
+	// The ID of the node, which is the ML Metadata ID of the execution or the
+	// artifact prefixed by its node type, e.g. "execution/1" or "artifact/2".
+	ID string `json:"id,omitempty"`
+
+	// The name of the execution or the artifact, if any.
+	Name string `json:"name,omitempty"`
+
+	// node type
+	NodeType LineageNodeNodeType `json:"node_type,omitempty"`
+
+	// The properties and custom properties of the execution or the artifact,
+	// formatted as strings.
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// The last known state of the execution or the state of the artifact, e.g.
+	// "COMPLETE" or "LIVE".
+	State string `json:"state,omitempty"`
+
+	// The name of the ML Metadata type of the execution or the artifact, e.g.
+	// "system.Model".
+	TypeName string `json:"type_name,omitempty"`
+
+	// The URI of the artifact.
+	URI string `json:"uri,omitempty"`
+}
+
+// Validate validates this api lineage node
+func (m *APILineageNode) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNodeType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APILineageNode) validateNodeType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NodeType) { // not required
+		return nil
+	}
+
+	if err := m.NodeType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("node_type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILineageNode) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILineageNode) UnmarshalBinary(b []byte) error {
+	var res APILineageNode
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
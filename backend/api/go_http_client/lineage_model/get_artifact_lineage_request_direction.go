@@ -0,0 +1,68 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// GetArtifactLineageRequestDirection  - UPSTREAM: The executions which produced the artifacts, their inputs, and so on.
+//  - DOWNSTREAM: The executions which consumed the artifacts, their outputs, and so on.
+//  - BOTH: Both upstream and downstream.
+// swagger:model GetArtifactLineageRequestDirection
+type GetArtifactLineageRequestDirection string
+
+const (
+
+	// GetArtifactLineageRequestDirectionUPSTREAM captures enum value "UPSTREAM"
+	GetArtifactLineageRequestDirectionUPSTREAM GetArtifactLineageRequestDirection = "UPSTREAM"
+
+	// GetArtifactLineageRequestDirectionDOWNSTREAM captures enum value "DOWNSTREAM"
+	GetArtifactLineageRequestDirectionDOWNSTREAM GetArtifactLineageRequestDirection = "DOWNSTREAM"
+
+	// GetArtifactLineageRequestDirectionBOTH captures enum value "BOTH"
+	GetArtifactLineageRequestDirectionBOTH GetArtifactLineageRequestDirection = "BOTH"
+)
+
+// for schema
+var getArtifactLineageRequestDirectionEnum []interface{}
+
+func init() {
+	var res []GetArtifactLineageRequestDirection
+	if err := json.Unmarshal([]byte(`["UPSTREAM","DOWNSTREAM","BOTH"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getArtifactLineageRequestDirectionEnum = append(getArtifactLineageRequestDirectionEnum, v)
+	}
+}
+
+func (m GetArtifactLineageRequestDirection) validateGetArtifactLineageRequestDirectionEnum(path, location string, value GetArtifactLineageRequestDirection) error {
+	if err := validate.Enum(path, location, value, getArtifactLineageRequestDirectionEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this get artifact lineage request direction
+func (m GetArtifactLineageRequestDirection) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateGetArtifactLineageRequestDirectionEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
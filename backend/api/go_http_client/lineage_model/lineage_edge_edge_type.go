@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// LineageEdgeEdgeType  - INPUT: The source artifact is an input of the target execution.
+//  - OUTPUT: The target artifact is an output of the source execution.
+// swagger:model LineageEdgeEdgeType
+type LineageEdgeEdgeType string
+
+const (
+
+	// LineageEdgeEdgeTypeUNKNOWNEDGETYPE captures enum value "UNKNOWN_EDGE_TYPE"
+	LineageEdgeEdgeTypeUNKNOWNEDGETYPE LineageEdgeEdgeType = "UNKNOWN_EDGE_TYPE"
+
+	// LineageEdgeEdgeTypeINPUT captures enum value "INPUT"
+	LineageEdgeEdgeTypeINPUT LineageEdgeEdgeType = "INPUT"
+
+	// LineageEdgeEdgeTypeOUTPUT captures enum value "OUTPUT"
+	LineageEdgeEdgeTypeOUTPUT LineageEdgeEdgeType = "OUTPUT"
+)
+
+// for schema
+var lineageEdgeEdgeTypeEnum []interface{}
+
+func init() {
+	var res []LineageEdgeEdgeType
+	if err := json.Unmarshal([]byte(`["UNKNOWN_EDGE_TYPE","INPUT","OUTPUT"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		lineageEdgeEdgeTypeEnum = append(lineageEdgeEdgeTypeEnum, v)
+	}
+}
+
+func (m LineageEdgeEdgeType) validateLineageEdgeEdgeTypeEnum(path, location string, value LineageEdgeEdgeType) error {
+	if err := validate.Enum(path, location, value, lineageEdgeEdgeTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this lineage edge edge type
+func (m LineageEdgeEdgeType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateLineageEdgeEdgeTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
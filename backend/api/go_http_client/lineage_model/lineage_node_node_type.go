@@ -0,0 +1,66 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package lineage_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// LineageNodeNodeType lineage node node type
+// swagger:model LineageNodeNodeType
+type LineageNodeNodeType string
+
+const (
+
+	// LineageNodeNodeTypeUNKNOWNNODETYPE captures enum value "UNKNOWN_NODE_TYPE"
+	LineageNodeNodeTypeUNKNOWNNODETYPE LineageNodeNodeType = "UNKNOWN_NODE_TYPE"
+
+	// LineageNodeNodeTypeEXECUTION captures enum value "EXECUTION"
+	LineageNodeNodeTypeEXECUTION LineageNodeNodeType = "EXECUTION"
+
+	// LineageNodeNodeTypeARTIFACT captures enum value "ARTIFACT"
+	LineageNodeNodeTypeARTIFACT LineageNodeNodeType = "ARTIFACT"
+)
+
+// for schema
+var lineageNodeNodeTypeEnum []interface{}
+
+func init() {
+	var res []LineageNodeNodeType
+	if err := json.Unmarshal([]byte(`["UNKNOWN_NODE_TYPE","EXECUTION","ARTIFACT"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		lineageNodeNodeTypeEnum = append(lineageNodeNodeTypeEnum, v)
+	}
+}
+
+func (m LineageNodeNodeType) validateLineageNodeNodeTypeEnum(path, location string, value LineageNodeNodeType) error {
+	if err := validate.Enum(path, location, value, lineageNodeNodeTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this lineage node node type
+func (m LineageNodeNodeType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateLineageNodeNodeTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
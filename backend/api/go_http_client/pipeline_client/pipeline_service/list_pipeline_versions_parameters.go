@@ -23,9 +23,11 @@ import (
 func NewListPipelineVersionsParams() *ListPipelineVersionsParams {
 	var (
 		resourceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault            = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListPipelineVersionsParams{
 		ResourceKeyType: &resourceKeyTypeDefault,
+		View:            &viewDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -36,9 +38,11 @@ func NewListPipelineVersionsParams() *ListPipelineVersionsParams {
 func NewListPipelineVersionsParamsWithTimeout(timeout time.Duration) *ListPipelineVersionsParams {
 	var (
 		resourceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault            = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListPipelineVersionsParams{
 		ResourceKeyType: &resourceKeyTypeDefault,
+		View:            &viewDefault,
 
 		timeout: timeout,
 	}
@@ -49,9 +53,11 @@ func NewListPipelineVersionsParamsWithTimeout(timeout time.Duration) *ListPipeli
 func NewListPipelineVersionsParamsWithContext(ctx context.Context) *ListPipelineVersionsParams {
 	var (
 		resourceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault            = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListPipelineVersionsParams{
 		ResourceKeyType: &resourceKeyTypeDefault,
+		View:            &viewDefault,
 
 		Context: ctx,
 	}
@@ -62,9 +68,11 @@ func NewListPipelineVersionsParamsWithContext(ctx context.Context) *ListPipeline
 func NewListPipelineVersionsParamsWithHTTPClient(client *http.Client) *ListPipelineVersionsParams {
 	var (
 		resourceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault            = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListPipelineVersionsParams{
 		ResourceKeyType: &resourceKeyTypeDefault,
+		View:            &viewDefault,
 		HTTPClient:      client,
 	}
 }
@@ -110,6 +118,16 @@ type ListPipelineVersionsParams struct {
 
 	*/
 	SortBy *string
+	/*View
+	  BASIC leaves out the parameters of the pipeline versions. FULL by default.
+
+	 - LIST_VIEW_UNSPECIFIED: Same as FULL.
+	 - BASIC: Leaves out the large fields of the resources: the pipeline and workflow
+	manifests of runs and jobs, and the parameters of pipeline versions.
+	 - FULL: Returns the resources in full.
+
+	*/
+	View *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -215,6 +233,17 @@ func (o *ListPipelineVersionsParams) SetSortBy(sortBy *string) {
 	o.SortBy = sortBy
 }
 
+// WithView adds the view to the list pipeline versions params
+func (o *ListPipelineVersionsParams) WithView(view *string) *ListPipelineVersionsParams {
+	o.SetView(view)
+	return o
+}
+
+// SetView adds the view to the list pipeline versions params
+func (o *ListPipelineVersionsParams) SetView(view *string) {
+	o.View = view
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListPipelineVersionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -319,6 +348,22 @@ func (o *ListPipelineVersionsParams) WriteToRequest(r runtime.ClientRequest, reg
 
 	}
 
+	if o.View != nil {
+
+		// query param view
+		var qrView string
+		if o.View != nil {
+			qrView = *o.View
+		}
+		qView := qrView
+		if qView != "" {
+			if err := r.SetQueryParam("view", qView); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pipeline_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIListView ListView specifies how much of each resource a list call returns.
+//
+//  - LIST_VIEW_UNSPECIFIED: Same as FULL.
+//  - BASIC: Leaves out the large fields of the resources: the pipeline and workflow
+// manifests of runs and jobs, and the parameters of pipeline versions.
+//  - FULL: Returns the resources in full.
+// swagger:model apiListView
+type APIListView string
+
+const (
+
+	// APIListViewLISTVIEWUNSPECIFIED captures enum value "LIST_VIEW_UNSPECIFIED"
+	APIListViewLISTVIEWUNSPECIFIED APIListView = "LIST_VIEW_UNSPECIFIED"
+
+	// APIListViewBASIC captures enum value "BASIC"
+	APIListViewBASIC APIListView = "BASIC"
+
+	// APIListViewFULL captures enum value "FULL"
+	APIListViewFULL APIListView = "FULL"
+)
+
+// for schema
+var apiListViewEnum []interface{}
+
+func init() {
+	var res []APIListView
+	if err := json.Unmarshal([]byte(`["LIST_VIEW_UNSPECIFIED","BASIC","FULL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiListViewEnum = append(apiListViewEnum, v)
+	}
+}
+
+func (m APIListView) validateAPIListViewEnum(path, location string, value APIListView) error {
+	if err := validate.Enum(path, location, value, apiListViewEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api list view
+func (m APIListView) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIListViewEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -29,6 +29,10 @@ type APIPipelineVersion struct {
 	// Output. Unique version ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. User-defined labels of the pipeline version. Label keys and values
+	// follow the syntax of Kubernetes labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Optional input field. Version name provided by user.
 	Name string `json:"name,omitempty"`
 
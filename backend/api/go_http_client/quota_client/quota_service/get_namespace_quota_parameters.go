@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetNamespaceQuotaParams creates a new GetNamespaceQuotaParams object
+// with the default values initialized.
+func NewGetNamespaceQuotaParams() *GetNamespaceQuotaParams {
+	var ()
+	return &GetNamespaceQuotaParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetNamespaceQuotaParamsWithTimeout creates a new GetNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetNamespaceQuotaParamsWithTimeout(timeout time.Duration) *GetNamespaceQuotaParams {
+	var ()
+	return &GetNamespaceQuotaParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetNamespaceQuotaParamsWithContext creates a new GetNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetNamespaceQuotaParamsWithContext(ctx context.Context) *GetNamespaceQuotaParams {
+	var ()
+	return &GetNamespaceQuotaParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetNamespaceQuotaParamsWithHTTPClient creates a new GetNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetNamespaceQuotaParamsWithHTTPClient(client *http.Client) *GetNamespaceQuotaParams {
+	var ()
+	return &GetNamespaceQuotaParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetNamespaceQuotaParams contains all the parameters to send to the API endpoint
+for the get namespace quota operation typically these are written to a http.Request
+*/
+type GetNamespaceQuotaParams struct {
+
+	/*Namespace
+	  The namespace whose quota is retrieved.
+
+	*/
+	Namespace string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get namespace quota params
+func (o *GetNamespaceQuotaParams) WithTimeout(timeout time.Duration) *GetNamespaceQuotaParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get namespace quota params
+func (o *GetNamespaceQuotaParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get namespace quota params
+func (o *GetNamespaceQuotaParams) WithContext(ctx context.Context) *GetNamespaceQuotaParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get namespace quota params
+func (o *GetNamespaceQuotaParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get namespace quota params
+func (o *GetNamespaceQuotaParams) WithHTTPClient(client *http.Client) *GetNamespaceQuotaParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get namespace quota params
+func (o *GetNamespaceQuotaParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNamespace adds the namespace to the get namespace quota params
+func (o *GetNamespaceQuotaParams) WithNamespace(namespace string) *GetNamespaceQuotaParams {
+	o.SetNamespace(namespace)
+	return o
+}
+
+// SetNamespace adds the namespace to the get namespace quota params
+func (o *GetNamespaceQuotaParams) SetNamespace(namespace string) {
+	o.Namespace = namespace
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetNamespaceQuotaParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param namespace
+	if err := r.SetPathParam("namespace", o.Namespace); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	quota_model "github.com/kubeflow/pipelines/backend/api/go_http_client/quota_model"
+)
+
+// GetNamespaceQuotaReader is a Reader for the GetNamespaceQuota structure.
+type GetNamespaceQuotaReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetNamespaceQuotaReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetNamespaceQuotaOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewGetNamespaceQuotaDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetNamespaceQuotaOK creates a GetNamespaceQuotaOK with default headers values
+func NewGetNamespaceQuotaOK() *GetNamespaceQuotaOK {
+	return &GetNamespaceQuotaOK{}
+}
+
+/*GetNamespaceQuotaOK handles this case with default header values.
+
+A successful response.
+*/
+type GetNamespaceQuotaOK struct {
+	Payload *quota_model.APINamespaceQuota
+}
+
+func (o *GetNamespaceQuotaOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/namespaces/{namespace}/quota][%d] getNamespaceQuotaOK  %+v", 200, o.Payload)
+}
+
+func (o *GetNamespaceQuotaOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(quota_model.APINamespaceQuota)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNamespaceQuotaDefault creates a GetNamespaceQuotaDefault with default headers values
+func NewGetNamespaceQuotaDefault(code int) *GetNamespaceQuotaDefault {
+	return &GetNamespaceQuotaDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetNamespaceQuotaDefault handles this case with default header values.
+
+GetNamespaceQuotaDefault get namespace quota default
+*/
+type GetNamespaceQuotaDefault struct {
+	_statusCode int
+
+	Payload *quota_model.APIStatus
+}
+
+// Code gets the status code for the get namespace quota default response
+func (o *GetNamespaceQuotaDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetNamespaceQuotaDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/namespaces/{namespace}/quota][%d] GetNamespaceQuota default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetNamespaceQuotaDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(quota_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
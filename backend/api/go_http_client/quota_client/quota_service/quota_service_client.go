@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new quota service API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for quota service API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+GetNamespaceQuota finds the limits on the runs of a namespace and their current usage
+*/
+func (a *Client) GetNamespaceQuota(params *GetNamespaceQuotaParams, authInfo runtime.ClientAuthInfoWriter) (*GetNamespaceQuotaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetNamespaceQuotaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetNamespaceQuota",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/namespaces/{namespace}/quota",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetNamespaceQuotaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetNamespaceQuotaOK), nil
+
+}
+
+/*
+UpdateNamespaceQuota sets the limits on the runs of a namespace runs created over the limits are queued until the namespace has capacity again
+*/
+func (a *Client) UpdateNamespaceQuota(params *UpdateNamespaceQuotaParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateNamespaceQuotaOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateNamespaceQuotaParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "UpdateNamespaceQuota",
+		Method:             "PUT",
+		PathPattern:        "/apis/v1beta1/namespaces/{namespace}/quota",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateNamespaceQuotaReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateNamespaceQuotaOK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	quota_model "github.com/kubeflow/pipelines/backend/api/go_http_client/quota_model"
+)
+
+// NewUpdateNamespaceQuotaParams creates a new UpdateNamespaceQuotaParams object
+// with the default values initialized.
+func NewUpdateNamespaceQuotaParams() *UpdateNamespaceQuotaParams {
+	var ()
+	return &UpdateNamespaceQuotaParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateNamespaceQuotaParamsWithTimeout creates a new UpdateNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateNamespaceQuotaParamsWithTimeout(timeout time.Duration) *UpdateNamespaceQuotaParams {
+	var ()
+	return &UpdateNamespaceQuotaParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateNamespaceQuotaParamsWithContext creates a new UpdateNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateNamespaceQuotaParamsWithContext(ctx context.Context) *UpdateNamespaceQuotaParams {
+	var ()
+	return &UpdateNamespaceQuotaParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateNamespaceQuotaParamsWithHTTPClient creates a new UpdateNamespaceQuotaParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateNamespaceQuotaParamsWithHTTPClient(client *http.Client) *UpdateNamespaceQuotaParams {
+	var ()
+	return &UpdateNamespaceQuotaParams{
+		HTTPClient: client,
+	}
+}
+
+/*UpdateNamespaceQuotaParams contains all the parameters to send to the API endpoint
+for the update namespace quota operation typically these are written to a http.Request
+*/
+type UpdateNamespaceQuotaParams struct {
+
+	/*Body
+	  The new limits of the namespace. The usage is ignored.
+
+	*/
+	Body *quota_model.APINamespaceQuota
+	/*Namespace
+	  The namespace whose quota is updated.
+
+	*/
+	Namespace string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) WithTimeout(timeout time.Duration) *UpdateNamespaceQuotaParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) WithContext(ctx context.Context) *UpdateNamespaceQuotaParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) WithHTTPClient(client *http.Client) *UpdateNamespaceQuotaParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) WithBody(body *quota_model.APINamespaceQuota) *UpdateNamespaceQuotaParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) SetBody(body *quota_model.APINamespaceQuota) {
+	o.Body = body
+}
+
+// WithNamespace adds the namespace to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) WithNamespace(namespace string) *UpdateNamespaceQuotaParams {
+	o.SetNamespace(namespace)
+	return o
+}
+
+// SetNamespace adds the namespace to the update namespace quota params
+func (o *UpdateNamespaceQuotaParams) SetNamespace(namespace string) {
+	o.Namespace = namespace
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateNamespaceQuotaParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param namespace
+	if err := r.SetPathParam("namespace", o.Namespace); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
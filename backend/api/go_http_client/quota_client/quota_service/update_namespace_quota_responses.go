@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	quota_model "github.com/kubeflow/pipelines/backend/api/go_http_client/quota_model"
+)
+
+// UpdateNamespaceQuotaReader is a Reader for the UpdateNamespaceQuota structure.
+type UpdateNamespaceQuotaReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateNamespaceQuotaReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdateNamespaceQuotaOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewUpdateNamespaceQuotaDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateNamespaceQuotaOK creates a UpdateNamespaceQuotaOK with default headers values
+func NewUpdateNamespaceQuotaOK() *UpdateNamespaceQuotaOK {
+	return &UpdateNamespaceQuotaOK{}
+}
+
+/*UpdateNamespaceQuotaOK handles this case with default header values.
+
+A successful response.
+*/
+type UpdateNamespaceQuotaOK struct {
+	Payload *quota_model.APINamespaceQuota
+}
+
+func (o *UpdateNamespaceQuotaOK) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/namespaces/{namespace}/quota][%d] updateNamespaceQuotaOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateNamespaceQuotaOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(quota_model.APINamespaceQuota)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateNamespaceQuotaDefault creates a UpdateNamespaceQuotaDefault with default headers values
+func NewUpdateNamespaceQuotaDefault(code int) *UpdateNamespaceQuotaDefault {
+	return &UpdateNamespaceQuotaDefault{
+		_statusCode: code,
+	}
+}
+
+/*UpdateNamespaceQuotaDefault handles this case with default header values.
+
+UpdateNamespaceQuotaDefault update namespace quota default
+*/
+type UpdateNamespaceQuotaDefault struct {
+	_statusCode int
+
+	Payload *quota_model.APIStatus
+}
+
+// Code gets the status code for the update namespace quota default response
+func (o *UpdateNamespaceQuotaDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateNamespaceQuotaDefault) Error() string {
+	return fmt.Sprintf("[PUT /apis/v1beta1/namespaces/{namespace}/quota][%d] UpdateNamespaceQuota default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateNamespaceQuotaDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(quota_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
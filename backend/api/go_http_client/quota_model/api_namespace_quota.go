@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APINamespaceQuota api namespace quota
+// swagger:model apiNamespaceQuota
+type APINamespaceQuota struct {
+
+	// The maximum number of runs of the namespace that are active at the same
+	// time. Zero means there is no limit.
+	MaxActiveRuns int32 `json:"max_active_runs,omitempty"`
+
+	// The maximum number of runs of the namespace that are started within an
+	// hour. Zero means there is no limit.
+	MaxRunsPerHour int32 `json:"max_runs_per_hour,omitempty"`
+
+	// Output. The namespace the quota applies to.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Output. The current usage of the quota.
+	Usage *APIQuotaUsage `json:"usage,omitempty"`
+}
+
+// Validate validates this api namespace quota
+func (m *APINamespaceQuota) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUsage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APINamespaceQuota) validateUsage(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Usage) { // not required
+		return nil
+	}
+
+	if m.Usage != nil {
+		if err := m.Usage.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("usage")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APINamespaceQuota) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APINamespaceQuota) UnmarshalBinary(b []byte) error {
+	var res APINamespaceQuota
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package quota_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIQuotaUsage api quota usage
+// swagger:model apiQuotaUsage
+type APIQuotaUsage struct {
+
+	// The number of runs of the namespace that are currently active.
+	ActiveRuns int32 `json:"active_runs,omitempty"`
+
+	// The number of runs of the namespace waiting in the queue.
+	QueuedRuns int32 `json:"queued_runs,omitempty"`
+
+	// The number of runs of the namespace started within the last hour.
+	RunsLastHour int32 `json:"runs_last_hour,omitempty"`
+}
+
+// Validate validates this api quota usage
+func (m *APIQuotaUsage) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIQuotaUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIQuotaUsage) UnmarshalBinary(b []byte) error {
+	var res APIQuotaUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
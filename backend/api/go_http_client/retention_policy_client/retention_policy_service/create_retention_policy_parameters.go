@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	retention_policy_model "github.com/kubeflow/pipelines/backend/api/go_http_client/retention_policy_model"
+)
+
+// NewCreateRetentionPolicyParams creates a new CreateRetentionPolicyParams object
+// with the default values initialized.
+func NewCreateRetentionPolicyParams() *CreateRetentionPolicyParams {
+	var ()
+	return &CreateRetentionPolicyParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateRetentionPolicyParamsWithTimeout creates a new CreateRetentionPolicyParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCreateRetentionPolicyParamsWithTimeout(timeout time.Duration) *CreateRetentionPolicyParams {
+	var ()
+	return &CreateRetentionPolicyParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCreateRetentionPolicyParamsWithContext creates a new CreateRetentionPolicyParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCreateRetentionPolicyParamsWithContext(ctx context.Context) *CreateRetentionPolicyParams {
+	var ()
+	return &CreateRetentionPolicyParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCreateRetentionPolicyParamsWithHTTPClient creates a new CreateRetentionPolicyParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCreateRetentionPolicyParamsWithHTTPClient(client *http.Client) *CreateRetentionPolicyParams {
+	var ()
+	return &CreateRetentionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*CreateRetentionPolicyParams contains all the parameters to send to the API endpoint
+for the create retention policy operation typically these are written to a http.Request
+*/
+type CreateRetentionPolicyParams struct {
+
+	/*Body
+	  The retention policy to be created.
+
+	*/
+	Body *retention_policy_model.APIRetentionPolicy
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the create retention policy params
+func (o *CreateRetentionPolicyParams) WithTimeout(timeout time.Duration) *CreateRetentionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create retention policy params
+func (o *CreateRetentionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create retention policy params
+func (o *CreateRetentionPolicyParams) WithContext(ctx context.Context) *CreateRetentionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create retention policy params
+func (o *CreateRetentionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create retention policy params
+func (o *CreateRetentionPolicyParams) WithHTTPClient(client *http.Client) *CreateRetentionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create retention policy params
+func (o *CreateRetentionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create retention policy params
+func (o *CreateRetentionPolicyParams) WithBody(body *retention_policy_model.APIRetentionPolicy) *CreateRetentionPolicyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create retention policy params
+func (o *CreateRetentionPolicyParams) SetBody(body *retention_policy_model.APIRetentionPolicy) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateRetentionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
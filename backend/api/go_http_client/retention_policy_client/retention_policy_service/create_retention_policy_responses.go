@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	retention_policy_model "github.com/kubeflow/pipelines/backend/api/go_http_client/retention_policy_model"
+)
+
+// CreateRetentionPolicyReader is a Reader for the CreateRetentionPolicy structure.
+type CreateRetentionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateRetentionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCreateRetentionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewCreateRetentionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateRetentionPolicyOK creates a CreateRetentionPolicyOK with default headers values
+func NewCreateRetentionPolicyOK() *CreateRetentionPolicyOK {
+	return &CreateRetentionPolicyOK{}
+}
+
+/*CreateRetentionPolicyOK handles this case with default header values.
+
+A successful response.
+*/
+type CreateRetentionPolicyOK struct {
+	Payload *retention_policy_model.APIRetentionPolicy
+}
+
+func (o *CreateRetentionPolicyOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/retention_policies][%d] createRetentionPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateRetentionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIRetentionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateRetentionPolicyDefault creates a CreateRetentionPolicyDefault with default headers values
+func NewCreateRetentionPolicyDefault(code int) *CreateRetentionPolicyDefault {
+	return &CreateRetentionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/*CreateRetentionPolicyDefault handles this case with default header values.
+
+CreateRetentionPolicyDefault create retention policy default
+*/
+type CreateRetentionPolicyDefault struct {
+	_statusCode int
+
+	Payload *retention_policy_model.APIStatus
+}
+
+// Code gets the status code for the create retention policy default response
+func (o *CreateRetentionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateRetentionPolicyDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/retention_policies][%d] CreateRetentionPolicy default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateRetentionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteRetentionPolicyParams creates a new DeleteRetentionPolicyParams object
+// with the default values initialized.
+func NewDeleteRetentionPolicyParams() *DeleteRetentionPolicyParams {
+	var ()
+	return &DeleteRetentionPolicyParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteRetentionPolicyParamsWithTimeout creates a new DeleteRetentionPolicyParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteRetentionPolicyParamsWithTimeout(timeout time.Duration) *DeleteRetentionPolicyParams {
+	var ()
+	return &DeleteRetentionPolicyParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteRetentionPolicyParamsWithContext creates a new DeleteRetentionPolicyParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteRetentionPolicyParamsWithContext(ctx context.Context) *DeleteRetentionPolicyParams {
+	var ()
+	return &DeleteRetentionPolicyParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteRetentionPolicyParamsWithHTTPClient creates a new DeleteRetentionPolicyParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteRetentionPolicyParamsWithHTTPClient(client *http.Client) *DeleteRetentionPolicyParams {
+	var ()
+	return &DeleteRetentionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteRetentionPolicyParams contains all the parameters to send to the API endpoint
+for the delete retention policy operation typically these are written to a http.Request
+*/
+type DeleteRetentionPolicyParams struct {
+
+	/*ID
+	  The ID of the retention policy to be deleted.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) WithTimeout(timeout time.Duration) *DeleteRetentionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) WithContext(ctx context.Context) *DeleteRetentionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) WithHTTPClient(client *http.Client) *DeleteRetentionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) WithID(id string) *DeleteRetentionPolicyParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the delete retention policy params
+func (o *DeleteRetentionPolicyParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteRetentionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	retention_policy_model "github.com/kubeflow/pipelines/backend/api/go_http_client/retention_policy_model"
+)
+
+// DeleteRetentionPolicyReader is a Reader for the DeleteRetentionPolicy structure.
+type DeleteRetentionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteRetentionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewDeleteRetentionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewDeleteRetentionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteRetentionPolicyOK creates a DeleteRetentionPolicyOK with default headers values
+func NewDeleteRetentionPolicyOK() *DeleteRetentionPolicyOK {
+	return &DeleteRetentionPolicyOK{}
+}
+
+/*DeleteRetentionPolicyOK handles this case with default header values.
+
+A successful response.
+*/
+type DeleteRetentionPolicyOK struct {
+	Payload interface{}
+}
+
+func (o *DeleteRetentionPolicyOK) Error() string {
+	return fmt.Sprintf("[DELETE /apis/v1beta1/retention_policies/{id}][%d] deleteRetentionPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteRetentionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteRetentionPolicyDefault creates a DeleteRetentionPolicyDefault with default headers values
+func NewDeleteRetentionPolicyDefault(code int) *DeleteRetentionPolicyDefault {
+	return &DeleteRetentionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/*DeleteRetentionPolicyDefault handles this case with default header values.
+
+DeleteRetentionPolicyDefault delete retention policy default
+*/
+type DeleteRetentionPolicyDefault struct {
+	_statusCode int
+
+	Payload *retention_policy_model.APIStatus
+}
+
+// Code gets the status code for the delete retention policy default response
+func (o *DeleteRetentionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteRetentionPolicyDefault) Error() string {
+	return fmt.Sprintf("[DELETE /apis/v1beta1/retention_policies/{id}][%d] DeleteRetentionPolicy default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteRetentionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
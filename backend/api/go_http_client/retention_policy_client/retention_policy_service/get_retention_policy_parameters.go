@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetRetentionPolicyParams creates a new GetRetentionPolicyParams object
+// with the default values initialized.
+func NewGetRetentionPolicyParams() *GetRetentionPolicyParams {
+	var ()
+	return &GetRetentionPolicyParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRetentionPolicyParamsWithTimeout creates a new GetRetentionPolicyParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRetentionPolicyParamsWithTimeout(timeout time.Duration) *GetRetentionPolicyParams {
+	var ()
+	return &GetRetentionPolicyParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRetentionPolicyParamsWithContext creates a new GetRetentionPolicyParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRetentionPolicyParamsWithContext(ctx context.Context) *GetRetentionPolicyParams {
+	var ()
+	return &GetRetentionPolicyParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRetentionPolicyParamsWithHTTPClient creates a new GetRetentionPolicyParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRetentionPolicyParamsWithHTTPClient(client *http.Client) *GetRetentionPolicyParams {
+	var ()
+	return &GetRetentionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetRetentionPolicyParams contains all the parameters to send to the API endpoint
+for the get retention policy operation typically these are written to a http.Request
+*/
+type GetRetentionPolicyParams struct {
+
+	/*ID
+	  The ID of the retention policy to be retrieved.
+
+	*/
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get retention policy params
+func (o *GetRetentionPolicyParams) WithTimeout(timeout time.Duration) *GetRetentionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get retention policy params
+func (o *GetRetentionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get retention policy params
+func (o *GetRetentionPolicyParams) WithContext(ctx context.Context) *GetRetentionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get retention policy params
+func (o *GetRetentionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get retention policy params
+func (o *GetRetentionPolicyParams) WithHTTPClient(client *http.Client) *GetRetentionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get retention policy params
+func (o *GetRetentionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get retention policy params
+func (o *GetRetentionPolicyParams) WithID(id string) *GetRetentionPolicyParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get retention policy params
+func (o *GetRetentionPolicyParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRetentionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	retention_policy_model "github.com/kubeflow/pipelines/backend/api/go_http_client/retention_policy_model"
+)
+
+// GetRetentionPolicyReader is a Reader for the GetRetentionPolicy structure.
+type GetRetentionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRetentionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRetentionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewGetRetentionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetRetentionPolicyOK creates a GetRetentionPolicyOK with default headers values
+func NewGetRetentionPolicyOK() *GetRetentionPolicyOK {
+	return &GetRetentionPolicyOK{}
+}
+
+/*GetRetentionPolicyOK handles this case with default header values.
+
+A successful response.
+*/
+type GetRetentionPolicyOK struct {
+	Payload *retention_policy_model.APIRetentionPolicy
+}
+
+func (o *GetRetentionPolicyOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/retention_policies/{id}][%d] getRetentionPolicyOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRetentionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIRetentionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRetentionPolicyDefault creates a GetRetentionPolicyDefault with default headers values
+func NewGetRetentionPolicyDefault(code int) *GetRetentionPolicyDefault {
+	return &GetRetentionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetRetentionPolicyDefault handles this case with default header values.
+
+GetRetentionPolicyDefault get retention policy default
+*/
+type GetRetentionPolicyDefault struct {
+	_statusCode int
+
+	Payload *retention_policy_model.APIStatus
+}
+
+// Code gets the status code for the get retention policy default response
+func (o *GetRetentionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetRetentionPolicyDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/retention_policies/{id}][%d] GetRetentionPolicy default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetRetentionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	retention_policy_model "github.com/kubeflow/pipelines/backend/api/go_http_client/retention_policy_model"
+)
+
+// ListRetentionPoliciesReader is a Reader for the ListRetentionPolicies structure.
+type ListRetentionPoliciesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListRetentionPoliciesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListRetentionPoliciesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewListRetentionPoliciesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListRetentionPoliciesOK creates a ListRetentionPoliciesOK with default headers values
+func NewListRetentionPoliciesOK() *ListRetentionPoliciesOK {
+	return &ListRetentionPoliciesOK{}
+}
+
+/*ListRetentionPoliciesOK handles this case with default header values.
+
+A successful response.
+*/
+type ListRetentionPoliciesOK struct {
+	Payload *retention_policy_model.APIListRetentionPoliciesResponse
+}
+
+func (o *ListRetentionPoliciesOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/retention_policies][%d] listRetentionPoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListRetentionPoliciesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIListRetentionPoliciesResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListRetentionPoliciesDefault creates a ListRetentionPoliciesDefault with default headers values
+func NewListRetentionPoliciesDefault(code int) *ListRetentionPoliciesDefault {
+	return &ListRetentionPoliciesDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListRetentionPoliciesDefault handles this case with default header values.
+
+ListRetentionPoliciesDefault list retention policies default
+*/
+type ListRetentionPoliciesDefault struct {
+	_statusCode int
+
+	Payload *retention_policy_model.APIStatus
+}
+
+// Code gets the status code for the list retention policies default response
+func (o *ListRetentionPoliciesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListRetentionPoliciesDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/retention_policies][%d] ListRetentionPolicies default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListRetentionPoliciesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(retention_policy_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new retention policy service API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for retention policy service API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+CreateRetentionPolicy creates a retention policy for an experiment or a namespace
+*/
+func (a *Client) CreateRetentionPolicy(params *CreateRetentionPolicyParams, authInfo runtime.ClientAuthInfoWriter) (*CreateRetentionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateRetentionPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateRetentionPolicy",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/retention_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateRetentionPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateRetentionPolicyOK), nil
+
+}
+
+/*
+DeleteRetentionPolicy deletes a retention policy runs that were already purged are not restored
+*/
+func (a *Client) DeleteRetentionPolicy(params *DeleteRetentionPolicyParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteRetentionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteRetentionPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteRetentionPolicy",
+		Method:             "DELETE",
+		PathPattern:        "/apis/v1beta1/retention_policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteRetentionPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteRetentionPolicyOK), nil
+
+}
+
+/*
+GetRetentionPolicy finds a specific retention policy by ID
+*/
+func (a *Client) GetRetentionPolicy(params *GetRetentionPolicyParams, authInfo runtime.ClientAuthInfoWriter) (*GetRetentionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRetentionPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetRetentionPolicy",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/retention_policies/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetRetentionPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetRetentionPolicyOK), nil
+
+}
+
+/*
+ListRetentionPolicies finds all retention policies
+*/
+func (a *Client) ListRetentionPolicies(params *ListRetentionPoliciesParams, authInfo runtime.ClientAuthInfoWriter) (*ListRetentionPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRetentionPoliciesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListRetentionPolicies",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/retention_policies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListRetentionPoliciesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListRetentionPoliciesOK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
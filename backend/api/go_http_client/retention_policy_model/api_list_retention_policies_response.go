@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIListRetentionPoliciesResponse api list retention policies response
+// swagger:model apiListRetentionPoliciesResponse
+type APIListRetentionPoliciesResponse struct {
+
+	// The token to list the next page of retention policies.
+	NextPageToken string `json:"next_page_token,omitempty"`
+
+	// A list of retention policies returned.
+	RetentionPolicies []*APIRetentionPolicy `json:"retention_policies"`
+
+	// The total number of retention policies for the given query.
+	TotalSize int32 `json:"total_size,omitempty"`
+}
+
+// Validate validates this api list retention policies response
+func (m *APIListRetentionPoliciesResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRetentionPolicies(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIListRetentionPoliciesResponse) validateRetentionPolicies(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RetentionPolicies) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RetentionPolicies); i++ {
+		if swag.IsZero(m.RetentionPolicies[i]) { // not required
+			continue
+		}
+
+		if m.RetentionPolicies[i] != nil {
+			if err := m.RetentionPolicies[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("retention_policies" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIListRetentionPoliciesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIListRetentionPoliciesResponse) UnmarshalBinary(b []byte) error {
+	var res APIListRetentionPoliciesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
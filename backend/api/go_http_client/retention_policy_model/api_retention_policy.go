@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package retention_policy_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIRetentionPolicy api retention policy
+// swagger:model apiRetentionPolicy
+type APIRetentionPolicy struct {
+
+	// Runs that finished more than this number of days ago are archived.
+	// Zero means runs are never archived by the policy.
+	ArchiveAfterDays int32 `json:"archive_after_days,omitempty"`
+
+	// Output. The total number of runs archived by this policy.
+	ArchivedRunCount string `json:"archived_run_count,omitempty"`
+
+	// Output. The time this policy was created.
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// Runs that finished more than this number of days ago are deleted, along
+	// with their artifacts and archived logs. Zero means runs are never deleted
+	// by the policy.
+	DeleteAfterDays int32 `json:"delete_after_days,omitempty"`
+
+	// Output. The total number of artifact and log objects deleted by this
+	// policy.
+	DeletedObjectCount string `json:"deleted_object_count,omitempty"`
+
+	// Output. The total number of runs deleted by this policy.
+	DeletedRunCount string `json:"deleted_run_count,omitempty"`
+
+	// Output. In case the last enforcement of the policy failed, this field
+	// contains the error message.
+	Error string `json:"error,omitempty"`
+
+	// Output. Unique policy ID. Generated by API server.
+	ID string `json:"id,omitempty"`
+
+	// Output. The last time this policy was enforced.
+	// Format: date-time
+	LastEnforcedAt strfmt.DateTime `json:"last_enforced_at,omitempty"`
+
+	// The experiment or namespace the policy applies to. At most one policy can
+	// be created for each experiment or namespace. The policy of an experiment
+	// takes precedence over the policy of its namespace.
+	ResourceKey *APIResourceKey `json:"resource_key,omitempty"`
+}
+
+// Validate validates this api retention policy
+func (m *APIRetentionPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastEnforcedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIRetentionPolicy) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIRetentionPolicy) validateLastEnforcedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastEnforcedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_enforced_at", "body", "date-time", m.LastEnforcedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIRetentionPolicy) validateResourceKey(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ResourceKey) { // not required
+		return nil
+	}
+
+	if m.ResourceKey != nil {
+		if err := m.ResourceKey.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("resource_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRetentionPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRetentionPolicy) UnmarshalBinary(b []byte) error {
+	var res APIRetentionPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
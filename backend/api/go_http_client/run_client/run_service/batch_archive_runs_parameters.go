@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// NewBatchArchiveRunsParams creates a new BatchArchiveRunsParams object
+// with the default values initialized.
+func NewBatchArchiveRunsParams() *BatchArchiveRunsParams {
+	var ()
+	return &BatchArchiveRunsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchArchiveRunsParamsWithTimeout creates a new BatchArchiveRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchArchiveRunsParamsWithTimeout(timeout time.Duration) *BatchArchiveRunsParams {
+	var ()
+	return &BatchArchiveRunsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchArchiveRunsParamsWithContext creates a new BatchArchiveRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchArchiveRunsParamsWithContext(ctx context.Context) *BatchArchiveRunsParams {
+	var ()
+	return &BatchArchiveRunsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchArchiveRunsParamsWithHTTPClient creates a new BatchArchiveRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchArchiveRunsParamsWithHTTPClient(client *http.Client) *BatchArchiveRunsParams {
+	var ()
+	return &BatchArchiveRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*BatchArchiveRunsParams contains all the parameters to send to the API endpoint
+for the batch archive runs operation typically these are written to a http.Request
+*/
+type BatchArchiveRunsParams struct {
+
+	/*Body*/
+	Body *run_model.APIBatchArchiveRunsRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batch archive runs params
+func (o *BatchArchiveRunsParams) WithTimeout(timeout time.Duration) *BatchArchiveRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batch archive runs params
+func (o *BatchArchiveRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batch archive runs params
+func (o *BatchArchiveRunsParams) WithContext(ctx context.Context) *BatchArchiveRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batch archive runs params
+func (o *BatchArchiveRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batch archive runs params
+func (o *BatchArchiveRunsParams) WithHTTPClient(client *http.Client) *BatchArchiveRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batch archive runs params
+func (o *BatchArchiveRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batch archive runs params
+func (o *BatchArchiveRunsParams) WithBody(body *run_model.APIBatchArchiveRunsRequest) *BatchArchiveRunsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batch archive runs params
+func (o *BatchArchiveRunsParams) SetBody(body *run_model.APIBatchArchiveRunsRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchArchiveRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
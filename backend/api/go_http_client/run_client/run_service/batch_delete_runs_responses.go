@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// BatchDeleteRunsReader is a Reader for the BatchDeleteRuns structure.
+type BatchDeleteRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchDeleteRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewBatchDeleteRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewBatchDeleteRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewBatchDeleteRunsOK creates a BatchDeleteRunsOK with default headers values
+func NewBatchDeleteRunsOK() *BatchDeleteRunsOK {
+	return &BatchDeleteRunsOK{}
+}
+
+/*BatchDeleteRunsOK handles this case with default header values.
+
+A successful response.
+*/
+type BatchDeleteRunsOK struct {
+	Payload *run_model.APIBatchRunsResponse
+}
+
+func (o *BatchDeleteRunsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchDelete][%d] batchDeleteRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchDeleteRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIBatchRunsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchDeleteRunsDefault creates a BatchDeleteRunsDefault with default headers values
+func NewBatchDeleteRunsDefault(code int) *BatchDeleteRunsDefault {
+	return &BatchDeleteRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*BatchDeleteRunsDefault handles this case with default header values.
+
+BatchDeleteRunsDefault batch delete runs default
+*/
+type BatchDeleteRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the batch delete runs default response
+func (o *BatchDeleteRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *BatchDeleteRunsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchDelete][%d] BatchDeleteRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *BatchDeleteRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
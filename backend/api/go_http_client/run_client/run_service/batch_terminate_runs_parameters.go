@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// NewBatchTerminateRunsParams creates a new BatchTerminateRunsParams object
+// with the default values initialized.
+func NewBatchTerminateRunsParams() *BatchTerminateRunsParams {
+	var ()
+	return &BatchTerminateRunsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchTerminateRunsParamsWithTimeout creates a new BatchTerminateRunsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBatchTerminateRunsParamsWithTimeout(timeout time.Duration) *BatchTerminateRunsParams {
+	var ()
+	return &BatchTerminateRunsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBatchTerminateRunsParamsWithContext creates a new BatchTerminateRunsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBatchTerminateRunsParamsWithContext(ctx context.Context) *BatchTerminateRunsParams {
+	var ()
+	return &BatchTerminateRunsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBatchTerminateRunsParamsWithHTTPClient creates a new BatchTerminateRunsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBatchTerminateRunsParamsWithHTTPClient(client *http.Client) *BatchTerminateRunsParams {
+	var ()
+	return &BatchTerminateRunsParams{
+		HTTPClient: client,
+	}
+}
+
+/*BatchTerminateRunsParams contains all the parameters to send to the API endpoint
+for the batch terminate runs operation typically these are written to a http.Request
+*/
+type BatchTerminateRunsParams struct {
+
+	/*Body*/
+	Body *run_model.APIBatchTerminateRunsRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the batch terminate runs params
+func (o *BatchTerminateRunsParams) WithTimeout(timeout time.Duration) *BatchTerminateRunsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batch terminate runs params
+func (o *BatchTerminateRunsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batch terminate runs params
+func (o *BatchTerminateRunsParams) WithContext(ctx context.Context) *BatchTerminateRunsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batch terminate runs params
+func (o *BatchTerminateRunsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batch terminate runs params
+func (o *BatchTerminateRunsParams) WithHTTPClient(client *http.Client) *BatchTerminateRunsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batch terminate runs params
+func (o *BatchTerminateRunsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batch terminate runs params
+func (o *BatchTerminateRunsParams) WithBody(body *run_model.APIBatchTerminateRunsRequest) *BatchTerminateRunsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batch terminate runs params
+func (o *BatchTerminateRunsParams) SetBody(body *run_model.APIBatchTerminateRunsRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchTerminateRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// BatchTerminateRunsReader is a Reader for the BatchTerminateRuns structure.
+type BatchTerminateRunsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchTerminateRunsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewBatchTerminateRunsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewBatchTerminateRunsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewBatchTerminateRunsOK creates a BatchTerminateRunsOK with default headers values
+func NewBatchTerminateRunsOK() *BatchTerminateRunsOK {
+	return &BatchTerminateRunsOK{}
+}
+
+/*BatchTerminateRunsOK handles this case with default header values.
+
+A successful response.
+*/
+type BatchTerminateRunsOK struct {
+	Payload *run_model.APIBatchRunsResponse
+}
+
+func (o *BatchTerminateRunsOK) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchTerminate][%d] batchTerminateRunsOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchTerminateRunsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIBatchRunsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchTerminateRunsDefault creates a BatchTerminateRunsDefault with default headers values
+func NewBatchTerminateRunsDefault(code int) *BatchTerminateRunsDefault {
+	return &BatchTerminateRunsDefault{
+		_statusCode: code,
+	}
+}
+
+/*BatchTerminateRunsDefault handles this case with default header values.
+
+BatchTerminateRunsDefault batch terminate runs default
+*/
+type BatchTerminateRunsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the batch terminate runs default response
+func (o *BatchTerminateRunsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *BatchTerminateRunsDefault) Error() string {
+	return fmt.Sprintf("[POST /apis/v1beta1/runs:batchTerminate][%d] BatchTerminateRuns default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *BatchTerminateRunsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
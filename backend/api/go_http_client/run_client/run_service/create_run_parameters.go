@@ -65,6 +65,11 @@ type CreateRunParams struct {
 
 	/*Body*/
 	Body *run_model.APIRun
+	/*IdempotencyKey
+	  Optional. A client-supplied key of at most 128 characters identifying the request. A retried request with the same key and run returns the run created by the original request instead of creating another one, and a request with the same key but a different run is rejected.
+
+	*/
+	IdempotencyKey *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -115,6 +120,17 @@ func (o *CreateRunParams) SetBody(body *run_model.APIRun) {
 	o.Body = body
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the create run params
+func (o *CreateRunParams) WithIdempotencyKey(idempotencyKey *string) *CreateRunParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the create run params
+func (o *CreateRunParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -129,6 +145,22 @@ func (o *CreateRunParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Reg
 		}
 	}
 
+	if o.IdempotencyKey != nil {
+
+		// query param idempotency_key
+		var qrIdempotencyKey string
+		if o.IdempotencyKey != nil {
+			qrIdempotencyKey = *o.IdempotencyKey
+		}
+		qIdempotencyKey := qrIdempotencyKey
+		if qIdempotencyKey != "" {
+			if err := r.SetQueryParam("idempotency_key", qIdempotencyKey); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	run_model "github.com/kubeflow/pipelines/backend/api/go_http_client/run_model"
+)
+
+// ListRunArtifactsReader is a Reader for the ListRunArtifacts structure.
+type ListRunArtifactsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListRunArtifactsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListRunArtifactsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		result := NewListRunArtifactsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListRunArtifactsOK creates a ListRunArtifactsOK with default headers values
+func NewListRunArtifactsOK() *ListRunArtifactsOK {
+	return &ListRunArtifactsOK{}
+}
+
+/*ListRunArtifactsOK handles this case with default header values.
+
+A successful response.
+*/
+type ListRunArtifactsOK struct {
+	Payload *run_model.APIListRunArtifactsResponse
+}
+
+func (o *ListRunArtifactsOK) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/artifacts][%d] listRunArtifactsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListRunArtifactsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIListRunArtifactsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListRunArtifactsDefault creates a ListRunArtifactsDefault with default headers values
+func NewListRunArtifactsDefault(code int) *ListRunArtifactsDefault {
+	return &ListRunArtifactsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListRunArtifactsDefault handles this case with default header values.
+
+ListRunArtifactsDefault list run artifacts default
+*/
+type ListRunArtifactsDefault struct {
+	_statusCode int
+
+	Payload *run_model.APIStatus
+}
+
+// Code gets the status code for the list run artifacts default response
+func (o *ListRunArtifactsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListRunArtifactsDefault) Error() string {
+	return fmt.Sprintf("[GET /apis/v1beta1/runs/{run_id}/artifacts][%d] ListRunArtifacts default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListRunArtifactsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(run_model.APIStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -23,9 +23,11 @@ import (
 func NewListRunsParams() *ListRunsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListRunsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -36,9 +38,11 @@ func NewListRunsParams() *ListRunsParams {
 func NewListRunsParamsWithTimeout(timeout time.Duration) *ListRunsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListRunsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		timeout: timeout,
 	}
@@ -49,9 +53,11 @@ func NewListRunsParamsWithTimeout(timeout time.Duration) *ListRunsParams {
 func NewListRunsParamsWithContext(ctx context.Context) *ListRunsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListRunsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 
 		Context: ctx,
 	}
@@ -62,9 +68,11 @@ func NewListRunsParamsWithContext(ctx context.Context) *ListRunsParams {
 func NewListRunsParamsWithHTTPClient(client *http.Client) *ListRunsParams {
 	var (
 		resourceReferenceKeyTypeDefault = string("UNKNOWN_RESOURCE_TYPE")
+		viewDefault                     = string("LIST_VIEW_UNSPECIFIED")
 	)
 	return &ListRunsParams{
 		ResourceReferenceKeyType: &resourceReferenceKeyTypeDefault,
+		View:                     &viewDefault,
 		HTTPClient:               client,
 	}
 }
@@ -78,6 +86,8 @@ type ListRunsParams struct {
 	  A url-encoded, JSON-serialized Filter protocol buffer (see
 	[filter.proto](https://github.com/kubeflow/pipelines/
 	blob/master/backend/api/filter.proto)).
+	Besides run fields, predicates can use keys "param:<name>" and
+	"metric:<name>" to filter on parameter values and metrics of runs.
 
 	*/
 	Filter *string
@@ -108,9 +118,22 @@ type ListRunsParams struct {
 	/*SortBy
 	  Can be format of "field_name", "field_name asc" or "field_name desc"
 	(Example, "name asc" or "id desc"). Ascending by default.
+	Runs can also be sorted by a metric or a parameter value, e.g.
+	"metric:accuracy desc" or "param:learning_rate".
 
 	*/
 	SortBy *string
+	/*View
+	  BASIC leaves out the pipeline and workflow manifests of the runs. FULL by
+	default.
+
+	 - LIST_VIEW_UNSPECIFIED: Same as FULL.
+	 - BASIC: Leaves out the large fields of the resources: the pipeline and workflow
+	manifests of runs and jobs, and the parameters of pipeline versions.
+	 - FULL: Returns the resources in full.
+
+	*/
+	View *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -216,6 +239,17 @@ func (o *ListRunsParams) SetSortBy(sortBy *string) {
 	o.SortBy = sortBy
 }
 
+// WithView adds the view to the list runs params
+func (o *ListRunsParams) WithView(view *string) *ListRunsParams {
+	o.SetView(view)
+	return o
+}
+
+// SetView adds the view to the list runs params
+func (o *ListRunsParams) SetView(view *string) {
+	o.View = view
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -320,6 +354,22 @@ func (o *ListRunsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 
 	}
 
+	if o.View != nil {
+
+		// query param view
+		var qrView string
+		if o.View != nil {
+			qrView = *o.View
+		}
+		qView := qrView
+		if qView != "" {
+			if err := r.SetQueryParam("view", qView); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
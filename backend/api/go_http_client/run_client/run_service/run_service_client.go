@@ -53,6 +53,93 @@ func (a *Client) ArchiveRun(params *ArchiveRunParams, authInfo runtime.ClientAut
 
 }
 
+/*
+BatchArchiveRuns archives a set of runs selected by ids or by a filter
+*/
+func (a *Client) BatchArchiveRuns(params *BatchArchiveRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchArchiveRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchArchiveRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchArchiveRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchArchive",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchArchiveRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchArchiveRunsOK), nil
+
+}
+
+/*
+BatchDeleteRuns deletes a set of runs selected by ids or by a filter
+*/
+func (a *Client) BatchDeleteRuns(params *BatchDeleteRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchDeleteRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchDeleteRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchDeleteRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchDelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchDeleteRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchDeleteRunsOK), nil
+
+}
+
+/*
+BatchTerminateRuns terminates a set of active runs selected by ids or by a filter
+*/
+func (a *Client) BatchTerminateRuns(params *BatchTerminateRunsParams, authInfo runtime.ClientAuthInfoWriter) (*BatchTerminateRunsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchTerminateRunsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "BatchTerminateRuns",
+		Method:             "POST",
+		PathPattern:        "/apis/v1beta1/runs:batchTerminate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BatchTerminateRunsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BatchTerminateRunsOK), nil
+
+}
+
 /*
 CreateRun creates a new run
 */
@@ -140,6 +227,35 @@ func (a *Client) GetRun(params *GetRunParams, authInfo runtime.ClientAuthInfoWri
 
 }
 
+/*
+ListRunArtifacts finds the artifacts a run produced per task
+*/
+func (a *Client) ListRunArtifacts(params *ListRunArtifactsParams, authInfo runtime.ClientAuthInfoWriter) (*ListRunArtifactsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRunArtifactsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListRunArtifacts",
+		Method:             "GET",
+		PathPattern:        "/apis/v1beta1/runs/{run_id}/artifacts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListRunArtifactsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListRunArtifactsOK), nil
+
+}
+
 /*
 ListRuns finds all runs
 */
@@ -0,0 +1,106 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIBatchTerminateRunsRequest api batch terminate runs request
+// swagger:model apiBatchTerminateRunsRequest
+type APIBatchTerminateRunsRequest struct {
+
+	// If true, the runs are not terminated, and the response lists the runs
+	// that would be terminated.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// A filter selecting the runs to be terminated. Predicates can use the same
+	// keys as in ListRuns.
+	Filter *APIFilter `json:"filter,omitempty"`
+
+	// The IDs of the runs to be terminated. Exactly one of ids and filter must
+	// be specified.
+	Ids []string `json:"ids"`
+
+	// The namespace of the runs selected by the filter, with type NAMESPACE.
+	// Required in multi-user mode when the runs are selected by a filter.
+	ResourceReferenceKey *APIResourceKey `json:"resource_reference_key,omitempty"`
+}
+
+// Validate validates this api batch terminate runs request
+func (m *APIBatchTerminateRunsRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFilter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResourceReferenceKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIBatchTerminateRunsRequest) validateFilter(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Filter) { // not required
+		return nil
+	}
+
+	if m.Filter != nil {
+		if err := m.Filter.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("filter")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIBatchTerminateRunsRequest) validateResourceReferenceKey(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ResourceReferenceKey) { // not required
+		return nil
+	}
+
+	if m.ResourceReferenceKey != nil {
+		if err := m.ResourceReferenceKey.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("resource_reference_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIBatchTerminateRunsRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIBatchTerminateRunsRequest) UnmarshalBinary(b []byte) error {
+	var res APIBatchTerminateRunsRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
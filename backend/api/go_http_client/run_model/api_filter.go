@@ -0,0 +1,222 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIFilter Filter is used to filter resources returned from a ListXXX request.
+//
+// Example filters:
+// 1) Filter runs with status = 'Running'
+// filter {
+//   predicate {
+//     key: "status"
+//     op: EQUALS
+//     string_value: "Running"
+//   }
+// }
+//
+// 2) Filter runs that succeeded since Dec 1, 2018
+// filter {
+//   predicate {
+//     key: "status"
+//     op: EQUALS
+//     string_value: "Succeeded"
+//   }
+//   predicate {
+//     key: "created_at"
+//     op: GREATER_THAN
+//     timestamp_value {
+//       seconds: 1543651200
+//     }
+//   }
+// }
+//
+// 3) Filter runs with one of labels 'label_1' or 'label_2'
+//
+// filter {
+//   predicate {
+//     key: "label"
+//     op: IN
+//     string_values {
+//       value: 'label_1'
+//       value: 'label_2'
+//     }
+//   }
+// }
+//
+// 4) Filter runs with parameter 'learning_rate' set to '0.01' and metric
+// 'accuracy' greater than 0.9
+//
+// filter {
+//   predicates {
+//     key: "param:learning_rate"
+//     op: EQUALS
+//     string_value: "0.01"
+//   }
+//   predicates {
+//     key: "metric:accuracy"
+//     op: GREATER_THAN
+//     double_value: 0.9
+//   }
+// }
+//
+// 5) Filter runs that failed or errored, and whose name does not contain 'tmp'
+//
+// filter {
+//   filters {
+//     combinator: OR
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Failed"
+//     }
+//     predicates {
+//       key: "status"
+//       op: EQUALS
+//       string_value: "Error"
+//     }
+//   }
+//   filters {
+//     negate: true
+//     predicates {
+//       key: "name"
+//       op: IS_SUBSTRING
+//       string_value: "tmp"
+//     }
+//   }
+// }
+// swagger:model apiFilter
+type APIFilter struct {
+
+	// combinator
+	Combinator FilterCombinator `json:"combinator,omitempty"`
+
+	// Nested filters, each of which is evaluated as a single condition and
+	// combined with |predicates| using |combinator|. Nested filters must contain
+	// at least one predicate or nested filter.
+	Filters []*APIFilter `json:"filters"`
+
+	// If true, the combined result of |predicates| and |filters| is negated.
+	Negate bool `json:"negate,omitempty"`
+
+	// All predicates are AND-ed when this filter is applied, unless a different
+	// |combinator| is specified.
+	Predicates []*APIPredicate `json:"predicates"`
+}
+
+// Validate validates this api filter
+func (m *APIFilter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCombinator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFilters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePredicates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIFilter) validateCombinator(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Combinator) { // not required
+		return nil
+	}
+
+	if err := m.Combinator.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("combinator")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFilter) validateFilters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Filters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Filters); i++ {
+		if swag.IsZero(m.Filters[i]) { // not required
+			continue
+		}
+
+		if m.Filters[i] != nil {
+			if err := m.Filters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("filters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIFilter) validatePredicates(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Predicates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Predicates); i++ {
+		if swag.IsZero(m.Predicates[i]) { // not required
+			continue
+		}
+
+		if m.Predicates[i] != nil {
+			if err := m.Predicates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("predicates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIFilter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIFilter) UnmarshalBinary(b []byte) error {
+	var res APIFilter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -38,6 +38,11 @@ type APIRun struct {
 	// Output. Unique run ID. Generated by API server.
 	ID string `json:"id,omitempty"`
 
+	// Optional input field. User-defined labels of the run. Label keys and values
+	// follow the syntax of Kubernetes labels. The labels are also set on the
+	// PipelineRun created for the run.
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// Output. The metrics of the run. The metrics are reported by ReportMetrics
 	// API.
 	Metrics []*APIRunMetric `json:"metrics"`
@@ -50,6 +55,15 @@ type APIRun struct {
 	// Describing what the pipeline manifest and parameters to use for the run.
 	PipelineSpec *APIPipelineSpec `json:"pipeline_spec,omitempty"`
 
+	// Optional input field. The priority of the run when it is queued because
+	// its namespace is over quota. Runs with a higher priority leave the queue
+	// first if the API server dispatches runs in priority order.
+	Priority int32 `json:"priority,omitempty"`
+
+	// Output. The position of the run in the queue of its namespace, starting
+	// from 1, while the run is Queued. Zero otherwise.
+	QueuePosition int32 `json:"queue_position,omitempty"`
+
 	// Optional input field. Specify which resource this run belongs to.
 	// When creating a run from a particular pipeline version, the pipeline
 	// version can be specified here.
@@ -66,7 +80,7 @@ type APIRun struct {
 	ServiceAccount string `json:"service_account,omitempty"`
 
 	// Output. The status of the run.
-	// One of [Pending, Running, Succeeded, Skipped, Failed, Error]
+	// One of [Queued, Pending, Running, Succeeded, Skipped, Failed, Error]
 	Status string `json:"status,omitempty"`
 
 	// Output. Specify whether this run is in archived or available mode.
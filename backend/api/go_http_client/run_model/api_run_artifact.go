@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunArtifact api run artifact
+// swagger:model apiRunArtifact
+type APIRunArtifact struct {
+
+	// The last time the artifact was stored.
+	// Format: date-time
+	LastModifiedAt strfmt.DateTime `json:"last_modified_at,omitempty"`
+
+	// The name of the artifact, which is passed as artifact_name to
+	// ReadArtifact.
+	Name string `json:"name,omitempty"`
+
+	// The key of the artifact in the object store.
+	ObjectKey string `json:"object_key,omitempty"`
+
+	// The size of the stored artifact archive in bytes.
+	SizeBytes string `json:"size_bytes,omitempty"`
+
+	// The path of the output in the task container, if the task declared it.
+	SourcePath string `json:"source_path,omitempty"`
+
+	// The name of the task which produced the artifact, which is passed as
+	// node_id to ReadArtifact.
+	TaskName string `json:"task_name,omitempty"`
+
+	// The type of the artifact, detected from its name.
+	Type APIRunArtifactType `json:"type,omitempty"`
+}
+
+// Validate validates this api run artifact
+func (m *APIRunArtifact) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLastModifiedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIRunArtifact) validateLastModifiedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastModifiedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_modified_at", "body", "date-time", m.LastModifiedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIRunArtifact) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIRunArtifact) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIRunArtifact) UnmarshalBinary(b []byte) error {
+	var res APIRunArtifact
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIRunArtifactType  - DATA: An output declared by the task.
+//  - METRICS: The metrics reported by the task.
+//  - UI_METADATA: The metadata of the visualizations of the task in the UI.
+//  - LOG: The archived log of the task.
+// swagger:model apiRunArtifactType
+type APIRunArtifactType string
+
+const (
+
+	// APIRunArtifactTypeUNKNOWNTYPE captures enum value "UNKNOWN_TYPE"
+	APIRunArtifactTypeUNKNOWNTYPE APIRunArtifactType = "UNKNOWN_TYPE"
+
+	// APIRunArtifactTypeDATA captures enum value "DATA"
+	APIRunArtifactTypeDATA APIRunArtifactType = "DATA"
+
+	// APIRunArtifactTypeMETRICS captures enum value "METRICS"
+	APIRunArtifactTypeMETRICS APIRunArtifactType = "METRICS"
+
+	// APIRunArtifactTypeUIMETADATA captures enum value "UI_METADATA"
+	APIRunArtifactTypeUIMETADATA APIRunArtifactType = "UI_METADATA"
+
+	// APIRunArtifactTypeLOG captures enum value "LOG"
+	APIRunArtifactTypeLOG APIRunArtifactType = "LOG"
+)
+
+// for schema
+var apiRunArtifactTypeEnum []interface{}
+
+func init() {
+	var res []APIRunArtifactType
+	if err := json.Unmarshal([]byte(`["UNKNOWN_TYPE","DATA","METRICS","UI_METADATA","LOG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiRunArtifactTypeEnum = append(apiRunArtifactTypeEnum, v)
+	}
+}
+
+func (m APIRunArtifactType) validateAPIRunArtifactTypeEnum(path, location string, value APIRunArtifactType) error {
+	if err := validate.Enum(path, location, value, apiRunArtifactTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api run artifact type
+func (m APIRunArtifactType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIRunArtifactTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// BatchRunsResponseBatchRunResult batch runs response batch run result
+// swagger:model BatchRunsResponseBatchRunResult
+type BatchRunsResponseBatchRunResult struct {
+
+	// Output. The detailed message of the error of the operation.
+	Message string `json:"message,omitempty"`
+
+	// Output. The ID of the run.
+	RunID string `json:"run_id,omitempty"`
+
+	// Output. The name of the run.
+	RunName string `json:"run_name,omitempty"`
+
+	// Output. The status of the operation on the run.
+	Status BatchRunsResponseBatchRunResultStatus `json:"status,omitempty"`
+}
+
+// Validate validates this batch runs response batch run result
+func (m *BatchRunsResponseBatchRunResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchRunsResponseBatchRunResult) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("status")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchRunsResponseBatchRunResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchRunsResponseBatchRunResult) UnmarshalBinary(b []byte) error {
+	var res BatchRunsResponseBatchRunResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package run_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// FilterCombinator Combinator is the boolean operation used to join the conditions of a
+// filter.
+// swagger:model FilterCombinator
+type FilterCombinator string
+
+const (
+
+	// FilterCombinatorAND captures enum value "AND"
+	FilterCombinatorAND FilterCombinator = "AND"
+
+	// FilterCombinatorOR captures enum value "OR"
+	FilterCombinatorOR FilterCombinator = "OR"
+)
+
+// for schema
+var filterCombinatorEnum []interface{}
+
+func init() {
+	var res []FilterCombinator
+	if err := json.Unmarshal([]byte(`["AND","OR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		filterCombinatorEnum = append(filterCombinatorEnum, v)
+	}
+}
+
+func (m FilterCombinator) validateFilterCombinatorEnum(path, location string, value FilterCombinator) error {
+	if err := validate.Enum(path, location, value, filterCombinatorEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this filter combinator
+func (m FilterCombinator) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateFilterCombinatorEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -57,8 +57,19 @@ ${PROTOCCOMPILER} -I. -Ibackend/api \
     --swagger_out=logtostderr=true:${TMP_OUTPUT} \
     backend/api/*.proto
 cp ${TMP_OUTPUT}/backend/api/*.swagger.json ./backend/api/swagger
+# protoc-gen-swagger only documents the query parameters of GET and DELETE
+# methods, so add the idempotency key of the create requests, which the
+# gateway reads from the query string. The characters jq unescapes are escaped
+# again like protoc-gen-swagger does.
+for resource in run job; do
+  jq --arg path "/apis/v1beta1/${resource}s" --arg resource "${resource}" \
+    '.paths[$path].post.parameters += [{"name": "idempotency_key", "description": ("Optional. A client-supplied key of at most 128 characters identifying the request. A retried request with the same key and " + $resource + " returns the " + $resource + " created by the original request instead of creating another one, and a request with the same key but a different " + $resource + " is rejected."), "in": "query", "required": false, "type": "string"}]' \
+    backend/api/swagger/${resource}.swagger.json |
+    sed -e 's/</\\u003c/g' -e 's/>/\\u003e/g' -e 's/&/\\u0026/g' > ${TMP_OUTPUT}/${resource}.swagger.json
+  mv ${TMP_OUTPUT}/${resource}.swagger.json backend/api/swagger/${resource}.swagger.json
+done
 # Generate a single swagger json file from the swagger json files of all models.
-# Note: use backend/backend/api/swagger/{run,job,experiment,pipeline,pipeline.upload,healthz}.swagger.json when apt-get can install jq-1.6
+# Note: use backend/backend/api/swagger/{run,job,experiment,pipeline,pipeline.upload,healthz,label,retention_policy,quota,lineage,audit}.swagger.json when apt-get can install jq-1.6
 jq -s 'reduce .[] as $item ({}; . * $item) | .info.title = "Kubeflow Pipelines API" | .info.description = "This file contains REST API specification for Kubeflow Pipelines. The file is autogenerated from the swagger definition." | .info.version = "'$KFP_VERSION'" | .info.contact = { "name": "google", "email": "kubeflow-pipelines@google.com", "url": "https://www.google.com" } | .info.license = { "name": "Apache 2.0", "url": "https://raw.githubusercontent.com/kubeflow/pipelines/master/LICENSE" }' \
     backend/api/swagger/run.swagger.json \
     backend/api/swagger/job.swagger.json \
@@ -66,6 +77,11 @@ jq -s 'reduce .[] as $item ({}; . * $item) | .info.title = "Kubeflow Pipelines A
     backend/api/swagger/pipeline.swagger.json \
     backend/api/swagger/pipeline.upload.swagger.json \
     backend/api/swagger/healthz.swagger.json \
+    backend/api/swagger/label.swagger.json \
+    backend/api/swagger/retention_policy.swagger.json \
+    backend/api/swagger/quota.swagger.json \
+    backend/api/swagger/lineage.swagger.json \
+    backend/api/swagger/audit.swagger.json \
     > "backend/api/swagger/kfp_api_single_file.swagger.json"
 # Generate go_http_client from swagger json.
 swagger generate client \
@@ -117,6 +133,41 @@ swagger generate client \
     -c healthz_client \
     -m healthz_model \
     -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/label.swagger.json \
+    -A label \
+    --principal models.Principal \
+    -c label_client \
+    -m label_model \
+    -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/retention_policy.swagger.json \
+    -A retention_policy \
+    --principal models.Principal \
+    -c retention_policy_client \
+    -m retention_policy_model \
+    -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/quota.swagger.json \
+    -A quota \
+    --principal models.Principal \
+    -c quota_client \
+    -m quota_model \
+    -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/lineage.swagger.json \
+    -A lineage \
+    --principal models.Principal \
+    -c lineage_client \
+    -m lineage_model \
+    -t backend/api/go_http_client
+swagger generate client \
+    -f backend/api/swagger/audit.swagger.json \
+    -A audit \
+    --principal models.Principal \
+    -c audit_client \
+    -m audit_model \
+    -t backend/api/go_http_client
 # Hack to fix an issue with go-swagger
 # See https://github.com/go-swagger/go-swagger/issues/1381 for details.
 sed -i -- 's/MaxConcurrency int64 `json:"max_concurrency,omitempty"`/MaxConcurrency int64 `json:"max_concurrency,omitempty,string"`/g' backend/api/go_http_client/job_model/api_job.go
@@ -15,10 +15,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"time"
 
 	"github.com/kubeflow/pipelines/backend/src/agent/persistence/client"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfclientset "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	swfinformers "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/informers/externalversions"
@@ -45,6 +47,7 @@ var (
 	numWorker                     int
 	clientQPS                     float64
 	clientBurst                   int
+	tracingConfig                 tracing.Config
 )
 
 const (
@@ -61,6 +64,10 @@ const (
 	numWorkerName                         = "numWorker"
 	clientQPSFlagName                     = "clientQPS"
 	clientBurstFlagName                   = "clientBurst"
+	tracingEnabledFlagName                = "tracingEnabled"
+	otlpEndpointFlagName                  = "otlpEndpoint"
+	otlpInsecureFlagName                  = "otlpInsecure"
+	tracingSampleRatioFlagName            = "tracingSampleRatio"
 )
 
 func main() {
@@ -69,6 +76,12 @@ func main() {
 	// set up signals so we handle the first shutdown signal gracefully
 	stopCh := signals.SetupSignalHandler()
 
+	shutdownTracing, err := tracing.Init("ml-pipeline-persistenceagent", tracingConfig)
+	if err != nil {
+		log.Fatalf("Error initializing tracing: %s", err.Error())
+	}
+	defer shutdownTracing(context.Background())
+
 	cfg, err := clientcmd.BuildConfigFromFlags(masterURL, kubeconfig)
 	if err != nil {
 		log.Fatalf("Error building kubeconfig: %s", err.Error())
@@ -138,4 +151,8 @@ func init() {
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientQPS, clientQPSFlagName, 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientBurst, clientBurstFlagName, 10, "Maximum burst for throttle from this client.")
+	flag.BoolVar(&tracingConfig.Enabled, tracingEnabledFlagName, false, "Whether to export traces over OTLP.")
+	flag.StringVar(&tracingConfig.Endpoint, otlpEndpointFlagName, "", "The host:port of the OTLP gRPC receiver of the traces.")
+	flag.BoolVar(&tracingConfig.Insecure, otlpInsecureFlagName, true, "Whether to disable TLS for the connection to the OTLP receiver.")
+	flag.Float64Var(&tracingConfig.SampleRatio, tracingSampleRatioFlagName, 1, "The fraction of the traces started by the persistence agent which are sampled.")
 }
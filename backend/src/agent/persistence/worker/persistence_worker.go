@@ -15,12 +15,15 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	errorutil "github.com/kubeflow/pipelines/backend/src/common/util"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/client-go/tools/cache"
@@ -214,7 +217,9 @@ func (p *PersistenceWorker) processNextWorkItem() bool {
 
 // syncHandler picks items from the queue and passes them to the saver, which,
 // in turn, calls Report[Scheduled]Workflow to sync it with the DB
-func (p *PersistenceWorker) syncHandler(key string) error {
+func (p *PersistenceWorker) syncHandler(key string) (err error) {
+	_, span := tracing.StartSpan(context.Background(), "PersistenceWorker.syncHandler", attribute.String("persistence.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
 
 	// Convert the namespace/name string into a distinct namespace and name
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"path"
 	"regexp"
 	"strings"
 	"time"
@@ -39,13 +41,29 @@ const (
 type ExtractLogOptions struct {
 	LogFormat  LogFormat
 	Timestamps bool
+	// Container selects the log of a container when the archive holds the
+	// logs of several containers, one file per container, named after it.
+	Container string
+	// TailLines, if set, keeps only the given number of lines at the end of
+	// the log.
+	TailLines *int64
+	// SinceTime, if set, drops the lines logged before the given time. Lines
+	// without a timestamp are kept.
+	SinceTime *time.Time
 }
 
 type LogArchiveInterface interface {
 	GetLogObjectKey(workflow *util.Workflow, nodeId string) (string, error)
+	GetLogFolderKey(workflowName string) (string, error)
 	CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error
+	// Open the raw log of a container in a task run archived log, to be copied
+	// with CopyLog.
+	OpenLogFromArchive(logContent []byte, container string) (io.Reader, error)
 }
 
+// The container of the main step of a task, whose log is read by default.
+const DefaultLogContainer = "step-main"
+
 // Log Archive
 type RunLogEntry struct {
 	Log       string    `json:"log"`
@@ -76,46 +94,97 @@ func (a *LogArchive) GetLogObjectKey(workflow *util.Workflow, nodeID string) (ke
 	return
 }
 
+// GetLogFolderKey returns the folder that contains the archived logs of all
+// the nodes of a workflow.
+func (a *LogArchive) GetLogFolderKey(workflowName string) (key string, err error) {
+	if a.logPathPrefix == "" || workflowName == "" {
+		err = fmt.Errorf("invalid log archive configuration: %v", a)
+	} else {
+		key = strings.Join([]string{a.logPathPrefix, workflowName}, "/")
+	}
+	return
+}
+
 // CopyLogFromArchive copies a task run archived log into expected format.
 func (a *LogArchive) CopyLogFromArchive(logContent []byte, dst io.Writer, opts ExtractLogOptions) error {
-	reader, err := decompressLogArchive(logContent)
+	reader, err := a.OpenLogFromArchive(logContent, opts.Container)
 	if err != nil {
 		return err
 	}
+	return CopyLog(reader, dst, opts)
+}
 
-	scanner := bufio.NewScanner(reader)
+// OpenLogFromArchive opens the raw log of a container in a task run archived
+// log. It fails if the archive holds no log of the container.
+func (a *LogArchive) OpenLogFromArchive(logContent []byte, container string) (io.Reader, error) {
+	return decompressLogArchive(logContent, container)
+}
+
+// CopyLog copies log lines, either raw, prefixed by their timestamp or in JSON,
+// into expected format.
+func CopyLog(src io.Reader, dst io.Writer, opts ExtractLogOptions) error {
+	var tail [][]byte
+	var line bytes.Buffer
+	scanner := bufio.NewScanner(src)
 	for scanner.Scan() {
 		// line := strings.Trim(scanner.LogFormatText(), "\n\r\t ")
 		bytes := scanner.Bytes()
 		if len(bytes) == 0 {
 			continue
 		}
-		var err error
-		var entry RunLogEntry
-		if json.Unmarshal(bytes, &entry) == nil {
-			if opts.LogFormat == LogFormatJSON {
-				err = writeBytesLn(dst, bytes)
-			} else if opts.Timestamps && !entry.Timestamp.IsZero() {
-				_, err = fmt.Fprintf(dst, "%s %s\n", entry.Timestamp.Format(time.RFC3339), entry.Log)
-			} else {
-				_, err = fmt.Fprintln(dst, entry.Log)
-			}
-		} else if result := crioLogPrefixExp.FindSubmatch(bytes); result != nil && len(result) == 4 {
-			err = writeLogLn(dst, result[3], result[1], opts)
-		} else if result := k8sLogPrefixExp.FindSubmatch(bytes); result != nil && len(result) == 3 {
-			err = writeLogLn(dst, result[2], result[1], opts)
-		} else {
-			err = writeLogLn(dst, bytes, nil, opts)
-		}
+		line.Reset()
+		timestamp, err := writeLogLine(&line, bytes, opts)
 		if err != nil {
 			return util.NewInternalServerError(err, "error in parsing the log lines")
 		}
+		if opts.SinceTime != nil && !timestamp.IsZero() && timestamp.Before(*opts.SinceTime) {
+			continue
+		}
+		if opts.TailLines == nil {
+			if _, err := dst.Write(line.Bytes()); err != nil {
+				return util.NewInternalServerError(err, "error in writing the log lines")
+			}
+			continue
+		}
+		tail = append(tail, append([]byte(nil), line.Bytes()...))
+		if int64(len(tail)) > *opts.TailLines {
+			tail = tail[1:]
+		}
+	}
+	for _, line := range tail {
+		if _, err := dst.Write(line); err != nil {
+			return util.NewInternalServerError(err, "error in writing the log lines")
+		}
 	}
-
 	return nil
 }
 
-func decompressLogArchive(logContent []byte) (reader io.Reader, err error) {
+// writeLogLine writes a log line into expected format, and returns its
+// timestamp, which is zero when the line has none.
+func writeLogLine(dst io.Writer, line []byte, opts ExtractLogOptions) (timestamp time.Time, err error) {
+	var entry RunLogEntry
+	if json.Unmarshal(line, &entry) == nil {
+		if opts.LogFormat == LogFormatJSON {
+			err = writeBytesLn(dst, line)
+		} else if opts.Timestamps && !entry.Timestamp.IsZero() {
+			_, err = fmt.Fprintf(dst, "%s %s\n", entry.Timestamp.Format(time.RFC3339), entry.Log)
+		} else {
+			_, err = fmt.Fprintln(dst, entry.Log)
+		}
+		return entry.Timestamp, err
+	} else if result := crioLogPrefixExp.FindSubmatch(line); result != nil && len(result) == 4 {
+		timestamp, _ = time.Parse(time.RFC3339, string(result[1]))
+		err = writeLogLn(dst, result[3], result[1], opts)
+	} else if result := k8sLogPrefixExp.FindSubmatch(line); result != nil && len(result) == 3 {
+		timestamp, _ = time.Parse(time.RFC3339, string(result[1]))
+		err = writeLogLn(dst, result[2], result[1], opts)
+	} else {
+		err = writeLogLn(dst, line, nil, opts)
+	}
+	return timestamp, err
+}
+
+func decompressLogArchive(logContent []byte, container string) (reader io.Reader, err error) {
 	// Decompress tar archive
 	compressedReader := bytes.NewReader(logContent)
 	decompressedLogs, gzipErr := gzip.NewReader(compressedReader)
@@ -134,11 +203,52 @@ func decompressLogArchive(logContent []byte) (reader io.Reader, err error) {
 		decompressedLogs.Reset(compressedReader)
 		reader = decompressedLogs
 	} else {
-		reader = archiveReader
+		reader, err = selectLogArchiveEntry(archiveReader, header, container)
 	}
 	return
 }
 
+// selectLogArchiveEntry returns the entry of a tar archive holding the log of
+// a container, which is named after the container, e.g. step-main.log. An
+// archive of a single entry holds the log of the only archived container,
+// which is read as the log of the default container, but not of another
+// container requested explicitly.
+func selectLogArchiveEntry(archiveReader *tar.Reader, header *tar.Header, container string) (io.Reader, error) {
+	if container == "" {
+		return archiveReader, nil
+	}
+	var first []byte
+	entries := 0
+	for {
+		if header.Typeflag == tar.TypeReg {
+			name := path.Base(header.Name)
+			if strings.TrimSuffix(name, path.Ext(name)) == container {
+				return archiveReader, nil
+			}
+			entries++
+			if entries == 1 {
+				content, err := ioutil.ReadAll(archiveReader)
+				if err != nil {
+					return nil, util.NewInternalServerError(err, "Failed to read the archived log file")
+				}
+				first = content
+			}
+		}
+		var err error
+		header, err = archiveReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to read the archived log file")
+		}
+	}
+	if entries == 1 && container == DefaultLogContainer {
+		return bytes.NewReader(first), nil
+	}
+	return nil, util.NewResourceNotFoundError("Archived log of container", container)
+}
+
 func writeLogLn(dst io.Writer, log []byte, timestamp []byte, opts ExtractLogOptions) (err error) {
 	if opts.LogFormat == LogFormatJSON {
 		var ts time.Time
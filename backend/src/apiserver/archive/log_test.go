@@ -15,6 +15,7 @@
 package archive
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"compress/gzip"
@@ -22,11 +23,31 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"google.golang.org/grpc/codes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"testing"
 	"time"
 )
 
+func archiveInput(t *testing.T, files map[string]string) []byte {
+	src := bytes.Buffer{}
+	gw := gzip.NewWriter(&src)
+	tw := tar.NewWriter(gw)
+	for _, name := range []string{"step-main.log", "step-prepare.log"} {
+		content, ok := files[name]
+		if !ok {
+			continue
+		}
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		assert.Nil(t, err)
+		_, err = tw.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gw.Close())
+	return src.Bytes()
+}
+
 func compressInput(t *testing.T, content string) []byte {
 	src := bytes.Buffer{}
 	gw := gzip.NewWriter(&src)
@@ -73,6 +94,16 @@ func TestGetLogObjectKey(t *testing.T) {
 	assert.Equal(t, "/logs/MY_NAME/node-id-98765432/main.log", key)
 }
 
+func TestGetLogFolderKey(t *testing.T) {
+	logArchive := initLogArchive()
+	key, err := logArchive.GetLogFolderKey("MY_NAME")
+	assert.Nil(t, err)
+	assert.Equal(t, "/logs/MY_NAME", key)
+
+	_, err = NewLogArchive("", "").GetLogFolderKey("MY_NAME")
+	assert.NotNil(t, err)
+}
+
 func TestGetLogObjectKey_InvalidConfig(t *testing.T) {
 	logArchive := NewLogArchive("", "")
 	_, err := logArchive.GetLogObjectKey(nil, "node-id-98765432")
@@ -273,3 +304,71 @@ func TestCopyLogFromArchive_FromCriOTextToTextWithTimestamp(t *testing.T) {
 	line = scanner.Text()
 	assert.Equal(t, "2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect", line)
 }
+
+func TestCopyLogFromArchive_TailLines(t *testing.T) {
+	logArchive := initLogArchive()
+	tailLines := int64(1)
+	opts := ExtractLogOptions{LogFormat: LogFormatText, TailLines: &tailLines}
+	dst := bytes.Buffer{}
+
+	err := logArchive.CopyLogFromArchive(compressInput(t, logCriOText), &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "[ERROR] Unable to connect\n", dst.String())
+
+	tailLines = 0
+	dst.Reset()
+	err = logArchive.CopyLogFromArchive(compressInput(t, logCriOText), &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "", dst.String())
+}
+
+func TestCopyLogFromArchive_SinceTime(t *testing.T) {
+	logArchive := initLogArchive()
+	sinceTime := logTs0.Add(time.Second)
+	opts := ExtractLogOptions{LogFormat: LogFormatText, Timestamps: true, SinceTime: &sinceTime}
+	dst := bytes.Buffer{}
+
+	err := logArchive.CopyLogFromArchive(compressInput(t, logCriOText), &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-08-31T15:00:02.260657206Z [ERROR] Unable to connect\n", dst.String())
+
+	// Lines without a timestamp are kept.
+	dst.Reset()
+	err = logArchive.CopyLogFromArchive(compressInput(t, logText), &dst, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "[ERROR] Unable to connect\n", dst.String())
+}
+
+func TestCopyLogFromArchive_Container(t *testing.T) {
+	logArchive := initLogArchive()
+	src := archiveInput(t, map[string]string{
+		"step-main.log":    "main\n",
+		"step-prepare.log": "prepare\n",
+	})
+	dst := bytes.Buffer{}
+
+	err := logArchive.CopyLogFromArchive(src, &dst, ExtractLogOptions{LogFormat: LogFormatText, Container: "step-prepare"})
+	assert.Nil(t, err)
+	assert.Equal(t, "prepare\n", dst.String())
+
+	dst.Reset()
+	err = logArchive.CopyLogFromArchive(src, &dst, ExtractLogOptions{LogFormat: LogFormatText, Container: "step-main"})
+	assert.Nil(t, err)
+	assert.Equal(t, "main\n", dst.String())
+
+	err = logArchive.CopyLogFromArchive(src, &dst, ExtractLogOptions{LogFormat: LogFormatText, Container: "step-other"})
+	assert.NotNil(t, err)
+
+	// The only archived log is the log of the default container, but not of
+	// another container.
+	dst.Reset()
+	src = archiveInput(t, map[string]string{"step-prepare.log": "prepare\n"})
+	err = logArchive.CopyLogFromArchive(src, &dst, ExtractLogOptions{LogFormat: LogFormatText, Container: DefaultLogContainer})
+	assert.Nil(t, err)
+	assert.Equal(t, "prepare\n", dst.String())
+
+	dst.Reset()
+	err = logArchive.CopyLogFromArchive(src, &dst, ExtractLogOptions{LogFormat: LogFormatText, Container: "step-other"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	assert.Empty(t, dst.String())
+}
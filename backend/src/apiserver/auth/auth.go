@@ -17,6 +17,7 @@ package auth
 import (
 	"context"
 
+	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
@@ -25,6 +26,18 @@ import (
 
 type Authenticator interface {
 	GetUserIdentity(ctx context.Context) (string, error)
+	// GetUserInfo returns the user of a request, with the groups and the extra
+	// attributes the authenticator knows of.
+	GetUserInfo(ctx context.Context) (*UserInfo, error)
+}
+
+// UserInfo is the identity of the user of a request.
+type UserInfo struct {
+	Name   string
+	Groups []string
+	// Extra holds additional attributes of the user, e.g. scopes, as found in
+	// the UserInfo of a TokenReview.
+	Extra map[string][]string
 }
 
 var IdentityHeaderMissingError = util.NewUnauthenticatedError(
@@ -32,14 +45,59 @@ var IdentityHeaderMissingError = util.NewUnauthenticatedError(
 	"Request header error: there is no user identity header.",
 )
 
+// GetAuthenticators returns the authenticators selected by config, in the
+// order they are tried.
 func GetAuthenticators(tokenReviewClient client.TokenReviewInterface) []Authenticator {
-	return []Authenticator{
-		NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()),
-		NewTokenReviewAuthenticator(
-			common.AuthorizationBearerTokenHeader,
-			common.AuthorizationBearerTokenPrefix,
-			[]string{common.GetTokenReviewAudience()},
-			tokenReviewClient,
-		),
+	var authenticators []Authenticator
+	for _, name := range common.GetAuthenticators() {
+		switch name {
+		case common.AuthenticatorHTTPHeader:
+			authenticators = append(authenticators,
+				NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()))
+		case common.AuthenticatorTokenReview:
+			authenticators = append(authenticators, NewTokenReviewAuthenticator(
+				common.AuthorizationBearerTokenHeader,
+				common.AuthorizationBearerTokenPrefix,
+				[]string{common.GetTokenReviewAudience()},
+				tokenReviewClient,
+			))
+		case common.AuthenticatorJWT:
+			authenticators = append(authenticators, newJWTAuthenticatorFromConfigOrFatal())
+		default:
+			glog.Fatalf("Unknown authenticator '%s' in %s. Expected %s, %s or %s.", name, common.Authenticators,
+				common.AuthenticatorHTTPHeader, common.AuthenticatorTokenReview, common.AuthenticatorJWT)
+		}
+	}
+	return authenticators
+}
+
+func newJWTAuthenticatorFromConfigOrFatal() *JWTAuthenticator {
+	issuer := common.GetJWTIssuer()
+	if issuer == "" {
+		glog.Fatalf("Please specify %s to authenticate JWTs", common.JWTIssuer)
+	}
+	source := common.GetJWTJWKSSource()
+	if source == "" {
+		glog.Fatalf("Please specify %s to authenticate JWTs", common.JWTJWKSSource)
+	}
+	// JWTs issued for any other service of the identity provider would be
+	// accepted without an audience.
+	audience := common.GetJWTAudience()
+	if audience == "" {
+		glog.Fatalf("Please specify %s to authenticate JWTs", common.JWTAudience)
 	}
+	return NewJWTAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		JWTAuthenticatorOptions{
+			Issuer:         issuer,
+			Audience:       audience,
+			UsernameClaim:  common.GetJWTUsernameClaim(),
+			UsernamePrefix: common.GetJWTUsernamePrefix(),
+			GroupsClaim:    common.GetJWTGroupsClaim(),
+			GroupsPrefix:   common.GetJWTGroupsPrefix(),
+		},
+		NewJWKSKeySet(source, common.GetJWTJWKSRefreshInterval(), util.NewRealTime()),
+		util.NewRealTime(),
+	)
 }
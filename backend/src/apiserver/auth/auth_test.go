@@ -0,0 +1,51 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuthenticators(t *testing.T) {
+	authenticators := GetAuthenticators(client.NewFakeTokenReviewClient())
+	assert.Len(t, authenticators, 2)
+	assert.IsType(t, &HTTPHeaderAuthenticator{}, authenticators[0])
+	assert.IsType(t, &TokenReviewAuthenticator{}, authenticators[1])
+
+	viper.Set(common.Authenticators, "jwt, token-review")
+	viper.Set(common.JWTIssuer, testIssuer)
+	viper.Set(common.JWTJWKSSource, "https://issuer.example.com/jwks")
+	defer func() {
+		viper.Set(common.Authenticators, nil)
+		viper.Set(common.JWTIssuer, nil)
+		viper.Set(common.JWTJWKSSource, nil)
+	}()
+	authenticators = GetAuthenticators(client.NewFakeTokenReviewClient())
+	assert.Len(t, authenticators, 2)
+	assert.IsType(t, &JWTAuthenticator{}, authenticators[0])
+	assert.IsType(t, &TokenReviewAuthenticator{}, authenticators[1])
+	jwtAuthenticator := authenticators[0].(*JWTAuthenticator)
+	assert.Equal(t, JWTAuthenticatorOptions{
+		Issuer:        testIssuer,
+		Audience:      common.DefaultTokenReviewAudience,
+		UsernameClaim: "email",
+		GroupsClaim:   "groups",
+	}, jwtAuthenticator.options)
+}
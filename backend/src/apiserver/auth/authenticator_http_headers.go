@@ -34,3 +34,12 @@ func (ha *HTTPHeaderAuthenticator) GetUserIdentity(ctx context.Context) (string,
 	}
 	return userID, nil
 }
+
+// GetUserInfo returns the user of a request. The header carries no groups.
+func (ha *HTTPHeaderAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	userID, err := ha.GetUserIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UserInfo{Name: userID}, nil
+}
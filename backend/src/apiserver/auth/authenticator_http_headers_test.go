@@ -58,3 +58,14 @@ func TestHTTPHeaderAuthenticatorFromHeaderNonGoogle(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "user", userIdentity)
 }
+
+func TestHTTPHeaderAuthenticatorUserInfo(t *testing.T) {
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	authenticator := NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix())
+
+	userInfo, err := authenticator.GetUserInfo(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "user@google.com"}, userInfo)
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// jwtClockSkew is the clock skew tolerated when checking the validity period
+// of JWTs.
+const jwtClockSkew = time.Minute
+
+// JWTAuthenticatorOptions configures how the claims of JWTs are checked and
+// mapped to users.
+type JWTAuthenticatorOptions struct {
+	// Issuer is the issuer JWTs must be issued by.
+	Issuer string
+	// Audience is the audience JWTs must be issued for.
+	Audience string
+	// UsernameClaim is the claim holding the user name, e.g. sub or email.
+	UsernameClaim string
+	// UsernamePrefix is prepended to the user name.
+	UsernamePrefix string
+	// GroupsClaim is the claim holding the groups of the user, if any.
+	GroupsClaim string
+	// GroupsPrefix is prepended to each group.
+	GroupsPrefix string
+}
+
+// JWTAuthenticator authenticates requests by OIDC JWTs, e.g. ID tokens, whose
+// signatures are verified locally with the keys of a JSON Web Key Set.
+type JWTAuthenticator struct {
+	// tokenHeader in which the authenticator expects to find the JWT
+	tokenHeader string
+	// tokenPrefix is the prefix encountered before the token
+	tokenPrefix string
+	options     JWTAuthenticatorOptions
+	keySet      *JWKSKeySet
+	time        util.TimeInterface
+}
+
+func NewJWTAuthenticator(tokenHeader, tokenPrefix string, options JWTAuthenticatorOptions, keySet *JWKSKeySet, clock util.TimeInterface) *JWTAuthenticator {
+	return &JWTAuthenticator{
+		tokenHeader: tokenHeader,
+		tokenPrefix: tokenPrefix,
+		options:     options,
+		keySet:      keySet,
+		time:        clock,
+	}
+}
+
+func (ja *JWTAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
+	userInfo, err := ja.GetUserInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	return userInfo.Name, nil
+}
+
+// GetUserInfo returns the user and the groups of the JWT of a request.
+func (ja *JWTAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, ja.tokenHeader, ja.tokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := ja.verify(token)
+	if err != nil {
+		return nil, util.Wrap(err, "Authentication failure")
+	}
+	return ja.mapClaims(claims)
+}
+
+// verify verifies the signature and the registered claims of a JWT, and
+// returns its claims.
+func (ja *JWTAuthenticator) verify(token string) (map[string]interface{}, error) {
+	t, err := parseJWT(token)
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(err, "Failed to verify the JWT: the token is malformed")
+	}
+	if !isSupportedJWTAlgorithm(t.header.Alg) {
+		return nil, util.NewUnauthenticatedError(
+			errors.Errorf("Unsupported JWT algorithm '%s'", t.header.Alg),
+			"Failed to verify the JWT: unsupported signing algorithm '%s'", t.header.Alg)
+	}
+	keys, err := ja.keySet.getKeys(t.header.Kid, t.header.Alg)
+	if err != nil {
+		return nil, err
+	}
+	verified := false
+	for _, key := range keys {
+		if err = verifyJWTSignature(t, key.key); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, util.NewUnauthenticatedError(err, "Failed to verify the JWT: invalid signature")
+	}
+
+	if issuer, _ := t.claims["iss"].(string); issuer != ja.options.Issuer {
+		return nil, util.NewUnauthenticatedError(
+			errors.Errorf("Unexpected JWT issuer '%s'", issuer),
+			"Failed to verify the JWT: expected issuer '%s', got '%s'", ja.options.Issuer, issuer)
+	}
+	if !containsAudience(t.claims["aud"], ja.options.Audience) {
+		return nil, util.NewUnauthenticatedError(
+			errors.Errorf("Unexpected JWT audience %v", t.claims["aud"]),
+			"Failed to verify the JWT: audience '%s' not found in %v", ja.options.Audience, t.claims["aud"])
+	}
+	now := ja.time.Now()
+	expiry, ok := numericDate(t.claims["exp"])
+	if !ok {
+		return nil, util.NewUnauthenticatedError(errors.New("Missing JWT expiry"), "Failed to verify the JWT: the token has no expiry")
+	}
+	if now.After(expiry.Add(jwtClockSkew)) {
+		return nil, util.NewUnauthenticatedError(errors.New("Expired JWT"), "Failed to verify the JWT: the token expired at %v", expiry)
+	}
+	if notBefore, ok := numericDate(t.claims["nbf"]); ok && now.Before(notBefore.Add(-jwtClockSkew)) {
+		return nil, util.NewUnauthenticatedError(errors.New("JWT not valid yet"), "Failed to verify the JWT: the token is not valid before %v", notBefore)
+	}
+	return t.claims, nil
+}
+
+// mapClaims maps the claims of a verified JWT to a user and groups.
+func (ja *JWTAuthenticator) mapClaims(claims map[string]interface{}) (*UserInfo, error) {
+	name, _ := claims[ja.options.UsernameClaim].(string)
+	if name == "" {
+		return nil, util.NewUnauthenticatedError(
+			errors.Errorf("Missing JWT claim '%s'", ja.options.UsernameClaim),
+			"Failed to authenticate the JWT: the user name claim '%s' is missing", ja.options.UsernameClaim)
+	}
+	// Like Kubernetes, unverified emails are not trusted as user names.
+	if ja.options.UsernameClaim == "email" {
+		if verified, ok := claims["email_verified"].(bool); ok && !verified {
+			return nil, util.NewUnauthenticatedError(
+				errors.New("Unverified email in JWT"),
+				"Failed to authenticate the JWT: the email '%s' is not verified", name)
+		}
+	}
+	userInfo := &UserInfo{Name: ja.options.UsernamePrefix + name}
+
+	if ja.options.GroupsClaim == "" {
+		return userInfo, nil
+	}
+	switch groups := claims[ja.options.GroupsClaim].(type) {
+	case string:
+		userInfo.Groups = []string{ja.options.GroupsPrefix + groups}
+	case []interface{}:
+		for _, group := range groups {
+			g, ok := group.(string)
+			if !ok {
+				return nil, util.NewUnauthenticatedError(
+					errors.Errorf("Invalid JWT claim '%s'", ja.options.GroupsClaim),
+					"Failed to authenticate the JWT: the groups claim '%s' is not a list of strings", ja.options.GroupsClaim)
+			}
+			userInfo.Groups = append(userInfo.Groups, ja.options.GroupsPrefix+g)
+		}
+	}
+	return userInfo, nil
+}
+
+// containsAudience returns whether the aud claim, a string or a list of
+// strings, contains an audience.
+func containsAudience(claim interface{}, audience string) bool {
+	switch aud := claim.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// numericDate parses a claim of seconds since the epoch.
+func numericDate(claim interface{}) (time.Time, bool) {
+	number, ok := claim.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, int64(seconds*float64(time.Second))), true
+}
@@ -0,0 +1,361 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+const testIssuer = "https://issuer.example.com"
+
+var testNow = time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+var (
+	testKeysOnce sync.Once
+	testRSAKey   *rsa.PrivateKey
+	testRSAKey2  *rsa.PrivateKey
+	testECKey    *ecdsa.PrivateKey
+)
+
+func testKeys(t *testing.T) {
+	testKeysOnce.Do(func() {
+		var err error
+		testRSAKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		assert.Nil(t, err)
+		testRSAKey2, err = rsa.GenerateKey(rand.Reader, 2048)
+		assert.Nil(t, err)
+		testECKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.Nil(t, err)
+	})
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   encodeSegment(key.N.Bytes()),
+		"e":   encodeSegment(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   encodeSegment(key.X.Bytes()),
+		"y":   encodeSegment(key.Y.Bytes()),
+	}
+}
+
+func jwks(t *testing.T, keys ...map[string]string) []byte {
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	assert.Nil(t, err)
+	return data
+}
+
+// signJWT signs claims with an RSA or EC key, with the given algorithm.
+func signJWT(t *testing.T, alg string, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	assert.Nil(t, err)
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+	signingInput := encodeSegment(header) + "." + encodeSegment(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if strings.HasPrefix(alg, "PS") {
+			signature, err = rsa.SignPSS(rand.Reader, k, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	}
+	assert.Nil(t, err)
+	return signingInput + "." + encodeSegment(signature)
+}
+
+func testClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":            testIssuer,
+		"aud":            []string{"other", common.DefaultTokenReviewAudience},
+		"sub":            "1234",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"groups":         []string{"admins", "users"},
+		"exp":            testNow.Add(time.Hour).Unix(),
+		"iat":            testNow.Unix(),
+	}
+}
+
+func bearerContext(token string) context.Context {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + token})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// newTestJWTAuthenticator creates a JWT authenticator whose key set is loaded
+// from a file holding the RSA key rsa1 and the EC key ec1.
+func newTestJWTAuthenticator(t *testing.T, options JWTAuthenticatorOptions) (*JWTAuthenticator, *testClock) {
+	testKeys(t)
+	dir, err := ioutil.TempDir("", "jwks")
+	assert.Nil(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "jwks.json")
+	assert.Nil(t, ioutil.WriteFile(path, jwks(t, rsaJWK("rsa1", testRSAKey), ecJWK("ec1", testECKey)), 0600))
+
+	clock := &testClock{now: testNow}
+	options.Issuer = testIssuer
+	options.Audience = common.DefaultTokenReviewAudience
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = "email"
+	}
+	keySet := NewJWKSKeySet(path, time.Hour, clock)
+	return NewJWTAuthenticator(common.AuthorizationBearerTokenHeader, common.AuthorizationBearerTokenPrefix, options, keySet, clock), clock
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	authenticator, _ := newTestJWTAuthenticator(t, JWTAuthenticatorOptions{GroupsClaim: "groups", GroupsPrefix: "oidc:"})
+
+	for _, token := range []string{
+		signJWT(t, "RS256", "rsa1", testRSAKey, testClaims()),
+		signJWT(t, "PS256", "rsa1", testRSAKey, testClaims()),
+		signJWT(t, "ES256", "ec1", testECKey, testClaims()),
+	} {
+		userInfo, err := authenticator.GetUserInfo(bearerContext(token))
+		assert.Nil(t, err)
+		assert.Equal(t, &UserInfo{Name: "user@example.com", Groups: []string{"oidc:admins", "oidc:users"}}, userInfo)
+	}
+
+	userIdentity, err := authenticator.GetUserIdentity(bearerContext(signJWT(t, "RS256", "rsa1", testRSAKey, testClaims())))
+	assert.Nil(t, err)
+	assert.Equal(t, "user@example.com", userIdentity)
+}
+
+func TestJWTAuthenticator_UsernameClaim(t *testing.T) {
+	authenticator, _ := newTestJWTAuthenticator(t, JWTAuthenticatorOptions{UsernameClaim: "sub", UsernamePrefix: "oidc:"})
+
+	claims := testClaims()
+	claims["email_verified"] = false
+	userInfo, err := authenticator.GetUserInfo(bearerContext(signJWT(t, "RS256", "rsa1", testRSAKey, claims)))
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{Name: "oidc:1234"}, userInfo)
+}
+
+func TestJWTAuthenticator_Invalid(t *testing.T) {
+	authenticator, _ := newTestJWTAuthenticator(t, JWTAuthenticatorOptions{GroupsClaim: "groups"})
+
+	withClaim := func(name string, value interface{}) map[string]interface{} {
+		claims := testClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+	none := encodeSegment([]byte(`{"alg":"none"}`)) + "." + encodeSegment([]byte(`{"iss":"`+testIssuer+`"}`)) + "."
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"malformed", "token", "the token is malformed"},
+		{"none", none, "unsupported signing algorithm 'none'"},
+		{"unknown key", signJWT(t, "RS256", "rsa2", testRSAKey2, testClaims()), "the signing key is unknown"},
+		{"wrong key", signJWT(t, "RS256", "rsa1", testRSAKey2, testClaims()), "invalid signature"},
+		{"wrong issuer", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("iss", "https://other.example.com")), "expected issuer"},
+		{"wrong audience", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("aud", "other")), "audience 'pipelines.kubeflow.org' not found"},
+		{"no expiry", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("exp", nil)), "the token has no expiry"},
+		{"expired", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("exp", testNow.Add(-2*time.Minute).Unix())), "the token expired"},
+		{"not valid yet", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("nbf", testNow.Add(2*time.Minute).Unix())), "not valid before"},
+		{"no user name", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("email", nil)), "the user name claim 'email' is missing"},
+		{"unverified email", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("email_verified", false)), "is not verified"},
+		{"invalid groups", signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("groups", []int{1})), "is not a list of strings"},
+	}
+	for _, test := range tests {
+		_, err := authenticator.GetUserIdentity(bearerContext(test.token))
+		assert.NotNil(t, err, test.name)
+		if err != nil {
+			assert.Contains(t, err.Error(), test.wantErr, test.name)
+		}
+	}
+
+	// Within the tolerated clock skew, tokens are still valid.
+	_, err := authenticator.GetUserIdentity(bearerContext(signJWT(t, "RS256", "rsa1", testRSAKey, withClaim("exp", testNow.Add(-30*time.Second).Unix()))))
+	assert.Nil(t, err)
+}
+
+func TestJWKSKeySet_Rotation(t *testing.T) {
+	testKeys(t)
+	var mu sync.Mutex
+	keys := jwks(t, rsaJWK("rsa1", testRSAKey))
+	loads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		loads++
+		w.Write(keys)
+	}))
+	defer server.Close()
+
+	clock := &testClock{now: testNow}
+	authenticator := NewJWTAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		JWTAuthenticatorOptions{Issuer: testIssuer, Audience: common.DefaultTokenReviewAudience, UsernameClaim: "sub"},
+		NewJWKSKeySet(server.URL, 10*time.Minute, clock),
+		clock,
+	)
+	token1 := signJWT(t, "RS256", "rsa1", testRSAKey, testClaims())
+	token2 := signJWT(t, "RS256", "rsa2", testRSAKey2, testClaims())
+
+	_, err := authenticator.GetUserIdentity(bearerContext(token1))
+	assert.Nil(t, err)
+	_, err = authenticator.GetUserIdentity(bearerContext(token1))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, loads)
+
+	// The identity provider rotates its key.
+	mu.Lock()
+	keys = jwks(t, rsaJWK("rsa1", testRSAKey), rsaJWK("rsa2", testRSAKey2))
+	mu.Unlock()
+
+	// Unknown keys only trigger a reload once in a while.
+	_, err = authenticator.GetUserIdentity(bearerContext(token2))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, loads)
+	clock.advance(2 * time.Minute)
+	_, err = authenticator.GetUserIdentity(bearerContext(token2))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, loads)
+
+	// The previous keys are kept when the key set cannot be reloaded.
+	mu.Lock()
+	keys = []byte("not json")
+	mu.Unlock()
+	clock.advance(15 * time.Minute)
+	_, err = authenticator.GetUserIdentity(bearerContext(token2))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, loads)
+}
+
+func TestJWKSKeySet_LookupNotBlockedByLoad(t *testing.T) {
+	testKeys(t)
+	fetching, release := make(chan struct{}), make(chan struct{})
+	var mu sync.Mutex
+	loads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		loads++
+		reload := loads > 1
+		mu.Unlock()
+		if reload {
+			close(fetching)
+			<-release
+		}
+		w.Write(jwks(t, rsaJWK("rsa1", testRSAKey), rsaJWK("rsa2", testRSAKey2)))
+	}))
+	defer server.Close()
+
+	clock := &testClock{now: testNow}
+	authenticator := NewJWTAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		JWTAuthenticatorOptions{Issuer: testIssuer, Audience: common.DefaultTokenReviewAudience, UsernameClaim: "sub"},
+		NewJWKSKeySet(server.URL, 10*time.Minute, clock),
+		clock,
+	)
+	token1 := signJWT(t, "RS256", "rsa1", testRSAKey, testClaims())
+	_, err := authenticator.GetUserIdentity(bearerContext(token1))
+	assert.Nil(t, err)
+
+	// A token signed by an unknown key reloads the key set, slowly.
+	clock.advance(2 * time.Minute)
+	reloaded := make(chan error)
+	go func() {
+		_, err := authenticator.GetUserIdentity(bearerContext(signJWT(t, "RS256", "rsa3", testRSAKey2, testClaims())))
+		reloaded <- err
+	}()
+	<-fetching
+
+	// Tokens signed by known keys are verified in the meantime.
+	verified := make(chan error)
+	go func() {
+		_, err := authenticator.GetUserIdentity(bearerContext(token1))
+		verified <- err
+	}()
+	select {
+	case err := <-verified:
+		assert.Nil(t, err)
+	case <-time.After(10 * time.Second):
+		t.Error("The verification of a token signed by a known key waited for the key set to be loaded")
+	}
+	close(release)
+	assert.NotNil(t, <-reloaded)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, loads)
+}
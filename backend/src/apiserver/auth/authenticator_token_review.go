@@ -46,16 +46,33 @@ func NewTokenReviewAuthenticator(tokenHeader, tokenPrefix string, audiences []st
 }
 
 func (tra *TokenReviewAuthenticator) GetUserIdentity(ctx context.Context) (string, error) {
-	token, err := singlePrefixedHeaderFromMetadata(ctx, tra.tokenHeader, tra.tokenPrefix)
+	userInfo, err := tra.GetUserInfo(ctx)
 	if err != nil {
 		return "", err
 	}
+	return userInfo.Name, nil
+}
+
+// GetUserInfo returns the user of a request, with the groups and the extra
+// attributes reported by the TokenReview.
+func (tra *TokenReviewAuthenticator) GetUserInfo(ctx context.Context) (*UserInfo, error) {
+	token, err := singlePrefixedHeaderFromMetadata(ctx, tra.tokenHeader, tra.tokenPrefix)
+	if err != nil {
+		return nil, err
+	}
 
-	userInfo, err := tra.doTokenReview(token)
+	user, err := tra.doTokenReview(token)
 	if err != nil {
-		return "", util.Wrap(err, "Authentication failure")
+		return nil, util.Wrap(err, "Authentication failure")
+	}
+	userInfo := &UserInfo{Name: user.Username, Groups: user.Groups}
+	if len(user.Extra) > 0 {
+		userInfo.Extra = make(map[string][]string, len(user.Extra))
+		for key, value := range user.Extra {
+			userInfo.Extra[key] = []string(value)
+		}
 	}
-	return userInfo.Username, err
+	return userInfo, nil
 }
 
 // ensureAudience makes sure all audience of the authenticator is found in the provided audience list
@@ -41,6 +41,26 @@ func TestTokenReviewAuthenticatorAuthenticated(t *testing.T) {
 	assert.Equal(t, "test", userIdentity)
 }
 
+func TestTokenReviewAuthenticatorUserInfo(t *testing.T) {
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + "token"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	authenticator := NewTokenReviewAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		[]string{common.GetTokenReviewAudience()},
+		client.NewFakeTokenReviewClient(),
+	)
+
+	userInfo, err := authenticator.GetUserInfo(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, &UserInfo{
+		Name:   "test",
+		Groups: []string{"test-group"},
+		Extra:  map[string][]string{"scopes": {"read"}},
+	}, userInfo)
+}
+
 func TestTokenReviewAuthenticatorAuthenticatedWrongAudience(t *testing.T) {
 	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + "token"})
 	ctx := metadata.NewIncomingContext(context.Background(), md)
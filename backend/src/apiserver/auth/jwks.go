@@ -0,0 +1,263 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// minJWKSReloadInterval limits how often an unknown key ID triggers loading
+// the key set again, so that tokens signed by unknown keys cannot overload the
+// identity provider.
+const minJWKSReloadInterval = time.Minute
+
+// jsonWebKey is a public key of a JSON Web Key Set, as defined by RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys.
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey is a parsed signing key of a key set.
+type publicKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// JWKSKeySet is a JSON Web Key Set loaded from a URL or a file. It is loaded
+// on first use, then again every refresh interval, and when a token is signed
+// by an unknown key, so that rotated keys are picked up.
+type JWKSKeySet struct {
+	source          string
+	refreshInterval time.Duration
+	httpClient      *http.Client
+	time            util.TimeInterface
+
+	// loadMu serializes the loads of the key set. It is not held by the
+	// lookups of keys, which are not blocked by a slow identity provider.
+	loadMu sync.Mutex
+
+	mu       sync.Mutex
+	keys     []publicKey
+	loadedAt time.Time
+}
+
+// NewJWKSKeySet creates a key set loaded from an http(s) URL or a file path.
+func NewJWKSKeySet(source string, refreshInterval time.Duration, clock util.TimeInterface) *JWKSKeySet {
+	return &JWKSKeySet{
+		source:          source,
+		refreshInterval: refreshInterval,
+		httpClient:      &http.Client{Timeout: 10 * time.Second},
+		time:            clock,
+	}
+}
+
+// getKeys returns the keys which may have signed a token, by key ID and
+// algorithm.
+func (s *JWKSKeySet) getKeys(kid string, alg string) ([]publicKey, error) {
+	now := s.time.Now()
+	keys, loadedAt := s.lookupKeys(kid, alg)
+	if loadedAt.IsZero() || now.Sub(loadedAt) >= s.refreshInterval {
+		if err := s.load(now); err != nil {
+			return nil, err
+		}
+		keys, loadedAt = s.lookupKeys(kid, alg)
+	}
+	if len(keys) == 0 && now.Sub(loadedAt) >= minJWKSReloadInterval {
+		// The key may have been rotated since the key set was loaded.
+		if err := s.load(now); err != nil {
+			return nil, err
+		}
+		keys, _ = s.lookupKeys(kid, alg)
+	}
+	if len(keys) == 0 {
+		return nil, util.NewUnauthenticatedError(
+			errors.Errorf("No key of ID '%s' for algorithm %s in the JSON Web Key Set", kid, alg),
+			"Failed to verify the JWT: the signing key is unknown")
+	}
+	return keys, nil
+}
+
+// lookupKeys returns the keys which may have signed a token, and when the key
+// set was loaded.
+func (s *JWKSKeySet) lookupKeys(kid string, alg string) ([]publicKey, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.findKeys(kid, alg), s.loadedAt
+}
+
+func (s *JWKSKeySet) findKeys(kid string, alg string) []publicKey {
+	var keys []publicKey
+	for _, key := range s.keys {
+		if kid != "" && key.kid != kid {
+			continue
+		}
+		if key.alg != "" && key.alg != alg {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// load loads the key set, unless it was loaded by another call since now.
+// The key set is read without holding the lock of the keys, and swapped under
+// it. When the key set cannot be loaded but was loaded before, the previous
+// keys are kept until the next refresh.
+func (s *JWKSKeySet) load(now time.Time) error {
+	s.loadMu.Lock()
+	defer s.loadMu.Unlock()
+	s.mu.Lock()
+	loaded := !s.loadedAt.Before(now)
+	s.mu.Unlock()
+	if loaded {
+		return nil
+	}
+
+	data, err := s.read()
+	var keys []publicKey
+	if err == nil {
+		keys, err = parseJWKS(data)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		if s.keys != nil {
+			glog.Warningf("Failed to reload the JSON Web Key Set from %s, keeping the previous keys: %v", s.source, err)
+			s.loadedAt = now
+			return nil
+		}
+		return util.NewInternalServerError(err, "Failed to load the JSON Web Key Set from %s", s.source)
+	}
+	s.keys = keys
+	s.loadedAt = now
+	return nil
+}
+
+func (s *JWKSKeySet) read() ([]byte, error) {
+	if !strings.HasPrefix(s.source, "http://") && !strings.HasPrefix(s.source, "https://") {
+		return ioutil.ReadFile(s.source)
+	}
+	response, err := s.httpClient.Get(s.source)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %s", response.Status)
+	}
+	return ioutil.ReadAll(response.Body)
+}
+
+// parseJWKS parses the signing keys of a JSON Web Key Set. Keys of other
+// types or uses are skipped.
+func parseJWKS(data []byte) ([]publicKey, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, errors.Wrap(err, "invalid JSON Web Key Set")
+	}
+	keys := make([]publicKey, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		var key crypto.PublicKey
+		var err error
+		switch jwk.Kty {
+		case "RSA":
+			key, err = parseRSAKey(jwk)
+		case "EC":
+			key, err = parseECKey(jwk)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key '%s' in JSON Web Key Set", jwk.Kid)
+		}
+		keys = append(keys, publicKey{kid: jwk.Kid, alg: jwk.Alg, key: key})
+	}
+	return keys, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+	exponent := 0
+	for _, b := range e {
+		exponent = exponent<<8 | int(b)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}, nil
+}
+
+func parseECKey(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, errors.New("invalid x coordinate")
+	}
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, errors.New("invalid y coordinate")
+	}
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point is not on the curve")
+	}
+	return key, nil
+}
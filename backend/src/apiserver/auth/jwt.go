@@ -0,0 +1,133 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// jwtHeader is the JOSE header of a JWT.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// jwt is a signed JWT in compact serialization, whose signature is not
+// verified yet.
+type jwt struct {
+	header       jwtHeader
+	claims       map[string]interface{}
+	signingInput string
+	signature    []byte
+}
+
+func parseJWT(token string) (*jwt, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("the token is not a JWT in compact serialization")
+	}
+	var t jwt
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid header encoding")
+	}
+	if err := json.Unmarshal(header, &t.header); err != nil {
+		return nil, errors.Wrap(err, "invalid header")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid payload encoding")
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(payload)))
+	// Numeric dates are kept as json.Number, so that they are not rounded.
+	decoder.UseNumber()
+	if err := decoder.Decode(&t.claims); err != nil {
+		return nil, errors.Wrap(err, "invalid claims")
+	}
+	if t.signature, err = base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+		return nil, errors.Wrap(err, "invalid signature encoding")
+	}
+	t.signingInput = parts[0] + "." + parts[1]
+	return &t, nil
+}
+
+// verifyJWTSignature verifies the signature of a JWT with a public key. Only
+// asymmetric algorithms are supported, and in particular not "none".
+func verifyJWTSignature(t *jwt, key crypto.PublicKey) error {
+	var hash crypto.Hash
+	switch t.header.Alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	}
+	h := hash.New()
+	h.Write([]byte(t.signingInput))
+	digest := h.Sum(nil)
+
+	switch t.header.Alg[:2] {
+	case "RS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("key of type %T cannot verify %s signatures", key, t.header.Alg)
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, t.signature)
+	case "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("key of type %T cannot verify %s signatures", key, t.header.Alg)
+		}
+		return rsa.VerifyPSS(rsaKey, hash, digest, t.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	default:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.Errorf("key of type %T cannot verify %s signatures", key, t.header.Alg)
+		}
+		// The signature is the concatenation of r and s, of the size of the
+		// curve each.
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(t.signature) != 2*size {
+			return errors.New("invalid ECDSA signature size")
+		}
+		r := new(big.Int).SetBytes(t.signature[:size])
+		s := new(big.Int).SetBytes(t.signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("ECDSA verification error")
+		}
+		return nil
+	}
+}
+
+// isSupportedJWTAlgorithm returns whether JWTs signed with an algorithm can
+// be verified.
+func isSupportedJWTAlgorithm(alg string) bool {
+	switch alg {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512":
+		return true
+	}
+	return false
+}
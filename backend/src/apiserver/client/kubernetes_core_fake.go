@@ -15,8 +15,16 @@
 package client
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	corev1 "k8s.io/api/core/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
 )
 
 type FakeKuberneteCoreClient struct {
@@ -27,8 +35,30 @@ func (c *FakeKuberneteCoreClient) PodClient(namespace string) v1.PodInterface {
 	if len(namespace) == 0 {
 		panic(util.NewResourceNotFoundError("Namespace", namespace))
 	}
-	return nil
-	// return c.podClientFake
+	return fakeDeletedPodClient{k8sfake.NewSimpleClientset().CoreV1().Pods(namespace)}
+}
+
+// fakeDeletedPodClient is a fake pod client whose pods are deleted, so their
+// logs are read from the log archive.
+type fakeDeletedPodClient struct {
+	v1.PodInterface
+}
+
+func (fakeDeletedPodClient) GetLogs(name string, opts *corev1.PodLogOptions) *rest.Request {
+	base := &url.URL{Scheme: "http", Host: "localhost"}
+	client := &http.Client{Transport: podNotFoundTransport{}}
+	return rest.NewRequestWithClient(base, "", rest.ClientContentConfig{}, client).Verb("GET").Name(name)
+}
+
+type podNotFoundTransport struct{}
+
+func (podNotFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
 }
 
 func NewFakeKuberneteCoresClient() *FakeKuberneteCoreClient {
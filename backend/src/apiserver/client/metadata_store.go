@@ -0,0 +1,51 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"google.golang.org/grpc"
+)
+
+// MetadataStoreInterface is the part of the ML Metadata store service which
+// the API server reads lineage from.
+type MetadataStoreInterface interface {
+	GetContextByTypeAndName(ctx context.Context, in *mlmdPb.GetContextByTypeAndNameRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextByTypeAndNameResponse, error)
+	GetContextType(ctx context.Context, in *mlmdPb.GetContextTypeRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextTypeResponse, error)
+	GetContextsByExecution(ctx context.Context, in *mlmdPb.GetContextsByExecutionRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextsByExecutionResponse, error)
+	GetExecutionsByContext(ctx context.Context, in *mlmdPb.GetExecutionsByContextRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionsByContextResponse, error)
+	GetExecutionsByID(ctx context.Context, in *mlmdPb.GetExecutionsByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionsByIDResponse, error)
+	GetArtifactsByID(ctx context.Context, in *mlmdPb.GetArtifactsByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactsByIDResponse, error)
+	GetArtifactsByURI(ctx context.Context, in *mlmdPb.GetArtifactsByURIRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactsByURIResponse, error)
+	GetEventsByExecutionIDs(ctx context.Context, in *mlmdPb.GetEventsByExecutionIDsRequest, opts ...grpc.CallOption) (*mlmdPb.GetEventsByExecutionIDsResponse, error)
+	GetEventsByArtifactIDs(ctx context.Context, in *mlmdPb.GetEventsByArtifactIDsRequest, opts ...grpc.CallOption) (*mlmdPb.GetEventsByArtifactIDsResponse, error)
+	GetExecutionTypesByID(ctx context.Context, in *mlmdPb.GetExecutionTypesByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionTypesByIDResponse, error)
+	GetArtifactTypesByID(ctx context.Context, in *mlmdPb.GetArtifactTypesByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactTypesByIDResponse, error)
+}
+
+// CreateMetadataStoreClientOrFatal creates a new client for the ML Metadata
+// store service. The connection is established lazily, so the API server
+// starts even when ML Metadata is not deployed.
+func CreateMetadataStoreClientOrFatal(host string, port string) MetadataStoreInterface {
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%s", host, port), grpc.WithInsecure())
+	if err != nil {
+		glog.Fatalf("Failed to create ML Metadata client. Error: %v", err)
+	}
+	return mlmdPb.NewMetadataStoreServiceClient(conn)
+}
@@ -0,0 +1,249 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"sort"
+
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// FakeMetadataStore is an in-memory ML Metadata store, filled with the Add
+// methods. Like MLMD, it returns the executions and artifacts with their type
+// IDs only.
+type FakeMetadataStore struct {
+	typeIds      map[string]int64
+	contexts     []*mlmdPb.Context
+	executions   map[int64]*mlmdPb.Execution
+	artifacts    map[int64]*mlmdPb.Artifact
+	events       []*mlmdPb.Event
+	attributions map[int64][]int64
+	nextId       int64
+}
+
+func NewFakeMetadataStore() *FakeMetadataStore {
+	return &FakeMetadataStore{
+		typeIds:      make(map[string]int64),
+		executions:   make(map[int64]*mlmdPb.Execution),
+		artifacts:    make(map[int64]*mlmdPb.Artifact),
+		attributions: make(map[int64][]int64),
+	}
+}
+
+func (s *FakeMetadataStore) newId() int64 {
+	s.nextId++
+	return s.nextId
+}
+
+func (s *FakeMetadataStore) typeId(typeName string) int64 {
+	if _, ok := s.typeIds[typeName]; !ok {
+		s.typeIds[typeName] = s.newId()
+	}
+	return s.typeIds[typeName]
+}
+
+func (s *FakeMetadataStore) typeName(typeId int64) (string, bool) {
+	for name, id := range s.typeIds {
+		if id == typeId {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// AddContext adds a context and returns its ID.
+func (s *FakeMetadataStore) AddContext(typeName string, name string) int64 {
+	id := s.newId()
+	s.contexts = append(s.contexts, &mlmdPb.Context{Id: proto.Int64(id), TypeId: proto.Int64(s.typeId(typeName)), Name: proto.String(name)})
+	return id
+}
+
+// AddExecution adds an execution in the given contexts and returns its ID.
+func (s *FakeMetadataStore) AddExecution(typeName string, name string, state mlmdPb.Execution_State, contextIds ...int64) int64 {
+	id := s.newId()
+	s.executions[id] = &mlmdPb.Execution{
+		Id:             proto.Int64(id),
+		TypeId:         proto.Int64(s.typeId(typeName)),
+		Name:           proto.String(name),
+		LastKnownState: state.Enum(),
+		CustomProperties: map[string]*mlmdPb.Value{
+			"task_name": {Value: &mlmdPb.Value_StringValue{StringValue: name}},
+		},
+	}
+	for _, contextId := range contextIds {
+		s.attributions[contextId] = append(s.attributions[contextId], id)
+	}
+	return id
+}
+
+// AddArtifact adds a live artifact and returns its ID.
+func (s *FakeMetadataStore) AddArtifact(typeName string, uri string) int64 {
+	id := s.newId()
+	s.artifacts[id] = &mlmdPb.Artifact{
+		Id:     proto.Int64(id),
+		TypeId: proto.Int64(s.typeId(typeName)),
+		Uri:    proto.String(uri),
+		State:  mlmdPb.Artifact_LIVE.Enum(),
+	}
+	return id
+}
+
+// AddEvent adds an event linking an execution to one of its inputs or outputs.
+func (s *FakeMetadataStore) AddEvent(executionId int64, artifactId int64, eventType mlmdPb.Event_Type, name string) {
+	s.events = append(s.events, &mlmdPb.Event{
+		ExecutionId: proto.Int64(executionId),
+		ArtifactId:  proto.Int64(artifactId),
+		Type:        eventType.Enum(),
+		Path: &mlmdPb.Event_Path{Steps: []*mlmdPb.Event_Path_Step{
+			{Value: &mlmdPb.Event_Path_Step_Key{Key: name}},
+		}},
+	})
+}
+
+func (s *FakeMetadataStore) GetContextByTypeAndName(ctx context.Context, in *mlmdPb.GetContextByTypeAndNameRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextByTypeAndNameResponse, error) {
+	for _, c := range s.contexts {
+		if typeName, _ := s.typeName(c.GetTypeId()); typeName == in.GetTypeName() && c.GetName() == in.GetContextName() {
+			return &mlmdPb.GetContextByTypeAndNameResponse{Context: c}, nil
+		}
+	}
+	// Like MLMD, a missing context is not an error.
+	return &mlmdPb.GetContextByTypeAndNameResponse{}, nil
+}
+
+func (s *FakeMetadataStore) GetContextType(ctx context.Context, in *mlmdPb.GetContextTypeRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextTypeResponse, error) {
+	id, ok := s.typeIds[in.GetTypeName()]
+	if !ok {
+		// Unlike a missing context, MLMD reports a missing type as NOT_FOUND.
+		return nil, status.Errorf(codes.NotFound, "No type found for query, name: `%s`", in.GetTypeName())
+	}
+	return &mlmdPb.GetContextTypeResponse{ContextType: &mlmdPb.ContextType{Id: proto.Int64(id), Name: proto.String(in.GetTypeName())}}, nil
+}
+
+func (s *FakeMetadataStore) GetContextsByExecution(ctx context.Context, in *mlmdPb.GetContextsByExecutionRequest, opts ...grpc.CallOption) (*mlmdPb.GetContextsByExecutionResponse, error) {
+	response := &mlmdPb.GetContextsByExecutionResponse{}
+	for _, c := range s.contexts {
+		for _, id := range s.attributions[c.GetId()] {
+			if id == in.GetExecutionId() {
+				response.Contexts = append(response.Contexts, c)
+				break
+			}
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetExecutionsByContext(ctx context.Context, in *mlmdPb.GetExecutionsByContextRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionsByContextResponse, error) {
+	response := &mlmdPb.GetExecutionsByContextResponse{}
+	for _, id := range s.attributions[in.GetContextId()] {
+		response.Executions = append(response.Executions, s.executions[id])
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetExecutionsByID(ctx context.Context, in *mlmdPb.GetExecutionsByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionsByIDResponse, error) {
+	response := &mlmdPb.GetExecutionsByIDResponse{}
+	for _, id := range in.GetExecutionIds() {
+		if execution, ok := s.executions[id]; ok {
+			response.Executions = append(response.Executions, execution)
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetArtifactsByID(ctx context.Context, in *mlmdPb.GetArtifactsByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactsByIDResponse, error) {
+	response := &mlmdPb.GetArtifactsByIDResponse{}
+	for _, id := range in.GetArtifactIds() {
+		if artifact, ok := s.artifacts[id]; ok {
+			response.Artifacts = append(response.Artifacts, artifact)
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetArtifactsByURI(ctx context.Context, in *mlmdPb.GetArtifactsByURIRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactsByURIResponse, error) {
+	uris := make(map[string]bool)
+	for _, uri := range in.GetUris() {
+		uris[uri] = true
+	}
+	response := &mlmdPb.GetArtifactsByURIResponse{}
+	for _, id := range s.sortedArtifactIds() {
+		if uris[s.artifacts[id].GetUri()] {
+			response.Artifacts = append(response.Artifacts, s.artifacts[id])
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) sortedArtifactIds() []int64 {
+	ids := make([]int64, 0, len(s.artifacts))
+	for id := range s.artifacts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (s *FakeMetadataStore) GetEventsByExecutionIDs(ctx context.Context, in *mlmdPb.GetEventsByExecutionIDsRequest, opts ...grpc.CallOption) (*mlmdPb.GetEventsByExecutionIDsResponse, error) {
+	ids := make(map[int64]bool)
+	for _, id := range in.GetExecutionIds() {
+		ids[id] = true
+	}
+	response := &mlmdPb.GetEventsByExecutionIDsResponse{}
+	for _, event := range s.events {
+		if ids[event.GetExecutionId()] {
+			response.Events = append(response.Events, event)
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetEventsByArtifactIDs(ctx context.Context, in *mlmdPb.GetEventsByArtifactIDsRequest, opts ...grpc.CallOption) (*mlmdPb.GetEventsByArtifactIDsResponse, error) {
+	ids := make(map[int64]bool)
+	for _, id := range in.GetArtifactIds() {
+		ids[id] = true
+	}
+	response := &mlmdPb.GetEventsByArtifactIDsResponse{}
+	for _, event := range s.events {
+		if ids[event.GetArtifactId()] {
+			response.Events = append(response.Events, event)
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetExecutionTypesByID(ctx context.Context, in *mlmdPb.GetExecutionTypesByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetExecutionTypesByIDResponse, error) {
+	response := &mlmdPb.GetExecutionTypesByIDResponse{}
+	for _, id := range in.GetTypeIds() {
+		if name, ok := s.typeName(id); ok {
+			response.ExecutionTypes = append(response.ExecutionTypes, &mlmdPb.ExecutionType{Id: proto.Int64(id), Name: proto.String(name)})
+		}
+	}
+	return response, nil
+}
+
+func (s *FakeMetadataStore) GetArtifactTypesByID(ctx context.Context, in *mlmdPb.GetArtifactTypesByIDRequest, opts ...grpc.CallOption) (*mlmdPb.GetArtifactTypesByIDResponse, error) {
+	response := &mlmdPb.GetArtifactTypesByIDResponse{}
+	for _, id := range in.GetTypeIds() {
+		if name, ok := s.typeName(id); ok {
+			response.ArtifactTypes = append(response.ArtifactTypes, &mlmdPb.ArtifactType{Id: proto.Int64(id), Name: proto.String(name)})
+		}
+	}
+	return response, nil
+}
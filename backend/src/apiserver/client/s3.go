@@ -0,0 +1,149 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/glog"
+	minio "github.com/minio/minio-go"
+	credentials "github.com/minio/minio-go/pkg/credentials"
+	"github.com/pkg/errors"
+)
+
+const (
+	awsWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	awsRoleArn              = "AWS_ROLE_ARN"
+	awsRoleSessionName      = "AWS_ROLE_SESSION_NAME"
+	defaultAWSSTSEndpoint   = "https://sts.amazonaws.com"
+	defaultRoleSessionName  = "kfp-api-server"
+)
+
+// webIdentityProvider retrieves temporary credentials by assuming the role
+// of AWS_ROLE_ARN with the token of AWS_WEB_IDENTITY_TOKEN_FILE, which is how
+// IAM roles are given to service accounts (IRSA). It retrieves no credentials
+// when these are not set, so that the next provider of a chain is used.
+type webIdentityProvider struct {
+	credentials.Expiry
+	client      *http.Client
+	stsEndpoint string
+}
+
+func (p *webIdentityProvider) Retrieve() (credentials.Value, error) {
+	tokenFile := os.Getenv(awsWebIdentityTokenFile)
+	roleArn := os.Getenv(awsRoleArn)
+	if tokenFile == "" || roleArn == "" {
+		return credentials.Value{}, errors.New("web identity is not configured")
+	}
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "Failed to read the web identity token")
+	}
+	sessionName := os.Getenv(awsRoleSessionName)
+	if sessionName == "" {
+		sessionName = defaultRoleSessionName
+	}
+
+	query := url.Values{}
+	query.Set("Action", "AssumeRoleWithWebIdentity")
+	query.Set("Version", "2011-06-15")
+	query.Set("RoleArn", roleArn)
+	query.Set("RoleSessionName", sessionName)
+	query.Set("WebIdentityToken", strings.TrimSpace(string(token)))
+	resp, err := p.client.PostForm(p.stsEndpoint, query)
+	if err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "Failed to assume role %s", roleArn)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return credentials.Value{}, errors.Errorf("Failed to assume role %s: %s", roleArn, resp.Status)
+	}
+	var response credentials.AssumeRoleWithWebIdentityResponse
+	if err = xml.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return credentials.Value{}, errors.Wrapf(err, "Failed to parse the credentials of role %s", roleArn)
+	}
+
+	p.SetExpiration(response.Result.Credentials.Expiration, credentials.DefaultExpiryWindow)
+	return credentials.Value{
+		AccessKeyID:     response.Result.Credentials.AccessKey,
+		SecretAccessKey: response.Result.Credentials.SecretKey,
+		SessionToken:    response.Result.Credentials.SessionToken,
+		SignerType:      credentials.SignatureV4,
+	}, nil
+}
+
+// createS3CredentialProvidersChain creates the credentials of an S3 client. Static
+// keys are used if set. Otherwise the credentials are searched like the AWS SDKs
+// do: environment variables, web identity, shared credentials file and finally
+// the IAM role of the instance.
+func createS3CredentialProvidersChain(accessKey, secretKey, stsEndpoint string) *credentials.Credentials {
+	if accessKey != "" && secretKey != "" {
+		return credentials.NewStaticV4(accessKey, secretKey, "")
+	}
+	if stsEndpoint == "" {
+		stsEndpoint = defaultAWSSTSEndpoint
+	}
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	providers := []credentials.Provider{
+		&credentials.EnvAWS{},
+		&webIdentityProvider{client: httpClient, stsEndpoint: stsEndpoint},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{Client: httpClient},
+	}
+	return credentials.New(&credentials.Chain{Providers: providers})
+}
+
+func CreateS3Client(endpoint string, accessKey string, secretKey string, secure bool,
+	region string, stsEndpoint string) (*minio.Client, error) {
+	cred := createS3CredentialProvidersChain(accessKey, secretKey, stsEndpoint)
+	s3Client, err := minio.NewWithCredentials(endpoint, cred, secure, region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error while creating S3 client: %+v", err)
+	}
+	return s3Client, nil
+}
+
+func CreateS3ClientOrFatal(endpoint string, accessKey string, secretKey string, secure bool,
+	region string, stsEndpoint string, initConnectionTimeout time.Duration) *minio.Client {
+	var s3Client *minio.Client
+	var err error
+	var operation = func() error {
+		s3Client, err = CreateS3Client(endpoint, accessKey, secretKey, secure, region, stsEndpoint)
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = initConnectionTimeout
+	err = backoff.Retry(operation, b)
+	if err != nil {
+		glog.Fatalf("Failed to create S3 client. Error: %v", err)
+	}
+	return s3Client
+}
+
+// S3Endpoint returns the default endpoint of S3 in a region.
+func S3Endpoint(region string) string {
+	if region == "" || region == "us-east-1" {
+		return "s3.amazonaws.com"
+	}
+	return fmt.Sprintf("s3.%s.amazonaws.com", region)
+}
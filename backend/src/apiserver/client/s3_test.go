@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const assumeRoleWithWebIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>access-key</AccessKeyId>
+      <SecretAccessKey>secret-key</SecretAccessKey>
+      <SessionToken>session-token</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`
+
+func TestWebIdentityProvider(t *testing.T) {
+	stsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "AssumeRoleWithWebIdentity", r.Form.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/kfp", r.Form.Get("RoleArn"))
+		assert.Equal(t, "kfp-api-server", r.Form.Get("RoleSessionName"))
+		assert.Equal(t, "token", r.Form.Get("WebIdentityToken"))
+		w.Write([]byte(assumeRoleWithWebIdentityResponse))
+	}))
+	defer stsServer.Close()
+	tokenFile, err := ioutil.TempFile("", "token")
+	assert.Nil(t, err)
+	defer os.Remove(tokenFile.Name())
+	tokenFile.WriteString("token\n")
+	tokenFile.Close()
+	os.Setenv(awsWebIdentityTokenFile, tokenFile.Name())
+	defer os.Unsetenv(awsWebIdentityTokenFile)
+	os.Setenv(awsRoleArn, "arn:aws:iam::123456789012:role/kfp")
+	defer os.Unsetenv(awsRoleArn)
+
+	provider := &webIdentityProvider{client: stsServer.Client(), stsEndpoint: stsServer.URL}
+	value, err := provider.Retrieve()
+	assert.Nil(t, err)
+	assert.Equal(t, "access-key", value.AccessKeyID)
+	assert.Equal(t, "secret-key", value.SecretAccessKey)
+	assert.Equal(t, "session-token", value.SessionToken)
+	assert.False(t, provider.IsExpired())
+}
+
+func TestWebIdentityProvider_NotConfigured(t *testing.T) {
+	provider := &webIdentityProvider{client: http.DefaultClient, stsEndpoint: defaultAWSSTSEndpoint}
+	_, err := provider.Retrieve()
+	assert.NotNil(t, err)
+}
+
+func TestS3Endpoint(t *testing.T) {
+	assert.Equal(t, "s3.amazonaws.com", S3Endpoint(""))
+	assert.Equal(t, "s3.amazonaws.com", S3Endpoint("us-east-1"))
+	assert.Equal(t, "s3.eu-west-1.amazonaws.com", S3Endpoint("eu-west-1"))
+}
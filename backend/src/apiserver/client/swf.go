@@ -20,6 +20,7 @@ import (
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	swfclient "github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned"
 	"github.com/kubeflow/pipelines/backend/src/crd/pkg/client/clientset/versioned/typed/scheduledworkflow/v1beta1"
@@ -51,6 +52,7 @@ func NewScheduledWorkflowClientOrFatal(initConnectionTimeout time.Duration, clie
 		}
 		restConfig.QPS = float32(clientParams.QPS)
 		restConfig.Burst = clientParams.Burst
+		restConfig.Wrap(tracing.NewTransport)
 		swfClientSet := swfclient.NewForConfigOrDie(restConfig)
 		swfClient = swfClientSet.ScheduledworkflowV1beta1()
 		return nil
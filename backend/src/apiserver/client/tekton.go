@@ -19,6 +19,7 @@ import (
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	tektonclient "github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
@@ -47,6 +48,7 @@ func NewTektonClientOrFatal(initConnectionTimeout time.Duration, clientParams ut
 		}
 		restConfig.QPS = float32(clientParams.QPS)
 		restConfig.Burst = clientParams.Burst
+		restConfig.Wrap(tracing.NewTransport)
 		tektonClient = tektonclient.NewForConfigOrDie(restConfig).TektonV1beta1()
 		return nil
 	}
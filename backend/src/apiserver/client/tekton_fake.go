@@ -54,6 +54,11 @@ func (c *FakeTektonClient) GetWorkflowKeys() map[string]bool {
 	return result
 }
 
+// DeleteWorkflow deletes a workflow out of band, without its run.
+func (c *FakeTektonClient) DeleteWorkflow(name string) {
+	delete(c.workflowClientFake.workflows, name)
+}
+
 func (c *FakeTektonClient) IsTerminated(name string) (bool, error) {
 	_, ok := c.workflowClientFake.workflows[name]
 	if !ok {
@@ -29,9 +29,13 @@ type FakeTokenReviewClient struct {
 func (FakeTokenReviewClient) Create(context.Context, *authv1.TokenReview, metav1.CreateOptions) (*authv1.TokenReview, error) {
 	return &authv1.TokenReview{Status: authv1.TokenReviewStatus{
 		Authenticated: true,
-		User:          authv1.UserInfo{Username: "test"},
-		Audiences:     []string{common.GetTokenReviewAudience()},
-		Error:         "",
+		User: authv1.UserInfo{
+			Username: "test",
+			Groups:   []string{"test-group"},
+			Extra:    map[string]authv1.ExtraValue{"scopes": {"read"}},
+		},
+		Audiences: []string{common.GetTokenReviewAudience()},
+		Error:     "",
 	}}, nil
 }
 
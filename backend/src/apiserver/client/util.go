@@ -15,6 +15,7 @@
 package client
 
 import (
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
@@ -28,6 +29,7 @@ func getKubernetesClientset(clientParams util.ClientParameters) (*kubernetes.Cli
 	}
 	restConfig.QPS = float32(clientParams.QPS)
 	restConfig.Burst = clientParams.Burst
+	restConfig.Wrap(tracing.NewTransport)
 
 	clientSet, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
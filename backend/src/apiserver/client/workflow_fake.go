@@ -26,6 +26,7 @@ import (
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	k8schema "k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
@@ -66,8 +67,17 @@ func (c *FakeWorkflowClient) UpdateStatus(ctx context.Context, workflow *v1beta1
 }
 
 func (c *FakeWorkflowClient) List(ctx context.Context, opts v1.ListOptions) (*v1beta1.PipelineRunList, error) {
-	glog.Error("This fake method is not yet implemented.")
-	return nil, nil
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1beta1.PipelineRunList{}
+	for _, workflow := range c.workflows {
+		if selector.Matches(labels.Set(workflow.Labels)) {
+			list.Items = append(list.Items, *workflow)
+		}
+	}
+	return list, nil
 }
 
 func (c *FakeWorkflowClient) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
@@ -87,6 +97,7 @@ func (c *FakeWorkflowClient) Update(ctx context.Context, workflow *v1beta1.Pipel
 func (c *FakeWorkflowClient) Delete(ctx context.Context, name string, options v1.DeleteOptions) error {
 	_, ok := c.workflows[name]
 	if ok {
+		delete(c.workflows, name)
 		return nil
 	}
 	return k8errors.NewNotFound(k8schema.ParseGroupResource("tekton.dev"), name)
@@ -17,6 +17,7 @@ package main
 import (
 	"database/sql"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/minio/minio-go"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -41,6 +43,10 @@ const (
 	minioServiceSecure     = "MINIO_SERVICE_SECURE"
 	pipelineBucketName     = "MINIO_PIPELINE_BUCKET_NAME"
 	pipelinePath           = "MINIO_PIPELINE_PATH"
+	objectStoreType        = "ObjectStoreConfig.Type"
+	objectStoreTypeMinio   = "minio"
+	objectStoreTypeS3      = "s3"
+	objectStoreTypeFile    = "filesystem"
 	mysqlServiceHost       = "DBConfig.Host"
 	mysqlServicePort       = "DBConfig.Port"
 	mysqlUser              = "DBConfig.User"
@@ -50,6 +56,10 @@ const (
 	mysqlExtraParams       = "DBConfig.ExtraParams"
 	archiveLogFileName     = "ARCHIVE_LOG_FILE_NAME"
 	archiveLogPathPrefix   = "ARCHIVE_LOG_PATH_PREFIX"
+	auditLogPath           = "AUDIT_LOG_PATH"
+
+	metadataServiceHost = "METADATA_GRPC_SERVICE_SERVICE_HOST"
+	metadataServicePort = "METADATA_GRPC_SERVICE_SERVICE_PORT"
 
 	visualizationServiceHost = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_HOST"
 	visualizationServicePort = "ML_PIPELINE_VISUALIZATIONSERVER_SERVICE_PORT"
@@ -58,6 +68,11 @@ const (
 
 	clientQPS   = "ClientQPS"
 	clientBurst = "ClientBurst"
+
+	// The number of runs whose manifests are offloaded per query.
+	runManifestOffloadBatchSize = 100
+	// The number of runs whose parameters are backfilled per transaction.
+	runParameterBackfillBatchSize = 100
 )
 
 // Container for all service clients
@@ -68,9 +83,18 @@ type ClientManager struct {
 	jobStore                  storage.JobStoreInterface
 	runStore                  storage.RunStoreInterface
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
+	labelStore                storage.LabelStoreInterface
+	idempotencyKeyStore       storage.IdempotencyKeyStoreInterface
+	namespaceQuotaStore       storage.NamespaceQuotaStoreInterface
+	runQueueStore             storage.RunQueueStoreInterface
+	retentionPolicyStore      storage.RetentionPolicyStoreInterface
+	auditEventStore           storage.AuditEventStoreInterface
+	auditLog                  io.WriteCloser
+	runObjectCleanupStore     storage.RunObjectCleanupStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
 	objectStore               storage.ObjectStoreInterface
+	metadataStore             client.MetadataStoreInterface
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
 	subjectAccessReviewClient client.SubjectAccessReviewInterface
@@ -102,6 +126,43 @@ func (c *ClientManager) ResourceReferenceStore() storage.ResourceReferenceStoreI
 	return c.resourceReferenceStore
 }
 
+func (c *ClientManager) LabelStore() storage.LabelStoreInterface {
+	return c.labelStore
+}
+
+func (c *ClientManager) IdempotencyKeyStore() storage.IdempotencyKeyStoreInterface {
+	return c.idempotencyKeyStore
+}
+
+func (c *ClientManager) NamespaceQuotaStore() storage.NamespaceQuotaStoreInterface {
+	return c.namespaceQuotaStore
+}
+
+func (c *ClientManager) RunQueueStore() storage.RunQueueStoreInterface {
+	return c.runQueueStore
+}
+
+func (c *ClientManager) RetentionPolicyStore() storage.RetentionPolicyStoreInterface {
+	return c.retentionPolicyStore
+}
+
+func (c *ClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return c.auditEventStore
+}
+
+// AuditLog returns the sink audit events are written to as JSON lines, or nil
+// if none is configured.
+func (c *ClientManager) AuditLog() io.Writer {
+	if c.auditLog == nil {
+		return nil
+	}
+	return c.auditLog
+}
+
+func (c *ClientManager) RunObjectCleanupStore() storage.RunObjectCleanupStoreInterface {
+	return c.runObjectCleanupStore
+}
+
 func (c *ClientManager) DBStatusStore() storage.DBStatusStoreInterface {
 	return c.dBStatusStore
 }
@@ -114,6 +175,10 @@ func (c *ClientManager) ObjectStore() storage.ObjectStoreInterface {
 	return c.objectStore
 }
 
+func (c *ClientManager) MetadataStore() client.MetadataStoreInterface {
+	return c.metadataStore
+}
+
 func (c *ClientManager) TektonClient() client.TektonClientInterface {
 	return c.tektonClient
 }
@@ -165,9 +230,19 @@ func (c *ClientManager) init() {
 	c.pipelineStore = storage.NewPipelineStore(db, c.time, c.uuid)
 	c.jobStore = storage.NewJobStore(db, c.time)
 	c.resourceReferenceStore = storage.NewResourceReferenceStore(db)
+	c.labelStore = storage.NewLabelStore(db)
+	c.idempotencyKeyStore = storage.NewIdempotencyKeyStore(db, c.time)
+	c.namespaceQuotaStore = storage.NewNamespaceQuotaStore(db, c.time)
+	c.runQueueStore = storage.NewRunQueueStore(db)
+	c.retentionPolicyStore = storage.NewRetentionPolicyStore(db, c.time, c.uuid)
+	c.auditEventStore = storage.NewAuditEventStore(db, c.time, c.uuid)
+	c.runObjectCleanupStore = storage.NewRunObjectCleanupStore(db, c.time)
 	c.dBStatusStore = storage.NewDBStatusStore(db)
 	c.defaultExperimentStore = storage.NewDefaultExperimentStore(db)
-	c.objectStore = initMinioClient(common.GetDurationConfig(initConnectionTimeout))
+	c.objectStore = initObjectStore(common.GetDurationConfig(initConnectionTimeout))
+	c.metadataStore = client.CreateMetadataStoreClientOrFatal(
+		common.GetStringConfigWithDefault(metadataServiceHost, "metadata-grpc-service"),
+		common.GetStringConfigWithDefault(metadataServicePort, "8080"))
 
 	// Use default value of client QPS (5) & burst (10) defined in
 	// k8s.io/client-go/rest/config.go#RESTClientFor
@@ -181,12 +256,37 @@ func (c *ClientManager) init() {
 
 	c.k8sCoreClient = client.CreateKubernetesCoreOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 
-	runStore := storage.NewRunStore(db, c.time)
+	runStore := storage.NewRunStoreWithObjectStore(db, c.time, c.objectStore, common.IsOffloadRunManifests())
 	c.runStore = runStore
+	// The parameters of the existing runs are backfilled in the background,
+	// since the runs are only missing from the results of the list requests
+	// filtering or sorting on parameters meanwhile.
+	go func() {
+		count, err := runStore.BackfillRunParameters(runParameterBackfillBatchSize)
+		if err != nil {
+			glog.Errorf("Failed to backfill run_parameters table. Error: %v", err)
+			return
+		}
+		glog.Infof("Backfilled the parameters of %d existing runs", count)
+	}()
+	if common.IsOffloadRunManifests() {
+		// The manifests of the existing runs are migrated in the background,
+		// since they remain readable from the database meanwhile.
+		go func() {
+			count, err := runStore.OffloadRunManifests(runManifestOffloadBatchSize)
+			if err != nil {
+				glog.Errorf("Failed to offload the manifests of existing runs to the object store. Error: %v", err)
+				return
+			}
+			glog.Infof("Offloaded the manifests of %d existing runs to the object store", count)
+		}()
+	}
 
 	// Log archive
 	c.logArchive = initLogArchive()
 
+	c.auditLog = initAuditLog()
+
 	if common.IsMultiUserMode() {
 		c.subjectAccessReviewClient = client.CreateSubjectAccessReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
 		c.tokenReviewClient = client.CreateTokenReviewClientOrFatal(common.GetDurationConfig(initConnectionTimeout), clientParams)
@@ -197,6 +297,9 @@ func (c *ClientManager) init() {
 
 func (c *ClientManager) Close() {
 	c.db.Close()
+	if c.auditLog != nil {
+		c.auditLog.Close()
+	}
 }
 
 func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
@@ -212,7 +315,9 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 
 	// db is safe for concurrent use by multiple goroutines
 	// and maintains its own pool of idle connections.
-	db, err := gorm.Open(driverName, arg)
+	sqlDB, err := storage.OpenDB(driverName, arg)
+	util.TerminateIfError(err)
+	db, err := gorm.Open(driverName, sqlDB)
 	util.TerminateIfError(err)
 
 	// If pipeline_versions table is introduced into DB for the first time,
@@ -236,6 +341,14 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunParameter{},
+		&model.Label{},
+		&model.IdempotencyKey{},
+		&model.NamespaceQuota{},
+		&model.QueuedRun{},
+		&model.RetentionPolicy{},
+		&model.AuditEvent{},
+		&model.RunObjectCleanup{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
@@ -278,6 +391,27 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	if response.Error != nil {
 		glog.Fatalf("Failed to create a foreign key for RunID in run_metrics table. Error: %s", response.Error)
 	}
+	response = db.Model(&model.RunMetric{}).AddIndex("name_numbervalue", "Name", "NumberValue")
+	if response.Error != nil {
+		glog.Fatalf("Failed to create index name_numbervalue on run_metrics. Error: %s", response.Error)
+	}
+	response = db.Model(&model.RunParameter{}).
+		AddForeignKey("RunUUID", "run_details(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
+	if response.Error != nil {
+		glog.Fatalf("Failed to create a foreign key for RunUUID in run_parameters table. Error: %s", response.Error)
+	}
+	response = db.Model(&model.RunParameter{}).AddIndex("name_value", "Name", "Value")
+	if response.Error != nil {
+		glog.Fatalf("Failed to create index name_value on run_parameters. Error: %s", response.Error)
+	}
+	response = db.Model(&model.RunParameter{}).AddIndex("name_numbervalue", "Name", "NumberValue")
+	if response.Error != nil {
+		glog.Fatalf("Failed to create index name_numbervalue on run_parameters. Error: %s", response.Error)
+	}
+	response = db.Model(&model.Label{}).AddIndex("resourcetype_labelkey_labelvalue", "ResourceType", "LabelKey", "LabelValue")
+	if response.Error != nil {
+		glog.Fatalf("Failed to create index resourcetype_labelkey_labelvalue on labels. Error: %s", response.Error)
+	}
 	response = db.Model(&model.PipelineVersion{}).
 		AddForeignKey("PipelineId", "pipelines(UUID)", "CASCADE" /* onDelete */, "CASCADE" /* update */)
 	if response.Error != nil {
@@ -309,7 +443,9 @@ func initDBClient(initConnectionTimeout time.Duration) *storage.DB {
 	}
 	rows.Close()
 
-	return storage.NewDB(db.DB(), storage.NewMySQLDialect())
+	storageDB := storage.NewDB(db.DB(), storage.NewMySQLDialect())
+	prometheus.MustRegister(storage.NewDBStatsCollector(storageDB))
+	return storageDB
 }
 
 // Initialize the connection string for connecting to Mysql database
@@ -367,6 +503,25 @@ func initMysql(driverName string, initConnectionTimeout time.Duration) string {
 	return mysqlConfig.FormatDSN()
 }
 
+// initObjectStore creates the object store of the type set in the config.
+// Minio is used by default.
+func initObjectStore(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	storeType := common.GetStringConfigWithDefault(objectStoreType, objectStoreTypeMinio)
+	glog.Infof("Using a %s object store", storeType)
+	switch storeType {
+	case objectStoreTypeMinio:
+		return initMinioClient(initConnectionTimeout)
+	case objectStoreTypeS3:
+		return initS3Client(initConnectionTimeout)
+	case objectStoreTypeFile:
+		return initFileObjectStore()
+	default:
+		glog.Fatalf("Unsupported object store type %q. Supported types are %s, %s and %s.",
+			storeType, objectStoreTypeMinio, objectStoreTypeS3, objectStoreTypeFile)
+		return nil
+	}
+}
+
 func initMinioClient(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
 	// Create minio client.
 	minioServiceHost := common.GetStringConfigWithDefault(
@@ -390,6 +545,35 @@ func initMinioClient(initConnectionTimeout time.Duration) storage.ObjectStoreInt
 	return storage.NewMinioObjectStore(&storage.MinioClient{Client: minioClient}, bucketName, pipelinePath, disableMultipart)
 }
 
+func initS3Client(initConnectionTimeout time.Duration) storage.ObjectStoreInterface {
+	region := common.GetStringConfigWithDefault("ObjectStoreConfig.Region", os.Getenv("AWS_REGION"))
+	endpoint := common.GetStringConfigWithDefault("ObjectStoreConfig.S3.Endpoint", client.S3Endpoint(region))
+	secure := common.GetBoolConfigWithDefault("ObjectStoreConfig.Secure", true)
+	stsEndpoint := common.GetStringConfigWithDefault("ObjectStoreConfig.S3.STSEndpoint", "")
+	// Leave the keys unset to use the credentials of the environment, e.g. IRSA.
+	accessKey := common.GetStringConfigWithDefault("ObjectStoreConfig.AccessKey", "")
+	secretKey := common.GetStringConfigWithDefault("ObjectStoreConfig.SecretAccessKey", "")
+	bucketName := common.GetStringConfigWithDefault("ObjectStoreConfig.BucketName", os.Getenv(pipelineBucketName))
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+	disableMultipart := common.GetBoolConfigWithDefault("ObjectStoreConfig.Multipart.Disable", false)
+
+	s3Client := client.CreateS3ClientOrFatal(endpoint, accessKey, secretKey, secure, region, stsEndpoint,
+		initConnectionTimeout)
+	createMinioBucket(s3Client, bucketName, region)
+
+	return storage.NewMinioObjectStore(&storage.MinioClient{Client: s3Client}, bucketName, pipelinePath, disableMultipart)
+}
+
+func initFileObjectStore() storage.ObjectStoreInterface {
+	rootPath := common.GetStringConfig("ObjectStoreConfig.FileSystem.RootPath")
+	pipelinePath := common.GetStringConfigWithDefault("ObjectStoreConfig.PipelinePath", os.Getenv(pipelinePath))
+	objectStore, err := storage.NewFileObjectStore(rootPath, pipelinePath)
+	if err != nil {
+		glog.Fatalf("Failed to create the file object store. Error: %v", err)
+	}
+	return objectStore
+}
+
 func createMinioBucket(minioClient *minio.Client, bucketName, region string) {
 	// Check to see if we already own this bucket.
 	exists, err := minioClient.BucketExists(bucketName)
@@ -419,6 +603,20 @@ func initLogArchive() (logArchive archive.LogArchiveInterface) {
 	return
 }
 
+// initAuditLog opens the file audit events are appended to, e.g. /dev/stdout
+// to have them collected with the logs of the container.
+func initAuditLog() io.WriteCloser {
+	path := common.GetStringConfigWithDefault(auditLogPath, "")
+	if path == "" {
+		return nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		glog.Fatalf("Failed to open the audit log %s. Error: %v", path, err)
+	}
+	return file
+}
+
 // newClientManager creates and Init a new instance of ClientManager
 func newClientManager() ClientManager {
 	clientManager := ClientManager{}
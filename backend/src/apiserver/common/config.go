@@ -16,9 +16,11 @@ package common
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -45,6 +47,32 @@ const (
 	UpdatePipelineVersionByDefault      string = "AUTO_UPDATE_PIPELINE_DEFAULT_VERSION"
 	TokenReviewAudience                 string = "TOKEN_REVIEW_AUDIENCE"
 	TerminateStatus                     string = "TERMINATE_STATUS"
+	RetentionPolicyEnforcementInterval  string = "RETENTION_POLICY_ENFORCEMENT_INTERVAL"
+	RunObjectCleanupInterval            string = "RUN_OBJECT_CLEANUP_INTERVAL"
+	OffloadRunManifests                 string = "OFFLOAD_RUN_MANIFESTS"
+	RunDispatchInterval                 string = "RUN_DISPATCH_INTERVAL"
+	RunQueueOrder                       string = "RUN_QUEUE_ORDER"
+	Authenticators                      string = "AUTHENTICATORS"
+	JWTIssuer                           string = "JWT_ISSUER"
+	JWTAudience                         string = "JWT_AUDIENCE"
+	JWTJWKSSource                       string = "JWT_JWKS_SOURCE"
+	JWTJWKSRefreshInterval              string = "JWT_JWKS_REFRESH_INTERVAL"
+	JWTUsernameClaim                    string = "JWT_USERNAME_CLAIM"
+	JWTUsernamePrefix                   string = "JWT_USERNAME_PREFIX"
+	JWTGroupsClaim                      string = "JWT_GROUPS_CLAIM"
+	JWTGroupsPrefix                     string = "JWT_GROUPS_PREFIX"
+	AuthorizationCacheTTL               string = "AUTHORIZATION_CACHE_TTL"
+	AuthorizationCacheSize              string = "AUTHORIZATION_CACHE_SIZE"
+	TracingEnabled                      string = "TRACING_ENABLED"
+	TracingOTLPEndpoint                 string = "TRACING_OTLP_ENDPOINT"
+	TracingOTLPInsecure                 string = "TRACING_OTLP_INSECURE"
+	TracingSampleRatio                  string = "TRACING_SAMPLE_RATIO"
+)
+
+// The orders in which queued runs are dispatched.
+const (
+	RunQueueOrderFIFO     = "FIFO"
+	RunQueueOrderPriority = "PRIORITY"
 )
 
 func IsPipelineVersionUpdatedByDefault() bool {
@@ -105,6 +133,38 @@ func GetDurationConfig(configName string) time.Duration {
 	return viper.GetDuration(configName)
 }
 
+func GetDurationConfigWithDefault(configName string, value time.Duration) time.Duration {
+	if !viper.IsSet(configName) {
+		return value
+	}
+	return viper.GetDuration(configName)
+}
+
+// GetRetentionPolicyEnforcementInterval returns how often the retention
+// policies are enforced. Zero disables the enforcement.
+func GetRetentionPolicyEnforcementInterval() time.Duration {
+	return GetDurationConfigWithDefault(RetentionPolicyEnforcementInterval, time.Hour)
+}
+
+// GetRunObjectCleanupInterval returns how often the objects of deleted runs
+// are deleted from the object store. Zero disables the cleanup.
+func GetRunObjectCleanupInterval() time.Duration {
+	return GetDurationConfigWithDefault(RunObjectCleanupInterval, time.Minute)
+}
+
+// GetRunDispatchInterval returns how often the runs queued because their
+// namespace is over quota are dispatched. Zero disables the dispatching.
+func GetRunDispatchInterval() time.Duration {
+	return GetDurationConfigWithDefault(RunDispatchInterval, 10*time.Second)
+}
+
+// IsRunQueuePriorityOrder returns whether queued runs are dispatched by
+// descending priority, and first in first out among the same priority.
+// Otherwise they are dispatched first in first out.
+func IsRunQueuePriorityOrder() bool {
+	return strings.ToUpper(GetStringConfigWithDefault(RunQueueOrder, RunQueueOrderFIFO)) == RunQueueOrderPriority
+}
+
 func IsMultiUserSharedReadMode() bool {
 	return GetBoolConfigWithDefault(MultiUserModeSharedReadAccess, false)
 }
@@ -117,6 +177,12 @@ func IsArchiveLogs() bool {
 	return GetBoolConfigWithDefault(ArchiveLogs, false)
 }
 
+// IsOffloadRunManifests returns whether the runtime manifests of runs are
+// stored compressed in the object store instead of the database.
+func IsOffloadRunManifests() bool {
+	return GetBoolConfigWithDefault(OffloadRunManifests, false)
+}
+
 func IsStripEOF() bool {
 	return GetBoolConfigWithDefault(StripEOF, true)
 }
@@ -133,6 +199,69 @@ func IsApplyTektonCustomResource() string {
 	return GetStringConfigWithDefault(ApplyTektonCustomResource, "true")
 }
 
+// GetAuthenticators returns the names of the authenticators of requests, in
+// the order they are tried.
+func GetAuthenticators() []string {
+	value := GetStringConfigWithDefault(Authenticators, AuthenticatorHTTPHeader+","+AuthenticatorTokenReview)
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func GetJWTIssuer() string {
+	return GetStringConfigWithDefault(JWTIssuer, "")
+}
+
+// GetJWTAudience returns the audience JWTs must be issued for. It defaults to
+// the audience of token reviews.
+func GetJWTAudience() string {
+	return GetStringConfigWithDefault(JWTAudience, GetTokenReviewAudience())
+}
+
+// GetJWTJWKSSource returns the URL or the file path of the JSON Web Key Set
+// verifying the signatures of JWTs.
+func GetJWTJWKSSource() string {
+	return GetStringConfigWithDefault(JWTJWKSSource, "")
+}
+
+// GetJWTJWKSRefreshInterval returns how often the JSON Web Key Set is loaded
+// again, to pick up rotated keys.
+func GetJWTJWKSRefreshInterval() time.Duration {
+	return GetDurationConfigWithDefault(JWTJWKSRefreshInterval, time.Hour)
+}
+
+func GetJWTUsernameClaim() string {
+	return GetStringConfigWithDefault(JWTUsernameClaim, "email")
+}
+
+func GetJWTUsernamePrefix() string {
+	return GetStringConfigWithDefault(JWTUsernamePrefix, "")
+}
+
+func GetJWTGroupsClaim() string {
+	return GetStringConfigWithDefault(JWTGroupsClaim, "groups")
+}
+
+func GetJWTGroupsPrefix() string {
+	return GetStringConfigWithDefault(JWTGroupsPrefix, "")
+}
+
+// GetAuthorizationCacheTTL returns how long the decisions of
+// SubjectAccessReviews are cached. Zero disables the cache.
+func GetAuthorizationCacheTTL() time.Duration {
+	return GetDurationConfigWithDefault(AuthorizationCacheTTL, 10*time.Second)
+}
+
+// GetAuthorizationCacheSize returns the maximum number of cached decisions of
+// SubjectAccessReviews.
+func GetAuthorizationCacheSize() int {
+	return GetIntConfigWithDefault(AuthorizationCacheSize, 10000)
+}
+
 func GetPodNamespace() string {
 	return GetStringConfig(PodNamespace)
 }
@@ -192,3 +321,14 @@ func GetArtifactScript() string {
 func GetTerminateStatus() string {
 	return GetStringConfigWithDefault(TerminateStatus, DefaultTerminateStatus)
 }
+
+// GetTracingConfig returns the configuration of the export of traces over
+// OTLP, which is off by default.
+func GetTracingConfig() tracing.Config {
+	return tracing.Config{
+		Enabled:     GetBoolConfigWithDefault(TracingEnabled, false),
+		Endpoint:    GetStringConfigWithDefault(TracingOTLPEndpoint, ""),
+		Insecure:    GetBoolConfigWithDefault(TracingOTLPInsecure, true),
+		SampleRatio: GetFloat64ConfigWithDefault(TracingSampleRatio, 1),
+	}
+}
@@ -42,6 +42,8 @@ const (
 	RbacResourceTypeJobs           = "jobs"
 	RbacResourceTypeViewers        = "viewers"
 	RbacResourceTypeVisualizations = "visualizations"
+	RbacResourceTypeQuotas         = "quotas"
+	RbacResourceTypeAuditEvents    = "auditevents"
 
 	RbacResourceVerbArchive   = "archive"
 	RbacResourceVerbUpdate    = "update"
@@ -70,6 +72,13 @@ const (
 
 const DefaultTokenReviewAudience string = "pipelines.kubeflow.org"
 
+// The authenticators of requests.
+const (
+	AuthenticatorHTTPHeader  string = "http-header"
+	AuthenticatorTokenReview string = "token-review"
+	AuthenticatorJWT         string = "jwt"
+)
+
 const (
 	DefaultArtifactBucket         string = "mlpipeline"
 	DefaultArtifactEndpoint       string = "minio-service.kubeflow:9000"
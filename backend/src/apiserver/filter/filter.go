@@ -19,6 +19,8 @@ package filter
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/golang/protobuf/jsonpb"
@@ -43,6 +45,80 @@ type Filter struct {
 	in map[string][]interface{}
 
 	substring map[string][]interface{}
+
+	// filters are the nested filter groups, each evaluated as a single
+	// condition.
+	filters []*Filter
+	// or is true if the conditions are OR-ed instead of AND-ed.
+	or bool
+	// not is true if the combined conditions are negated.
+	not bool
+
+	// keyValues are the predicates evaluated against a KeyValueLookup table.
+	keyValues []*keyValuePredicate
+}
+
+// KeyValueLookup describes a table of name/value pairs that belong to the model
+// being filtered, such as run parameters or run metrics. A predicate on key
+// "<prefix><name>", where prefix is the key under which the lookup is
+// registered, matches the resources that have a row in Table named name whose
+// value satisfies the predicate.
+type KeyValueLookup struct {
+	// Table is the table holding the name/value pairs.
+	Table string
+	// ForeignKey is the column in Table referencing the primary key of the
+	// model being filtered.
+	ForeignKey string
+	// NameColumn is the column in Table holding the name.
+	NameColumn string
+	// StringValueColumn is the column in Table holding string values. If empty,
+	// predicates on this lookup can not use string values.
+	StringValueColumn string
+	// NumberValueColumn is the column in Table holding numeric values. If empty,
+	// predicates on this lookup can not use numeric values.
+	NumberValueColumn string
+	// Constraints are the values that other columns in Table must have, e.g.
+	// the type of the resource for tables shared by several resource types.
+	Constraints map[string]string
+}
+
+// keyValueLookups holds the lookups supported when parsing a filter, as well as
+// the fully qualified primary key column of the model being filtered.
+type keyValueLookups struct {
+	primaryKey string
+	lookups    map[string]KeyValueLookup
+}
+
+// find returns the prefix of the lookup and the name for key, or false if key
+// does not refer to any lookup.
+func (l *keyValueLookups) find(key string) (string, string, bool) {
+	if l == nil {
+		return "", "", false
+	}
+	for prefix := range l.lookups {
+		if strings.HasPrefix(key, prefix) {
+			return prefix, strings.TrimPrefix(key, prefix), true
+		}
+	}
+	return "", "", false
+}
+
+// resolve sets the primary key column and the lookup of p.
+func (l *keyValueLookups) resolve(p *keyValuePredicate) error {
+	var lookup KeyValueLookup
+	ok := false
+	if l != nil {
+		lookup, ok = l.lookups[p.Prefix]
+	}
+	if !ok {
+		return util.NewInvalidInputError("no support for filtering on key prefix %q", p.Prefix)
+	}
+	p.key = l.primaryKey
+	p.lookup = &lookup
+	if p.valueColumn() == "" {
+		return util.NewInvalidInputError("cannot use value type %T when filtering on key prefix %q", p.Value, p.Prefix)
+	}
+	return nil
 }
 
 // filterForMarshaling is a helper struct for marshaling Filter into JSON. This
@@ -60,6 +136,13 @@ type filterForMarshaling struct {
 	IN map[string][]interface{}
 
 	SUBSTRING map[string][]interface{}
+
+	// The fields below are omitted when empty so that page tokens for filters
+	// without nested groups are unchanged.
+	FILTERS   []*Filter            `json:",omitempty"`
+	OR        bool                 `json:",omitempty"`
+	NOT       bool                 `json:",omitempty"`
+	KEYVALUES []*keyValuePredicate `json:",omitempty"`
 }
 
 // MarshalJSON implements JSON Marshaler for Filter.
@@ -79,6 +162,10 @@ func (f *Filter) MarshalJSON() ([]byte, error) {
 		LTE:         f.lte,
 		IN:          f.in,
 		SUBSTRING:   f.substring,
+		FILTERS:     f.filters,
+		OR:          f.or,
+		NOT:         f.not,
+		KEYVALUES:   f.keyValues,
 	})
 }
 
@@ -104,12 +191,20 @@ func (f *Filter) UnmarshalJSON(b []byte) error {
 	f.lte = ffm.LTE
 	f.in = ffm.IN
 	f.substring = ffm.SUBSTRING
+	f.filters = ffm.FILTERS
+	f.or = ffm.OR
+	f.not = ffm.NOT
+	f.keyValues = ffm.KEYVALUES
 
 	return nil
 }
 
 // New creates a new Filter from parsing the API filter protocol buffer.
 func New(filterProto *api.Filter) (*Filter, error) {
+	return newFilter(filterProto, nil)
+}
+
+func newFilter(filterProto *api.Filter, lookups *keyValueLookups) (*Filter, error) {
 	f := &Filter{
 		filterProto: filterProto,
 		eq:          make(map[string][]interface{}, 0),
@@ -122,7 +217,7 @@ func New(filterProto *api.Filter) (*Filter, error) {
 		substring:   make(map[string][]interface{}, 0),
 	}
 
-	if err := f.parseFilterProto(); err != nil {
+	if err := f.parseFilterProto(lookups); err != nil {
 		return nil, err
 	}
 	return f, nil
@@ -134,93 +229,296 @@ func New(filterProto *api.Filter) (*Filter, error) {
 // the equivalent column name is "Name", then filterProto with predicates against key "name"
 // will be parsed as if the key value was "pipelines.Name".
 func NewWithKeyMap(filterProto *api.Filter, keyMap map[string]string, modelName string) (*Filter, error) {
+	return NewWithKeyMapAndLookups(filterProto, keyMap, modelName, "", nil)
+}
+
+// NewWithKeyMapAndLookups is like NewWithKeyMap, but additionally accepts
+// predicates on keys of the form "<prefix><name>" for every prefix in lookups.
+// For example, with a lookup registered under "param:" for table
+// run_parameters, a predicate on "param:learning_rate" matches the resources
+// whose primaryKey column is referenced by a run_parameters row named
+// "learning_rate" with a matching value.
+func NewWithKeyMapAndLookups(filterProto *api.Filter, keyMap map[string]string, modelName string,
+	primaryKey string, lookups map[string]KeyValueLookup) (*Filter, error) {
 	// Fully qualify column name to avoid "ambiguous column name" error.
 	var modelNamePrefix string
 	if modelName != "" {
 		modelNamePrefix = modelName + "."
 	}
 
+	l := newKeyValueLookups(modelName, primaryKey, lookups)
+	if err := mapKeys(filterProto, keyMap, modelNamePrefix, l); err != nil {
+		return nil, err
+	}
+	return newFilter(filterProto, l)
+}
+
+func newKeyValueLookups(modelName string, primaryKey string, lookups map[string]KeyValueLookup) *keyValueLookups {
+	if len(lookups) == 0 {
+		return nil
+	}
+	if modelName != "" {
+		primaryKey = modelName + "." + primaryKey
+	}
+	return &keyValueLookups{primaryKey: primaryKey, lookups: lookups}
+}
+
+// SetKeyValueLookups sets the lookups of the predicates on name/value pairs in
+// f, including those in nested filters, after f has been decoded from a page
+// token. The arguments are the same as in NewWithKeyMapAndLookups. It fails if
+// a predicate refers to a lookup which is not in lookups.
+func (f *Filter) SetKeyValueLookups(modelName string, primaryKey string, lookups map[string]KeyValueLookup) error {
+	return f.setKeyValueLookups(newKeyValueLookups(modelName, primaryKey, lookups))
+}
+
+func (f *Filter) setKeyValueLookups(lookups *keyValueLookups) error {
+	for _, kv := range f.keyValues {
+		if err := lookups.resolve(kv); err != nil {
+			return err
+		}
+	}
+	for _, child := range f.filters {
+		if err := child.setKeyValueLookups(lookups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapKeys rewrites the keys of all predicates in filterProto, including those in
+// nested filters, to their fully qualified model names. Keys referring to a
+// lookup are left unchanged.
+func mapKeys(filterProto *api.Filter, keyMap map[string]string, modelNamePrefix string, lookups *keyValueLookups) error {
 	for _, pred := range filterProto.Predicates {
+		if _, _, ok := lookups.find(pred.Key); ok {
+			continue
+		}
 		k, ok := keyMap[pred.Key]
 		if !ok {
-			return nil, util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
+			return util.NewInvalidInputError("no support for filtering on unrecognized field %q", pred.Key)
 		}
 		pred.Key = modelNamePrefix + k
 	}
-	return New(filterProto)
+	for _, child := range filterProto.Filters {
+		if err := mapKeys(child, keyMap, modelNamePrefix, lookups); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // AddToSelect builds a WHERE clause from the Filter f, adds it to the supplied
 // SelectBuilder object and returns it for use in SQL queries.
 func (f *Filter) AddToSelect(sb squirrel.SelectBuilder) squirrel.SelectBuilder {
-	for k := range f.eq {
+	if f.or || f.not {
+		return sb.Where(f.condition())
+	}
+
+	for _, c := range f.conditions() {
+		sb = sb.Where(c)
+	}
+	return sb
+}
+
+// condition combines all the conditions in f into a single SQL condition.
+func (f *Filter) condition() squirrel.Sqlizer {
+	var c squirrel.Sqlizer
+	if f.or {
+		c = squirrel.Or(f.conditions())
+	} else {
+		c = squirrel.And(f.conditions())
+	}
+
+	if f.not {
+		return not{c}
+	}
+	return c
+}
+
+// conditions returns one SQL condition for each predicate value and nested
+// filter in f.
+func (f *Filter) conditions() []squirrel.Sqlizer {
+	var conds []squirrel.Sqlizer
+
+	for _, k := range sortedKeys(f.eq) {
 		for _, v := range f.eq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conds = append(conds, squirrel.Eq{k: v})
 		}
 	}
 
-	for k := range f.neq {
+	for _, k := range sortedKeys(f.neq) {
 		for _, v := range f.neq[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.NotEq(m))
+			conds = append(conds, squirrel.NotEq{k: v})
 		}
 	}
 
-	for k := range f.gt {
+	for _, k := range sortedKeys(f.gt) {
 		for _, v := range f.gt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Gt(m))
+			conds = append(conds, squirrel.Gt{k: v})
 		}
 	}
 
-	for k := range f.gte {
+	for _, k := range sortedKeys(f.gte) {
 		for _, v := range f.gte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.GtOrEq(m))
+			conds = append(conds, squirrel.GtOrEq{k: v})
 		}
 	}
 
-	for k := range f.lt {
+	for _, k := range sortedKeys(f.lt) {
 		for _, v := range f.lt[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Lt(m))
+			conds = append(conds, squirrel.Lt{k: v})
 		}
 	}
 
-	for k := range f.lte {
+	for _, k := range sortedKeys(f.lte) {
 		for _, v := range f.lte[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.LtOrEq(m))
+			conds = append(conds, squirrel.LtOrEq{k: v})
 		}
 	}
 
 	// In
-	for k := range f.in {
+	for _, k := range sortedKeys(f.in) {
 		for _, v := range f.in[k] {
-			m := map[string]interface{}{k: v}
-			sb = sb.Where(squirrel.Eq(m))
+			conds = append(conds, squirrel.Eq{k: v})
 		}
 	}
 
-	for k := range f.substring {
+	for _, k := range sortedKeys(f.substring) {
 		// Modify each string value v so it looks like %v% so we are doing a substring
 		// match with the LIKE operator.
 		for _, v := range f.substring[k] {
-			like := make(squirrel.Like)
-			like[k] = fmt.Sprintf("%%%s%%", v)
-			sb = sb.Where(like)
+			conds = append(conds, squirrel.Like{k: fmt.Sprintf("%%%s%%", v)})
 		}
 	}
 
-	return sb
+	for _, kv := range f.keyValues {
+		conds = append(conds, kv)
+	}
+
+	for _, child := range f.filters {
+		conds = append(conds, child.condition())
+	}
+
+	return conds
+}
+
+// keyValuePredicate is a predicate evaluated against a KeyValueLookup table. It
+// is converted to a semi-join on the lookup table, so that lookups by name and
+// value can use the indices on that table.
+//
+// Only the prefix of the filtering key is serialized in page tokens. The table
+// and the columns of the lookup, as well as the primary key column, are set
+// from the listed model when the filter is parsed or decoded, so that they
+// never come from clients.
+type keyValuePredicate struct {
+	// Prefix is the prefix under which the lookup is registered.
+	Prefix string
+	Name   string
+	// IsString is true if the predicate is on the string values of the lookup.
+	IsString bool `json:",omitempty"`
+	Op       api.Predicate_Op
+	Value    interface{}
+
+	// key is the fully qualified primary key column of the model being
+	// filtered.
+	key    string
+	lookup *KeyValueLookup
+}
+
+// valueColumn returns the column of the lookup table holding the values the
+// predicate applies to, or an empty string if the lookup has no such column.
+func (p *keyValuePredicate) valueColumn() string {
+	if p.IsString {
+		return p.lookup.StringValueColumn
+	}
+	return p.lookup.NumberValueColumn
+}
+
+// ToSql implements squirrel.Sqlizer for keyValuePredicate.
+func (p *keyValuePredicate) ToSql() (string, []interface{}, error) {
+	if p.lookup == nil {
+		return "", nil, fmt.Errorf("no lookup set for filtering key prefix %q", p.Prefix)
+	}
+	valueColumn := p.valueColumn()
+	if valueColumn == "" {
+		return "", nil, fmt.Errorf("no value column for filtering key prefix %q", p.Prefix)
+	}
+
+	var valueCond squirrel.Sqlizer
+	switch p.Op {
+	case api.Predicate_EQUALS, api.Predicate_IN:
+		valueCond = squirrel.Eq{valueColumn: p.Value}
+	case api.Predicate_NOT_EQUALS:
+		valueCond = squirrel.NotEq{valueColumn: p.Value}
+	case api.Predicate_GREATER_THAN:
+		valueCond = squirrel.Gt{valueColumn: p.Value}
+	case api.Predicate_GREATER_THAN_EQUALS:
+		valueCond = squirrel.GtOrEq{valueColumn: p.Value}
+	case api.Predicate_LESS_THAN:
+		valueCond = squirrel.Lt{valueColumn: p.Value}
+	case api.Predicate_LESS_THAN_EQUALS:
+		valueCond = squirrel.LtOrEq{valueColumn: p.Value}
+	case api.Predicate_IS_SUBSTRING:
+		valueCond = squirrel.Like{valueColumn: fmt.Sprintf("%%%s%%", p.Value)}
+	default:
+		return "", nil, fmt.Errorf("invalid predicate operation: %v", p.Op)
+	}
+
+	sb := squirrel.
+		Select(p.lookup.ForeignKey).
+		From(p.lookup.Table).
+		Where(squirrel.Eq{p.lookup.NameColumn: p.Name})
+	for _, k := range sortedStringKeys(p.lookup.Constraints) {
+		sb = sb.Where(squirrel.Eq{k: p.lookup.Constraints[k]})
+	}
+	sql, args, err := sb.Where(valueCond).ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("%s IN (%s)", p.key, sql), args, nil
+}
+
+// not is a SQL condition that negates the wrapped condition. The wrapped
+// condition is always a squirrel.And or squirrel.Or, which are parenthesized
+// when converted to SQL.
+type not struct {
+	squirrel.Sqlizer
+}
+
+// ToSql implements squirrel.Sqlizer for not.
+func (n not) ToSql() (string, []interface{}, error) {
+	sql, args, err := n.Sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return fmt.Sprintf("NOT %s", sql), args, nil
+}
+
+// sortedKeys returns the keys of m in sorted order so that the generated SQL
+// is deterministic.
+func sortedKeys(m map[string][]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func checkPredicate(p *api.Predicate) error {
 	switch p.Op {
 	case api.Predicate_IN:
 		switch t := p.Value.(type) {
-		case *api.Predicate_IntValue, *api.Predicate_LongValue, *api.Predicate_StringValue, *api.Predicate_TimestampValue:
+		case *api.Predicate_IntValue, *api.Predicate_LongValue, *api.Predicate_StringValue, *api.Predicate_TimestampValue, *api.Predicate_DoubleValue:
 			return util.NewInvalidInputError("cannot use IN operator with scalar type %T", t)
 		}
 
@@ -245,12 +543,21 @@ func checkPredicate(p *api.Predicate) error {
 	return nil
 }
 
-func (f *Filter) parseFilterProto() error {
+func (f *Filter) parseFilterProto(lookups *keyValueLookups) error {
 	for _, pred := range f.filterProto.Predicates {
 		if err := checkPredicate(pred); err != nil {
 			return err
 		}
 
+		if prefix, name, ok := lookups.find(pred.Key); ok {
+			kv, err := newKeyValuePredicate(pred, lookups, prefix, name)
+			if err != nil {
+				return err
+			}
+			f.keyValues = append(f.keyValues, kv)
+			continue
+		}
+
 		var m map[string][]interface{}
 		switch pred.Op {
 		case api.Predicate_EQUALS:
@@ -278,9 +585,59 @@ func (f *Filter) parseFilterProto() error {
 		}
 	}
 
+	for _, child := range f.filterProto.Filters {
+		if len(child.Predicates) == 0 && len(child.Filters) == 0 {
+			return util.NewInvalidInputError("nested filters must contain at least one predicate or nested filter")
+		}
+		sub, err := newFilter(child, lookups)
+		if err != nil {
+			return err
+		}
+		f.filters = append(f.filters, sub)
+	}
+
+	switch f.filterProto.Combinator {
+	case api.Filter_AND:
+	case api.Filter_OR:
+		f.or = true
+	default:
+		return util.NewInvalidInputError("invalid filter combinator: %v", f.filterProto.Combinator)
+	}
+	f.not = f.filterProto.Negate
+
+	if (f.or || f.not) && len(f.filterProto.Predicates) == 0 && len(f.filterProto.Filters) == 0 {
+		return util.NewInvalidInputError("cannot use combinator %v or negation on a filter without predicates or nested filters", f.filterProto.Combinator)
+	}
+
 	return nil
 }
 
+func newKeyValuePredicate(p *api.Predicate, lookups *keyValueLookups, prefix string, name string) (*keyValuePredicate, error) {
+	if name == "" {
+		return nil, util.NewInvalidInputError("missing name in filtering key %q", p.Key)
+	}
+
+	m := make(map[string][]interface{})
+	if err := addPredicateValue(m, p); err != nil {
+		return nil, err
+	}
+
+	kv := &keyValuePredicate{
+		Prefix: prefix,
+		Name:   name,
+		Op:     p.Op,
+		Value:  m[p.Key][0],
+	}
+	switch p.Value.(type) {
+	case *api.Predicate_StringValue, *api.Predicate_StringValues:
+		kv.IsString = true
+	}
+	if err := lookups.resolve(kv); err != nil {
+		return nil, util.NewInvalidInputError("cannot use value type %T when filtering on key %q", p.Value, p.Key)
+	}
+	return kv, nil
+}
+
 func addPredicateValue(m map[string][]interface{}, p *api.Predicate) error {
 	switch t := p.Value.(type) {
 	case *api.Predicate_IntValue:
@@ -289,6 +646,8 @@ func addPredicateValue(m map[string][]interface{}, p *api.Predicate) error {
 		m[p.Key] = append(m[p.Key], p.GetLongValue())
 	case *api.Predicate_StringValue:
 		m[p.Key] = append(m[p.Key], p.GetStringValue())
+	case *api.Predicate_DoubleValue:
+		m[p.Key] = append(m[p.Key], p.GetDoubleValue())
 	case *api.Predicate_TimestampValue:
 		ts, err := ptypes.Timestamp(p.GetTimestampValue())
 		if err != nil {
@@ -2,6 +2,7 @@ package filter
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/Masterminds/squirrel"
@@ -15,7 +16,7 @@ func TestValidNewFilters(t *testing.T) {
 	opts := []cmp.Option{
 		cmp.AllowUnexported(Filter{}),
 		cmp.FilterPath(func(p cmp.Path) bool {
-			return p.String() == "filterProto"
+			return strings.HasSuffix(p.String(), "filterProto")
 		}, cmp.Ignore()),
 		cmpopts.EquateEmpty(),
 	}
@@ -71,6 +72,40 @@ func TestValidNewFilters(t *testing.T) {
 				key: "label" op: IS_SUBSTRING string_value: "label_substring" }`,
 			&Filter{substring: map[string][]interface{}{"label": {"label_substring"}}},
 		},
+		{
+			`combinator: OR
+			 predicates { key: "status" op: EQUALS string_value: "Failed" }
+			 predicates { key: "status" op: EQUALS string_value: "Error" }`,
+			&Filter{eq: map[string][]interface{}{"status": {"Failed", "Error"}}, or: true},
+		},
+		{
+			`negate: true
+			 predicates { key: "name" op: IS_SUBSTRING string_value: "tmp" }`,
+			&Filter{substring: map[string][]interface{}{"name": {"tmp"}}, not: true},
+		},
+		{
+			`predicates { key: "total" op: GREATER_THAN int_value: 10 }
+			 filters {
+				combinator: OR
+				predicates { key: "status" op: EQUALS string_value: "Failed" }
+				filters {
+					negate: true
+					predicates { key: "name" op: IS_SUBSTRING string_value: "tmp" }
+				}
+			 }`,
+			&Filter{
+				gt: map[string][]interface{}{"total": {int32(10)}},
+				filters: []*Filter{
+					{
+						eq: map[string][]interface{}{"status": {"Failed"}},
+						or: true,
+						filters: []*Filter{
+							{substring: map[string][]interface{}{"name": {"tmp"}}, not: true},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -91,7 +126,7 @@ func TestValidNewFiltersWithKeyMap(t *testing.T) {
 	opts := []cmp.Option{
 		cmp.AllowUnexported(Filter{}),
 		cmp.FilterPath(func(p cmp.Path) bool {
-			return p.String() == "filterProto"
+			return strings.HasSuffix(p.String(), "filterProto")
 		}, cmp.Ignore()),
 		cmpopts.EquateEmpty(),
 	}
@@ -119,6 +154,17 @@ func TestValidNewFiltersWithKeyMap(t *testing.T) {
 				key: "name" op: IS_SUBSTRING string_value: "pipeline" }`,
 			&Filter{substring: map[string][]interface{}{"pipelines.Name": {"pipeline"}}},
 		},
+		{
+			`filters {
+				negate: true
+				predicates { key: "name" op: IS_SUBSTRING string_value: "pipeline" }
+			 }`,
+			&Filter{
+				filters: []*Filter{
+					{substring: map[string][]interface{}{"pipelines.Name": {"pipeline"}}, not: true},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -204,6 +250,24 @@ func TestInvalidFilters(t *testing.T) {
 			`predicates { key: "total" op: LESS_THAN
 				timestamp_value { seconds: -100000000000 }}`,
 		},
+		// Invalid predicate in nested filter
+		{
+			`filters { predicates { key: "total" op: IN int_value: 10 } }`,
+		},
+		// Empty nested filter
+		{
+			`predicates { key: "status" op: EQUALS string_value: "Running" }
+			 filters { combinator: OR }`,
+		},
+		// Negation without any predicates
+		{
+			`negate: true`,
+		},
+		// Unknown combinator
+		{
+			`combinator: 5
+			 predicates { key: "status" op: EQUALS string_value: "Running" }`,
+		},
 	}
 
 	for _, test := range tests {
@@ -280,12 +344,55 @@ func TestAddToSelect(t *testing.T) {
 			"SELECT mycolumn WHERE label LIKE ?",
 			[]interface{}{"%label_substring%"},
 		},
+		{
+			`predicates { key: "accuracy" op: GREATER_THAN double_value: 0.9 }`,
+			"SELECT mycolumn WHERE accuracy > ?",
+			[]interface{}{0.9},
+		},
 		{
 			`predicates { key: "label" op: IS_SUBSTRING  string_value: "label_substring1" }
 			 predicates { key: "label" op: IS_SUBSTRING  string_value: "label_substring2" }`,
 			"SELECT mycolumn WHERE label LIKE ? AND label LIKE ?",
 			[]interface{}{"%label_substring1%", "%label_substring2%"},
 		},
+		{
+			`combinator: OR
+			 predicates { key: "status" op: EQUALS string_value: "Failed" }
+			 predicates { key: "status" op: EQUALS string_value: "Error" }`,
+			"SELECT mycolumn WHERE (status = ? OR status = ?)",
+			[]interface{}{"Failed", "Error"},
+		},
+		{
+			`negate: true
+			 predicates { key: "name" op: IS_SUBSTRING string_value: "tmp" }`,
+			"SELECT mycolumn WHERE NOT (name LIKE ?)",
+			[]interface{}{"%tmp%"},
+		},
+		{
+			`predicates { key: "total" op: GREATER_THAN long_value: 100 }
+			 filters {
+				combinator: OR
+				predicates { key: "status" op: EQUALS string_value: "Failed" }
+				predicates { key: "status" op: EQUALS string_value: "Error" }
+			 }
+			 filters {
+				negate: true
+				predicates { key: "name" op: IS_SUBSTRING string_value: "tmp" }
+			 }`,
+			"SELECT mycolumn WHERE total > ? AND (status = ? OR status = ?) AND NOT (name LIKE ?)",
+			[]interface{}{int64(100), "Failed", "Error", "%tmp%"},
+		},
+		{
+			`combinator: OR
+			 negate: true
+			 predicates { key: "status" op: IN string_values { values: "Failed" values: "Error" } }
+			 filters {
+				predicates { key: "name" op: EQUALS string_value: "run" }
+				predicates { key: "total" op: LESS_THAN int_value: 5 }
+			 }`,
+			"SELECT mycolumn WHERE NOT (status IN (?,?) OR (name = ? AND total < ?))",
+			[]interface{}{"Failed", "Error", "run", int32(5)},
+		},
 	}
 
 	for _, test := range tests {
@@ -351,3 +458,187 @@ func TestUnmarshalJSON(t *testing.T) {
 		t.Errorf("json.Unmarshal(%+v):\nGot: %v, Error: %v\nWant:\n%+v, Error: nil\nDiff:%s\n", in, got, err, want, cmp.Diff(want, got, cmp.AllowUnexported(Filter{})))
 	}
 }
+
+func TestMarshalJSON_NestedFilters(t *testing.T) {
+	filterProto := &api.Filter{}
+	protoStr := `predicates { key: "status" op: EQUALS string_value: "Running" }
+		filters {
+			combinator: OR
+			negate: true
+			predicates { key: "name" op: IS_SUBSTRING string_value: "tmp" }
+			predicates { key: "name" op: EQUALS string_value: "run" }
+		}`
+	if err := proto.UnmarshalText(protoStr, filterProto); err != nil {
+		t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", protoStr, err)
+	}
+	f, err := New(filterProto)
+	if err != nil {
+		t.Fatalf("New(%+v) = _, %v\nWant nil error", *filterProto, err)
+	}
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v) = _, %v\nWant nil error", f, err)
+	}
+	got := &Filter{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) = %v\nWant nil error", b, err)
+	}
+
+	wantSQL, wantArgs, _ := f.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	gotSQL, gotArgs, err := got.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+	if err != nil || gotSQL != wantSQL || !cmp.Equal(gotArgs, wantArgs) {
+		t.Errorf("Unmarshaled filter SQL =\nGot: %v, %v, %v\nWant: %v, %v, <nil>", gotSQL, gotArgs, err, wantSQL, wantArgs)
+	}
+}
+
+var testLookups = map[string]KeyValueLookup{
+	"param:": {
+		Table:             "run_parameters",
+		ForeignKey:        "RunUUID",
+		NameColumn:        "Name",
+		StringValueColumn: "Value",
+		NumberValueColumn: "NumberValue",
+	},
+	"metric:": {
+		Table:             "run_metrics",
+		ForeignKey:        "RunUUID",
+		NameColumn:        "Name",
+		NumberValueColumn: "NumberValue",
+	},
+	"label:": {
+		Table:             "labels",
+		ForeignKey:        "ResourceUUID",
+		NameColumn:        "LabelKey",
+		StringValueColumn: "LabelValue",
+		Constraints:       map[string]string{"ResourceType": "Run"},
+	},
+}
+
+func TestAddToSelect_WithKeyValueLookups(t *testing.T) {
+	tests := []struct {
+		in       string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			`predicates { key: "param:learning_rate" op: EQUALS string_value: "0.01" }
+			 predicates { key: "metric:accuracy" op: GREATER_THAN double_value: 0.9 }`,
+			"SELECT mycolumn WHERE runs.UUID IN (SELECT RunUUID FROM run_parameters WHERE Name = ? AND Value = ?) " +
+				"AND runs.UUID IN (SELECT RunUUID FROM run_metrics WHERE Name = ? AND NumberValue > ?)",
+			[]interface{}{"learning_rate", "0.01", "accuracy", 0.9},
+		},
+		{
+			`predicates { key: "name" op: EQUALS string_value: "run" }
+			 predicates { key: "param:learning_rate" op: LESS_THAN_EQUALS long_value: 1 }`,
+			"SELECT mycolumn WHERE runs.Name = ? AND runs.UUID IN (SELECT RunUUID FROM run_parameters WHERE Name = ? AND NumberValue <= ?)",
+			[]interface{}{"run", "learning_rate", int64(1)},
+		},
+		{
+			`predicates { key: "param:optimizer" op: IN string_values { values: "adam" values: "sgd" } }`,
+			"SELECT mycolumn WHERE runs.UUID IN (SELECT RunUUID FROM run_parameters WHERE Name = ? AND Value IN (?,?))",
+			[]interface{}{"optimizer", "adam", "sgd"},
+		},
+		{
+			`predicates { key: "param:optimizer" op: IS_SUBSTRING string_value: "ada" }`,
+			"SELECT mycolumn WHERE runs.UUID IN (SELECT RunUUID FROM run_parameters WHERE Name = ? AND Value LIKE ?)",
+			[]interface{}{"optimizer", "%ada%"},
+		},
+		{
+			`predicates { key: "label:team" op: EQUALS string_value: "vision" }`,
+			"SELECT mycolumn WHERE runs.UUID IN (SELECT ResourceUUID FROM labels WHERE LabelKey = ? AND ResourceType = ? AND LabelValue = ?)",
+			[]interface{}{"team", "Run", "vision"},
+		},
+		{
+			`filters {
+			   combinator: OR
+			   negate: true
+			   predicates { key: "param:optimizer" op: NOT_EQUALS string_value: "adam" }
+			   predicates { key: "metric:loss" op: GREATER_THAN_EQUALS int_value: 2 }
+			 }`,
+			"SELECT mycolumn WHERE NOT (runs.UUID IN (SELECT RunUUID FROM run_parameters WHERE Name = ? AND Value <> ?) " +
+				"OR runs.UUID IN (SELECT RunUUID FROM run_metrics WHERE Name = ? AND NumberValue >= ?))",
+			[]interface{}{"optimizer", "adam", "loss", int32(2)},
+		},
+	}
+
+	for _, test := range tests {
+		filterProto := &api.Filter{}
+		if err := proto.UnmarshalText(test.in, filterProto); err != nil {
+			t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", test.in, err)
+		}
+
+		filter, err := NewWithKeyMapAndLookups(filterProto, map[string]string{"name": "Name"}, "runs", "UUID", testLookups)
+		if err != nil {
+			t.Errorf("NewWithKeyMapAndLookups(%+v) = %+v, %v; Want nil error", filterProto, filter, err)
+			continue
+		}
+
+		gotSQL, gotArgs, err := filter.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+		if !cmp.Equal(gotSQL, test.wantSQL) || !cmp.Equal(gotArgs, test.wantArgs) || err != nil {
+			t.Errorf("Filter.AddToSelect(%+v) =\nGot: %q, %v, %v\nWant: %q, %v, <nil>",
+				filter, gotSQL, gotArgs, err, test.wantSQL, test.wantArgs)
+		}
+
+		// The filter must produce the same query after a round trip through JSON,
+		// which leaves out the tables and columns of the lookups.
+		b, err := json.Marshal(filter)
+		if err != nil {
+			t.Fatalf("json.Marshal(%+v) = _, %v\nWant nil error", filter, err)
+		}
+		if strings.Contains(string(b), "run_parameters") || strings.Contains(string(b), "UUID") {
+			t.Errorf("json.Marshal(%+v) = %s; Want no table or column names", filter, b)
+		}
+		got := &Filter{}
+		if err := json.Unmarshal(b, got); err != nil {
+			t.Fatalf("json.Unmarshal(%s) = %v\nWant nil error", b, err)
+		}
+		if err := got.SetKeyValueLookups("runs", "UUID", testLookups); err != nil {
+			t.Fatalf("SetKeyValueLookups() = %v\nWant nil error", err)
+		}
+		gotSQL, _, err = got.AddToSelect(squirrel.Select("mycolumn")).ToSql()
+		if gotSQL != test.wantSQL || err != nil {
+			t.Errorf("Unmarshaled filter SQL =\nGot: %q, %v\nWant: %q, <nil>", gotSQL, err, test.wantSQL)
+		}
+	}
+}
+
+func TestSetKeyValueLookups_UnknownPrefix(t *testing.T) {
+	// A page token referring to a lookup which the listed model does not have.
+	got := &Filter{}
+	token := `{"FilterProto":"{}","KEYVALUES":[{"Prefix":"run_parameters WHERE 1=1; --","Name":"a","IsString":true,"Op":0,"Value":"b"}]}`
+	if err := json.Unmarshal([]byte(token), got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) = %v\nWant nil error", token, err)
+	}
+	if err := got.SetKeyValueLookups("runs", "UUID", testLookups); err == nil {
+		t.Errorf("SetKeyValueLookups() = <nil>; Want error")
+	}
+	if _, _, err := got.AddToSelect(squirrel.Select("mycolumn")).ToSql(); err == nil {
+		t.Errorf("AddToSelect().ToSql() = _, _, <nil>; Want error")
+	}
+}
+
+func TestInvalidFiltersWithKeyValueLookups(t *testing.T) {
+	tests := []string{
+		// Metrics only have numeric values.
+		`predicates { key: "metric:accuracy" op: EQUALS string_value: "high" }`,
+		// Missing parameter name.
+		`predicates { key: "param:" op: EQUALS string_value: "adam" }`,
+		// Unknown key in a nested filter.
+		`filters { predicates { key: "tag:team" op: EQUALS string_value: "a" } }`,
+		// Substring match on a numeric value.
+		`predicates { key: "param:learning_rate" op: IS_SUBSTRING double_value: 0.1 }`,
+	}
+
+	for _, test := range tests {
+		filterProto := &api.Filter{}
+		if err := proto.UnmarshalText(test, filterProto); err != nil {
+			t.Fatalf("Failed to unmarshal Filter text proto\n%q\nError: %v", test, err)
+		}
+
+		f, err := NewWithKeyMapAndLookups(filterProto, map[string]string{"name": "Name"}, "runs", "UUID", testLookups)
+		if err == nil {
+			t.Errorf("NewWithKeyMapAndLookups(%+v) = %+v, <nil>; Want error", filterProto, f)
+		}
+	}
+}
@@ -94,6 +94,9 @@ func (t *token) marshal() (string, error) {
 // of results as well as subsequent pages of results.
 type Options struct {
 	PageSize int
+	// BasicView leaves the large fields, such as manifests, out of the listed
+	// resources. It is set per request and not carried in page tokens.
+	BasicView bool
 	*token
 }
 
@@ -105,10 +108,10 @@ func (o *Options) Matches(opts *Options) bool {
 		reflect.DeepEqual(o.Filter, opts.Filter)
 }
 
-// NewOptionsFromToken creates a new Options struct from the passed in token
-// which represents the next page of results. An empty nextPageToken will result
-// in an error.
-func NewOptionsFromToken(nextPageToken string, pageSize int) (*Options, error) {
+// NewOptionsFromToken creates a new Options struct for the given listable from
+// the passed in token which represents the next page of results. An empty
+// nextPageToken will result in an error.
+func NewOptionsFromToken(listable Listable, nextPageToken string, pageSize int) (*Options, error) {
 	if nextPageToken == "" {
 		return nil, util.NewInvalidInputError("cannot create list.Options from empty page token")
 	}
@@ -121,9 +124,25 @@ func NewOptionsFromToken(nextPageToken string, pageSize int) (*Options, error) {
 	if err := t.unmarshal(nextPageToken); err != nil {
 		return nil, err
 	}
+	if t.Filter != nil {
+		// The lookups are not part of the token, see filter.SetKeyValueLookups.
+		if err := t.Filter.SetKeyValueLookups(listable.GetModelName(), listable.PrimaryKeyColumnName(),
+			keyValueLookups(listable)); err != nil {
+			return nil, err
+		}
+	}
 	return &Options{PageSize: pageSize, token: t}, nil
 }
 
+// keyValueLookups returns the lookups of listable, if it is a
+// KeyValueListable.
+func keyValueLookups(listable Listable) map[string]filter.KeyValueLookup {
+	if kv, ok := listable.(KeyValueListable); ok {
+		return kv.GetKeyValueLookups()
+	}
+	return nil
+}
+
 // NewOptions creates a new Options struct for the given listable. It uses
 // sorting and filtering criteria parsed from sortBy and filterProto
 // respectively.
@@ -163,7 +182,8 @@ func NewOptions(listable Listable, pageSize int, sortBy string, filterProto *api
 
 	// Filtering.
 	if filterProto != nil {
-		f, err := filter.NewWithKeyMap(filterProto, listable.APIToModelFieldMap(), listable.GetModelName())
+		f, err := filter.NewWithKeyMapAndLookups(filterProto, listable.APIToModelFieldMap(), listable.GetModelName(),
+			listable.PrimaryKeyColumnName(), keyValueLookups(listable))
 		if err != nil {
 			return nil, err
 		}
@@ -320,6 +340,15 @@ type Listable interface {
 	GetFieldValue(name string) interface{}
 }
 
+// KeyValueListable is implemented by listable models that can also be filtered
+// on name/value pairs stored in other tables, e.g. run parameters and metrics.
+type KeyValueListable interface {
+	Listable
+	// GetKeyValueLookups returns the lookups keyed by the prefix of the filtering
+	// keys they support, e.g. "param:".
+	GetKeyValueLookups() map[string]filter.KeyValueLookup
+}
+
 // NextPageToken returns a string that can be used to fetch the subsequent set
 // of results using the same listing options in o, starting with listable as the
 // first record.
@@ -261,7 +261,7 @@ func TestNewOptions_FromValidSerializedToken(t *testing.T) {
 	}
 
 	want := &Options{PageSize: 123, token: tok}
-	got, err := NewOptionsFromToken(s, 123)
+	got, err := NewOptionsFromToken(&fakeListable{}, s, 123)
 
 	opt := cmp.AllowUnexported(Options{})
 	if !cmp.Equal(got, want, opt) || err != nil {
@@ -274,7 +274,7 @@ func TestNewOptionsFromToken_FromInValidSerializedToken(t *testing.T) {
 	tests := []struct{ in string }{{"random nonsense"}, {""}}
 
 	for _, test := range tests {
-		got, err := NewOptionsFromToken(test.in, 123)
+		got, err := NewOptionsFromToken(&fakeListable{}, test.in, 123)
 		if err == nil {
 			t.Errorf("NewOptionsFromToken(%q, 123) =\nGot: %+v, <nil>\nWant: _, error",
 				test.in, got)
@@ -297,7 +297,7 @@ func TestNewOptionsFromToken_FromInValidPageSize(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to marshal token %+v: %v", tok, err)
 	}
-	got, err := NewOptionsFromToken(s, -1)
+	got, err := NewOptionsFromToken(&fakeListable{}, s, -1)
 
 	if err == nil {
 		t.Errorf("NewOptionsFromToken(%q, 123) =\nGot: %+v, <nil>\nWant: _, error",
@@ -634,6 +634,28 @@ func TestTokenSerialization(t *testing.T) {
 		t.Fatalf("failed to parse filter proto %+v: %v", protoFilter, err)
 	}
 
+	protoNestedFilter := &api.Filter{
+		Combinator: api.Filter_OR,
+		Predicates: []*api.Predicate{
+			&api.Predicate{
+				Key:   "name",
+				Op:    api.Predicate_EQUALS,
+				Value: &api.Predicate_StringValue{StringValue: "SomeName"},
+			}},
+		Filters: []*api.Filter{
+			&api.Filter{
+				Negate: true,
+				Predicates: []*api.Predicate{
+					&api.Predicate{
+						Key:   "name",
+						Op:    api.Predicate_IS_SUBSTRING,
+						Value: &api.Predicate_StringValue{StringValue: "tmp"},
+					}}}}}
+	testNestedFilter, err := filter.New(protoNestedFilter)
+	if err != nil {
+		t.Fatalf("failed to parse filter proto %+v: %v", protoNestedFilter, err)
+	}
+
 	tests := []struct {
 		in   *token
 		want *token
@@ -699,6 +721,29 @@ func TestTokenSerialization(t *testing.T) {
 				Filter:            testFilter,
 			},
 		},
+		// has a filter with nested filters.
+		{
+			in: &token{
+				SortByFieldName:   "SortField",
+				SortByFieldValue:  "string_field_value",
+				SortByFieldPrefix: "",
+				KeyFieldName:      "KeyField",
+				KeyFieldValue:     "string_key_value",
+				KeyFieldPrefix:    "",
+				IsDesc:            false,
+				Filter:            testNestedFilter,
+			},
+			want: &token{
+				SortByFieldName:   "SortField",
+				SortByFieldValue:  "string_field_value",
+				SortByFieldPrefix: "",
+				KeyFieldName:      "KeyField",
+				KeyFieldValue:     "string_key_value",
+				KeyFieldPrefix:    "",
+				IsDesc:            false,
+				Filter:            testNestedFilter,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -37,6 +37,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/server"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -58,9 +59,14 @@ func main() {
 	flag.Parse()
 
 	initConfig()
+	shutdownTracing, err := tracing.Init("ml-pipeline", common.GetTracingConfig())
+	if err != nil {
+		glog.Fatalf("Failed to initialize tracing. Err: %v", err)
+	}
+	defer shutdownTracing(context.Background())
 	clientManager := newClientManager()
 	resourceManager := resource.NewResourceManager(&clientManager)
-	err := loadSamples(resourceManager)
+	err = loadSamples(resourceManager)
 	if err != nil {
 		glog.Fatalf("Failed to load samples. Err: %v", err)
 	}
@@ -70,6 +76,18 @@ func main() {
 		glog.Fatalf("Failed to create default experiment. Err: %v", err)
 	}
 
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	if interval := common.GetRetentionPolicyEnforcementInterval(); interval > 0 {
+		go resource.NewRetentionWorker(resourceManager, interval).Run(stopCh)
+	}
+	if interval := common.GetRunObjectCleanupInterval(); interval > 0 {
+		go resource.NewRunObjectCleanupWorker(resourceManager, interval).Run(stopCh)
+	}
+	if interval := common.GetRunDispatchInterval(); interval > 0 {
+		go resource.NewRunDispatcher(resourceManager, interval).Run(stopCh)
+	}
+
 	go startRpcServer(resourceManager)
 	startHttpProxy(resourceManager)
 
@@ -91,7 +109,14 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 	if err != nil {
 		glog.Fatalf("Failed to start RPC server: %v", err)
 	}
-	s := grpc.NewServer(grpc.UnaryInterceptor(apiServerInterceptor), grpc.MaxRecvMsgSize(math.MaxInt32))
+	interceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor()}
+	if *collectMetricsFlag {
+		interceptors = append(interceptors, server.MetricsInterceptor)
+	}
+	interceptors = append(interceptors, apiServerInterceptor, server.NewAuditInterceptor(resourceManager).Intercept)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.MaxRecvMsgSize(math.MaxInt32))
 	api.RegisterPipelineServiceServer(s, server.NewPipelineServer(resourceManager, &server.PipelineServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterExperimentServiceServer(s, server.NewExperimentServer(resourceManager, &server.ExperimentServerOptions{CollectMetrics: *collectMetricsFlag}))
 	api.RegisterRunServiceServer(s, server.NewRunServer(resourceManager, &server.RunServerOptions{CollectMetrics: *collectMetricsFlag}))
@@ -105,6 +130,11 @@ func startRpcServer(resourceManager *resource.ResourceManager) {
 			common.GetStringConfig(visualizationServicePort),
 		))
 	api.RegisterAuthServiceServer(s, server.NewAuthServer(resourceManager))
+	api.RegisterLabelServiceServer(s, server.NewLabelServer(resourceManager))
+	api.RegisterRetentionPolicyServiceServer(s, server.NewRetentionPolicyServer(resourceManager))
+	api.RegisterQuotaServiceServer(s, server.NewQuotaServer(resourceManager))
+	api.RegisterLineageServiceServer(s, server.NewLineageServer(resourceManager))
+	api.RegisterAuditServiceServer(s, server.NewAuditServer(resourceManager))
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
@@ -130,36 +160,59 @@ func startHttpProxy(resourceManager *resource.ResourceManager) {
 	registerHttpHandlerFromEndpoint(api.RegisterReportServiceHandlerFromEndpoint, "ReportService", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterVisualizationServiceHandlerFromEndpoint, "Visualization", ctx, runtimeMux)
 	registerHttpHandlerFromEndpoint(api.RegisterAuthServiceHandlerFromEndpoint, "AuthService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterLabelServiceHandlerFromEndpoint, "LabelService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterRetentionPolicyServiceHandlerFromEndpoint, "RetentionPolicyService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterQuotaServiceHandlerFromEndpoint, "QuotaService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterLineageServiceHandlerFromEndpoint, "LineageService", ctx, runtimeMux)
+	registerHttpHandlerFromEndpoint(api.RegisterAuditServiceHandlerFromEndpoint, "AuditService", ctx, runtimeMux)
 
 	// Create a top level mux to include both pipeline upload server and gRPC servers.
 	topMux := mux.NewRouter()
+	// The HTTP-only handlers are instrumented like the gRPC servers.
+	instrument := func(handlerName string, handler http.HandlerFunc) http.Handler {
+		if !*collectMetricsFlag {
+			return handler
+		}
+		return server.InstrumentHTTPHandler(handlerName, handler)
+	}
 
 	// multipart upload is only supported in HTTP. In long term, we should have gRPC endpoints that
 	// accept pipeline url for importing.
 	// https://github.com/grpc-ecosystem/grpc-gateway/issues/410
 	pipelineUploadServer := server.NewPipelineUploadServer(resourceManager, &server.PipelineUploadServerOptions{CollectMetrics: *collectMetricsFlag})
-	topMux.HandleFunc("/apis/v1beta1/pipelines/upload", pipelineUploadServer.UploadPipeline)
-	topMux.HandleFunc("/apis/v1beta1/pipelines/upload_version", pipelineUploadServer.UploadPipelineVersion)
+	topMux.Handle("/apis/v1beta1/pipelines/upload", instrument("UploadPipeline", pipelineUploadServer.UploadPipeline))
+	topMux.Handle("/apis/v1beta1/pipelines/upload_version", instrument("UploadPipelineVersion", pipelineUploadServer.UploadPipelineVersion))
 	topMux.HandleFunc("/apis/v1beta1/healthz", func(w http.ResponseWriter, r *http.Request) {
 		io.WriteString(w, `{"commit_sha":"`+common.GetStringConfigWithDefault("COMMIT_SHA", "unknown")+`", "tag_name":"`+common.GetStringConfigWithDefault("TAG_NAME", "unknown")+`", "multi_user":`+strconv.FormatBool(common.IsMultiUserMode())+`}`)
 	})
 
 	// log streaming is provided via HTTP.
 	runLogServer := server.NewRunLogServer(resourceManager)
-	topMux.HandleFunc("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/log", runLogServer.ReadRunLog)
+	topMux.Handle("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/log", instrument("ReadRunLog", runLogServer.ReadRunLog))
+	topMux.Handle("/apis/v1beta1/runs/{run_id}/log", instrument("ReadRunLogs", runLogServer.ReadRunLogs))
+	topMux.Handle("/apis/v1beta1/runs/{run_id}/log:download", instrument("DownloadRunLogs", runLogServer.DownloadRunLogs))
+
+	// Large artifacts are streamed via HTTP.
+	runArtifactServer := server.NewRunArtifactServer(resourceManager)
+	topMux.Handle("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download",
+		instrument("DownloadArtifact", runArtifactServer.DownloadArtifact))
 
 	topMux.PathPrefix("/apis/").Handler(runtimeMux)
 
 	// Register a handler for Prometheus to poll.
 	topMux.Handle("/metrics", promhttp.Handler())
 
-	http.ListenAndServe(*httpPortFlag, topMux)
+	http.ListenAndServe(*httpPortFlag, tracing.NewHandler(topMux, "ml-pipeline", "/metrics", "/apis/v1beta1/healthz"))
 	glog.Info("Http Proxy started")
 }
 
 func registerHttpHandlerFromEndpoint(handler RegisterHttpHandlerFromEndpoint, serviceName string, ctx context.Context, mux *runtime.ServeMux) {
 	endpoint := "localhost" + *rpcPortFlag
-	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))}
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32)),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+	}
 
 	if err := handler(ctx, mux, endpoint, opts); err != nil {
 		glog.Fatalf("Failed to register %v handler: %v", serviceName, err)
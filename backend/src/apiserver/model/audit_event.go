@@ -0,0 +1,113 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// The outcomes of audited operations.
+const (
+	AuditOutcomeSucceeded = "SUCCEEDED"
+	AuditOutcomeFailed    = "FAILED"
+	AuditOutcomeDenied    = "DENIED"
+)
+
+// AuditEvent records who did a mutating API operation on which resource, and
+// whether it succeeded.
+type AuditEvent struct {
+	UUID           string `gorm:"column:UUID; not null; primary_key"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null; index"`
+	User           string `gorm:"column:User; not null; size:255"`
+	// The full name of the gRPC method, e.g. /api.RunService/DeleteRun, or the
+	// path of the HTTP endpoint of pipeline uploads.
+	Method string `gorm:"column:Method; not null; size:255"`
+	// The type of the target resource, as named in RBAC rules, e.g. runs.
+	ResourceType string `gorm:"column:ResourceType; not null; size:64"`
+	ResourceUUID string `gorm:"column:ResourceUUID; not null; index; size:191"`
+	Namespace    string `gorm:"column:Namespace; not null; index; size:63"`
+	Outcome      string `gorm:"column:Outcome; not null; size:32"`
+	Error        string `gorm:"column:Error; not null; size:65535"`
+}
+
+func (e AuditEvent) GetValueOfPrimaryKey() string {
+	return e.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model AuditEvent.
+func (e *AuditEvent) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model AuditEvent.
+func (e *AuditEvent) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var auditEventAPIToModelFieldMap = map[string]string{
+	"id":            "UUID",
+	"created_at":    "CreatedAtInSec",
+	"user":          "User",
+	"method":        "Method",
+	"resource_type": "ResourceType",
+	"resource_id":   "ResourceUUID",
+	"namespace":     "Namespace",
+	"outcome":       "Outcome",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// AuditEvent.
+func (e *AuditEvent) APIToModelFieldMap() map[string]string {
+	return auditEventAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix
+func (e *AuditEvent) GetModelName() string {
+	return "audit_events"
+}
+
+func (e *AuditEvent) GetField(name string) (string, bool) {
+	if field, ok := auditEventAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (e *AuditEvent) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return e.UUID
+	case "CreatedAtInSec":
+		return e.CreatedAtInSec
+	case "User":
+		return e.User
+	case "Method":
+		return e.Method
+	case "ResourceType":
+		return e.ResourceType
+	case "ResourceUUID":
+		return e.ResourceUUID
+	case "Namespace":
+		return e.Namespace
+	case "Outcome":
+		return e.Outcome
+	default:
+		return nil
+	}
+}
+
+func (e *AuditEvent) GetSortByFieldPrefix(name string) string {
+	return "audit_events."
+}
+
+func (e *AuditEvent) GetKeyFieldPrefix() string {
+	return "audit_events."
+}
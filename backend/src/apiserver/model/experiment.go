@@ -1,12 +1,18 @@
 package model
 
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
+)
+
 type Experiment struct {
-	UUID           string `gorm:"column:UUID; not null; primary_key"`
-	Name           string `gorm:"column:Name; not null; unique_index:idx_name_namespace"`
-	Description    string `gorm:"column:Description; not null"`
-	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
-	Namespace      string `gorm:"column:Namespace; not null; unique_index:idx_name_namespace"`
-	StorageState   string `gorm:"column:StorageState; not null;"`
+	UUID           string            `gorm:"column:UUID; not null; primary_key"`
+	Name           string            `gorm:"column:Name; not null; unique_index:idx_name_namespace"`
+	Description    string            `gorm:"column:Description; not null"`
+	CreatedAtInSec int64             `gorm:"column:CreatedAtInSec; not null"`
+	Namespace      string            `gorm:"column:Namespace; not null; unique_index:idx_name_namespace"`
+	StorageState   string            `gorm:"column:StorageState; not null;"`
+	Labels         map[string]string `gorm:"-"`
 }
 
 func (e Experiment) GetValueOfPrimaryKey() string {
@@ -47,6 +53,15 @@ func (e *Experiment) GetModelName() string {
 	return "experiments"
 }
 
+var experimentKeyValueLookups = map[string]filter.KeyValueLookup{
+	LabelKeyPrefix: labelLookup(common.Experiment),
+}
+
+// GetKeyValueLookups returns the lookups for filtering experiments by labels.
+func (e *Experiment) GetKeyValueLookups() map[string]filter.KeyValueLookup {
+	return experimentKeyValueLookups
+}
+
 func (e *Experiment) GetField(name string) (string, bool) {
 	if field, ok := experimentAPIToModelFieldMap[name]; ok {
 		return field, true
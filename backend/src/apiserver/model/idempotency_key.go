@@ -0,0 +1,38 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "github.com/kubeflow/pipelines/backend/src/apiserver/common"
+
+// IdempotencyKey table stores the client-supplied keys of the requests which
+// created runs and jobs, so that a retried request returns the resource the
+// original request created.
+type IdempotencyKey struct {
+	// The type of the created resource object
+	ResourceType common.ResourceType `gorm:"column:ResourceType; not null; primary_key; size:64"`
+
+	// The key supplied by the client, which is at most 128 characters long.
+	Key string `gorm:"column:IdempotencyKey; not null; primary_key; size:128"`
+
+	// ID of the created resource object
+	ResourceUUID string `gorm:"column:ResourceUUID; not null; index; size:64"`
+
+	// The hex-encoded sha256 of the request which created the resource.
+	RequestHash string `gorm:"column:RequestHash; not null; size:64"`
+
+	// The time the key was reserved, used to reclaim the keys of requests
+	// which never completed.
+	ReservedAtInSec int64 `gorm:"column:ReservedAtInSec; not null; default:0"`
+}
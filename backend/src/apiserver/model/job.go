@@ -14,7 +14,12 @@
 
 package model
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
+)
 
 type Job struct {
 	UUID               string `gorm:"column:UUID; not null; primary_key"`
@@ -31,7 +36,10 @@ type Job struct {
 	ResourceReferences []*ResourceReference
 	Trigger
 	PipelineSpec
-	Conditions string `gorm:"column:Conditions; not null"`
+	Conditions string            `gorm:"column:Conditions; not null"`
+	Labels     map[string]string `gorm:"-"`
+	// The idempotency key of the request which created the job, if any.
+	IdempotencyKey *IdempotencyKey `gorm:"-"`
 }
 
 // Trigger specifies when to create a new workflow.
@@ -105,6 +113,15 @@ func (j *Job) GetModelName() string {
 	return "jobs"
 }
 
+var jobKeyValueLookups = map[string]filter.KeyValueLookup{
+	LabelKeyPrefix: labelLookup(common.Job),
+}
+
+// GetKeyValueLookups returns the lookups for filtering jobs by labels.
+func (j *Job) GetKeyValueLookups() map[string]filter.KeyValueLookup {
+	return jobKeyValueLookups
+}
+
 func (j *Job) GetField(name string) (string, bool) {
 	if field, ok := jobAPIToModelFieldMap[name]; ok {
 		return field, true
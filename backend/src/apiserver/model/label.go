@@ -0,0 +1,52 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
+)
+
+// LabelKeyPrefix is the prefix of filtering keys on labels, e.g. "label:team".
+const LabelKeyPrefix = "label:"
+
+// Label table stores the user-defined labels of runs, jobs, experiments and
+// pipeline versions.
+type Label struct {
+	// ID of the labeled resource object
+	ResourceUUID string `gorm:"column:ResourceUUID; not null; primary_key; size:64"`
+
+	// The type of the labeled resource object
+	ResourceType common.ResourceType `gorm:"column:ResourceType; not null; primary_key; size:64"`
+
+	// The label key, which is at most 63 characters long with an optional
+	// DNS subdomain prefix of at most 253 characters.
+	LabelKey string `gorm:"column:LabelKey; not null; primary_key; size:317"`
+
+	// The label value, which is at most 63 characters long.
+	LabelValue string `gorm:"column:LabelValue; not null; size:63"`
+}
+
+// labelLookup returns the lookup for filtering resources of the given type by
+// their labels.
+func labelLookup(resourceType common.ResourceType) filter.KeyValueLookup {
+	return filter.KeyValueLookup{
+		Table:             "labels",
+		ForeignKey:        "ResourceUUID",
+		NameColumn:        "LabelKey",
+		StringValueColumn: "LabelValue",
+		Constraints:       map[string]string{"ResourceType": string(resourceType)},
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// The directions in which the lineage of artifacts is walked.
+const (
+	LineageDirectionUpstream   = "UPSTREAM"
+	LineageDirectionDownstream = "DOWNSTREAM"
+	LineageDirectionBoth       = "BOTH"
+)
+
+// The types of lineage nodes and edges.
+const (
+	LineageNodeTypeExecution = "EXECUTION"
+	LineageNodeTypeArtifact  = "ARTIFACT"
+	LineageEdgeTypeInput     = "INPUT"
+	LineageEdgeTypeOutput    = "OUTPUT"
+)
+
+// LineageGraph is the graph of executions and artifacts recorded in ML
+// Metadata, linked by their events. It is not stored in the database.
+type LineageGraph struct {
+	Nodes []*LineageNode
+	Edges []*LineageEdge
+}
+
+// LineageNode is an execution or an artifact of a lineage graph.
+type LineageNode struct {
+	// The ML Metadata ID prefixed by the node type, e.g. "execution/1".
+	ID         string
+	NodeType   string
+	TypeName   string
+	Name       string
+	URI        string
+	State      string
+	Properties map[string]string
+	// The number of executions between the node and the artifacts whose
+	// lineage is walked.
+	Depth int32
+}
+
+// LineageEdge links an input artifact to an execution, or an execution to an
+// output artifact.
+type LineageEdge struct {
+	Source   string
+	Target   string
+	EdgeType string
+	// The name of the input or output of the execution.
+	Name string
+}
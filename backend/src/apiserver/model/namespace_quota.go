@@ -0,0 +1,59 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// NamespaceQuota limits the runs of a namespace. Runs created over the limits
+// are queued until the namespace has capacity again. Zero means no limit.
+type NamespaceQuota struct {
+	Namespace      string `gorm:"column:Namespace; not null; primary_key; size:63"`
+	MaxActiveRuns  int32  `gorm:"column:MaxActiveRuns; not null; default:0"`
+	MaxRunsPerHour int32  `gorm:"column:MaxRunsPerHour; not null; default:0"`
+	UpdatedAtInSec int64  `gorm:"column:UpdatedAtInSec; not null"`
+}
+
+// NamespaceQuotaUsage is the current usage of the quota of a namespace. It is
+// computed from the runs of the namespace and not stored.
+type NamespaceQuotaUsage struct {
+	// The runs which are neither queued nor finished.
+	ActiveRuns int32
+	// The runs started within the last hour.
+	RunsLastHour int32
+	QueuedRuns   int32
+}
+
+// NamespaceQuotaUsageOptions select the runs counted against the quota of a
+// namespace.
+type NamespaceQuotaUsageOptions struct {
+	// Runs created or admitted since then count as started in the last hour.
+	SinceInSec int64
+	// The runs whose PipelineRun exists. An unfinished run without a
+	// PipelineRun was deleted out of band and is not counted as active, unless
+	// it was created or admitted since GraceSinceInSec, since its PipelineRun
+	// may have been created after WorkflowRunIds were listed.
+	WorkflowRunIds  []string
+	GraceSinceInSec int64
+}
+
+// QueuedRun is a run waiting for its namespace to have capacity. It is removed
+// once the run is dispatched, cancelled or deleted.
+type QueuedRun struct {
+	RunUUID       string `gorm:"column:RunUUID; not null; primary_key"`
+	Namespace     string `gorm:"column:Namespace; not null; index; size:63"`
+	Priority      int32  `gorm:"column:Priority; not null; default:0"`
+	QueuedAtInSec int64  `gorm:"column:QueuedAtInSec; not null"`
+	// When the run was claimed to be dispatched, or 0 if it is not being
+	// dispatched. The claim keeps two dispatches of the run from racing.
+	ClaimedAtInSec int64 `gorm:"column:ClaimedAtInSec; not null; default:0"`
+}
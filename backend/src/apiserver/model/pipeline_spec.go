@@ -14,6 +14,12 @@
 
 package model
 
+import (
+	"encoding/json"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
 type PipelineSpec struct {
 	// Pipeline ID will be optional. It's available only if the resource is created through
 	// a pipeline ID.
@@ -33,3 +39,22 @@ type PipelineSpec struct {
 	// Store parameters key-value pairs as serialized string.
 	Parameters string `gorm:"column:Parameters; size:65535"`
 }
+
+// ParameterValues returns the values of the string parameters in Parameters,
+// keyed by parameter name.
+func (p PipelineSpec) ParameterValues() (map[string]string, error) {
+	values := make(map[string]string)
+	if p.Parameters == "" {
+		return values, nil
+	}
+	var params []v1beta1.Param
+	if err := json.Unmarshal([]byte(p.Parameters), &params); err != nil {
+		return nil, err
+	}
+	for _, param := range params {
+		if param.Value.Type == v1beta1.ParamTypeString {
+			values[param.Name] = param.Value.StringVal
+		}
+	}
+	return values, nil
+}
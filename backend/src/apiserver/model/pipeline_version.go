@@ -16,6 +16,9 @@ package model
 
 import (
 	"fmt"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 )
 
 // PipelineVersionStatus a label for the status of the Pipeline.
@@ -42,6 +45,8 @@ type PipelineVersion struct {
 	Status     PipelineVersionStatus `gorm:"column:Status; not null"`
 	// Code source url links to the pipeline version's definition in repo.
 	CodeSourceUrl string `gorm:"column:CodeSourceUrl;"`
+	// User-defined labels of the pipeline version.
+	Labels map[string]string `gorm:"-"`
 }
 
 func (p PipelineVersion) GetValueOfPrimaryKey() string {
@@ -74,6 +79,16 @@ func (p *PipelineVersion) GetModelName() string {
 	return "pipeline_versions"
 }
 
+var pipelineVersionKeyValueLookups = map[string]filter.KeyValueLookup{
+	LabelKeyPrefix: labelLookup(common.PipelineVersion),
+}
+
+// GetKeyValueLookups returns the lookups for filtering pipeline versions by
+// labels.
+func (p *PipelineVersion) GetKeyValueLookups() map[string]filter.KeyValueLookup {
+	return pipelineVersionKeyValueLookups
+}
+
 func (p *PipelineVersion) GetField(name string) (string, bool) {
 	if field, ok := p.APIToModelFieldMap()[name]; ok {
 		return field, true
@@ -0,0 +1,108 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+)
+
+// RetentionPolicy archives and deletes the finished runs of an experiment or
+// a namespace after a number of days.
+type RetentionPolicy struct {
+	UUID string `gorm:"column:UUID; not null; primary_key"`
+
+	// The type and ID of the experiment or namespace the policy applies to.
+	ResourceType common.ResourceType `gorm:"column:ResourceType; not null; unique_index:idx_retention_policy_resource; size:64"`
+	ResourceUUID string              `gorm:"column:ResourceUUID; not null; unique_index:idx_retention_policy_resource; size:191"`
+
+	// Zero disables archiving or deleting runs respectively.
+	ArchiveAfterDays int32 `gorm:"column:ArchiveAfterDays; not null"`
+	DeleteAfterDays  int32 `gorm:"column:DeleteAfterDays; not null"`
+
+	CreatedAtInSec int64 `gorm:"column:CreatedAtInSec; not null"`
+
+	// The result of enforcing the policy.
+	LastEnforcedAtInSec int64  `gorm:"column:LastEnforcedAtInSec; default:0"`
+	ArchivedRunCount    int64  `gorm:"column:ArchivedRunCount; default:0"`
+	DeletedRunCount     int64  `gorm:"column:DeletedRunCount; default:0"`
+	DeletedObjectCount  int64  `gorm:"column:DeletedObjectCount; default:0"`
+	Error               string `gorm:"column:Error; not null; size:65535"`
+}
+
+func (p RetentionPolicy) GetValueOfPrimaryKey() string {
+	return p.UUID
+}
+
+// PrimaryKeyColumnName returns the primary key for model RetentionPolicy.
+func (p *RetentionPolicy) PrimaryKeyColumnName() string {
+	return "UUID"
+}
+
+// DefaultSortField returns the default sorting field for model
+// RetentionPolicy.
+func (p *RetentionPolicy) DefaultSortField() string {
+	return "CreatedAtInSec"
+}
+
+var retentionPolicyAPIToModelFieldMap = map[string]string{
+	"id":                 "UUID",
+	"created_at":         "CreatedAtInSec",
+	"archive_after_days": "ArchiveAfterDays",
+	"delete_after_days":  "DeleteAfterDays",
+	"last_enforced_at":   "LastEnforcedAtInSec",
+}
+
+// APIToModelFieldMap returns a map from API names to field names for model
+// RetentionPolicy.
+func (p *RetentionPolicy) APIToModelFieldMap() map[string]string {
+	return retentionPolicyAPIToModelFieldMap
+}
+
+// GetModelName returns table name used as sort field prefix
+func (p *RetentionPolicy) GetModelName() string {
+	return "retention_policies"
+}
+
+func (p *RetentionPolicy) GetField(name string) (string, bool) {
+	if field, ok := retentionPolicyAPIToModelFieldMap[name]; ok {
+		return field, true
+	}
+	return "", false
+}
+
+func (p *RetentionPolicy) GetFieldValue(name string) interface{} {
+	switch name {
+	case "UUID":
+		return p.UUID
+	case "CreatedAtInSec":
+		return p.CreatedAtInSec
+	case "ArchiveAfterDays":
+		return p.ArchiveAfterDays
+	case "DeleteAfterDays":
+		return p.DeleteAfterDays
+	case "LastEnforcedAtInSec":
+		return p.LastEnforcedAtInSec
+	default:
+		return nil
+	}
+}
+
+func (p *RetentionPolicy) GetSortByFieldPrefix(name string) string {
+	return "retention_policies."
+}
+
+func (p *RetentionPolicy) GetKeyFieldPrefix() string {
+	return "retention_policies."
+}
@@ -15,11 +15,22 @@
 package model
 
 import (
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/filter"
 )
 
 const (
 	RunTerminatingConditions string = "Terminating"
+	// A run waiting for its namespace to have capacity before its PipelineRun
+	// is created.
+	RunQueuedConditions string = "Queued"
+	// A queued run terminated before it was dispatched.
+	RunCancelledConditions string = "Cancelled"
 )
 
 type Run struct {
@@ -37,6 +48,13 @@ type Run struct {
 	Conditions         string `gorm:"column:Conditions; not null"`
 	Metrics            []*RunMetric
 	ResourceReferences []*ResourceReference
+	Labels             map[string]string `gorm:"-"`
+	// The idempotency key of the request which created the run, if any.
+	IdempotencyKey *IdempotencyKey `gorm:"-"`
+	// The priority and the position of the run in the queue of its namespace
+	// while it is queued.
+	Priority      int32 `gorm:"-"`
+	QueuePosition int32 `gorm:"-"`
 	PipelineSpec
 }
 
@@ -60,6 +78,47 @@ type RunMetric struct {
 	Payload     string  `gorm:"column:Payload; not null; size:65535"`
 }
 
+// RunParameter is a projection of a run parameter stored in the Parameters of
+// the run, which allows filtering and sorting runs by parameter values.
+type RunParameter struct {
+	RunUUID string `gorm:"column:RunUUID; not null; primary_key"`
+	Name    string `gorm:"column:Name; not null; primary_key"`
+	Value   string `gorm:"column:Value; not null"`
+	// NumberValue is set if Value can be parsed as a number.
+	NumberValue *float64 `gorm:"column:NumberValue"`
+	// SortValue is the value by which runs are sorted on the parameter, see
+	// RunParameterSortValue.
+	SortValue string `gorm:"column:SortValue; not null; size:256"`
+}
+
+// RunParameterSortValue returns the value by which runs are sorted on a
+// parameter with the given value. Numbers are encoded so that they sort
+// numerically, and before the other values, which sort lexically.
+func RunParameterSortValue(value string) string {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "1" + value
+	}
+	// Flip the sign bit of positive numbers and all the bits of negative
+	// numbers, so that the bits sort in the same order as the numbers.
+	bits := math.Float64bits(f)
+	if bits&(1<<63) == 0 {
+		bits |= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	return fmt.Sprintf("0%016x", bits)
+}
+
+const (
+	// RunParameterKeyPrefix is the prefix of filtering and sorting keys on run
+	// parameters, e.g. "param:learning_rate".
+	RunParameterKeyPrefix = "param:"
+	// RunMetricKeyPrefix is the prefix of filtering and sorting keys on run
+	// metrics, e.g. "metric:accuracy".
+	RunMetricKeyPrefix = "metric:"
+)
+
 func (r Run) GetValueOfPrimaryKey() string {
 	return r.UUID
 }
@@ -84,6 +143,7 @@ var runAPIToModelFieldMap = map[string]string{
 	"created_at":    "CreatedAtInSec",
 	"description":   "Description",
 	"scheduled_at":  "ScheduledAtInSec",
+	"finished_at":   "FinishedAtInSec",
 	"storage_state": "StorageState",
 	"status":        "Conditions",
 }
@@ -104,12 +164,62 @@ func (r *Run) GetField(name string) (string, bool) {
 	if field, ok := runAPIToModelFieldMap[name]; ok {
 		return field, true
 	}
-	if strings.HasPrefix(name, "metric:") {
+	if strings.HasPrefix(name, RunMetricKeyPrefix) {
 		return name[7:], true
 	}
+	if strings.HasPrefix(name, RunParameterKeyPrefix) {
+		paramName := strings.TrimPrefix(name, RunParameterKeyPrefix)
+		if paramName == "" || strings.Contains(paramName, "`") {
+			return "", false
+		}
+		return runParameterSortField(paramName), true
+	}
 	return "", false
 }
 
+// runParameterSortField returns the sorting field for a run parameter. The
+// field is used as a column alias in the list query, hence it is quoted.
+func runParameterSortField(name string) string {
+	return "`" + RunParameterKeyPrefix + name + "`"
+}
+
+// GetRunParameterName returns the name of the run parameter if field is a
+// sorting field returned by Run.GetField for a run parameter.
+func GetRunParameterName(field string) (string, bool) {
+	prefix := "`" + RunParameterKeyPrefix
+	if len(field) <= len(prefix)+1 || !strings.HasPrefix(field, prefix) || !strings.HasSuffix(field, "`") {
+		return "", false
+	}
+	name := field[len(prefix) : len(field)-1]
+	if strings.Contains(name, "`") {
+		return "", false
+	}
+	return name, true
+}
+
+var runKeyValueLookups = map[string]filter.KeyValueLookup{
+	RunParameterKeyPrefix: {
+		Table:             "run_parameters",
+		ForeignKey:        "RunUUID",
+		NameColumn:        "Name",
+		StringValueColumn: "Value",
+		NumberValueColumn: "NumberValue",
+	},
+	RunMetricKeyPrefix: {
+		Table:             "run_metrics",
+		ForeignKey:        "RunUUID",
+		NameColumn:        "Name",
+		NumberValueColumn: "NumberValue",
+	},
+	LabelKeyPrefix: labelLookup(common.Run),
+}
+
+// GetKeyValueLookups returns the lookups for filtering runs by parameters,
+// metrics and labels.
+func (r *Run) GetKeyValueLookups() map[string]filter.KeyValueLookup {
+	return runKeyValueLookups
+}
+
 func (r *Run) GetFieldValue(name string) interface{} {
 	// "name" could be a field in Run type or a name inside an array typed field
 	// in Run type
@@ -125,12 +235,25 @@ func (r *Run) GetFieldValue(name string) interface{} {
 		return r.Description
 	case "ScheduledAtInSec":
 		return r.ScheduledAtInSec
+	case "FinishedAtInSec":
+		return r.FinishedAtInSec
 	case "StorageState":
 		return r.StorageState
 	case "Conditions":
 		return r.Conditions
 	}
-	// Second, try to find the match of "name" inside an array typed field
+	// Second, try to find the value if "name" is a run parameter
+	if paramName, ok := GetRunParameterName(name); ok {
+		values, err := r.ParameterValues()
+		if err != nil {
+			return nil
+		}
+		if value, ok := values[paramName]; ok {
+			return RunParameterSortValue(value)
+		}
+		return nil
+	}
+	// Third, try to find the match of "name" inside an array typed field
 	for _, metric := range r.Metrics {
 		if metric.Name == name {
 			return metric.NumberValue
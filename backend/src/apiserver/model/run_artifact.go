@@ -0,0 +1,36 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// The types of run artifacts.
+const (
+	RunArtifactTypeData       = "DATA"
+	RunArtifactTypeMetrics    = "METRICS"
+	RunArtifactTypeUIMetadata = "UI_METADATA"
+	RunArtifactTypeLog        = "LOG"
+)
+
+// RunArtifact is an artifact a task of a run stored in the object store. It is
+// found by listing the object store and not stored in the database.
+type RunArtifact struct {
+	Name                string
+	TaskName            string
+	ObjectKey           string
+	SizeBytes           int64
+	LastModifiedAtInSec int64
+	Type                string
+	// The path of the output in the task container, if the task declared it.
+	SourcePath string
+}
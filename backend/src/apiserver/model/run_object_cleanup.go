@@ -0,0 +1,31 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RunObjectCleanup tracks the deletion of the artifacts and archived logs a
+// deleted run left in the object store. It is removed once all the objects are
+// deleted.
+type RunObjectCleanup struct {
+	RunUUID string `gorm:"column:RunUUID; not null; primary_key"`
+	// The name of the PipelineRun of the run, which keys its objects.
+	RunName        string `gorm:"column:RunName; not null"`
+	CreatedAtInSec int64  `gorm:"column:CreatedAtInSec; not null"`
+
+	// The number of failed attempts, the time of the next attempt and the
+	// error of the last failed attempt.
+	Attempts           int32  `gorm:"column:Attempts; default:0"`
+	NextAttemptAtInSec int64  `gorm:"column:NextAttemptAtInSec; not null; index"`
+	LastError          string `gorm:"column:LastError; not null; size:65535"`
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+// auditLogEntry is the JSON representation of an audit event in the audit
+// log.
+type auditLogEntry struct {
+	ID           string `json:"id"`
+	Timestamp    string `json:"timestamp"`
+	User         string `json:"user"`
+	Method       string `json:"method"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Outcome      string `json:"outcome"`
+	Error        string `json:"error,omitempty"`
+}
+
+// auditLogWriter writes audit events to a sink as JSON lines. Concurrent
+// events are written one line at a time.
+type auditLogWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// newAuditLogWriter returns a writer of audit events to w, or nil if w is nil.
+func newAuditLogWriter(w io.Writer) *auditLogWriter {
+	if w == nil {
+		return nil
+	}
+	return &auditLogWriter{w: w}
+}
+
+func (l *auditLogWriter) write(event *model.AuditEvent) error {
+	line, err := json.Marshal(&auditLogEntry{
+		ID:           event.UUID,
+		Timestamp:    time.Unix(event.CreatedAtInSec, 0).UTC().Format(time.RFC3339),
+		User:         event.User,
+		Method:       event.Method,
+		ResourceType: event.ResourceType,
+		ResourceID:   event.ResourceUUID,
+		Namespace:    event.Namespace,
+		Outcome:      event.Outcome,
+		Error:        event.Error,
+	})
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.w.Write(append(line, '\n'))
+	return err
+}
+
+// RecordAuditEvent stores an audit event and writes it to the audit log, if
+// any. The event is written to the audit log even if it cannot be stored.
+func (r *ResourceManager) RecordAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error) {
+	newEvent, storeErr := r.auditEventStore.CreateAuditEvent(event)
+	if storeErr != nil {
+		glog.Errorf("Failed to store audit event %+v. Error: %v", event, storeErr)
+		unstoredEvent := *event
+		unstoredEvent.CreatedAtInSec = r.time.Now().Unix()
+		newEvent = &unstoredEvent
+	}
+	if r.auditLog != nil {
+		if err := r.auditLog.write(newEvent); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to write audit event to the audit log")
+		}
+	}
+	if storeErr != nil {
+		return nil, util.Wrap(storeErr, "Failed to record audit event")
+	}
+	return newEvent, nil
+}
+
+func (r *ResourceManager) ListAuditEvents(filterContext *common.FilterContext, opts *list.Options) (
+	events []*model.AuditEvent, total_size int, nextPageToken string, err error) {
+	return r.auditEventStore.ListAuditEvents(filterContext, opts)
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAuditEvent(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+
+	event, err := manager.RecordAuditEvent(&model.AuditEvent{
+		User:         "user@google.com",
+		Method:       "/api.RunService/DeleteRun",
+		ResourceType: "runs",
+		ResourceUUID: "run1",
+		Namespace:    "ns1",
+		Outcome:      model.AuditOutcomeFailed,
+		Error:        "run not found",
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, event.UUID)
+	assert.Equal(t, int64(1), event.CreatedAtInSec)
+
+	expectedLine := `{"id":"` + event.UUID + `","timestamp":"1970-01-01T00:00:01Z","user":"user@google.com",` +
+		`"method":"/api.RunService/DeleteRun","resource_type":"runs","resource_id":"run1","namespace":"ns1",` +
+		`"outcome":"FAILED","error":"run not found"}` + "\n"
+	assert.Equal(t, expectedLine, store.AuditLogFake.String())
+}
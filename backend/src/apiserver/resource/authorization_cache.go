@@ -0,0 +1,140 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"container/list"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+var (
+	authorizationCacheHitCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_authorization_cache_hits",
+		Help: "The number of authorization decisions found in the cache",
+	})
+
+	authorizationCacheMissCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_authorization_cache_misses",
+		Help: "The number of authorization decisions not found in the cache",
+	})
+)
+
+// authorizationDecision is the outcome of a SubjectAccessReview.
+type authorizationDecision struct {
+	allowed bool
+	reason  string
+}
+
+type authorizationCacheEntry struct {
+	key       string
+	decision  authorizationDecision
+	expiresAt time.Time
+}
+
+// authorizationCache is a bounded cache of the decisions of
+// SubjectAccessReviews, keyed by user, groups, extra attributes and resource
+// attributes. Decisions expire after a TTL, and the least recently used
+// decision is evicted when the cache is full.
+type authorizationCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	time    util.TimeInterface
+	entries map[string]*list.Element
+	// order lists the entries from the most to the least recently used.
+	order *list.List
+}
+
+// newAuthorizationCache returns a cache of at most size decisions, or nil if
+// the size or the TTL is not positive, which disables caching.
+func newAuthorizationCache(size int, ttl time.Duration, time util.TimeInterface) *authorizationCache {
+	if size <= 0 || ttl <= 0 {
+		return nil
+	}
+	return &authorizationCache{
+		size:    size,
+		ttl:     ttl,
+		time:    time,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *authorizationCache) get(key string) (authorizationDecision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		authorizationCacheMissCounter.Inc()
+		return authorizationDecision{}, false
+	}
+	entry := element.Value.(*authorizationCacheEntry)
+	if !c.time.Now().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		authorizationCacheMissCounter.Inc()
+		return authorizationDecision{}, false
+	}
+	c.order.MoveToFront(element)
+	authorizationCacheHitCounter.Inc()
+	return entry.decision, true
+}
+
+func (c *authorizationCache) put(key string, decision authorizationDecision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expiresAt := c.time.Now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*authorizationCacheEntry)
+		entry.decision = decision
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&authorizationCacheEntry{key: key, decision: decision, expiresAt: expiresAt})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*authorizationCacheEntry).key)
+	}
+}
+
+// authorizationCacheKey returns the key of the decision for a user to access
+// a resource. The groups and the extra attributes are sorted, so the key does
+// not depend on the order the authenticator reported them in.
+func authorizationCacheKey(userInfo *kfpauth.UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) (string, error) {
+	groups := append([]string(nil), userInfo.Groups...)
+	sort.Strings(groups)
+	extra := make(map[string][]string, len(userInfo.Extra))
+	for name, values := range userInfo.Extra {
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		extra[name] = sorted
+	}
+	key, err := json.Marshal([]interface{}{userInfo.Name, groups, extra, resourceAttributes})
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
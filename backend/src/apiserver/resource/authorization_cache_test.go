@@ -0,0 +1,202 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	authzv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// recordingSubjectAccessReviewClient records the SubjectAccessReviews and
+// allows the members of the group "admins".
+type recordingSubjectAccessReviewClient struct {
+	reviews []*authzv1.SubjectAccessReview
+	err     error
+}
+
+func (c *recordingSubjectAccessReviewClient) Create(ctx context.Context, review *authzv1.SubjectAccessReview, options v1.CreateOptions) (*authzv1.SubjectAccessReview, error) {
+	c.reviews = append(c.reviews, review)
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, group := range review.Spec.Groups {
+		if group == "admins" {
+			return &authzv1.SubjectAccessReview{Status: authzv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+		}
+	}
+	return &authzv1.SubjectAccessReview{Status: authzv1.SubjectAccessReviewStatus{Reason: "not an admin"}}, nil
+}
+
+var runAttributes = &authzv1.ResourceAttributes{
+	Namespace: "ns1",
+	Verb:      common.RbacResourceVerbGet,
+	Group:     common.RbacPipelinesGroup,
+	Version:   common.RbacPipelinesVersion,
+	Resource:  common.RbacResourceTypeRuns,
+}
+
+func initWithRecordingSubjectAccessReviewClient() (*recordingSubjectAccessReviewClient, *ResourceManager) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	sarClient := &recordingSubjectAccessReviewClient{}
+	store.SubjectAccessReviewClientFake = sarClient
+	return sarClient, NewResourceManager(store)
+}
+
+func TestAuthenticateRequest_UserInfo(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	md := metadata.New(map[string]string{common.AuthorizationBearerTokenHeader: common.AuthorizationBearerTokenPrefix + "token"})
+
+	userInfo, err := manager.AuthenticateRequest(metadata.NewIncomingContext(context.Background(), md))
+	assert.Nil(t, err)
+	assert.Equal(t, &kfpauth.UserInfo{
+		Name:   "test",
+		Groups: []string{"test-group"},
+		Extra:  map[string][]string{"scopes": {"read"}},
+	}, userInfo)
+}
+
+func TestIsRequestAuthorized_Groups(t *testing.T) {
+	sarClient, manager := initWithRecordingSubjectAccessReviewClient()
+	userInfo := &kfpauth.UserInfo{
+		Name:   "user@example.com",
+		Groups: []string{"admins"},
+		Extra:  map[string][]string{"scopes": {"read"}},
+	}
+
+	err := manager.IsRequestAuthorized(context.Background(), userInfo, runAttributes)
+	assert.Nil(t, err)
+	assert.Len(t, sarClient.reviews, 1)
+	assert.Equal(t, authzv1.SubjectAccessReviewSpec{
+		ResourceAttributes: runAttributes,
+		User:               "user@example.com",
+		Groups:             []string{"admins"},
+		Extra:              map[string]authzv1.ExtraValue{"scopes": {"read"}},
+	}, sarClient.reviews[0].Spec)
+
+	err = manager.IsRequestAuthorized(context.Background(), &kfpauth.UserInfo{Name: "user@example.com"}, runAttributes)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.PermissionDenied, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "not an admin")
+}
+
+func TestIsRequestAuthorized_CachesDecisions(t *testing.T) {
+	sarClient, manager := initWithRecordingSubjectAccessReviewClient()
+	admin := &kfpauth.UserInfo{Name: "user@example.com", Groups: []string{"team", "admins"}}
+	user := &kfpauth.UserInfo{Name: "user@example.com", Groups: []string{"team"}}
+
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(), admin, runAttributes))
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(),
+		&kfpauth.UserInfo{Name: "user@example.com", Groups: []string{"admins", "team"}}, runAttributes))
+	assert.Len(t, sarClient.reviews, 1)
+
+	// Denials are cached too, separately for each set of groups.
+	assert.NotNil(t, manager.IsRequestAuthorized(context.Background(), user, runAttributes))
+	assert.NotNil(t, manager.IsRequestAuthorized(context.Background(), user, runAttributes))
+	assert.Len(t, sarClient.reviews, 2)
+
+	// Other resource attributes are reviewed again.
+	otherAttributes := *runAttributes
+	otherAttributes.Namespace = "ns2"
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(), admin, &otherAttributes))
+	assert.Len(t, sarClient.reviews, 3)
+}
+
+func TestIsRequestAuthorized_DoesNotCacheErrors(t *testing.T) {
+	sarClient, manager := initWithRecordingSubjectAccessReviewClient()
+	sarClient.err = errors.New("apiserver unavailable")
+	admin := &kfpauth.UserInfo{Name: "user@example.com", Groups: []string{"admins"}}
+
+	err := manager.IsRequestAuthorized(context.Background(), admin, runAttributes)
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
+
+	sarClient.err = nil
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(), admin, runAttributes))
+	assert.Len(t, sarClient.reviews, 2)
+}
+
+func TestIsRequestAuthorized_CacheDisabled(t *testing.T) {
+	viper.Set(common.AuthorizationCacheTTL, "0s")
+	defer viper.Set(common.AuthorizationCacheTTL, nil)
+	sarClient, manager := initWithRecordingSubjectAccessReviewClient()
+	admin := &kfpauth.UserInfo{Name: "user@example.com", Groups: []string{"admins"}}
+
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(), admin, runAttributes))
+	assert.Nil(t, manager.IsRequestAuthorized(context.Background(), admin, runAttributes))
+	assert.Len(t, sarClient.reviews, 2)
+}
+
+func TestAuthorizationCache_Expires(t *testing.T) {
+	// The fake time advances by a second on every read.
+	cache := newAuthorizationCache(10, 2*time.Second, util.NewFakeTimeForEpoch())
+	cache.put("key", authorizationDecision{allowed: true})
+
+	decision, ok := cache.get("key")
+	assert.True(t, ok)
+	assert.True(t, decision.allowed)
+	_, ok = cache.get("key")
+	assert.False(t, ok)
+}
+
+func TestAuthorizationCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newAuthorizationCache(2, time.Hour, util.NewFakeTimeForEpoch())
+	cache.put("a", authorizationDecision{allowed: true})
+	cache.put("b", authorizationDecision{allowed: true})
+	_, ok := cache.get("a")
+	assert.True(t, ok)
+
+	cache.put("c", authorizationDecision{allowed: true})
+	_, ok = cache.get("b")
+	assert.False(t, ok)
+	_, ok = cache.get("a")
+	assert.True(t, ok)
+	_, ok = cache.get("c")
+	assert.True(t, ok)
+}
+
+func TestAuthorizationCacheKey(t *testing.T) {
+	key1, err := authorizationCacheKey(&kfpauth.UserInfo{
+		Name:   "user",
+		Groups: []string{"a", "b"},
+		Extra:  map[string][]string{"scopes": {"x", "y"}},
+	}, runAttributes)
+	assert.Nil(t, err)
+	key2, err := authorizationCacheKey(&kfpauth.UserInfo{
+		Name:   "user",
+		Groups: []string{"b", "a"},
+		Extra:  map[string][]string{"scopes": {"y", "x"}},
+	}, runAttributes)
+	assert.Nil(t, err)
+	assert.Equal(t, key1, key2)
+
+	key3, err := authorizationCacheKey(&kfpauth.UserInfo{Name: "user", Groups: []string{"a"}}, runAttributes)
+	assert.Nil(t, err)
+	assert.NotEqual(t, key1, key3)
+	assert.Nil(t, newAuthorizationCache(0, time.Hour, util.NewFakeTimeForEpoch()))
+}
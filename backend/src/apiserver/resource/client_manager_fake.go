@@ -15,6 +15,9 @@
 package resource
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/golang/glog"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/auth"
@@ -40,9 +43,18 @@ type FakeClientManager struct {
 	jobStore                      storage.JobStoreInterface
 	runStore                      storage.RunStoreInterface
 	resourceReferenceStore        storage.ResourceReferenceStoreInterface
+	labelStore                    storage.LabelStoreInterface
+	idempotencyKeyStore           storage.IdempotencyKeyStoreInterface
+	namespaceQuotaStore           storage.NamespaceQuotaStoreInterface
+	runQueueStore                 storage.RunQueueStoreInterface
+	retentionPolicyStore          storage.RetentionPolicyStoreInterface
+	auditEventStore               storage.AuditEventStoreInterface
+	AuditLogFake                  *bytes.Buffer
+	runObjectCleanupStore         storage.RunObjectCleanupStoreInterface
 	dBStatusStore                 storage.DBStatusStoreInterface
 	defaultExperimentStore        storage.DefaultExperimentStoreInterface
 	objectStore                   storage.ObjectStoreInterface
+	MetadataStoreFake             *client.FakeMetadataStore
 	swfClientFake                 *client.FakeSwfClient
 	k8sCoreClientFake             *client.FakeKuberneteCoreClient
 	SubjectAccessReviewClientFake client.SubjectAccessReviewInterface
@@ -70,18 +82,31 @@ func NewFakeClientManager(time util.TimeInterface, uuid util.UUIDGeneratorInterf
 		return nil, err
 	}
 
-	// TODO(neuromage): Pass in metadata.Store instance for tests as well.
+	objectStore := storage.NewFakeObjectStore()
+	// Audit events have their own clock and IDs, so that recording them does
+	// not change the creation times and IDs of the audited resources.
+	auditEventStore := storage.NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewUUIDGenerator())
+
 	return &FakeClientManager{
 		TektonClientFake:              client.NewFakeTektonClient(),
 		db:                            db,
 		experimentStore:               storage.NewExperimentStore(db, time, uuid),
 		pipelineStore:                 storage.NewPipelineStore(db, time, uuid),
 		jobStore:                      storage.NewJobStore(db, time),
-		runStore:                      storage.NewRunStore(db, time),
+		runStore:                      storage.NewRunStoreWithObjectStore(db, time, objectStore, false),
 		resourceReferenceStore:        storage.NewResourceReferenceStore(db),
+		labelStore:                    storage.NewLabelStore(db),
+		idempotencyKeyStore:           storage.NewIdempotencyKeyStore(db, time),
+		namespaceQuotaStore:           storage.NewNamespaceQuotaStore(db, time),
+		runQueueStore:                 storage.NewRunQueueStore(db),
+		retentionPolicyStore:          storage.NewRetentionPolicyStore(db, time, uuid),
+		auditEventStore:               auditEventStore,
+		AuditLogFake:                  &bytes.Buffer{},
+		runObjectCleanupStore:         storage.NewRunObjectCleanupStore(db, time),
 		dBStatusStore:                 storage.NewDBStatusStore(db),
 		defaultExperimentStore:        storage.NewDefaultExperimentStore(db),
-		objectStore:                   storage.NewFakeObjectStore(),
+		objectStore:                   objectStore,
+		MetadataStoreFake:             client.NewFakeMetadataStore(),
 		swfClientFake:                 client.NewFakeSwfClient(),
 		k8sCoreClientFake:             client.NewFakeKuberneteCoresClient(),
 		SubjectAccessReviewClientFake: client.NewFakeSubjectAccessReviewClient(),
@@ -114,6 +139,10 @@ func (f *FakeClientManager) ObjectStore() storage.ObjectStoreInterface {
 	return f.objectStore
 }
 
+func (f *FakeClientManager) MetadataStore() client.MetadataStoreInterface {
+	return f.MetadataStoreFake
+}
+
 func (f *FakeClientManager) LogArchive() archive.LogArchiveInterface {
 	return f.logArchive
 }
@@ -146,6 +175,38 @@ func (f *FakeClientManager) ResourceReferenceStore() storage.ResourceReferenceSt
 	return f.resourceReferenceStore
 }
 
+func (f *FakeClientManager) LabelStore() storage.LabelStoreInterface {
+	return f.labelStore
+}
+
+func (f *FakeClientManager) IdempotencyKeyStore() storage.IdempotencyKeyStoreInterface {
+	return f.idempotencyKeyStore
+}
+
+func (f *FakeClientManager) NamespaceQuotaStore() storage.NamespaceQuotaStoreInterface {
+	return f.namespaceQuotaStore
+}
+
+func (f *FakeClientManager) RunQueueStore() storage.RunQueueStoreInterface {
+	return f.runQueueStore
+}
+
+func (f *FakeClientManager) RetentionPolicyStore() storage.RetentionPolicyStoreInterface {
+	return f.retentionPolicyStore
+}
+
+func (f *FakeClientManager) AuditEventStore() storage.AuditEventStoreInterface {
+	return f.auditEventStore
+}
+
+func (f *FakeClientManager) AuditLog() io.Writer {
+	return f.AuditLogFake
+}
+
+func (f *FakeClientManager) RunObjectCleanupStore() storage.RunObjectCleanupStoreInterface {
+	return f.runObjectCleanupStore
+}
+
 func (f *FakeClientManager) DBStatusStore() storage.DBStatusStoreInterface {
 	return f.dBStatusStore
 }
@@ -183,4 +244,5 @@ func (f *FakeClientManager) UpdateUUID(uuid util.UUIDGeneratorInterface) {
 	f.uuid = uuid
 	f.experimentStore = storage.NewExperimentStore(f.db, f.time, uuid)
 	f.pipelineStore = storage.NewPipelineStore(f.db, f.time, uuid)
+	f.retentionPolicyStore = storage.NewRetentionPolicyStore(f.db, f.time, uuid)
 }
@@ -0,0 +1,636 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultLineageDepth = 3
+	maxLineageDepth     = 10
+	// The maximum number of executions of a run returned by ML Metadata per
+	// page.
+	metadataPageSize = 100
+)
+
+// The context types of runs in ML Metadata. The v2 launcher records a run
+// under its ID, and the metadata writer under the name of its PipelineRun.
+const (
+	pipelineRunContextType = "kfp.PipelineRun"
+	kfpRunContextType      = "KfpRun"
+)
+
+// GetRunLineage returns the executions of a run recorded in ML Metadata, with
+// the artifacts they consumed and produced.
+func (r *ResourceManager) GetRunLineage(ctx context.Context, runId string) (*model.LineageGraph, error) {
+	run, err := r.GetRun(runId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get the lineage of the run")
+	}
+	runContext, err := r.getRunContext(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []*mlmdPb.Execution
+	options := &mlmdPb.ListOperationOptions{MaxResultSize: proto.Int32(metadataPageSize)}
+	for {
+		response, err := r.metadataStore.GetExecutionsByContext(ctx, &mlmdPb.GetExecutionsByContextRequest{
+			ContextId: runContext.Id,
+			Options:   options,
+		})
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the executions of run %s from ML Metadata", runId)
+		}
+		executions = append(executions, response.GetExecutions()...)
+		if response.GetNextPageToken() == "" {
+			break
+		}
+		options.NextPageToken = proto.String(response.GetNextPageToken())
+	}
+
+	builder := newLineageGraphBuilder(ctx, r.metadataStore)
+	builder.addExecutions(executions, 0)
+	events, err := builder.getEventsByExecutions(executionIds(executions))
+	if err != nil {
+		return nil, err
+	}
+	artifactIds := make([]int64, 0, len(events))
+	for _, event := range events {
+		artifactIds = append(artifactIds, event.GetArtifactId())
+	}
+	artifacts, err := builder.getArtifacts(artifactIds)
+	if err != nil {
+		return nil, err
+	}
+	builder.addArtifacts(artifacts, 0)
+	builder.addEvents(events)
+	return builder.build()
+}
+
+// getRunContext finds the context of a run in ML Metadata, by the ID of the
+// run or by the name of its PipelineRun.
+func (r *ResourceManager) getRunContext(ctx context.Context, run *model.RunDetail) (*mlmdPb.Context, error) {
+	candidates := []struct{ typeName, name string }{
+		{pipelineRunContextType, run.UUID},
+		{pipelineRunContextType, run.Name},
+		{kfpRunContextType, run.Name},
+	}
+	for _, candidate := range candidates {
+		if candidate.name == "" {
+			continue
+		}
+		response, err := r.metadataStore.GetContextByTypeAndName(ctx, &mlmdPb.GetContextByTypeAndNameRequest{
+			TypeName:    proto.String(candidate.typeName),
+			ContextName: proto.String(candidate.name),
+		})
+		// MLMD returns NOT_FOUND when the context type does not exist yet.
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to get the context of run %s from ML Metadata", run.UUID)
+		}
+		if response.GetContext() != nil {
+			return response.GetContext(), nil
+		}
+	}
+	return nil, util.NewResourceNotFoundError("Lineage of run", run.UUID)
+}
+
+// GetArtifactLineage walks the lineage of the artifacts with the given URI in
+// ML Metadata, through at most maxDepth executions in each direction. If
+// canAccessRun is not nil, the walk only goes through the executions whose
+// runs it allows.
+func (r *ResourceManager) GetArtifactLineage(ctx context.Context, uri string, direction string, maxDepth int,
+	canAccessRun func(runId string) (bool, error)) (*model.LineageGraph, error) {
+	if uri == "" {
+		return nil, util.NewInvalidInputError("The artifact URI is empty.")
+	}
+	if maxDepth == 0 {
+		maxDepth = defaultLineageDepth
+	}
+	if maxDepth < 0 || maxDepth > maxLineageDepth {
+		return nil, util.NewInvalidInputError("The maximum depth of the lineage must be between 1 and %d, but got %d.", maxLineageDepth, maxDepth)
+	}
+	response, err := r.metadataStore.GetArtifactsByURI(ctx, &mlmdPb.GetArtifactsByURIRequest{Uris: []string{uri}})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the artifacts with URI %s from ML Metadata", uri)
+	}
+	if len(response.GetArtifacts()) == 0 {
+		return nil, util.NewResourceNotFoundError("Artifact", uri)
+	}
+
+	builder := newLineageGraphBuilder(ctx, r.metadataStore)
+	if canAccessRun != nil {
+		contextTypeId, err := r.getPipelineRunContextTypeId(ctx)
+		if err != nil {
+			return nil, err
+		}
+		builder.filterExecutions = func(executions []*mlmdPb.Execution) ([]*mlmdPb.Execution, error) {
+			return r.filterExecutionsByRun(ctx, contextTypeId, executions, canAccessRun)
+		}
+	}
+	builder.addArtifacts(response.GetArtifacts(), 0)
+	if direction != model.LineageDirectionDownstream {
+		if err := builder.walk(artifactIds(response.GetArtifacts()), true, maxDepth); err != nil {
+			return nil, err
+		}
+	}
+	if direction != model.LineageDirectionUpstream {
+		if err := builder.walk(artifactIds(response.GetArtifacts()), false, maxDepth); err != nil {
+			return nil, err
+		}
+	}
+	return builder.build()
+}
+
+// GetArtifactRuns returns the runs whose executions produced the artifacts
+// with the given URI. Only the runs recorded by the v2 launcher, whose context
+// in ML Metadata is named by the run ID, are found.
+func (r *ResourceManager) GetArtifactRuns(ctx context.Context, uri string) ([]*model.RunDetail, error) {
+	if uri == "" {
+		return nil, util.NewInvalidInputError("The artifact URI is empty.")
+	}
+	artifacts, err := r.metadataStore.GetArtifactsByURI(ctx, &mlmdPb.GetArtifactsByURIRequest{Uris: []string{uri}})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the artifacts with URI %s from ML Metadata", uri)
+	}
+	if len(artifacts.GetArtifacts()) == 0 {
+		return nil, util.NewResourceNotFoundError("Artifact", uri)
+	}
+	contextTypeId, err := r.getPipelineRunContextTypeId(ctx)
+	if err != nil || contextTypeId == 0 {
+		return nil, err
+	}
+
+	builder := newLineageGraphBuilder(ctx, r.metadataStore)
+	events, err := builder.getEventsByArtifacts(artifactIds(artifacts.GetArtifacts()))
+	if err != nil {
+		return nil, err
+	}
+	producers := make(map[int64]bool)
+	for _, event := range events {
+		if isOutputEvent(event) {
+			producers[event.GetExecutionId()] = true
+		}
+	}
+	var runs []*model.RunDetail
+	seen := make(map[string]bool)
+	for _, executionId := range sortedIds(producers) {
+		executionRuns, err := r.getExecutionRuns(ctx, contextTypeId, executionId)
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to get the runs which produced artifact %s", uri)
+		}
+		for _, run := range executionRuns {
+			if !seen[run.UUID] {
+				seen[run.UUID] = true
+				runs = append(runs, run)
+			}
+		}
+	}
+	return runs, nil
+}
+
+// getPipelineRunContextTypeId returns the ID of the context type of the runs
+// in ML Metadata, or 0 if no run has been recorded yet.
+func (r *ResourceManager) getPipelineRunContextTypeId(ctx context.Context) (int64, error) {
+	response, err := r.metadataStore.GetContextType(ctx, &mlmdPb.GetContextTypeRequest{TypeName: proto.String(pipelineRunContextType)})
+	if status.Code(err) == codes.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get the context type %s from ML Metadata", pipelineRunContextType)
+	}
+	return response.GetContextType().GetId(), nil
+}
+
+// getExecutionRuns returns the runs of an execution, from its contexts of the
+// given type in ML Metadata.
+func (r *ResourceManager) getExecutionRuns(ctx context.Context, contextTypeId int64, executionId int64) ([]*model.RunDetail, error) {
+	if contextTypeId == 0 {
+		return nil, nil
+	}
+	response, err := r.metadataStore.GetContextsByExecution(ctx, &mlmdPb.GetContextsByExecutionRequest{ExecutionId: proto.Int64(executionId)})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the contexts of execution %d from ML Metadata", executionId)
+	}
+	var runs []*model.RunDetail
+	for _, runContext := range response.GetContexts() {
+		if runContext.GetTypeId() != contextTypeId {
+			continue
+		}
+		run, err := r.GetRun(runContext.GetName())
+		// The context of a run is named by its PipelineRun before the v2
+		// launcher, or the run may have been deleted.
+		if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// filterExecutionsByRun returns the executions which belong to at least one
+// run, all of which canAccessRun allows.
+func (r *ResourceManager) filterExecutionsByRun(ctx context.Context, contextTypeId int64, executions []*mlmdPb.Execution,
+	canAccessRun func(runId string) (bool, error)) ([]*mlmdPb.Execution, error) {
+	var filtered []*mlmdPb.Execution
+	for _, execution := range executions {
+		runs, err := r.getExecutionRuns(ctx, contextTypeId, execution.GetId())
+		if err != nil {
+			return nil, util.Wrapf(err, "Failed to get the runs of execution %d", execution.GetId())
+		}
+		allowed := len(runs) > 0
+		for _, run := range runs {
+			ok, err := canAccessRun(run.UUID)
+			if err != nil {
+				return nil, err
+			}
+			allowed = allowed && ok
+		}
+		if allowed {
+			filtered = append(filtered, execution)
+		}
+	}
+	return filtered, nil
+}
+
+// lineageGraphBuilder collects the executions, artifacts and events of a
+// lineage graph from ML Metadata.
+type lineageGraphBuilder struct {
+	ctx        context.Context
+	store      client.MetadataStoreInterface
+	executions map[int64]*model.LineageNode
+	artifacts  map[int64]*model.LineageNode
+	// The type IDs of the nodes, whose names are resolved when the graph is
+	// built.
+	typeIds map[*model.LineageNode]int64
+	edges   map[model.LineageEdge]bool
+	// If not nil, filterExecutions returns the executions the walk may go
+	// through.
+	filterExecutions func(executions []*mlmdPb.Execution) ([]*mlmdPb.Execution, error)
+}
+
+func newLineageGraphBuilder(ctx context.Context, store client.MetadataStoreInterface) *lineageGraphBuilder {
+	return &lineageGraphBuilder{
+		ctx:        ctx,
+		store:      store,
+		executions: make(map[int64]*model.LineageNode),
+		artifacts:  make(map[int64]*model.LineageNode),
+		typeIds:    make(map[*model.LineageNode]int64),
+		edges:      make(map[model.LineageEdge]bool),
+	}
+}
+
+// walk adds the executions and artifacts upstream or downstream of the given
+// artifacts, through at most maxDepth executions.
+func (b *lineageGraphBuilder) walk(artifactIds []int64, upstream bool, maxDepth int) error {
+	// Upstream, the executions are found by the events of their outputs and
+	// the next artifacts by the events of their inputs, and conversely.
+	toExecution, toArtifact := isOutputEvent, isInputEvent
+	if !upstream {
+		toExecution, toArtifact = isInputEvent, isOutputEvent
+	}
+	visitedExecutions := make(map[int64]bool)
+	visitedArtifacts := make(map[int64]bool)
+	for _, id := range artifactIds {
+		visitedArtifacts[id] = true
+	}
+	frontier := artifactIds
+	for depth := 1; depth <= maxDepth && len(frontier) > 0; depth++ {
+		events, err := b.getEventsByArtifacts(frontier)
+		if err != nil {
+			return err
+		}
+		var executionIds []int64
+		var executionEvents []*mlmdPb.Event
+		for _, event := range events {
+			if !toExecution(event) {
+				continue
+			}
+			executionEvents = append(executionEvents, event)
+			if !visitedExecutions[event.GetExecutionId()] {
+				visitedExecutions[event.GetExecutionId()] = true
+				executionIds = append(executionIds, event.GetExecutionId())
+			}
+		}
+		executions, err := b.getExecutions(executionIds)
+		if err != nil {
+			return err
+		}
+		if b.filterExecutions != nil {
+			executions, err = b.filterExecutions(executions)
+			if err != nil {
+				return err
+			}
+			executionIds = executionIds[:0]
+			for _, execution := range executions {
+				executionIds = append(executionIds, execution.GetId())
+			}
+		}
+		b.addExecutions(executions, int32(depth))
+		b.addEvents(executionEvents)
+
+		events, err = b.getEventsByExecutions(executionIds)
+		if err != nil {
+			return err
+		}
+		frontier = nil
+		var artifactEvents []*mlmdPb.Event
+		for _, event := range events {
+			if !toArtifact(event) {
+				continue
+			}
+			artifactEvents = append(artifactEvents, event)
+			if !visitedArtifacts[event.GetArtifactId()] {
+				visitedArtifacts[event.GetArtifactId()] = true
+				frontier = append(frontier, event.GetArtifactId())
+			}
+		}
+		artifacts, err := b.getArtifacts(frontier)
+		if err != nil {
+			return err
+		}
+		b.addArtifacts(artifacts, int32(depth))
+		b.addEvents(artifactEvents)
+	}
+	return nil
+}
+
+func (b *lineageGraphBuilder) getEventsByArtifacts(ids []int64) ([]*mlmdPb.Event, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	response, err := b.store.GetEventsByArtifactIDs(b.ctx, &mlmdPb.GetEventsByArtifactIDsRequest{ArtifactIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the events of artifacts from ML Metadata")
+	}
+	return response.GetEvents(), nil
+}
+
+func (b *lineageGraphBuilder) getEventsByExecutions(ids []int64) ([]*mlmdPb.Event, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	response, err := b.store.GetEventsByExecutionIDs(b.ctx, &mlmdPb.GetEventsByExecutionIDsRequest{ExecutionIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the events of executions from ML Metadata")
+	}
+	return response.GetEvents(), nil
+}
+
+func (b *lineageGraphBuilder) getExecutions(ids []int64) ([]*mlmdPb.Execution, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	response, err := b.store.GetExecutionsByID(b.ctx, &mlmdPb.GetExecutionsByIDRequest{ExecutionIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get executions from ML Metadata")
+	}
+	return response.GetExecutions(), nil
+}
+
+func (b *lineageGraphBuilder) getArtifacts(ids []int64) ([]*mlmdPb.Artifact, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	response, err := b.store.GetArtifactsByID(b.ctx, &mlmdPb.GetArtifactsByIDRequest{ArtifactIds: ids})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get artifacts from ML Metadata")
+	}
+	return response.GetArtifacts(), nil
+}
+
+func (b *lineageGraphBuilder) addExecutions(executions []*mlmdPb.Execution, depth int32) {
+	for _, execution := range executions {
+		if node, ok := b.executions[execution.GetId()]; ok {
+			if depth < node.Depth {
+				node.Depth = depth
+			}
+			continue
+		}
+		node := &model.LineageNode{
+			ID:         fmt.Sprintf("execution/%d", execution.GetId()),
+			NodeType:   model.LineageNodeTypeExecution,
+			TypeName:   execution.GetType(),
+			Name:       execution.GetName(),
+			State:      execution.GetLastKnownState().String(),
+			Properties: formatMetadataProperties(execution.GetProperties(), execution.GetCustomProperties()),
+			Depth:      depth,
+		}
+		b.executions[execution.GetId()] = node
+		b.typeIds[node] = execution.GetTypeId()
+	}
+}
+
+func (b *lineageGraphBuilder) addArtifacts(artifacts []*mlmdPb.Artifact, depth int32) {
+	for _, artifact := range artifacts {
+		if node, ok := b.artifacts[artifact.GetId()]; ok {
+			if depth < node.Depth {
+				node.Depth = depth
+			}
+			continue
+		}
+		node := &model.LineageNode{
+			ID:         fmt.Sprintf("artifact/%d", artifact.GetId()),
+			NodeType:   model.LineageNodeTypeArtifact,
+			TypeName:   artifact.GetType(),
+			Name:       artifact.GetName(),
+			URI:        artifact.GetUri(),
+			State:      artifact.GetState().String(),
+			Properties: formatMetadataProperties(artifact.GetProperties(), artifact.GetCustomProperties()),
+			Depth:      depth,
+		}
+		b.artifacts[artifact.GetId()] = node
+		b.typeIds[node] = artifact.GetTypeId()
+	}
+}
+
+// addEvents adds the edges of the events whose execution and artifact are both
+// in the graph.
+func (b *lineageGraphBuilder) addEvents(events []*mlmdPb.Event) {
+	for _, event := range events {
+		execution, ok := b.executions[event.GetExecutionId()]
+		if !ok {
+			continue
+		}
+		artifact, ok := b.artifacts[event.GetArtifactId()]
+		if !ok {
+			continue
+		}
+		edge := model.LineageEdge{Source: artifact.ID, Target: execution.ID, EdgeType: model.LineageEdgeTypeInput}
+		if isOutputEvent(event) {
+			edge = model.LineageEdge{Source: execution.ID, Target: artifact.ID, EdgeType: model.LineageEdgeTypeOutput}
+		} else if !isInputEvent(event) {
+			continue
+		}
+		if steps := event.GetPath().GetSteps(); len(steps) > 0 {
+			edge.Name = steps[0].GetKey()
+		}
+		b.edges[edge] = true
+	}
+}
+
+// build resolves the type names of the nodes and returns the graph, with the
+// executions then the artifacts sorted by ID, and the edges sorted by source
+// and target.
+func (b *lineageGraphBuilder) build() (*model.LineageGraph, error) {
+	if err := b.resolveTypeNames(); err != nil {
+		return nil, err
+	}
+	graph := &model.LineageGraph{Nodes: []*model.LineageNode{}, Edges: []*model.LineageEdge{}}
+	for _, nodes := range []map[int64]*model.LineageNode{b.executions, b.artifacts} {
+		ids := make([]int64, 0, len(nodes))
+		for id := range nodes {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for _, id := range ids {
+			graph.Nodes = append(graph.Nodes, nodes[id])
+		}
+	}
+	for edge := range b.edges {
+		edge := edge
+		graph.Edges = append(graph.Edges, &edge)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		if graph.Edges[i].Target != graph.Edges[j].Target {
+			return graph.Edges[i].Target < graph.Edges[j].Target
+		}
+		return graph.Edges[i].Name < graph.Edges[j].Name
+	})
+	return graph, nil
+}
+
+// resolveTypeNames sets the type names of the nodes which ML Metadata did not
+// return with their type names.
+func (b *lineageGraphBuilder) resolveTypeNames() error {
+	executionTypeIds := make(map[int64]bool)
+	artifactTypeIds := make(map[int64]bool)
+	for node, typeId := range b.typeIds {
+		if node.TypeName != "" {
+			continue
+		}
+		if node.NodeType == model.LineageNodeTypeExecution {
+			executionTypeIds[typeId] = true
+		} else {
+			artifactTypeIds[typeId] = true
+		}
+	}
+	typeNames := make(map[string]string)
+	if len(executionTypeIds) > 0 {
+		response, err := b.store.GetExecutionTypesByID(b.ctx, &mlmdPb.GetExecutionTypesByIDRequest{TypeIds: sortedIds(executionTypeIds)})
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to get execution types from ML Metadata")
+		}
+		for _, executionType := range response.GetExecutionTypes() {
+			typeNames[model.LineageNodeTypeExecution+strconv.FormatInt(executionType.GetId(), 10)] = executionType.GetName()
+		}
+	}
+	if len(artifactTypeIds) > 0 {
+		response, err := b.store.GetArtifactTypesByID(b.ctx, &mlmdPb.GetArtifactTypesByIDRequest{TypeIds: sortedIds(artifactTypeIds)})
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to get artifact types from ML Metadata")
+		}
+		for _, artifactType := range response.GetArtifactTypes() {
+			typeNames[model.LineageNodeTypeArtifact+strconv.FormatInt(artifactType.GetId(), 10)] = artifactType.GetName()
+		}
+	}
+	for node, typeId := range b.typeIds {
+		if node.TypeName == "" {
+			node.TypeName = typeNames[node.NodeType+strconv.FormatInt(typeId, 10)]
+		}
+	}
+	return nil
+}
+
+func isInputEvent(event *mlmdPb.Event) bool {
+	switch event.GetType() {
+	case mlmdPb.Event_INPUT, mlmdPb.Event_DECLARED_INPUT, mlmdPb.Event_INTERNAL_INPUT:
+		return true
+	}
+	return false
+}
+
+func isOutputEvent(event *mlmdPb.Event) bool {
+	switch event.GetType() {
+	case mlmdPb.Event_OUTPUT, mlmdPb.Event_DECLARED_OUTPUT, mlmdPb.Event_INTERNAL_OUTPUT:
+		return true
+	}
+	return false
+}
+
+// formatMetadataProperties merges the properties and the custom properties of
+// an execution or an artifact, formatted as strings.
+func formatMetadataProperties(properties map[string]*mlmdPb.Value, customProperties map[string]*mlmdPb.Value) map[string]string {
+	formatted := make(map[string]string)
+	for _, values := range []map[string]*mlmdPb.Value{customProperties, properties} {
+		for name, value := range values {
+			switch v := value.GetValue().(type) {
+			case *mlmdPb.Value_IntValue:
+				formatted[name] = strconv.FormatInt(v.IntValue, 10)
+			case *mlmdPb.Value_DoubleValue:
+				formatted[name] = strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+			default:
+				formatted[name] = value.GetStringValue()
+			}
+		}
+	}
+	return formatted
+}
+
+func executionIds(executions []*mlmdPb.Execution) []int64 {
+	ids := make([]int64, 0, len(executions))
+	for _, execution := range executions {
+		ids = append(ids, execution.GetId())
+	}
+	return ids
+}
+
+func artifactIds(artifacts []*mlmdPb.Artifact) []int64 {
+	ids := make([]int64, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		ids = append(ids, artifact.GetId())
+	}
+	return ids
+}
+
+func sortedIds(ids map[int64]bool) []int64 {
+	sorted := make([]int64, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+type lineageFixture struct {
+	prep, train, eval int64
+	raw, data, model  int64
+}
+
+// initLineage records a pipeline of three executions in the fake ML Metadata:
+// prep turns the raw artifact into data, train the data into a model, which
+// eval consumes. Only train is in the context of the run.
+func initLineage(t *testing.T) (*FakeClientManager, *ResourceManager, *model.RunDetail, lineageFixture) {
+	store, manager, run := initWithRun(t)
+	mlmd := store.MetadataStoreFake
+	runContext := mlmd.AddContext("kfp.PipelineRun", run.UUID)
+	f := lineageFixture{
+		prep:  mlmd.AddExecution("kfp.ContainerExecution", "prep", mlmdPb.Execution_COMPLETE),
+		train: mlmd.AddExecution("kfp.ContainerExecution", "train", mlmdPb.Execution_COMPLETE, runContext),
+		eval:  mlmd.AddExecution("kfp.ContainerExecution", "eval", mlmdPb.Execution_RUNNING),
+		raw:   mlmd.AddArtifact("system.Dataset", "s3://bucket/raw"),
+		data:  mlmd.AddArtifact("system.Dataset", "s3://bucket/data"),
+		model: mlmd.AddArtifact("system.Model", "s3://bucket/model"),
+	}
+	mlmd.AddEvent(f.prep, f.raw, mlmdPb.Event_INPUT, "raw")
+	mlmd.AddEvent(f.prep, f.data, mlmdPb.Event_OUTPUT, "data")
+	mlmd.AddEvent(f.train, f.data, mlmdPb.Event_INPUT, "data")
+	mlmd.AddEvent(f.train, f.model, mlmdPb.Event_OUTPUT, "model")
+	mlmd.AddEvent(f.eval, f.model, mlmdPb.Event_INPUT, "model")
+	return store, manager, run, f
+}
+
+func executionNode(id int64) string {
+	return fmt.Sprintf("execution/%d", id)
+}
+
+func artifactNode(id int64) string {
+	return fmt.Sprintf("artifact/%d", id)
+}
+
+func nodeIds(graph *model.LineageGraph) []string {
+	ids := make([]string, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+func TestGetRunLineage(t *testing.T) {
+	store, manager, run, f := initLineage(t)
+	defer store.Close()
+
+	graph, err := manager.GetRunLineage(context.Background(), run.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{executionNode(f.train), artifactNode(f.data), artifactNode(f.model)}, nodeIds(graph))
+	assert.Equal(t, &model.LineageNode{
+		ID:         executionNode(f.train),
+		NodeType:   model.LineageNodeTypeExecution,
+		TypeName:   "kfp.ContainerExecution",
+		Name:       "train",
+		State:      "COMPLETE",
+		Properties: map[string]string{"task_name": "train"},
+	}, graph.Nodes[0])
+	assert.Equal(t, "system.Model", graph.Nodes[2].TypeName)
+	assert.Equal(t, "s3://bucket/model", graph.Nodes[2].URI)
+	assert.Equal(t, []*model.LineageEdge{
+		{Source: artifactNode(f.data), Target: executionNode(f.train), EdgeType: model.LineageEdgeTypeInput, Name: "data"},
+		{Source: executionNode(f.train), Target: artifactNode(f.model), EdgeType: model.LineageEdgeTypeOutput, Name: "model"},
+	}, graph.Edges)
+}
+
+func TestGetRunLineage_NotRecorded(t *testing.T) {
+	store, manager, run := initWithRun(t)
+	defer store.Close()
+
+	_, err := manager.GetRunLineage(context.Background(), run.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetRunLineage_RunNotFound(t *testing.T) {
+	store, manager, _ := initWithExperiment(t)
+	defer store.Close()
+
+	_, err := manager.GetRunLineage(context.Background(), "not-a-run")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetArtifactLineage(t *testing.T) {
+	store, manager, _, f := initLineage(t)
+	defer store.Close()
+
+	tests := []struct {
+		direction string
+		maxDepth  int
+		wantNodes []string
+	}{
+		{model.LineageDirectionUpstream, 0,
+			[]string{executionNode(f.prep), executionNode(f.train), artifactNode(f.raw), artifactNode(f.data), artifactNode(f.model)}},
+		{model.LineageDirectionUpstream, 1,
+			[]string{executionNode(f.train), artifactNode(f.data), artifactNode(f.model)}},
+		{model.LineageDirectionDownstream, 0,
+			[]string{executionNode(f.eval), artifactNode(f.model)}},
+		{model.LineageDirectionBoth, 1,
+			[]string{executionNode(f.train), executionNode(f.eval), artifactNode(f.data), artifactNode(f.model)}},
+	}
+	for _, test := range tests {
+		graph, err := manager.GetArtifactLineage(context.Background(), "s3://bucket/model", test.direction, test.maxDepth, nil)
+		assert.Nil(t, err, test.direction)
+		assert.Equal(t, test.wantNodes, nodeIds(graph), "%s %d", test.direction, test.maxDepth)
+	}
+
+	graph, err := manager.GetArtifactLineage(context.Background(), "s3://bucket/model", model.LineageDirectionUpstream, 0, nil)
+	assert.Nil(t, err)
+	depths := make(map[string]int32)
+	for _, node := range graph.Nodes {
+		depths[node.ID] = node.Depth
+	}
+	assert.Equal(t, map[string]int32{
+		artifactNode(f.model):  0,
+		executionNode(f.train): 1,
+		artifactNode(f.data):   1,
+		executionNode(f.prep):  2,
+		artifactNode(f.raw):    2,
+	}, depths)
+	assert.Len(t, graph.Edges, 4)
+}
+
+func TestGetArtifactRuns(t *testing.T) {
+	store, manager, run, f := initLineage(t)
+	defer store.Close()
+	// The metadata writer names the context of a run by its PipelineRun.
+	mlmd := store.MetadataStoreFake
+	mlmd.AddEvent(mlmd.AddExecution("kfp.ContainerExecution", "prep", mlmdPb.Execution_COMPLETE, mlmd.AddContext("kfp.PipelineRun", "pipelinerun-1")),
+		f.raw, mlmdPb.Event_OUTPUT, "raw")
+
+	runs, err := manager.GetArtifactRuns(context.Background(), "s3://bucket/model")
+	assert.Nil(t, err)
+	assert.Len(t, runs, 1)
+	assert.Equal(t, run.UUID, runs[0].UUID)
+
+	for _, uri := range []string{"s3://bucket/data", "s3://bucket/raw"} {
+		runs, err = manager.GetArtifactRuns(context.Background(), uri)
+		assert.Nil(t, err, uri)
+		assert.Empty(t, runs, uri)
+	}
+
+	_, err = manager.GetArtifactRuns(context.Background(), "s3://bucket/unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetArtifactLineage_Invalid(t *testing.T) {
+	store, manager, _, _ := initLineage(t)
+	defer store.Close()
+
+	tests := []struct {
+		uri      string
+		maxDepth int
+		wantCode codes.Code
+	}{
+		{"", 0, codes.InvalidArgument},
+		{"s3://bucket/model", -1, codes.InvalidArgument},
+		{"s3://bucket/model", 11, codes.InvalidArgument},
+		{"s3://bucket/unknown", 0, codes.NotFound},
+	}
+	for _, test := range tests {
+		_, err := manager.GetArtifactLineage(context.Background(), test.uri, model.LineageDirectionBoth, test.maxDepth, nil)
+		assert.NotNil(t, err, test.uri)
+		assert.Equal(t, test.wantCode, err.(*util.UserError).ExternalStatusCode(), "%s %d", test.uri, test.maxDepth)
+	}
+}
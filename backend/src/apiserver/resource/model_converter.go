@@ -40,6 +40,20 @@ func (r *ResourceManager) ToModelExperiment(apiExperiment *api.Experiment) (*mod
 		Name:        apiExperiment.Name,
 		Description: apiExperiment.Description,
 		Namespace:   namespace,
+		Labels:      apiExperiment.Labels,
+	}, nil
+}
+
+func (r *ResourceManager) ToModelRetentionPolicy(apiPolicy *api.RetentionPolicy) (*model.RetentionPolicy, error) {
+	resourceType, err := common.ToModelResourceType(apiPolicy.GetResourceKey().GetType())
+	if err != nil {
+		return nil, util.Wrap(err, "Unable to convert to model retention policy.")
+	}
+	return &model.RetentionPolicy{
+		ResourceType:     resourceType,
+		ResourceUUID:     apiPolicy.GetResourceKey().GetId(),
+		ArchiveAfterDays: apiPolicy.GetArchiveAfterDays(),
+		DeleteAfterDays:  apiPolicy.GetDeleteAfterDays(),
 	}, nil
 }
 
@@ -88,6 +102,7 @@ func (r *ResourceManager) ToModelRunDetail(run *api.Run, runId string, workflow
 			Conditions:         workflow.Condition(),
 			Description:        run.Description,
 			ResourceReferences: resourceReferences,
+			Labels:             run.Labels,
 			PipelineSpec: model.PipelineSpec{
 				PipelineId:           run.GetPipelineSpec().GetPipelineId(),
 				PipelineName:         pipelineName,
@@ -134,6 +149,7 @@ func (r *ResourceManager) ToModelJob(job *api.Job, swf *util.ScheduledWorkflow,
 		MaxConcurrency:     job.MaxConcurrency,
 		NoCatchup:          job.NoCatchup,
 		ResourceReferences: resourceReferences,
+		Labels:             job.Labels,
 		PipelineSpec: model.PipelineSpec{
 			PipelineId:           job.GetPipelineSpec().GetPipelineId(),
 			PipelineName:         pipelineName,
@@ -163,6 +179,7 @@ func (r *ResourceManager) ToModelPipelineVersion(version *api.PipelineVersion) (
 		Parameters:     paramStr,
 		PipelineId:     pipelineId,
 		CodeSourceUrl:  version.CodeSourceUrl,
+		Labels:         version.Labels,
 	}, nil
 }
 
@@ -16,14 +16,20 @@ package resource
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strconv"
 	"strings"
 
 	"github.com/cenkalti/backoff"
+	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
 	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
@@ -40,6 +46,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	workflowclient "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/typed/pipeline/v1beta1"
+	"google.golang.org/grpc/codes"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -56,6 +63,8 @@ const (
 	HasDefaultBucketEnvVar              = "HAS_DEFAULT_BUCKET"
 	ProjectIDEnvVar                     = "PROJECT_ID"
 	DefaultBucketNameEnvVar             = "BUCKET_NAME"
+	// The container of the main step of a task, whose log is read by default.
+	defaultLogContainer = archive.DefaultLogContainer
 )
 
 // Metric variables. Please prefix the metric names with resource_manager_.
@@ -73,9 +82,18 @@ type ClientManagerInterface interface {
 	JobStore() storage.JobStoreInterface
 	RunStore() storage.RunStoreInterface
 	ResourceReferenceStore() storage.ResourceReferenceStoreInterface
+	LabelStore() storage.LabelStoreInterface
+	IdempotencyKeyStore() storage.IdempotencyKeyStoreInterface
+	NamespaceQuotaStore() storage.NamespaceQuotaStoreInterface
+	RunQueueStore() storage.RunQueueStoreInterface
+	RetentionPolicyStore() storage.RetentionPolicyStoreInterface
+	AuditEventStore() storage.AuditEventStoreInterface
+	AuditLog() io.Writer
+	RunObjectCleanupStore() storage.RunObjectCleanupStoreInterface
 	DBStatusStore() storage.DBStatusStoreInterface
 	DefaultExperimentStore() storage.DefaultExperimentStoreInterface
 	ObjectStore() storage.ObjectStoreInterface
+	MetadataStore() client.MetadataStoreInterface
 	TektonClient() client.TektonClientInterface
 	SwfClient() client.SwfClientInterface
 	KubernetesCoreClient() client.KubernetesCoreInterface
@@ -93,9 +111,18 @@ type ResourceManager struct {
 	jobStore                  storage.JobStoreInterface
 	runStore                  storage.RunStoreInterface
 	resourceReferenceStore    storage.ResourceReferenceStoreInterface
+	labelStore                storage.LabelStoreInterface
+	idempotencyKeyStore       storage.IdempotencyKeyStoreInterface
+	namespaceQuotaStore       storage.NamespaceQuotaStoreInterface
+	runQueueStore             storage.RunQueueStoreInterface
+	retentionPolicyStore      storage.RetentionPolicyStoreInterface
+	auditEventStore           storage.AuditEventStoreInterface
+	auditLog                  *auditLogWriter
+	runObjectCleanupStore     storage.RunObjectCleanupStoreInterface
 	dBStatusStore             storage.DBStatusStoreInterface
 	defaultExperimentStore    storage.DefaultExperimentStoreInterface
 	objectStore               storage.ObjectStoreInterface
+	metadataStore             client.MetadataStoreInterface
 	swfClient                 client.SwfClientInterface
 	k8sCoreClient             client.KubernetesCoreInterface
 	subjectAccessReviewClient client.SubjectAccessReviewInterface
@@ -105,6 +132,7 @@ type ResourceManager struct {
 	uuid                      util.UUIDGeneratorInterface
 	authenticators            []kfpauth.Authenticator
 	tektonClient              client.TektonClientInterface
+	authorizationCache        *authorizationCache
 }
 
 func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
@@ -115,9 +143,18 @@ func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 		jobStore:                  clientManager.JobStore(),
 		runStore:                  clientManager.RunStore(),
 		resourceReferenceStore:    clientManager.ResourceReferenceStore(),
+		labelStore:                clientManager.LabelStore(),
+		idempotencyKeyStore:       clientManager.IdempotencyKeyStore(),
+		namespaceQuotaStore:       clientManager.NamespaceQuotaStore(),
+		runQueueStore:             clientManager.RunQueueStore(),
+		retentionPolicyStore:      clientManager.RetentionPolicyStore(),
+		auditEventStore:           clientManager.AuditEventStore(),
+		auditLog:                  newAuditLogWriter(clientManager.AuditLog()),
+		runObjectCleanupStore:     clientManager.RunObjectCleanupStore(),
 		dBStatusStore:             clientManager.DBStatusStore(),
 		defaultExperimentStore:    clientManager.DefaultExperimentStore(),
 		objectStore:               clientManager.ObjectStore(),
+		metadataStore:             clientManager.MetadataStore(),
 		swfClient:                 clientManager.SwfClient(),
 		k8sCoreClient:             clientManager.KubernetesCoreClient(),
 		subjectAccessReviewClient: clientManager.SubjectAccessReviewClient(),
@@ -126,6 +163,9 @@ func NewResourceManager(clientManager ClientManagerInterface) *ResourceManager {
 		time:                      clientManager.Time(),
 		uuid:                      clientManager.UUID(),
 		authenticators:            clientManager.Authenticators(),
+		// Decisions expire in wall-clock time, whatever clock the stores use.
+		authorizationCache: newAuthorizationCache(
+			common.GetAuthorizationCacheSize(), common.GetAuthorizationCacheTTL(), util.NewRealTime()),
 	}
 }
 
@@ -200,7 +240,7 @@ func (r *ResourceManager) ArchiveExperiment(experimentId string) error {
 		if newToken == "" {
 			break
 		} else {
-			opts, err = list.NewOptionsFromToken(newToken, 50)
+			opts, err = list.NewOptionsFromToken(&model.Job{}, newToken, 50)
 			if err != nil {
 				return util.NewInternalServerError(err,
 					"Failed to create list jobs options from page token when archiving experiment. ")
@@ -262,7 +302,7 @@ func (r *ResourceManager) UpdatePipelineDefaultVersion(pipelineId string, versio
 
 func (r *ResourceManager) CreatePipeline(name string, description string, namespace string, pipelineFile []byte) (*model.Pipeline, error) {
 	// Extract the parameter from the pipeline
-	params, err := util.GetParameters(pipelineFile)
+	params, err := getPipelineParameters(pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
 	}
@@ -284,7 +324,7 @@ func (r *ResourceManager) CreatePipeline(name string, description string, namesp
 	}
 
 	// Store the pipeline file to a path dependent on pipeline version
-	err = r.objectStore.AddFile(pipelineFile,
+	err = r.objectStore.AddFile(context.Background(), pipelineFile,
 		r.objectStore.GetPipelineKey(fmt.Sprint(newPipeline.DefaultVersion.UUID)))
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline failed")
@@ -322,7 +362,7 @@ func (r *ResourceManager) GetPipelineTemplate(pipelineId string) ([]byte, error)
 		return nil, util.Wrap(err,
 			"Get pipeline template failed since no default version is defined")
 	}
-	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(pipeline.DefaultVersion.UUID)))
+	template, err := r.objectStore.GetFile(context.Background(), r.objectStore.GetPipelineKey(fmt.Sprint(pipeline.DefaultVersion.UUID)))
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline template failed")
 	}
@@ -330,31 +370,139 @@ func (r *ResourceManager) GetPipelineTemplate(pipelineId string) ([]byte, error)
 	return template, nil
 }
 
-func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (string, error) {
+// authenticationKey is the key of the result of the authentication of a
+// request in its context.
+type authenticationKey struct{}
+
+type authentication struct {
+	userInfo *kfpauth.UserInfo
+	err      error
+}
+
+// AuthenticateRequest returns the user of a request, with the groups and the
+// extra attributes reported by the first authenticator accepting the request.
+// The result of an earlier authentication carried by the context, see
+// AuthenticateRequestOnce, is returned as is.
+func (r *ResourceManager) AuthenticateRequest(ctx context.Context) (*kfpauth.UserInfo, error) {
 	if ctx == nil {
-		return "", util.NewUnauthenticatedError(errors.New("Request error: context is nil"), "Request error: context is nil.")
+		return nil, util.NewUnauthenticatedError(errors.New("Request error: context is nil"), "Request error: context is nil.")
+	}
+	if result, ok := ctx.Value(authenticationKey{}).(*authentication); ok {
+		return result.userInfo, result.err
 	}
 
 	// If the request header contains the user identity, requests are authorized
 	// based on the namespace field in the request.
 	var errlist []error
 	for _, auth := range r.authenticators {
-		userIdentity, err := auth.GetUserIdentity(ctx)
+		userInfo, err := auth.GetUserInfo(ctx)
 		if err == nil {
-			return userIdentity, nil
+			return userInfo, nil
 		}
 		errlist = append(errlist, err)
 	}
-	return "", utilerrors.NewAggregate(errlist)
+	return nil, utilerrors.NewAggregate(errlist)
+}
+
+// AuthenticateRequestOnce authenticates a request like AuthenticateRequest,
+// and returns a context carrying the result, so that the request is not
+// authenticated again when it is authorized and audited.
+func (r *ResourceManager) AuthenticateRequestOnce(ctx context.Context) (context.Context, *kfpauth.UserInfo, error) {
+	userInfo, err := r.AuthenticateRequest(ctx)
+	if ctx == nil {
+		return ctx, userInfo, err
+	}
+	return context.WithValue(ctx, authenticationKey{}, &authentication{userInfo: userInfo, err: err}), userInfo, err
 }
 
-func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
+// CreateRun creates a run. The calls it makes to the database, the object
+// store and Kubernetes are traced as part of the request in ctx.
+func (r *ResourceManager) CreateRun(ctx context.Context, apiRun *api.Run) (*model.RunDetail, error) {
+	return r.createRun(ctx, apiRun, nil)
+}
+
+// CreateRunWithIdempotencyKey creates a run, unless an earlier request with
+// the same idempotency key created one already. The earlier run is returned if
+// the requests are identical, and a request with a different run is rejected.
+func (r *ResourceManager) CreateRunWithIdempotencyKey(ctx context.Context, apiRun *api.Run, idempotencyKey string) (*model.RunDetail, error) {
+	if idempotencyKey == "" {
+		return r.CreateRun(ctx, apiRun)
+	}
+	key, err := r.reserveIdempotencyKey(ctx, common.Run, idempotencyKey, apiRun)
+	if err != nil {
+		return nil, err
+	}
+	if key.ResourceUUID != "" {
+		return r.GetRun(key.ResourceUUID)
+	}
+	runDetail, err := r.createRun(ctx, apiRun, key)
+	if err != nil {
+		r.releaseIdempotencyKey(ctx, key)
+		return nil, err
+	}
+	return runDetail, nil
+}
+
+// reserveIdempotencyKey reserves the idempotency key of a request creating a
+// resource of the given type before the resource is created. If an identical
+// earlier request reserved the key already, the key of the earlier request is
+// returned, which refers to the resource it created.
+func (r *ResourceManager) reserveIdempotencyKey(ctx context.Context, resourceType common.ResourceType, idempotencyKey string,
+	request proto.Message) (*model.IdempotencyKey, error) {
+	requestJson, err := (&jsonpb.Marshaler{}).MarshalToString(request)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to marshal the request with idempotency key %s", idempotencyKey)
+	}
+	requestHash := sha256.Sum256([]byte(requestJson))
+	key := &model.IdempotencyKey{
+		ResourceType: resourceType,
+		Key:          idempotencyKey,
+		RequestHash:  hex.EncodeToString(requestHash[:]),
+	}
+	err = r.idempotencyKeyStore.ReserveIdempotencyKey(ctx, key)
+	if err == nil {
+		return key, nil
+	}
+	if !util.IsUserErrorCodeMatch(err, codes.AlreadyExists) {
+		return nil, util.Wrapf(err, "Failed to reserve idempotency key %s", idempotencyKey)
+	}
+	existingKey, err := r.idempotencyKeyStore.GetIdempotencyKey(ctx, resourceType, idempotencyKey)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		// The earlier request failed and released the key in the meantime.
+		return nil, util.NewBadRequestError(errors.New("idempotency key was released"),
+			"The request with idempotency key %s failed, retry the request", idempotencyKey)
+	}
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get idempotency key %s", idempotencyKey)
+	}
+	if existingKey.RequestHash != key.RequestHash {
+		return nil, util.NewInvalidInputError(
+			"Idempotency key %s was already used by a different request to create a %s", idempotencyKey, resourceType)
+	}
+	if existingKey.ResourceUUID == "" {
+		return nil, util.NewBadRequestError(errors.New("idempotency key is pending"),
+			"A request with idempotency key %s is still creating the %s, retry the request later", idempotencyKey, resourceType)
+	}
+	return existingKey, nil
+}
+
+// releaseIdempotencyKey releases the reserved idempotency key of a request
+// which failed to create its resource, so that the request can be retried.
+func (r *ResourceManager) releaseIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) {
+	if err := r.idempotencyKeyStore.ReleaseIdempotencyKey(ctx, key.ResourceType, key.Key); err != nil {
+		glog.Errorf("Failed to release idempotency key %s of %s: %v", key.Key, key.ResourceType, err)
+	}
+}
+
+// createRun creates a run, recording the idempotency key of the request if
+// any.
+func (r *ResourceManager) createRun(ctx context.Context, apiRun *api.Run, idempotencyKey *model.IdempotencyKey) (*model.RunDetail, error) {
 	// Get workflow from either of the two places:
 	// (1) raw pipeline manifest in pipeline_spec
 	// (2) pipeline version in resource_references
 	// And the latter takes priority over the former when the pipeline manifest is from pipeline_spec.pipeline_id
 	// workflow manifest and pipeline id/version will not exist at the same time, guaranteed by the validation phase
-	workflowSpecManifestBytes, err := getWorkflowSpecManifestBytes(apiRun.PipelineSpec, &apiRun.ResourceReferences, r)
+	workflowSpecManifestBytes, err := getWorkflowSpecManifestBytes(ctx, apiRun.PipelineSpec, &apiRun.ResourceReferences, r)
 	if err != nil {
 		return nil, err
 	}
@@ -367,26 +515,36 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 	runAt := r.time.Now().Unix()
 
 	var workflow util.Workflow
-	if err = json.Unmarshal(workflowSpecManifestBytes, &workflow); err != nil {
-		return nil, util.NewInternalServerError(err,
-			"Failed to unmarshal workflow spec manifest. Workflow bytes: %s", string(workflowSpecManifestBytes))
-	}
-	if workflow.PipelineRun == nil {
-		return nil, util.Wrap(
-			util.NewResourceNotFoundError("WorkflowSpecManifest", apiRun.GetName()),
-			"Failed to fetch PipelineRun spec manifest.")
-	}
-
 	parameters := toParametersMap(apiRun.GetPipelineSpec().GetParameters())
-	// Verify no additional parameter provided
-	if err = workflow.VerifyParameters(parameters); err != nil {
-		return nil, util.Wrap(err, "Failed to verify parameters.")
+	formatter := util.NewRunParameterFormatter(uuid.String(), runAt)
+	if util.IsPipelineSpec(workflowSpecManifestBytes) {
+		// The pipeline spec of KFP v2 is stored as is, and compiled for each
+		// run. The values of the parameters are validated by their types.
+		compiledWorkflow, err := compilePipelineSpec(workflowSpecManifestBytes, formatter.FormatWorkflowParameters(parameters))
+		if err != nil {
+			return nil, err
+		}
+		workflow = *compiledWorkflow
+	} else {
+		if err = json.Unmarshal(workflowSpecManifestBytes, &workflow); err != nil {
+			return nil, util.NewInternalServerError(err,
+				"Failed to unmarshal workflow spec manifest. Workflow bytes: %s", string(workflowSpecManifestBytes))
+		}
+		if workflow.PipelineRun == nil {
+			return nil, util.Wrap(
+				util.NewResourceNotFoundError("WorkflowSpecManifest", apiRun.GetName()),
+				"Failed to fetch PipelineRun spec manifest.")
+		}
+
+		// Verify no additional parameter provided
+		if err = workflow.VerifyParameters(parameters); err != nil {
+			return nil, util.Wrap(err, "Failed to verify parameters.")
+		}
+		// Append provided parameter
+		workflow.OverrideParameters(parameters)
 	}
-	// Append provided parameter
-	workflow.OverrideParameters(parameters)
 
 	// Replace macros
-	formatter := util.NewRunParameterFormatter(uuid.String(), runAt)
 	formattedParams := formatter.FormatWorkflowParameters(workflow.GetWorkflowParametersAsMap())
 	workflow.OverrideParameters(formattedParams)
 
@@ -394,6 +552,10 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 
 	// Disable istio sidecar injection
 	workflow.SetAnnotations(util.AnnotationKeyIstioSidecarInject, util.AnnotationValueIstioSidecarInjectDisabled)
+	// Add the user labels first so that the KFP specific labels below take precedence.
+	for key, value := range apiRun.GetLabels() {
+		workflow.SetLabels(key, value)
+	}
 	// Add a KFP specific label for cache service filtering. The cache_enabled flag here is a global control for whether cache server will
 	// receive targeting pods. Since cache server only receives pods in step level, the resource manager here will set this global label flag
 	// on every single step/pod so the cache server can understand.
@@ -465,27 +627,71 @@ func (r *ResourceManager) CreateRun(apiRun *api.Run) (*model.RunDetail, error) {
 	// 	}
 	// }
 
-	// Create Tekton pipelineRun CRD resource
-	newWorkflow, err := r.getWorkflowClient(namespace).Create(context.Background(), workflow.Get(), v1.CreateOptions{})
-	wfs, _ := json.Marshal(newWorkflow)
-	glog.Infof(string(wfs))
+	// Runs of a namespace with a quota are queued, and their PipelineRun is
+	// created once the namespace has capacity, right away if possible.
+	quota, err := r.getNamespaceQuota(namespace)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create a workflow for (%s)", workflow.Name)
+		return nil, util.Wrap(err, "Failed to check the quota of the namespace")
+	}
+	queued := hasQuotaLimits(quota)
+	runWorkflow := &workflow
+	if queued {
+		workflow.Namespace = namespace
+	} else {
+		// Create Tekton pipelineRun CRD resource
+		newWorkflow, err := r.getWorkflowClient(namespace).Create(ctx, workflow.Get(), v1.CreateOptions{})
+		wfs, _ := json.Marshal(newWorkflow)
+		glog.Infof(string(wfs))
+		if err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to create a workflow for (%s)", workflow.Name)
+		}
+		runWorkflow = util.NewWorkflow(newWorkflow)
 	}
 
 	// Store run metadata into database
-	runDetail, err := r.ToModelRunDetail(apiRun, runId, util.NewWorkflow(newWorkflow), string(workflowSpecManifestBytes))
+	runDetail, err := r.ToModelRunDetail(apiRun, runId, runWorkflow, string(workflowSpecManifestBytes))
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to convert run model")
 	}
 
 	// Assign the create at time.
 	runDetail.CreatedAtInSec = runAt
-	return r.runStore.CreateRun(runDetail)
+	if idempotencyKey != nil {
+		idempotencyKey.ResourceUUID = runId
+		runDetail.IdempotencyKey = idempotencyKey
+	}
+	if queued {
+		runDetail.Priority = apiRun.GetPriority()
+		if err := r.queueRun(ctx, runDetail, quota); err != nil {
+			return nil, err
+		}
+		return r.GetRun(runId)
+	}
+	return r.runStore.CreateRun(ctx, runDetail)
 }
 
 func (r *ResourceManager) GetRun(runId string) (*model.RunDetail, error) {
-	return r.runStore.GetRun(runId)
+	runDetail, err := r.runStore.GetRun(runId)
+	if err != nil {
+		return nil, err
+	}
+	if runDetail.Conditions == model.RunQueuedConditions {
+		queuedRun, err := r.runQueueStore.GetQueuedRun(runId)
+		if err != nil {
+			if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+				// The run was dispatched after it was read.
+				return runDetail, nil
+			}
+			return nil, util.Wrap(err, "Failed to get the queued run")
+		}
+		position, err := r.runQueueStore.GetQueuePosition(queuedRun, common.IsRunQueuePriorityOrder())
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to get the queue position of the run")
+		}
+		runDetail.Priority = queuedRun.Priority
+		runDetail.QueuePosition = position
+	}
+	return runDetail, nil
 }
 
 func (r *ResourceManager) ListRuns(filterContext *common.FilterContext,
@@ -501,6 +707,9 @@ func (r *ResourceManager) UnarchiveRun(runId string) error {
 	return r.runStore.UnarchiveRun(runId)
 }
 
+// DeleteRun deletes a run and its PipelineRun. The artifacts and archived logs
+// of the run are deleted from the object store asynchronously by the
+// RunObjectCleanupWorker.
 func (r *ResourceManager) DeleteRun(runID string) error {
 	runDetail, err := r.checkRunExist(runID)
 	if err != nil {
@@ -555,6 +764,14 @@ func (r *ResourceManager) TerminateRun(runId string) error {
 	if err != nil {
 		return util.Wrap(err, "Terminate run failed")
 	}
+	if runDetail.Conditions == model.RunQueuedConditions {
+		// A queued run has no PipelineRun yet, so it is only removed from the queue.
+		err = r.runStore.CancelQueuedRun(runId)
+		if err != nil {
+			return util.Wrap(err, "Terminate run failed")
+		}
+		return nil
+	}
 
 	namespace, err := r.GetNamespaceFromRunID(runId)
 	if err != nil {
@@ -620,59 +837,116 @@ func (r *ResourceManager) RetryRun(runId string) error {
 	return nil
 }
 
-func (r *ResourceManager) readRunLogFromArchive(run *model.RunDetail, nodeId string, dst io.Writer) error {
+// getRunLogArchive downloads the log archive of the pod of a node of a run.
+func (r *ResourceManager) getRunLogArchive(run *model.RunDetail, nodeId string) ([]byte, error) {
 	workflow := new(util.Workflow)
 
 	if run.WorkflowRuntimeManifest == "" {
-		return util.NewBadRequestError(errors.New("archived log cannot be read"), "Failed to retrieve the runtime workflow from the run")
+		return nil, util.NewBadRequestError(errors.New("archived log cannot be read"), "Failed to retrieve the runtime workflow from the run")
 	}
 	if err := json.Unmarshal([]byte(run.WorkflowRuntimeManifest), workflow); err != nil {
-		return util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
+		return nil, util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
 	}
 
 	logPath, err := r.logArchive.GetLogObjectKey(workflow, nodeId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	logContent, err := r.objectStore.GetFile(logPath)
+	logContent, err := r.objectStore.GetFile(context.Background(), logPath)
 	if err != nil {
-		return util.NewInternalServerError(err, "Failed to retrieve the log file from archive")
+		return nil, util.NewInternalServerError(err, "Failed to retrieve the log file from archive")
 	}
+	return logContent, nil
+}
 
-	err = r.logArchive.CopyLogFromArchive(logContent, dst, archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Timestamps: false})
-
+// ReadLog copies the log of a container of the pod of a node of a run, from
+// the pod while it exists and from the log archive afterwards. The options
+// select the container, the lines and the format of the log.
+// OpenLog opens the log of a node of a run in the format of opts, from its
+// pod while it exists and from the log archive afterwards. The log is
+// formatted while it is read. The caller closes the returned reader.
+func (r *ResourceManager) OpenLog(runId string, nodeId string, follow bool, opts archive.ExtractLogOptions) (io.ReadCloser, error) {
+	run, err := r.checkRunExist(runId)
 	if err != nil {
-		return util.NewInternalServerError(err, "error in streaming the log")
+		return nil, util.NewBadRequestError(errors.New("log cannot be read"), "Run does not exist")
 	}
 
-	return nil
-}
+	podLogs, err := r.openRunLogFromPod(run, nodeId, follow, opts)
+	if err == nil {
+		if opts.LogFormat != archive.LogFormatJSON {
+			return podLogs, nil
+		}
+		// The lines are already selected by Kubernetes.
+		return formatLog(podLogs, archive.ExtractLogOptions{LogFormat: archive.LogFormatJSON}), nil
+	}
+	if r.logArchive == nil {
+		return nil, err
+	}
 
-func (r *ResourceManager) ReadLog(runId string, nodeId string, follow bool, dst io.Writer) error {
-	run, err := r.checkRunExist(runId)
+	logContent, err := r.getRunLogArchive(run, nodeId)
 	if err != nil {
-		return util.NewBadRequestError(errors.New("log cannot be read"), "Run does not exist")
+		return nil, err
 	}
-
-	err = r.readRunLogFromPod(run, nodeId, follow, dst)
-	if err != nil && r.logArchive != nil {
-		err = r.readRunLogFromArchive(run, nodeId, dst)
+	if opts.Container == "" {
+		opts.Container = defaultLogContainer
+	}
+	reader, err := r.logArchive.OpenLogFromArchive(logContent, opts.Container)
+	if err != nil {
+		return nil, util.Wrap(err, "error in opening the archived log")
 	}
+	return formatLog(ioutil.NopCloser(reader), opts), nil
+}
 
-	return err
+// formatLog returns a reader of the lines of a log in the format of opts. The
+// lines are formatted while they are read, and the log is closed once it is
+// read or the returned reader is closed.
+func formatLog(log io.ReadCloser, opts archive.ExtractLogOptions) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		err := archive.CopyLog(log, pw, opts)
+		log.Close()
+		pw.CloseWithError(err)
+	}()
+	return &formattedLog{PipeReader: pr, log: log}
+}
+
+type formattedLog struct {
+	*io.PipeReader
+	log io.Closer
 }
 
-func (r *ResourceManager) getPodLogOptions(follow bool) corev1.PodLogOptions {
-	return corev1.PodLogOptions{
-		Container:  "step-main",
-		Timestamps: false,
+func (l *formattedLog) Close() error {
+	// The log is closed too, in case its lines are still awaited.
+	l.log.Close()
+	return l.PipeReader.Close()
+}
+
+func (r *ResourceManager) getPodLogOptions(follow bool, opts archive.ExtractLogOptions) corev1.PodLogOptions {
+	logOptions := corev1.PodLogOptions{
+		Container:  opts.Container,
+		Timestamps: opts.Timestamps,
 		Follow:     follow,
+		TailLines:  opts.TailLines,
+	}
+	if logOptions.Container == "" {
+		logOptions.Container = defaultLogContainer
 	}
+	if opts.SinceTime != nil {
+		sinceTime := v1.NewTime(*opts.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+	// The timestamps of the lines are needed to convert them to JSON.
+	if opts.LogFormat == archive.LogFormatJSON {
+		logOptions.Timestamps = true
+	}
+	return logOptions
 }
 
-func (r *ResourceManager) readRunLogFromPod(run *model.RunDetail, nodeId string, follow bool, dst io.Writer) error {
-	logOptions := r.getPodLogOptions(follow)
+// openRunLogFromPod opens the stream of the log of a container of the pod of
+// a node of a run. The caller closes the stream.
+func (r *ResourceManager) openRunLogFromPod(run *model.RunDetail, nodeId string, follow bool, opts archive.ExtractLogOptions) (io.ReadCloser, error) {
+	logOptions := r.getPodLogOptions(follow, opts)
 
 	req := r.k8sCoreClient.PodClient(run.Namespace).GetLogs(nodeId, &logOptions)
 	podLogs, err := req.Stream(context.Background())
@@ -680,16 +954,9 @@ func (r *ResourceManager) readRunLogFromPod(run *model.RunDetail, nodeId string,
 		if !apierrors.IsNotFound(err) {
 			glog.Errorf("Failed to access Pod log: %v", err)
 		}
-		return util.NewInternalServerError(err, "error in opening log stream")
-	}
-	defer podLogs.Close()
-
-	_, err = io.Copy(dst, podLogs)
-	if err != nil && err != io.EOF {
-		return util.NewInternalServerError(err, "error in streaming the log")
+		return nil, util.NewInternalServerError(err, "error in opening log stream")
 	}
-
-	return nil
+	return podLogs, nil
 }
 
 func (r *ResourceManager) updateWorkflow(newWorkflow *util.Workflow, namespace string) error {
@@ -709,32 +976,74 @@ func (r *ResourceManager) GetJob(id string) (*model.Job, error) {
 }
 
 func (r *ResourceManager) CreateJob(apiJob *api.Job) (*model.Job, error) {
+	return r.createJob(apiJob, nil)
+}
+
+// CreateJobWithIdempotencyKey creates a job, unless an earlier request with
+// the same idempotency key created one already. The earlier job is returned if
+// the requests are identical, and a request with a different job is rejected.
+func (r *ResourceManager) CreateJobWithIdempotencyKey(apiJob *api.Job, idempotencyKey string) (*model.Job, error) {
+	if idempotencyKey == "" {
+		return r.CreateJob(apiJob)
+	}
+	key, err := r.reserveIdempotencyKey(context.Background(), common.Job, idempotencyKey, apiJob)
+	if err != nil {
+		return nil, err
+	}
+	if key.ResourceUUID != "" {
+		return r.GetJob(key.ResourceUUID)
+	}
+	job, err := r.createJob(apiJob, key)
+	if err != nil {
+		r.releaseIdempotencyKey(context.Background(), key)
+		return nil, err
+	}
+	return job, nil
+}
+
+// createJob creates a job, recording the idempotency key of the request if
+// any.
+func (r *ResourceManager) createJob(apiJob *api.Job, idempotencyKey *model.IdempotencyKey) (*model.Job, error) {
 	// Get workflow from either of the two places:
 	// (1) raw pipeline manifest in pipeline_spec
 	// (2) pipeline version in resource_references
 	// 	And the latter takes priority over the former when the pipeline manifest is from pipeline_spec.pipeline_id
 	// workflow manifest and pipeline id/version will not exist at the same time, guaranteed by the validation phase
-	workflowSpecManifestBytes, err := getWorkflowSpecManifestBytes(apiJob.PipelineSpec, &apiJob.ResourceReferences, r)
+	workflowSpecManifestBytes, err := getWorkflowSpecManifestBytes(context.Background(), apiJob.PipelineSpec, &apiJob.ResourceReferences, r)
 	if err != nil {
 		return nil, err
 	}
 
 	var workflow util.Workflow
-	err = json.Unmarshal(workflowSpecManifestBytes, &workflow)
-	if err != nil {
-		return nil, util.NewInternalServerError(err,
-			"Failed to unmarshal workflow spec manifest. Workflow bytes: %s", string(workflowSpecManifestBytes))
-	}
-	if workflow.PipelineRun == nil {
-		return nil, util.Wrap(
-			util.NewResourceNotFoundError("WorkflowSpecManifest", apiJob.GetName()),
-			"Failed to fetch PipelineRun spec manifest.")
-	}
+	crdParameters := toCRDParameter(apiJob.GetPipelineSpec().GetParameters())
+	if util.IsPipelineSpec(workflowSpecManifestBytes) {
+		// The ScheduledWorkflow sets the params of the compiled PipelineRun to
+		// the parameters formatted for each run, so the values are validated
+		// with their macros formatted.
+		formatter := util.NewSWFParameterFormatter("", 0, 0, 0)
+		compiledWorkflow, err := compilePipelineSpec(workflowSpecManifestBytes,
+			formatter.FormatWorkflowParameters(toParametersMap(apiJob.GetPipelineSpec().GetParameters())))
+		if err != nil {
+			return nil, util.Wrap(err, "Create job failed")
+		}
+		workflow = *compiledWorkflow
+	} else {
+		err = json.Unmarshal(workflowSpecManifestBytes, &workflow)
+		if err != nil {
+			return nil, util.NewInternalServerError(err,
+				"Failed to unmarshal workflow spec manifest. Workflow bytes: %s", string(workflowSpecManifestBytes))
+		}
+		if workflow.PipelineRun == nil {
+			return nil, util.Wrap(
+				util.NewResourceNotFoundError("WorkflowSpecManifest", apiJob.GetName()),
+				"Failed to fetch PipelineRun spec manifest.")
+		}
 
-	// Verify no additional parameter provided
-	err = workflow.VerifyParameters(toParametersMap(apiJob.GetPipelineSpec().GetParameters()))
-	if err != nil {
-		return nil, util.Wrap(err, "Create job failed")
+		// Verify no additional parameter provided
+		err = workflow.VerifyParameters(toParametersMap(apiJob.GetPipelineSpec().GetParameters()))
+		if err != nil {
+			return nil, util.Wrap(err, "Create job failed")
+		}
 	}
 
 	r.setDefaultServiceAccount(&workflow, apiJob.GetServiceAccount())
@@ -760,7 +1069,7 @@ func (r *ResourceManager) CreateJob(apiJob *api.Job) (*model.Job, error) {
 			MaxConcurrency: &apiJob.MaxConcurrency,
 			Trigger:        *toCRDTrigger(apiJob.Trigger),
 			Workflow: &scheduledworkflow.WorkflowResource{
-				Parameters: toCRDParameter(apiJob.GetPipelineSpec().GetParameters()),
+				Parameters: crdParameters,
 				Spec:       workflow.Spec,
 			},
 			NoCatchup: util.BoolPointer(apiJob.NoCatchup),
@@ -823,6 +1132,10 @@ func (r *ResourceManager) CreateJob(apiJob *api.Job) (*model.Job, error) {
 	now := r.time.Now().Unix()
 	job.CreatedAtInSec = now
 	job.UpdatedAtInSec = now
+	if idempotencyKey != nil {
+		idempotencyKey.ResourceUUID = job.UUID
+		job.IdempotencyKey = idempotencyKey
+	}
 	return r.jobStore.CreateJob(job)
 }
 
@@ -1061,7 +1374,7 @@ func (r *ResourceManager) getWorkflowSpecBytesFromPipelineSpec(spec *api.Pipelin
 	return nil, util.NewInvalidInputError("Please provide a valid pipeline spec")
 }
 
-func (r *ResourceManager) getWorkflowSpecBytesFromPipelineVersion(references []*api.ResourceReference) ([]byte, error) {
+func (r *ResourceManager) getWorkflowSpecBytesFromPipelineVersion(ctx context.Context, references []*api.ResourceReference) ([]byte, error) {
 	var pipelineVersionId = ""
 	for _, reference := range references {
 		if reference.Key.Type == api.ResourceType_PIPELINE_VERSION && reference.Relationship == api.Relationship_CREATOR {
@@ -1071,16 +1384,22 @@ func (r *ResourceManager) getWorkflowSpecBytesFromPipelineVersion(references []*
 	if len(pipelineVersionId) == 0 {
 		return nil, util.NewInvalidInputError("No pipeline version.")
 	}
-	var workflow util.Workflow
-	err := r.objectStore.GetFromYamlFile(&workflow, r.objectStore.GetPipelineKey(pipelineVersionId))
+	pipelineFile, err := r.objectStore.GetFile(ctx, r.objectStore.GetPipelineKey(pipelineVersionId))
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline YAML failed.")
 	}
+	if util.IsPipelineSpec(pipelineFile) {
+		return pipelineFile, nil
+	}
+	var workflow util.Workflow
+	if err = yaml.Unmarshal(pipelineFile, &workflow); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to unmarshal pipeline YAML of version %v", pipelineVersionId)
+	}
 
 	return []byte(workflow.ToStringForStore()), nil
 }
 
-func getWorkflowSpecManifestBytes(pipelineSpec *api.PipelineSpec, resourceReferences *[]*api.ResourceReference, r *ResourceManager) ([]byte, error) {
+func getWorkflowSpecManifestBytes(ctx context.Context, pipelineSpec *api.PipelineSpec, resourceReferences *[]*api.ResourceReference, r *ResourceManager) ([]byte, error) {
 	var workflowSpecManifestBytes []byte
 	if pipelineSpec.GetWorkflowManifest() != "" {
 		workflowSpecManifestBytes = []byte(pipelineSpec.GetWorkflowManifest())
@@ -1089,7 +1408,7 @@ func getWorkflowSpecManifestBytes(pipelineSpec *api.PipelineSpec, resourceRefere
 		if err != nil {
 			return nil, util.Wrap(err, "Failed to find default version to create run with pipeline id.")
 		}
-		workflowSpecManifestBytes, err = r.getWorkflowSpecBytesFromPipelineVersion(*resourceReferences)
+		workflowSpecManifestBytes, err = r.getWorkflowSpecBytesFromPipelineVersion(ctx, *resourceReferences)
 		if err != nil {
 			return nil, util.Wrap(err, "Failed to fetch workflow spec.")
 		}
@@ -1176,6 +1495,27 @@ func (r *ResourceManager) ReportMetric(metric *api.RunMetric, runUUID string) er
 // ReadArtifact parses run's workflow to find artifact file path and reads the content of the file
 // from object store.
 func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName string) ([]byte, error) {
+	artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, err
+	}
+	return r.objectStore.GetFile(context.Background(), artifactPath)
+}
+
+// OpenArtifact opens an artifact of a run for streaming its content. The
+// caller closes the returned reader.
+func (r *ResourceManager) OpenArtifact(runID string, nodeID string, artifactName string) (storage.FileReader, *storage.FileInfo, error) {
+	artifactPath, err := r.getArtifactPath(runID, nodeID, artifactName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.objectStore.GetFileReader(artifactPath)
+}
+
+// ListRunArtifacts lists the artifacts the tasks of a run stored in the object
+// store, or only those of taskName if it is set. The artifacts declared in the
+// artifact items annotation of the run get the path of their output.
+func (r *ResourceManager) ListRunArtifacts(runID string, taskName string) ([]*model.RunArtifact, error) {
 	run, err := r.runStore.GetRun(runID)
 	if err != nil {
 		return nil, err
@@ -1187,13 +1527,104 @@ func (r *ResourceManager) ReadArtifact(runID string, nodeID string, artifactName
 		return nil, util.NewInternalServerError(
 			err, "failed to unmarshal workflow '%s'", run.WorkflowRuntimeManifest)
 	}
+	sourcePaths := getArtifactSourcePaths(storageWorkflow.Annotations[common.ArtifactItemsAnnotation])
+
+	artifactFolder := util.ObjectStoreArtifactFolder(storageWorkflow.Name)
+	folder := artifactFolder
+	if taskName != "" {
+		folder = artifactFolder + "/" + taskName
+	}
+	files, err := r.objectStore.ListFiles(folder)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to list the artifacts of run %s", runID)
+	}
+	artifacts := make([]*model.RunArtifact, 0, len(files))
+	for _, file := range files {
+		// The artifacts are stored as artifacts/<PipelineRun>/<task>/<name>.tgz.
+		parts := strings.Split(strings.TrimPrefix(file.Key, artifactFolder+"/"), "/")
+		if len(parts) != 2 || !strings.HasSuffix(parts[1], ".tgz") {
+			continue
+		}
+		artifact := &model.RunArtifact{
+			Name:       strings.TrimSuffix(parts[1], ".tgz"),
+			TaskName:   parts[0],
+			ObjectKey:  file.Key,
+			SizeBytes:  file.Size,
+			SourcePath: sourcePaths[parts[0]][strings.TrimSuffix(parts[1], ".tgz")],
+		}
+		artifact.Type = getRunArtifactType(artifact.Name)
+		if !file.LastModified.IsZero() {
+			artifact.LastModifiedAtInSec = file.LastModified.Unix()
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	return artifacts, nil
+}
+
+// getArtifactSourcePaths returns the paths of the outputs declared in an
+// artifact items annotation by task and artifact name. The annotation maps the
+// tasks to lists of [name, path] pairs.
+func getArtifactSourcePaths(artifactItems string) map[string]map[string]string {
+	sourcePaths := make(map[string]map[string]string)
+	if artifactItems == "" {
+		return sourcePaths
+	}
+	var artifactItemsJSON map[string][][]interface{}
+	if err := json.Unmarshal([]byte(artifactItems), &artifactItemsJSON); err != nil {
+		glog.Warningf("Failed to parse the artifact items annotation. Error: %v", err)
+		return sourcePaths
+	}
+	for task, artifacts := range artifactItemsJSON {
+		sourcePaths[task] = make(map[string]string)
+		for _, artifact := range artifacts {
+			if len(artifact) != 2 {
+				continue
+			}
+			name, nameOk := artifact[0].(string)
+			path, pathOk := artifact[1].(string)
+			if nameOk && pathOk {
+				sourcePaths[task][name] = path
+			}
+		}
+	}
+	return sourcePaths
+}
+
+// getRunArtifactType detects the type of an artifact from the names the KFP
+// SDK and the artifact script give to metrics, UI metadata and logs.
+func getRunArtifactType(name string) string {
+	switch name {
+	case "mlpipeline-metrics":
+		return model.RunArtifactTypeMetrics
+	case "mlpipeline-ui-metadata":
+		return model.RunArtifactTypeUIMetadata
+	case "main-log":
+		return model.RunArtifactTypeLog
+	default:
+		return model.RunArtifactTypeData
+	}
+}
+
+// getArtifactPath returns the object store key of an artifact of a run.
+func (r *ResourceManager) getArtifactPath(runID string, nodeID string, artifactName string) (string, error) {
+	run, err := r.runStore.GetRun(runID)
+	if err != nil {
+		return "", err
+	}
+	var storageWorkflow workflowapi.PipelineRun
+	err = json.Unmarshal([]byte(run.WorkflowRuntimeManifest), &storageWorkflow)
+	if err != nil {
+		// This should never happen.
+		return "", util.NewInternalServerError(
+			err, "failed to unmarshal workflow '%s'", run.WorkflowRuntimeManifest)
+	}
 	workflow := util.NewWorkflow(&storageWorkflow)
 	artifactPath := workflow.FindObjectStoreArtifactKeyOrEmpty(nodeID, artifactName)
 	if artifactPath == "" {
-		return nil, util.NewResourceNotFoundError(
+		return "", util.NewResourceNotFoundError(
 			"artifact", common.CreateArtifactPath(runID, nodeID, artifactName))
 	}
-	return r.objectStore.GetFile(artifactPath)
+	return artifactPath, nil
 }
 
 func (r *ResourceManager) GetDefaultExperimentId() (string, error) {
@@ -1218,7 +1649,7 @@ func (r *ResourceManager) getDefaultSA() string {
 
 func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion, pipelineFile []byte, updateDefaultVersion bool) (*model.PipelineVersion, error) {
 	// Extract the parameters from the pipeline
-	params, err := util.GetParameters(pipelineFile)
+	params, err := getPipelineParameters(pipelineFile)
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
@@ -1241,6 +1672,7 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 		Status:        model.PipelineVersionCreating,
 		Parameters:    params,
 		CodeSourceUrl: apiVersion.CodeSourceUrl,
+		Labels:        apiVersion.Labels,
 	}
 	version, err = r.pipelineStore.CreatePipelineVersion(version, updateDefaultVersion)
 	if err != nil {
@@ -1248,7 +1680,7 @@ func (r *ResourceManager) CreatePipelineVersion(apiVersion *api.PipelineVersion,
 	}
 
 	// Store the pipeline file
-	err = r.objectStore.AddFile(pipelineFile, r.objectStore.GetPipelineKey(fmt.Sprint(version.UUID)))
+	err = r.objectStore.AddFile(context.Background(), pipelineFile, r.objectStore.GetPipelineKey(fmt.Sprint(version.UUID)))
 	if err != nil {
 		return nil, util.Wrap(err, "Create pipeline version failed")
 	}
@@ -1268,6 +1700,82 @@ func (r *ResourceManager) GetPipelineVersion(versionId string) (*model.PipelineV
 	return r.pipelineStore.GetPipelineVersion(versionId)
 }
 
+// UpdateLabels adds and removes labels of a run, job, experiment or pipeline
+// version, and returns the resulting labels.
+func (r *ResourceManager) UpdateLabels(resourceType common.ResourceType, id string, addLabels map[string]string,
+	removeLabelKeys []string) (map[string]string, error) {
+	var err error
+	switch resourceType {
+	case common.Run:
+		_, err = r.runStore.GetRun(id)
+	case common.Job:
+		_, err = r.jobStore.GetJob(id)
+	case common.Experiment:
+		_, err = r.experimentStore.GetExperiment(id)
+	case common.PipelineVersion:
+		_, err = r.pipelineStore.GetPipelineVersion(id)
+	default:
+		return nil, util.NewInvalidInputError("Labels are not supported on resource type %s", resourceType)
+	}
+	if err != nil {
+		return nil, util.Wrap(err, "Update labels failed")
+	}
+	labels, err := r.labelStore.UpdateLabels(resourceType, id, addLabels, removeLabelKeys)
+	if err != nil {
+		return nil, util.Wrap(err, "Update labels failed")
+	}
+	return labels, nil
+}
+
+// GetNamespaceQuota returns the quota of a namespace along with its current
+// usage. A namespace without a quota has no limits.
+func (r *ResourceManager) GetNamespaceQuota(namespace string) (*model.NamespaceQuota, *model.NamespaceQuotaUsage, error) {
+	quota, err := r.getNamespaceQuota(namespace)
+	if err != nil {
+		return nil, nil, util.Wrap(err, "Failed to get namespace quota")
+	}
+	usage, err := r.getNamespaceRunUsage(namespace)
+	if err != nil {
+		return nil, nil, util.Wrap(err, "Failed to get namespace quota")
+	}
+	return quota, usage, nil
+}
+
+func (r *ResourceManager) UpdateNamespaceQuota(quota *model.NamespaceQuota) (*model.NamespaceQuota, error) {
+	return r.namespaceQuotaStore.UpdateNamespaceQuota(quota)
+}
+
+func (r *ResourceManager) CreateRetentionPolicy(apiPolicy *api.RetentionPolicy) (*model.RetentionPolicy, error) {
+	policy, err := r.ToModelRetentionPolicy(apiPolicy)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to convert retention policy model")
+	}
+	if policy.ResourceType == common.Experiment {
+		_, err = r.experimentStore.GetExperiment(policy.ResourceUUID)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to create retention policy")
+		}
+	}
+	return r.retentionPolicyStore.CreateRetentionPolicy(policy)
+}
+
+func (r *ResourceManager) GetRetentionPolicy(policyId string) (*model.RetentionPolicy, error) {
+	return r.retentionPolicyStore.GetRetentionPolicy(policyId)
+}
+
+func (r *ResourceManager) ListRetentionPolicies(filterContext *common.FilterContext, opts *list.Options) (
+	policies []*model.RetentionPolicy, total_size int, nextPageToken string, err error) {
+	return r.retentionPolicyStore.ListRetentionPolicies(filterContext, opts)
+}
+
+func (r *ResourceManager) DeleteRetentionPolicy(policyId string) error {
+	_, err := r.retentionPolicyStore.GetRetentionPolicy(policyId)
+	if err != nil {
+		return util.Wrap(err, "Delete retention policy failed")
+	}
+	return r.retentionPolicyStore.DeleteRetentionPolicy(policyId)
+}
+
 func (r *ResourceManager) ListPipelineVersions(pipelineId string, opts *list.Options) (pipelines []*model.PipelineVersion, total_size int, nextPageToken string, err error) {
 	return r.pipelineStore.ListPipelineVersions(pipelineId, opts)
 }
@@ -1298,14 +1806,14 @@ func (r *ResourceManager) DeletePipelineVersion(pipelineVersionId string) error
 	return nil
 }
 
-func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte, error) {
+func (r *ResourceManager) GetPipelineVersionTemplate(ctx context.Context, versionId string) ([]byte, error) {
 	// Verify pipeline version exist
 	_, err := r.pipelineStore.GetPipelineVersion(versionId)
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline version template failed")
 	}
 
-	template, err := r.objectStore.GetFile(r.objectStore.GetPipelineKey(fmt.Sprint(versionId)))
+	template, err := r.objectStore.GetFile(ctx, r.objectStore.GetPipelineKey(fmt.Sprint(versionId)))
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline version template failed")
 	}
@@ -1313,35 +1821,71 @@ func (r *ResourceManager) GetPipelineVersionTemplate(versionId string) ([]byte,
 	return template, nil
 }
 
-func (r *ResourceManager) IsRequestAuthorized(userIdentity string, resourceAttributes *authorizationv1.ResourceAttributes) error {
+// IsRequestAuthorized checks with a SubjectAccessReview whether a user, or
+// one of its groups, may access a resource. Decisions are cached, errors are
+// not.
+func (r *ResourceManager) IsRequestAuthorized(ctx context.Context, userInfo *kfpauth.UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	var cacheKey string
+	decision, cached := authorizationDecision{}, false
+	if r.authorizationCache != nil {
+		key, err := authorizationCacheKey(userInfo, resourceAttributes)
+		if err != nil {
+			return util.NewInternalServerError(err, "Failed to authorize user '%s' (request: %+v)", userInfo.Name, resourceAttributes)
+		}
+		cacheKey = key
+		decision, cached = r.authorizationCache.get(cacheKey)
+	}
+	if !cached {
+		var err error
+		decision, err = r.reviewSubjectAccess(ctx, userInfo, resourceAttributes)
+		if err != nil {
+			return err
+		}
+		if r.authorizationCache != nil {
+			r.authorizationCache.put(cacheKey, decision)
+		}
+	}
+	if !decision.allowed {
+		return util.NewPermissionDeniedError(
+			errors.New("Unauthorized access"),
+			"User '%s' is not authorized with reason: %s (request: %+v)",
+			userInfo.Name,
+			decision.reason,
+			resourceAttributes,
+		)
+	}
+	return nil
+}
+
+func (r *ResourceManager) reviewSubjectAccess(ctx context.Context, userInfo *kfpauth.UserInfo, resourceAttributes *authorizationv1.ResourceAttributes) (authorizationDecision, error) {
+	var extra map[string]authorizationv1.ExtraValue
+	if len(userInfo.Extra) > 0 {
+		extra = make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+		for key, value := range userInfo.Extra {
+			extra[key] = authorizationv1.ExtraValue(value)
+		}
+	}
 	result, err := r.subjectAccessReviewClient.Create(
-		context.Background(),
+		ctx,
 		&authorizationv1.SubjectAccessReview{
 			Spec: authorizationv1.SubjectAccessReviewSpec{
 				ResourceAttributes: resourceAttributes,
-				User:               userIdentity,
+				User:               userInfo.Name,
+				Groups:             userInfo.Groups,
+				Extra:              extra,
 			},
 		},
 		v1.CreateOptions{},
 	)
 	if err != nil {
-		return util.NewInternalServerError(
+		return authorizationDecision{}, util.NewInternalServerError(
 			err,
 			"Failed to create SubjectAccessReview for user '%s' (request: %+v)",
-			userIdentity,
+			userInfo.Name,
 			resourceAttributes,
 		)
 	}
-	if !result.Status.Allowed {
-		return util.NewPermissionDeniedError(
-			errors.New("Unauthorized access"),
-			"User '%s' is not authorized with reason: %s (request: %+v)",
-			userIdentity,
-			result.Status.Reason,
-			resourceAttributes,
-		)
-	}
-	return nil
+	return authorizationDecision{allowed: result.Status.Allowed, reason: result.Status.Reason}, nil
 }
 
 func (r *ResourceManager) GetNamespaceFromExperimentID(experimentID string) (string, error) {
@@ -17,13 +17,19 @@ package resource
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -70,7 +76,7 @@ func (m *FakeBadObjectStore) GetPipelineKey(pipelineID string) string {
 	return pipelineID
 }
 
-func (m *FakeBadObjectStore) AddFile(template []byte, filePath string) error {
+func (m *FakeBadObjectStore) AddFile(ctx context.Context, template []byte, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
 
@@ -78,10 +84,22 @@ func (m *FakeBadObjectStore) DeleteFile(filePath string) error {
 	return errors.New("Not implemented.")
 }
 
-func (m *FakeBadObjectStore) GetFile(filePath string) ([]byte, error) {
+func (m *FakeBadObjectStore) DeleteFolder(folderPath string) (int, error) {
+	return 0, errors.New("Not implemented.")
+}
+
+func (m *FakeBadObjectStore) ListFiles(folderPath string) ([]*storage.FileInfo, error) {
+	return nil, errors.New("Not implemented.")
+}
+
+func (m *FakeBadObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
 
+func (m *FakeBadObjectStore) GetFileReader(filePath string) (storage.FileReader, *storage.FileInfo, error) {
+	return nil, nil, util.NewInternalServerError(errors.New("Error"), "bad object store")
+}
+
 func (m *FakeBadObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
 	return util.NewInternalServerError(errors.New("Error"), "bad object store")
 }
@@ -149,6 +167,23 @@ func initWithJob(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Job
 
 // Removed Argo related tests (check the top page comments for more details)
 
+func initWithRun(t *testing.T) (*FakeClientManager, *ResourceManager, *model.RunDetail) {
+	store, manager, exp := initWithExperiment(t)
+	apiRun := &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	return store, manager, runDetail
+}
+
 func initWithPatchedRun(t *testing.T) (*FakeClientManager, *ResourceManager, *model.RunDetail) {
 	store, manager, exp := initWithExperiment(t)
 	apiRun := &api.Run{
@@ -166,7 +201,7 @@ func initWithPatchedRun(t *testing.T) (*FakeClientManager, *ResourceManager, *mo
 			},
 		},
 	}
-	runDetail, err := manager.CreateRun(apiRun)
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
 	assert.Nil(t, err)
 	return store, manager, runDetail
 }
@@ -219,7 +254,7 @@ func TestGetPipelineTemplate_PipelineMetadataNotFound(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
 	template := []byte("workflow: foo")
-	store.objectStore.AddFile(template, store.objectStore.GetPipelineKey(fmt.Sprint(1)))
+	store.objectStore.AddFile(context.Background(), template, store.objectStore.GetPipelineKey(fmt.Sprint(1)))
 	manager := NewResourceManager(store)
 	_, err := manager.GetPipelineTemplate("1")
 	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
@@ -240,11 +275,152 @@ func TestCreateRun_EmptyPipelineSpec(t *testing.T) {
 			},
 		},
 	}
-	_, err := manager.CreateRun(apiRun)
+	_, err := manager.CreateRun(context.Background(), apiRun)
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Failed to fetch workflow spec")
 }
 
+// A pipeline spec of KFP v2 with a typed parameter.
+const testPipelineSpec = `{
+  "pipelineInfo": {"name": "hello-world"},
+  "root": {
+    "inputDefinitions": {"parameters": {"count": {"type": "INT"}}},
+    "dag": {"tasks": {"hello": {
+      "componentRef": {"name": "comp-hello"},
+      "inputs": {"parameters": {"count": {"componentInputParameter": "count"}}}
+    }}}
+  },
+  "components": {"comp-hello": {
+    "inputDefinitions": {"parameters": {"count": {"type": "INT"}}},
+    "executorLabel": "exec-hello"
+  }},
+  "deploymentSpec": {"executors": {"exec-hello": {"container": {
+    "image": "busybox",
+    "command": ["echo", "{{$.inputs.parameters['count']}}"]
+  }}}},
+  "runtimeParameters": {"count": {"type": "INT", "defaultValue": {"intValue": "1"}}}
+}`
+
+func TestCreatePipeline_PipelineSpec(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	pipeline, err := manager.CreatePipeline("pipeline1", "", "", []byte(testPipelineSpec))
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"count","type":"string","description":"INT","default":"1"}]`, pipeline.Parameters)
+	assert.Equal(t, pipeline.Parameters, pipeline.DefaultVersion.Parameters)
+
+	// The pipeline spec is stored as is.
+	template, err := manager.GetPipelineTemplate(pipeline.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, testPipelineSpec, string(template))
+}
+
+func TestCreatePipeline_InvalidPipelineSpec(t *testing.T) {
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	defer store.Close()
+	manager := NewResourceManager(store)
+	_, err := manager.CreatePipeline("pipeline1", "", "", []byte(`{"pipelineInfo": {"name": "hello-world"}}`))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "neither a root DAG nor tasks")
+
+	// The pipeline spec must compile.
+	_, err = manager.CreatePipeline("pipeline1", "", "", []byte(strings.Replace(testPipelineSpec, `"exec-hello": {"container"`, `"exec-other": {"container"`, 1)))
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Executor with label 'exec-hello' cannot be found")
+}
+
+func TestCreateRun_ThroughPipelineSpecVersion(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	pipeline, err := manager.CreatePipeline("pipeline1", "", "", []byte(testPipelineSpec))
+	assert.Nil(t, err)
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			Parameters: []*api.Parameter{{Name: "count", Value: "3"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_PIPELINE_VERSION, Id: pipeline.DefaultVersionId},
+				Relationship: api.Relationship_CREATOR,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
+	assert.Nil(t, err)
+	assert.Equal(t, testPipelineSpec, runDetail.WorkflowSpecManifest)
+	assert.Equal(t, `[{"name":"count","value":"3"}]`, runDetail.Parameters)
+
+	// The pipeline spec is compiled with the value of the parameter.
+	assert.Equal(t, "hello-world-123e4", runDetail.Name)
+	workflow, err := store.TektonClientFake.Workflow("ns1").Get(context.Background(), runDetail.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(workflow.Spec.PipelineSpec.Tasks))
+	assert.Equal(t, map[string]string{"count": "3"}, util.NewWorkflow(workflow).GetWorkflowParametersAsMap())
+}
+
+func TestCreateRun_PipelineSpecInvalidParameter(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiRun := &api.Run{
+		Name: "run1",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testPipelineSpec,
+			Parameters:       []*api.Parameter{{Name: "count", Value: "three"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.CreateRun(context.Background(), apiRun)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid value of input parameter 'count'")
+	assert.Equal(t, 0, store.TektonClientFake.GetWorkflowCount())
+}
+
+func TestCreateJob_ThroughPipelineSpec(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiJob := &api.Job{
+		Name:    "j1",
+		Enabled: true,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testPipelineSpec,
+			Parameters:       []*api.Parameter{{Name: "count", Value: "[[Index]]"}},
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	job, err := manager.CreateJob(apiJob)
+	assert.Nil(t, err)
+	assert.Equal(t, testPipelineSpec, job.WorkflowSpecManifest)
+
+	// The parameters stay parameters of the ScheduledWorkflow, which formats
+	// their macros for each run.
+	swf, err := store.SwfClient().ScheduledWorkflow("ns1").Get(context.Background(), job.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []scheduledworkflow.Parameter{{Name: "count", Value: "[[Index]]"}}, swf.Spec.Workflow.Parameters)
+	assert.Equal(t, "count", swf.Spec.Workflow.Spec.Params[0].Name)
+
+	// The values are validated by their types once formatted.
+	apiJob.PipelineSpec.Parameters = []*api.Parameter{{Name: "count", Value: "[[RunUUID]]"}}
+	_, err = manager.CreateJob(apiJob)
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "Invalid value of input parameter 'count'")
+}
+
 func TestCreateRun_InvalidWorkflowSpec(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -258,13 +434,102 @@ func TestCreateRun_InvalidWorkflowSpec(t *testing.T) {
 			},
 		},
 	}
-	_, err := manager.CreateRun(apiRun)
+	_, err := manager.CreateRun(context.Background(), apiRun)
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Failed to unmarshal workflow spec manifest")
 }
 
 // Removed Argo related tests (check the top page comments for more details)
 
+func TestCreateRunWithIdempotencyKey(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiRun := &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	runDetail, err := manager.CreateRunWithIdempotencyKey(context.Background(), proto.Clone(apiRun).(*api.Run), "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+
+	// A retried request returns the original run without creating a workflow.
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440001", nil))
+	manager = NewResourceManager(store)
+	retriedRun, err := manager.CreateRunWithIdempotencyKey(context.Background(), proto.Clone(apiRun).(*api.Run), "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, runDetail.UUID, retriedRun.UUID)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+
+	// A different request with the same key is rejected.
+	differentRun := proto.Clone(apiRun).(*api.Run)
+	differentRun.Name = "run2"
+	_, err = manager.CreateRunWithIdempotencyKey(context.Background(), differentRun, "key1")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+
+	// Keys are scoped to the resource type, and requests without a key are not deduplicated.
+	_, err = store.IdempotencyKeyStore().GetIdempotencyKey(context.Background(), common.Job, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	newRun, err := manager.CreateRunWithIdempotencyKey(context.Background(), proto.Clone(apiRun).(*api.Run), "")
+	assert.Nil(t, err)
+	assert.NotEqual(t, runDetail.UUID, newRun.UUID)
+
+	// The key is released when the run is deleted.
+	assert.Nil(t, manager.DeleteRun(runDetail.UUID))
+	_, err = store.IdempotencyKeyStore().GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCreateRunWithIdempotencyKey_Concurrent(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiRun := &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	// An identical request reserved the key and is still creating the run.
+	key, err := manager.reserveIdempotencyKey(context.Background(), common.Run, "key1", apiRun)
+	assert.Nil(t, err)
+	assert.Equal(t, "", key.ResourceUUID)
+
+	_, err = manager.CreateRunWithIdempotencyKey(context.Background(), proto.Clone(apiRun).(*api.Run), "key1")
+	assert.Equal(t, codes.Aborted, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "still creating the Run")
+	assert.Equal(t, 0, store.TektonClientFake.GetWorkflowCount())
+}
+
+func TestCreateRunWithIdempotencyKey_FailureReleasesKey(t *testing.T) {
+	store, manager, _ := initWithExperiment(t)
+	defer store.Close()
+	apiRun := &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "non-existent-experiment"},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	_, err := manager.CreateRunWithIdempotencyKey(context.Background(), apiRun, "key1")
+	assert.NotNil(t, err)
+
+	// The failed request doesn't keep the key, so it can be retried.
+	_, err = store.IdempotencyKeyStore().GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 func TestDeleteRun_RunNotExist(t *testing.T) {
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	defer store.Close()
@@ -384,6 +649,41 @@ func TestCreateJob_ThroughWorkflowSpec(t *testing.T) {
 	assert.Equal(t, expectedJob, job)
 }
 
+func TestCreateJobWithIdempotencyKey(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	apiJob := &api.Job{
+		Name:         "j1",
+		Enabled:      true,
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}
+	job, err := manager.CreateJobWithIdempotencyKey(apiJob, "key1")
+	assert.Nil(t, err)
+
+	// A retried request returns the original job.
+	retriedJob, err := manager.CreateJobWithIdempotencyKey(proto.Clone(apiJob).(*api.Job), "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, job.UUID, retriedJob.UUID)
+
+	// A different request with the same key is rejected.
+	differentJob := proto.Clone(apiJob).(*api.Job)
+	differentJob.Name = "j2"
+	_, err = manager.CreateJobWithIdempotencyKey(differentJob, "key1")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	assert.Contains(t, err.Error(), "already used by a different request")
+
+	// The key is released when the job is deleted.
+	assert.Nil(t, manager.DeleteJob(job.UUID))
+	_, err = store.IdempotencyKeyStore().GetIdempotencyKey(context.Background(), common.Job, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
 // Removed Argo related tests (check the top page comments for more details)
 
 func TestEnableJob_JobNotExist(t *testing.T) {
@@ -548,7 +848,7 @@ func TestGetPodLogOptions(t *testing.T) {
 	initEnvVars()
 	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
 	manager := NewResourceManager(store)
-	logOptions := manager.getPodLogOptions(true)
+	logOptions := manager.getPodLogOptions(true, archive.ExtractLogOptions{})
 	expectedLogOptions := corev1.PodLogOptions{
 		Container:  "step-main",
 		Timestamps: false,
@@ -556,3 +856,25 @@ func TestGetPodLogOptions(t *testing.T) {
 	}
 	assert.Equal(t, expectedLogOptions, logOptions)
 }
+
+func TestGetPodLogOptions_Selected(t *testing.T) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatal(util.NewFakeTimeForEpoch())
+	manager := NewResourceManager(store)
+	tailLines := int64(10)
+	sinceTime := time.Unix(1, 0).UTC()
+	logOptions := manager.getPodLogOptions(false, archive.ExtractLogOptions{
+		LogFormat: archive.LogFormatJSON,
+		Container: "step-prepare",
+		TailLines: &tailLines,
+		SinceTime: &sinceTime,
+	})
+	expectedSinceTime := v1.NewTime(sinceTime)
+	expectedLogOptions := corev1.PodLogOptions{
+		Container:  "step-prepare",
+		Timestamps: true,
+		TailLines:  &tailLines,
+		SinceTime:  &expectedSinceTime,
+	}
+	assert.Equal(t, expectedLogOptions, logOptions)
+}
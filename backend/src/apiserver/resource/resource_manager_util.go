@@ -16,6 +16,7 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -28,6 +29,7 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	scheduledworkflow "github.com/kubeflow/pipelines/backend/src/crd/pkg/apis/scheduledworkflow/v1beta1"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/tekton"
 	wfv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -119,6 +121,53 @@ func toParametersMap(apiParams []*api.Parameter) map[string]string {
 	return desiredParamsMap
 }
 
+// getPipelineParameters returns the parameters of a pipeline file, which is a
+// PipelineRun or a pipeline spec of KFP v2, as serialized string.
+func getPipelineParameters(pipelineFile []byte) (string, error) {
+	if !util.IsPipelineSpec(pipelineFile) {
+		return util.GetParameters(pipelineFile)
+	}
+	job, err := util.ValidatePipelineSpec(pipelineFile)
+	if err != nil {
+		return "", util.Wrap(err, "Failed to get parameters from the pipeline spec")
+	}
+	// The pipeline spec is compiled for each run, so it must compile.
+	if _, err = tekton.CompilePipelineJob(job, nil); err != nil {
+		return "", util.NewInvalidInputErrorWithDetails(err, "Failed to compile the pipeline spec.")
+	}
+	params, err := tekton.GetPipelineJobParameters(job)
+	if err != nil {
+		return "", util.NewInvalidInputErrorWithDetails(err, "Failed to get parameters from the pipeline spec.")
+	}
+	paramBytes, err := json.Marshal(params)
+	if err != nil {
+		return "", util.NewInvalidInputErrorWithDetails(err, "Failed to marshal the parameter.")
+	}
+	if len(paramBytes) > util.MaxParameterBytes {
+		return "", util.NewInvalidInputError("The input parameter length exceed maximum size of %v.", util.MaxParameterBytes)
+	}
+	return string(paramBytes), nil
+}
+
+// compilePipelineSpec compiles a pipeline spec of KFP v2 into a PipelineRun
+// with the given values of the pipeline parameters, which are params of the
+// PipelineRun.
+func compilePipelineSpec(pipelineSpecManifest []byte, parameters map[string]string) (*util.Workflow, error) {
+	job, err := util.ValidatePipelineSpec(pipelineSpecManifest)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to compile the pipeline spec")
+	}
+	pipelineRun, err := tekton.CompilePipelineJob(job, parameters)
+	if err != nil {
+		return nil, util.NewInvalidInputErrorWithDetails(err, "Failed to compile the pipeline spec.")
+	}
+	// Like the one of an uploaded PipelineRun, the name gets a suffix of the
+	// run ID.
+	pipelineRun.Name = strings.TrimSuffix(pipelineRun.GenerateName, "-")
+	pipelineRun.GenerateName = ""
+	return util.NewWorkflow(pipelineRun), nil
+}
+
 func formulateRetryWorkflow(wf *util.Workflow) (*util.Workflow, []string, error) {
 	if len(wf.Status.Status.Conditions) > 0 {
 		switch wf.Status.Status.Conditions[0].Type {
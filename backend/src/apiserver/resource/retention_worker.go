@@ -0,0 +1,308 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	secondsPerDay = 24 * 60 * 60
+	// The page size used to iterate over policies, experiments and runs.
+	retentionListPageSize = 200
+)
+
+// Metric variables. Please prefix the metric names with resource_manager_.
+var (
+	retentionArchivedRunCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_retention_archived_runs",
+		Help: "The number of runs archived by retention policies",
+	})
+
+	retentionDeletedRunCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_retention_deleted_runs",
+		Help: "The number of runs deleted by retention policies",
+	})
+
+	retentionDeletedObjectCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_retention_deleted_objects",
+		Help: "The number of artifact and log objects deleted by retention policies",
+	})
+)
+
+// RetentionPolicyReport summarizes one enforcement of a retention policy.
+type RetentionPolicyReport struct {
+	PolicyUUID         string
+	ArchivedRunIds     []string
+	DeletedRunIds      []string
+	DeletedObjectCount int
+	Errors             []error
+}
+
+// RetentionWorker periodically enforces the retention policies. Every API
+// server replica runs a worker, and a policy is enforced by the first worker
+// claiming it.
+type RetentionWorker struct {
+	resourceManager *ResourceManager
+	interval        time.Duration
+}
+
+func NewRetentionWorker(resourceManager *ResourceManager, interval time.Duration) *RetentionWorker {
+	return &RetentionWorker{resourceManager: resourceManager, interval: interval}
+}
+
+// Run enforces the retention policies every interval until stopCh is closed.
+func (w *RetentionWorker) Run(stopCh <-chan struct{}) {
+	glog.Infof("Starting retention worker with interval %v", w.interval)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			// The policies enforced within the last half interval were claimed by
+			// the workers of other replicas.
+			if _, err := w.resourceManager.EnforceRetentionPolicies(w.interval / 2); err != nil {
+				glog.Errorf("Failed to enforce retention policies. Error: %v", err)
+			}
+		}
+	}
+}
+
+// EnforceRetentionPolicies deletes and archives the finished runs that are
+// older than allowed by the retention policies. The policy of an experiment
+// takes precedence over the policy of its namespace. The result is recorded on
+// each policy.
+// Each policy is claimed before it is enforced, by setting the time it was
+// last enforced, and the policies enforced within minInterval are skipped.
+// Hence concurrent calls, e.g. by several API server replicas, do not enforce
+// the same policy.
+func (r *ResourceManager) EnforceRetentionPolicies(minInterval time.Duration) ([]*RetentionPolicyReport, error) {
+	policies, err := r.listAllRetentionPolicies()
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to enforce retention policies")
+	}
+	experimentsWithPolicy := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.ResourceType == common.Experiment {
+			experimentsWithPolicy[policy.ResourceUUID] = true
+		}
+	}
+
+	now := r.time.Now().Unix()
+	reports := make([]*RetentionPolicyReport, 0, len(policies))
+	for _, policy := range policies {
+		claimed, err := r.retentionPolicyStore.ClaimRetentionPolicyEnforcement(policy.UUID, now,
+			now-int64(minInterval.Seconds()))
+		if err != nil {
+			glog.Errorf("Failed to claim the enforcement of retention policy %s. Error: %v", policy.UUID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		report := r.enforceRetentionPolicy(policy, experimentsWithPolicy, now)
+		enforcementError := ""
+		if len(report.Errors) > 0 {
+			enforcementError = utilerrors.NewAggregate(report.Errors).Error()
+			glog.Errorf("Failed to enforce retention policy %s. Error: %v", policy.UUID, enforcementError)
+		}
+		glog.Infof("Enforced retention policy %s of %s %s. Archived runs: %v. Deleted runs: %v. Deleted objects: %d.",
+			policy.UUID, policy.ResourceType, policy.ResourceUUID, report.ArchivedRunIds, report.DeletedRunIds, report.DeletedObjectCount)
+		retentionArchivedRunCounter.Add(float64(len(report.ArchivedRunIds)))
+		retentionDeletedRunCounter.Add(float64(len(report.DeletedRunIds)))
+		retentionDeletedObjectCounter.Add(float64(report.DeletedObjectCount))
+
+		err = r.retentionPolicyStore.ReportRetentionPolicyEnforcement(policy.UUID, now, int64(len(report.ArchivedRunIds)),
+			int64(len(report.DeletedRunIds)), int64(report.DeletedObjectCount), enforcementError)
+		if err != nil {
+			glog.Errorf("Failed to record the enforcement of retention policy %s. Error: %v", policy.UUID, err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (r *ResourceManager) enforceRetentionPolicy(policy *model.RetentionPolicy, experimentsWithPolicy map[string]bool,
+	now int64) *RetentionPolicyReport {
+	report := &RetentionPolicyReport{PolicyUUID: policy.UUID}
+	experimentIds, err := r.getRetentionPolicyExperiments(policy, experimentsWithPolicy)
+	if err != nil {
+		report.Errors = append(report.Errors, err)
+		return report
+	}
+	for _, experimentId := range experimentIds {
+		// Delete before archiving so that runs about to be deleted are not archived first.
+		if policy.DeleteAfterDays > 0 {
+			runs, err := r.listExpiredRuns(experimentId, now-int64(policy.DeleteAfterDays)*secondsPerDay, false)
+			if err != nil {
+				report.Errors = append(report.Errors, err)
+				continue
+			}
+			for _, run := range runs {
+				objectCount, err := r.deleteRunAndObjects(run)
+				report.DeletedObjectCount += objectCount
+				if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+					// The run was deleted meanwhile.
+					continue
+				}
+				if err != nil {
+					report.Errors = append(report.Errors, err)
+					continue
+				}
+				report.DeletedRunIds = append(report.DeletedRunIds, run.UUID)
+			}
+		}
+		if policy.ArchiveAfterDays > 0 {
+			runs, err := r.listExpiredRuns(experimentId, now-int64(policy.ArchiveAfterDays)*secondsPerDay, true)
+			if err != nil {
+				report.Errors = append(report.Errors, err)
+				continue
+			}
+			for _, run := range runs {
+				err := r.ArchiveRun(run.UUID)
+				if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+					// The run was deleted meanwhile.
+					continue
+				}
+				if err != nil {
+					report.Errors = append(report.Errors, util.Wrapf(err, "Failed to archive run %s", run.UUID))
+					continue
+				}
+				report.ArchivedRunIds = append(report.ArchivedRunIds, run.UUID)
+			}
+		}
+	}
+	return report
+}
+
+func (r *ResourceManager) listAllRetentionPolicies() ([]*model.RetentionPolicy, error) {
+	var policies []*model.RetentionPolicy
+	opts, err := list.NewOptions(&model.RetentionPolicy{}, retentionListPageSize, "", nil)
+	for err == nil {
+		var page []*model.RetentionPolicy
+		var nextPageToken string
+		page, _, nextPageToken, err = r.retentionPolicyStore.ListRetentionPolicies(&common.FilterContext{}, opts)
+		if err != nil {
+			break
+		}
+		policies = append(policies, page...)
+		if nextPageToken == "" {
+			return policies, nil
+		}
+		opts, err = list.NewOptionsFromToken(&model.RetentionPolicy{}, nextPageToken, retentionListPageSize)
+	}
+	return nil, util.Wrap(err, "Failed to list retention policies")
+}
+
+// getRetentionPolicyExperiments returns the IDs of the experiments a policy
+// applies to.
+func (r *ResourceManager) getRetentionPolicyExperiments(policy *model.RetentionPolicy,
+	experimentsWithPolicy map[string]bool) ([]string, error) {
+	if policy.ResourceType == common.Experiment {
+		return []string{policy.ResourceUUID}, nil
+	}
+	filterContext := &common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: policy.ResourceUUID},
+	}
+	var experimentIds []string
+	opts, err := list.NewOptions(&model.Experiment{}, retentionListPageSize, "", nil)
+	for err == nil {
+		var experiments []*model.Experiment
+		var nextPageToken string
+		experiments, _, nextPageToken, err = r.experimentStore.ListExperiments(filterContext, opts)
+		if err != nil {
+			break
+		}
+		for _, experiment := range experiments {
+			if !experimentsWithPolicy[experiment.UUID] {
+				experimentIds = append(experimentIds, experiment.UUID)
+			}
+		}
+		if nextPageToken == "" {
+			return experimentIds, nil
+		}
+		opts, err = list.NewOptionsFromToken(&model.Experiment{}, nextPageToken, retentionListPageSize)
+	}
+	return nil, util.Wrapf(err, "Failed to list experiments in namespace %s", policy.ResourceUUID)
+}
+
+// listExpiredRuns returns the runs of an experiment that finished before the
+// given time.
+func (r *ResourceManager) listExpiredRuns(experimentId string, finishedBeforeInSec int64,
+	excludeArchived bool) ([]*model.Run, error) {
+	predicates := []*api.Predicate{
+		{Key: "finished_at", Op: api.Predicate_GREATER_THAN, Value: &api.Predicate_LongValue{LongValue: 0}},
+		{Key: "finished_at", Op: api.Predicate_LESS_THAN, Value: &api.Predicate_LongValue{LongValue: finishedBeforeInSec}},
+	}
+	if excludeArchived {
+		predicates = append(predicates, &api.Predicate{
+			Key:   "storage_state",
+			Op:    api.Predicate_NOT_EQUALS,
+			Value: &api.Predicate_StringValue{StringValue: api.Run_STORAGESTATE_ARCHIVED.String()},
+		})
+	}
+	filterContext := &common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: experimentId},
+	}
+	var runs []*model.Run
+	opts, err := list.NewOptions(&model.Run{}, retentionListPageSize, "", &api.Filter{Predicates: predicates})
+	for err == nil {
+		var page []*model.Run
+		var nextPageToken string
+		page, _, nextPageToken, err = r.runStore.ListRuns(filterContext, opts)
+		if err != nil {
+			break
+		}
+		runs = append(runs, page...)
+		if nextPageToken == "" {
+			return runs, nil
+		}
+		opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, retentionListPageSize)
+	}
+	return nil, util.Wrapf(err, "Failed to list expired runs of experiment %s", experimentId)
+}
+
+// deleteRunAndObjects deletes a run and attempts the cleanup of its artifacts
+// and archived logs in the object store right away. A failed cleanup is
+// retried by the run object cleanup worker. Returns the number of deleted
+// objects.
+func (r *ResourceManager) deleteRunAndObjects(run *model.Run) (int, error) {
+	err := r.DeleteRun(run.UUID)
+	if err != nil {
+		return 0, util.Wrapf(err, "Failed to delete run %s", run.UUID)
+	}
+	cleanup, err := r.runObjectCleanupStore.GetRunObjectCleanup(run.UUID)
+	if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		// The objects were cleaned up meanwhile by the run object cleanup worker.
+		return 0, nil
+	}
+	if err != nil {
+		return 0, util.Wrapf(err, "Failed to get the object cleanup of run %s", run.UUID)
+	}
+	return r.cleanupRunObjects(cleanup)
+}
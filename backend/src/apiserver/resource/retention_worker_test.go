@@ -0,0 +1,175 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+const retentionTestNowInSec = 100 * secondsPerDay
+
+// initWithRetentionRuns creates an experiment with three runs, finished 40
+// days ago, 10 days ago and not finished yet.
+func initWithRetentionRuns(t *testing.T) (*FakeClientManager, *ResourceManager, *model.Experiment, []*model.RunDetail) {
+	initEnvVars()
+	store := NewFakeClientManagerOrFatal(util.NewFakeTime(time.Unix(retentionTestNowInSec, 0)))
+	manager := NewResourceManager(store)
+	experiment, err := manager.CreateExperiment(&api.Experiment{Name: "e1"})
+	assert.Nil(t, err)
+
+	finishedAt := []int64{retentionTestNowInSec - 40*secondsPerDay, retentionTestNowInSec - 10*secondsPerDay, 0}
+	uuids := []string{"123e4567-e89b-12d3-a456-426655440001", "123e4567-e89b-12d3-a456-426655440002",
+		"123e4567-e89b-12d3-a456-426655440003"}
+	var runs []*model.RunDetail
+	for i, uuid := range uuids {
+		store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(uuid, nil))
+		manager = NewResourceManager(store)
+		runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+			Name:         "run",
+			PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+			ResourceReferences: []*api.ResourceReference{
+				{
+					Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+					Relationship: api.Relationship_OWNER,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		if finishedAt[i] > 0 {
+			err = store.RunStore().UpdateRun(runDetail.UUID, "Succeeded", finishedAt[i], testWorkflow.ToStringForStore())
+			assert.Nil(t, err)
+		}
+		runs = append(runs, runDetail)
+	}
+	return store, manager, experiment, runs
+}
+
+func TestEnforceRetentionPolicies(t *testing.T) {
+	store, manager, experiment, runs := initWithRetentionRuns(t)
+	defer store.Close()
+	err := store.ObjectStore().AddFile(context.Background(), []byte("a"), util.ObjectStoreArtifactFolder(runs[0].Name)+"/step/a.tgz")
+	assert.Nil(t, err)
+	err = store.ObjectStore().AddFile(context.Background(), []byte("b"), util.ObjectStoreArtifactFolder(runs[0].Name)+"/step/b.tgz")
+	assert.Nil(t, err)
+
+	policy, err := manager.CreateRetentionPolicy(&api.RetentionPolicy{
+		ResourceKey:      &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		ArchiveAfterDays: 7,
+		DeleteAfterDays:  30,
+	})
+	assert.Nil(t, err)
+
+	reports, err := manager.EnforceRetentionPolicies(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []*RetentionPolicyReport{{
+		PolicyUUID:         policy.UUID,
+		ArchivedRunIds:     []string{runs[1].UUID},
+		DeletedRunIds:      []string{runs[0].UUID},
+		DeletedObjectCount: 2,
+	}}, reports)
+
+	_, err = manager.GetRun(runs[0].UUID)
+	assert.NotNil(t, err)
+	run, err := manager.GetRun(runs[1].UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_ARCHIVED.String(), run.StorageState)
+	run, err = manager.GetRun(runs[2].UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+
+	policy, err = manager.GetRetentionPolicy(policy.UUID)
+	assert.Nil(t, err)
+	assert.True(t, policy.LastEnforcedAtInSec > retentionTestNowInSec)
+	assert.Equal(t, int64(1), policy.ArchivedRunCount)
+	assert.Equal(t, int64(1), policy.DeletedRunCount)
+	assert.Equal(t, int64(2), policy.DeletedObjectCount)
+	assert.Equal(t, "", policy.Error)
+
+	// A second enforcement doesn't archive the same run again.
+	reports, err = manager.EnforceRetentionPolicies(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reports))
+	assert.Empty(t, reports[0].ArchivedRunIds)
+	assert.Empty(t, reports[0].DeletedRunIds)
+
+	// A policy enforced within the minimum interval is skipped, as it is being
+	// or has just been enforced by another replica.
+	reports, err = manager.EnforceRetentionPolicies(time.Hour)
+	assert.Nil(t, err)
+	assert.Empty(t, reports)
+}
+
+func TestEnforceRetentionPolicies_RunDeletedMeanwhile(t *testing.T) {
+	store, manager, experiment, runs := initWithRetentionRuns(t)
+	defer store.Close()
+	_, err := manager.CreateRetentionPolicy(&api.RetentionPolicy{
+		ResourceKey:     &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		DeleteAfterDays: 30,
+	})
+	assert.Nil(t, err)
+
+	// The run was deleted after it was listed, e.g. by another replica.
+	deletedRun := &model.Run{UUID: runs[1].UUID, Name: runs[1].Name}
+	assert.Nil(t, manager.DeleteRun(deletedRun.UUID))
+	count, err := manager.deleteRunAndObjects(deletedRun)
+	assert.True(t, util.IsUserErrorCodeMatch(err, codes.NotFound))
+	assert.Equal(t, 0, count)
+
+	reports, err := manager.EnforceRetentionPolicies(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{runs[0].UUID}, reports[0].DeletedRunIds)
+	assert.Empty(t, reports[0].Errors)
+}
+
+func TestEnforceRetentionPolicies_ExperimentPolicyOverridesNamespacePolicy(t *testing.T) {
+	store, manager, experiment, runs := initWithRetentionRuns(t)
+	defer store.Close()
+	// Move the experiment to a namespace, which has a stricter policy.
+	_, err := store.DB().Exec(`UPDATE experiments SET Namespace = 'ns1' WHERE UUID = ?`, experiment.UUID)
+	assert.Nil(t, err)
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440010", nil))
+	manager = NewResourceManager(store)
+	namespacePolicy, err := manager.retentionPolicyStore.CreateRetentionPolicy(&model.RetentionPolicy{
+		ResourceType:    common.Namespace,
+		ResourceUUID:    "ns1",
+		DeleteAfterDays: 1,
+	})
+	assert.Nil(t, err)
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal("123e4567-e89b-12d3-a456-426655440011", nil))
+	manager = NewResourceManager(store)
+	experimentPolicy, err := manager.CreateRetentionPolicy(&api.RetentionPolicy{
+		ResourceKey:     &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		DeleteAfterDays: 30,
+	})
+	assert.Nil(t, err)
+
+	reports, err := manager.EnforceRetentionPolicies(0)
+	assert.Nil(t, err)
+	reportsByPolicy := map[string]*RetentionPolicyReport{}
+	for _, report := range reports {
+		reportsByPolicy[report.PolicyUUID] = report
+	}
+	assert.Empty(t, reportsByPolicy[namespacePolicy.UUID].DeletedRunIds)
+	assert.Equal(t, []string{runs[0].UUID}, reportsByPolicy[experimentPolicy.UUID].DeletedRunIds)
+}
@@ -0,0 +1,299 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Metric variables. Please prefix the metric names with resource_manager_.
+var (
+	runDispatcherDispatchedRunCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_run_dispatcher_dispatched_runs",
+		Help: "The number of queued runs whose PipelineRun was created",
+	})
+
+	runDispatcherFailureCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_run_dispatcher_failures",
+		Help: "The number of failed attempts to dispatch a queued run",
+	})
+)
+
+// A run created or admitted within this period counts against the quota of
+// its namespace even if its PipelineRun was not listed, since the PipelineRun
+// may be created after the PipelineRuns are listed.
+const namespaceQuotaUsageGracePeriod = time.Minute
+
+// A queued run claimed longer ago than this is claimed again, since the
+// dispatch which claimed it did not finish.
+const queuedRunClaimTimeout = 5 * time.Minute
+
+// RunDispatcher periodically creates the PipelineRuns of the queued runs of the
+// namespaces which have capacity again.
+type RunDispatcher struct {
+	resourceManager *ResourceManager
+	interval        time.Duration
+}
+
+func NewRunDispatcher(resourceManager *ResourceManager, interval time.Duration) *RunDispatcher {
+	return &RunDispatcher{resourceManager: resourceManager, interval: interval}
+}
+
+// Run dispatches the queued runs every interval until stopCh is closed.
+func (d *RunDispatcher) Run(stopCh <-chan struct{}) {
+	glog.Infof("Starting run dispatcher with interval %v", d.interval)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if _, err := d.resourceManager.DispatchQueuedRuns(); err != nil {
+				glog.Errorf("Failed to dispatch queued runs. Error: %v", err)
+			}
+		}
+	}
+}
+
+// DispatchQueuedRuns creates the PipelineRuns of the queued runs, in queue
+// order, as long as their namespace has capacity. A run failing to be
+// dispatched stays queued and blocks the runs behind it in its namespace.
+// Returns the number of dispatched runs.
+func (r *ResourceManager) DispatchQueuedRuns() (int, error) {
+	queuedRuns, err := r.runQueueStore.ListQueuedRuns(common.IsRunQueuePriorityOrder())
+	if err != nil {
+		return 0, util.Wrap(err, "Failed to dispatch queued runs")
+	}
+	// The remaining capacity of the namespaces seen so far.
+	capacities := make(map[string]int)
+	dispatched := 0
+	for _, queuedRun := range queuedRuns {
+		capacity, ok := capacities[queuedRun.Namespace]
+		if !ok {
+			capacity, err = r.namespaceCapacity(queuedRun.Namespace)
+			if err != nil {
+				return dispatched, util.Wrap(err, "Failed to dispatch queued runs")
+			}
+		}
+		if capacity <= 0 {
+			capacities[queuedRun.Namespace] = 0
+			continue
+		}
+		ok, err := r.dispatchQueuedRun(context.Background(), queuedRun)
+		if err != nil {
+			runDispatcherFailureCounter.Inc()
+			glog.Errorf("Failed to dispatch queued run %s. Error: %v", queuedRun.RunUUID, err)
+			capacities[queuedRun.Namespace] = 0
+			continue
+		}
+		// A run claimed by another dispatch takes the capacity all the same.
+		capacities[queuedRun.Namespace] = capacity - 1
+		if ok {
+			runDispatcherDispatchedRunCounter.Inc()
+			dispatched++
+		}
+	}
+	return dispatched, nil
+}
+
+// dispatchQueuedRun creates the PipelineRun stored with a queued run and
+// removes the run from the queue. The run is claimed first, so that it is
+// dispatched once when it is dispatched by queueRun and the RunDispatcher at
+// the same time. A PipelineRun left behind by a previous attempt is reused.
+// Returns whether the run was dispatched, which it is not if it was claimed
+// by another dispatch.
+func (r *ResourceManager) dispatchQueuedRun(ctx context.Context, queuedRun *model.QueuedRun) (bool, error) {
+	now := r.time.Now()
+	claimed, err := r.runQueueStore.ClaimQueuedRun(queuedRun.RunUUID, now.Unix(), now.Add(-queuedRunClaimTimeout).Unix())
+	if err != nil {
+		return false, util.Wrapf(err, "Failed to claim queued run %s", queuedRun.RunUUID)
+	}
+	if !claimed {
+		return false, nil
+	}
+	err = r.createQueuedRunWorkflow(ctx, queuedRun)
+	if err != nil {
+		if releaseErr := r.runQueueStore.ReleaseQueuedRun(queuedRun.RunUUID); releaseErr != nil {
+			glog.Warningf("Failed to release queued run %s. Error: %v", queuedRun.RunUUID, releaseErr)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *ResourceManager) createQueuedRunWorkflow(ctx context.Context, queuedRun *model.QueuedRun) error {
+	runDetail, err := r.runStore.GetRun(queuedRun.RunUUID)
+	if err != nil {
+		return util.Wrapf(err, "Failed to get queued run %s", queuedRun.RunUUID)
+	}
+	var workflow util.Workflow
+	if err := json.Unmarshal([]byte(runDetail.WorkflowRuntimeManifest), &workflow); err != nil {
+		return util.NewInternalServerError(err, "Failed to unmarshal the PipelineRun of queued run %s", queuedRun.RunUUID)
+	}
+	workflowClient := r.getWorkflowClient(queuedRun.Namespace)
+	newWorkflow, err := workflowClient.Create(ctx, workflow.Get(), v1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		newWorkflow, err = workflowClient.Get(ctx, workflow.Name, v1.GetOptions{})
+	}
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a workflow for (%s)", workflow.Name)
+	}
+	created := util.NewWorkflow(newWorkflow)
+	err = r.runStore.AdmitQueuedRun(queuedRun.RunUUID, created.Condition(), r.time.Now().Unix(), created.ToStringForStore())
+	if err == nil {
+		return nil
+	}
+	if !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrapf(err, "Failed to admit queued run %s", queuedRun.RunUUID)
+	}
+	// The run left the queue while its PipelineRun was created. Its
+	// PipelineRun is only deleted if the run was cancelled or deleted, and not
+	// if it was admitted by another dispatch.
+	runDetail, err = r.runStore.GetRun(queuedRun.RunUUID)
+	if err != nil && !util.IsUserErrorCodeMatch(err, codes.NotFound) {
+		return util.Wrapf(err, "Failed to get queued run %s", queuedRun.RunUUID)
+	}
+	if err == nil && runDetail.Conditions != model.RunCancelledConditions {
+		return nil
+	}
+	if deleteErr := workflowClient.Delete(ctx, workflow.Name, v1.DeleteOptions{}); deleteErr != nil {
+		glog.Warningf("Failed to delete the PipelineRun of cancelled run %s. Error: %v", queuedRun.RunUUID, deleteErr)
+	}
+	return nil
+}
+
+// queueRun stores a run of a namespace with a quota as queued, and dispatches
+// it right away if no other run is queued and the namespace has capacity.
+// A run failing to be dispatched stays queued for the RunDispatcher.
+func (r *ResourceManager) queueRun(ctx context.Context, runDetail *model.RunDetail, quota *model.NamespaceQuota) error {
+	opts, err := r.getNamespaceQuotaUsageOptions(ctx, runDetail.Namespace)
+	if err != nil {
+		return util.Wrap(err, "Failed to check the quota of the namespace")
+	}
+	usage, err := r.runStore.QueueRun(ctx, runDetail, opts)
+	if err != nil {
+		return util.Wrap(err, "Failed to queue run")
+	}
+	if usage.QueuedRuns > 0 || quotaCapacity(quota, usage) <= 0 {
+		return nil
+	}
+	dispatched, err := r.dispatchQueuedRun(ctx, &model.QueuedRun{
+		RunUUID:       runDetail.UUID,
+		Namespace:     runDetail.Namespace,
+		Priority:      runDetail.Priority,
+		QueuedAtInSec: runDetail.CreatedAtInSec,
+	})
+	if err != nil {
+		runDispatcherFailureCounter.Inc()
+		glog.Errorf("Failed to dispatch queued run %s. Error: %v", runDetail.UUID, err)
+		return nil
+	}
+	if dispatched {
+		runDispatcherDispatchedRunCounter.Inc()
+	}
+	return nil
+}
+
+// namespaceCapacity returns the number of runs a namespace can start before
+// reaching its quota, or math.MaxInt32 if it has no quota.
+func (r *ResourceManager) namespaceCapacity(namespace string) (int, error) {
+	quota, err := r.getNamespaceQuota(namespace)
+	if err != nil {
+		return 0, err
+	}
+	if !hasQuotaLimits(quota) {
+		return math.MaxInt32, nil
+	}
+	usage, err := r.getNamespaceRunUsage(namespace)
+	if err != nil {
+		return 0, err
+	}
+	return quotaCapacity(quota, usage), nil
+}
+
+// hasQuotaLimits returns whether a quota limits the runs of its namespace.
+func hasQuotaLimits(quota *model.NamespaceQuota) bool {
+	return quota.MaxActiveRuns > 0 || quota.MaxRunsPerHour > 0
+}
+
+// quotaCapacity returns the number of runs a namespace with the given usage
+// can start before reaching its quota.
+func quotaCapacity(quota *model.NamespaceQuota, usage *model.NamespaceQuotaUsage) int {
+	capacity := math.MaxInt32
+	if quota.MaxActiveRuns > 0 {
+		capacity = int(quota.MaxActiveRuns - usage.ActiveRuns)
+	}
+	if quota.MaxRunsPerHour > 0 && int(quota.MaxRunsPerHour-usage.RunsLastHour) < capacity {
+		capacity = int(quota.MaxRunsPerHour - usage.RunsLastHour)
+	}
+	return capacity
+}
+
+// getNamespaceQuota returns the quota of a namespace, which has no limits if
+// none was set.
+func (r *ResourceManager) getNamespaceQuota(namespace string) (*model.NamespaceQuota, error) {
+	quota, err := r.namespaceQuotaStore.GetNamespaceQuota(namespace)
+	if err != nil {
+		if util.IsUserErrorCodeMatch(err, codes.NotFound) {
+			return &model.NamespaceQuota{Namespace: namespace}, nil
+		}
+		return nil, err
+	}
+	return quota, nil
+}
+
+func (r *ResourceManager) getNamespaceRunUsage(namespace string) (*model.NamespaceQuotaUsage, error) {
+	opts, err := r.getNamespaceQuotaUsageOptions(context.Background(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	return r.runStore.GetNamespaceRunUsage(namespace, opts)
+}
+
+// getNamespaceQuotaUsageOptions lists the PipelineRuns of a namespace, so
+// that the runs whose PipelineRun was deleted out of band don't count against
+// its quota.
+func (r *ResourceManager) getNamespaceQuotaUsageOptions(ctx context.Context, namespace string) (*model.NamespaceQuotaUsageOptions, error) {
+	workflows, err := r.getWorkflowClient(namespace).List(ctx, v1.ListOptions{
+		LabelSelector: util.LabelKeyWorkflowRunId,
+	})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list the workflows of namespace %s", namespace)
+	}
+	runIds := []string{}
+	for _, workflow := range workflows.Items {
+		runIds = append(runIds, workflow.Labels[util.LabelKeyWorkflowRunId])
+	}
+	now := r.time.Now()
+	return &model.NamespaceQuotaUsageOptions{
+		SinceInSec:      now.Add(-time.Hour).Unix(),
+		WorkflowRunIds:  runIds,
+		GraceSinceInSec: now.Add(-namespaceQuotaUsageGracePeriod).Unix(),
+	}, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+// createQuotaTestRun creates a run with the given ID in the default experiment
+// namespace ns1.
+func createQuotaTestRun(t *testing.T, store *FakeClientManager, exp *model.Experiment, runId string, priority int32) *model.RunDetail {
+	store.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(runId, nil))
+	manager := NewResourceManager(store)
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name:         "run-" + runId[0:5],
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: exp.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+		Priority: priority,
+	})
+	assert.Nil(t, err)
+	return runDetail
+}
+
+func TestCreateRun_QueuedOverQuota(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 1})
+	assert.Nil(t, err)
+
+	run1 := createQuotaTestRun(t, store, exp, "11111111-e89b-12d3-a456-426655440000", 0)
+	assert.NotEqual(t, model.RunQueuedConditions, run1.Conditions)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+
+	// Runs over the quota are stored without a PipelineRun.
+	run2 := createQuotaTestRun(t, store, exp, "22222222-e89b-12d3-a456-426655440000", 0)
+	run3 := createQuotaTestRun(t, store, exp, "33333333-e89b-12d3-a456-426655440000", 5)
+	assert.Equal(t, model.RunQueuedConditions, run2.Conditions)
+	assert.Equal(t, model.RunQueuedConditions, run3.Conditions)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+
+	runDetail, err := manager.GetRun(run3.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), runDetail.Priority)
+	assert.Equal(t, int32(2), runDetail.QueuePosition)
+	viper.Set(common.RunQueueOrder, common.RunQueueOrderPriority)
+	defer viper.Set(common.RunQueueOrder, "")
+	runDetail, err = manager.GetRun(run3.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), runDetail.QueuePosition)
+
+	quota, usage, err := manager.GetNamespaceQuota("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), quota.MaxActiveRuns)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 1, QueuedRuns: 2}, usage)
+}
+
+func TestDispatchQueuedRuns(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 1})
+	assert.Nil(t, err)
+	run1 := createQuotaTestRun(t, store, exp, "11111111-e89b-12d3-a456-426655440000", 0)
+	run2 := createQuotaTestRun(t, store, exp, "22222222-e89b-12d3-a456-426655440000", 0)
+	run3 := createQuotaTestRun(t, store, exp, "33333333-e89b-12d3-a456-426655440000", 5)
+
+	// Nothing is dispatched while the namespace is at its quota.
+	dispatched, err := manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, dispatched)
+
+	viper.Set(common.RunQueueOrder, common.RunQueueOrderPriority)
+	defer viper.Set(common.RunQueueOrder, "")
+	assert.Nil(t, store.RunStore().UpdateRun(run1.UUID, "Succeeded", 10, run1.WorkflowRuntimeManifest))
+	dispatched, err = manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dispatched)
+	assert.Equal(t, 2, store.TektonClientFake.GetWorkflowCount())
+
+	// The run with the higher priority is dispatched first.
+	runDetail, err := manager.GetRun(run3.UUID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, model.RunQueuedConditions, runDetail.Conditions)
+	assert.NotZero(t, runDetail.ScheduledAtInSec)
+	runDetail, err = manager.GetRun(run2.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RunQueuedConditions, runDetail.Conditions)
+	assert.Equal(t, int32(1), runDetail.QueuePosition)
+
+	// Raising the quota dispatches the remaining run.
+	_, err = manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 2})
+	assert.Nil(t, err)
+	dispatched, err = manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dispatched)
+	assert.Equal(t, 3, store.TektonClientFake.GetWorkflowCount())
+}
+
+func TestDispatchQueuedRuns_DeletedPipelineRun(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 1})
+	assert.Nil(t, err)
+	run1 := createQuotaTestRun(t, store, exp, "11111111-e89b-12d3-a456-426655440000", 0)
+	run2 := createQuotaTestRun(t, store, exp, "22222222-e89b-12d3-a456-426655440000", 0)
+	assert.Equal(t, model.RunQueuedConditions, run2.Conditions)
+
+	// The PipelineRun of run1 is deleted out of band, so run1 never finishes.
+	store.TektonClientFake.DeleteWorkflow(run1.Name)
+	assert.Equal(t, 0, store.TektonClientFake.GetWorkflowCount())
+
+	// A recent run counts as active, since its PipelineRun may not be listed yet.
+	dispatched, err := manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, dispatched)
+
+	manager.time = util.NewFakeTime(time.Unix(3600, 0))
+	dispatched, err = manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dispatched)
+	_, usage, err := manager.GetNamespaceQuota("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), usage.ActiveRuns)
+}
+
+func TestDispatchQueuedRun_Twice(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 1})
+	assert.Nil(t, err)
+	run1 := createQuotaTestRun(t, store, exp, "11111111-e89b-12d3-a456-426655440000", 0)
+	run2 := createQuotaTestRun(t, store, exp, "22222222-e89b-12d3-a456-426655440000", 0)
+	run3 := createQuotaTestRun(t, store, exp, "33333333-e89b-12d3-a456-426655440000", 0)
+	assert.Nil(t, store.RunStore().UpdateRun(run1.UUID, "Succeeded", 10, run1.WorkflowRuntimeManifest))
+	queuedRun, err := store.RunQueueStore().GetQueuedRun(run2.UUID)
+	assert.Nil(t, err)
+
+	// The run is dispatched once when queueRun and the RunDispatcher dispatch
+	// it one after the other.
+	dispatched, err := manager.dispatchQueuedRun(context.Background(), queuedRun)
+	assert.Nil(t, err)
+	assert.True(t, dispatched)
+	dispatched, err = manager.dispatchQueuedRun(context.Background(), queuedRun)
+	assert.Nil(t, err)
+	assert.False(t, dispatched)
+	assert.Equal(t, 2, store.TektonClientFake.GetWorkflowCount())
+
+	// A dispatch which finds the run admitted by another dispatch keeps its
+	// PipelineRun.
+	assert.Nil(t, manager.createQueuedRunWorkflow(context.Background(), queuedRun))
+	assert.Equal(t, 2, store.TektonClientFake.GetWorkflowCount())
+	runDetail, err := manager.GetRun(run2.UUID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, model.RunQueuedConditions, runDetail.Conditions)
+
+	// A run claimed by another dispatch is not dispatched.
+	queuedRun, err = store.RunQueueStore().GetQueuedRun(run3.UUID)
+	assert.Nil(t, err)
+	claimed, err := store.RunQueueStore().ClaimQueuedRun(run3.UUID, manager.time.Now().Unix(), 0)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	dispatched, err = manager.dispatchQueuedRun(context.Background(), queuedRun)
+	assert.Nil(t, err)
+	assert.False(t, dispatched)
+	assert.Equal(t, 2, store.TektonClientFake.GetWorkflowCount())
+
+	// The PipelineRun of a cancelled run is deleted.
+	assert.Nil(t, manager.TerminateRun(run3.UUID))
+	assert.Nil(t, manager.createQueuedRunWorkflow(context.Background(), queuedRun))
+	assert.Equal(t, 2, store.TektonClientFake.GetWorkflowCount())
+}
+
+func TestTerminateRun_Queued(t *testing.T) {
+	store, manager, exp := initWithExperiment(t)
+	defer store.Close()
+	_, err := manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxRunsPerHour: 1})
+	assert.Nil(t, err)
+	createQuotaTestRun(t, store, exp, "11111111-e89b-12d3-a456-426655440000", 0)
+	run2 := createQuotaTestRun(t, store, exp, "22222222-e89b-12d3-a456-426655440000", 0)
+	assert.Equal(t, model.RunQueuedConditions, run2.Conditions)
+
+	assert.Nil(t, manager.TerminateRun(run2.UUID))
+	runDetail, err := manager.GetRun(run2.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.RunCancelledConditions, runDetail.Conditions)
+
+	// A cancelled run is not dispatched.
+	_, err = manager.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1"})
+	assert.Nil(t, err)
+	dispatched, err := manager.DispatchQueuedRuns()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, dispatched)
+	assert.Equal(t, 1, store.TektonClientFake.GetWorkflowCount())
+}
@@ -0,0 +1,355 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"container/heap"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+// runLogContainer is a step of a task of a run, whose log is the log of a
+// container of the pod of the task.
+type runLogContainer struct {
+	task      string
+	step      string
+	pod       string
+	container string
+}
+
+func (c runLogContainer) prefix() string {
+	return fmt.Sprintf("[%s/%s]", c.task, c.step)
+}
+
+// runLogLine is a line of the log of a container, split into its timestamp,
+// if any, and its text.
+type runLogLine struct {
+	container *runLogContainer
+	timestamp time.Time
+	rawTime   string
+	text      string
+}
+
+func (l runLogLine) format(timestamps bool) string {
+	if timestamps && l.rawTime != "" {
+		return l.rawTime + " " + l.text
+	}
+	return l.text
+}
+
+// runLogReader reads the lines of the log of a container one at a time.
+// Lines without a timestamp get the timestamp of the line before them, so that
+// they stay in place when the logs are interleaved.
+type runLogReader struct {
+	container *runLogContainer
+	// The position of the container in the run, which orders the lines of the
+	// same timestamp.
+	index   int
+	src     io.Closer
+	scanner *bufio.Scanner
+	line    runLogLine
+}
+
+func newRunLogReader(container *runLogContainer, index int, src io.ReadCloser) *runLogReader {
+	return &runLogReader{container: container, index: index, src: src, scanner: bufio.NewScanner(src)}
+}
+
+// next reads the next line into line, and returns false at the end of the log
+// or on error.
+func (l *runLogReader) next() bool {
+	if !l.scanner.Scan() {
+		return false
+	}
+	line := runLogLine{container: l.container, timestamp: l.line.timestamp, text: l.scanner.Text()}
+	if fields := strings.SplitN(line.text, " ", 2); len(fields) == 2 {
+		if timestamp, err := time.Parse(time.RFC3339, fields[0]); err == nil {
+			line.timestamp, line.rawTime, line.text = timestamp, fields[0], fields[1]
+		}
+	}
+	l.line = line
+	return true
+}
+
+func (l *runLogReader) err() error {
+	return l.scanner.Err()
+}
+
+func (l *runLogReader) close() {
+	l.src.Close()
+}
+
+// runLogHeap orders the readers by the timestamp of their current line.
+type runLogHeap []*runLogReader
+
+func (h runLogHeap) Len() int { return len(h) }
+func (h runLogHeap) Less(i, j int) bool {
+	if !h[i].line.timestamp.Equal(h[j].line.timestamp) {
+		return h[i].line.timestamp.Before(h[j].line.timestamp)
+	}
+	return h[i].index < h[j].index
+}
+func (h runLogHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *runLogHeap) Push(x interface{}) { *h = append(*h, x.(*runLogReader)) }
+func (h *runLogHeap) Pop() interface{} {
+	old := *h
+	reader := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return reader
+}
+
+// ReadRunLogs copies the logs of all the steps of all the tasks of a run,
+// interleaved in timestamp order. Each line is prefixed by [task/step]. The
+// logs are read from the pods while they exist and from the log archive
+// afterwards, like OpenLog does. The logs which cannot be read are reported
+// in place of their lines.
+func (r *ResourceManager) ReadRunLogs(runId string, timestamps bool, dst io.Writer) error {
+	run, containers, err := r.getRunLogContainers(runId)
+	if err != nil {
+		return err
+	}
+
+	// The first line of each log is read before anything is written, so that
+	// the logs which cannot be read are reported first.
+	logs := &runLogHeap{}
+	defer func() {
+		for _, reader := range *logs {
+			reader.close()
+		}
+	}()
+	var unavailable []string
+	archives := make(map[string]*runLogArchive)
+	for i := range containers {
+		reader, err := r.openRunLogReader(run, &containers[i], i, archives)
+		if err != nil {
+			unavailable = append(unavailable, fmt.Sprintf("%s The log is not available: %v", containers[i].prefix(), err))
+			continue
+		}
+		if reader == nil {
+			continue
+		}
+		*logs = append(*logs, reader)
+	}
+	if len(containers) > 0 && len(unavailable) == len(containers) {
+		return util.NewResourceNotFoundError("Logs of run", runId)
+	}
+
+	for _, line := range unavailable {
+		if _, err := fmt.Fprintln(dst, line); err != nil {
+			return util.NewInternalServerError(err, "error in streaming the logs")
+		}
+	}
+	// The lines of each container are in order already, so they are merged
+	// as they are read.
+	heap.Init(logs)
+	for logs.Len() > 0 {
+		reader := (*logs)[0]
+		if _, err := fmt.Fprintf(dst, "%s %s\n", reader.container.prefix(), reader.line.format(timestamps)); err != nil {
+			return util.NewInternalServerError(err, "error in streaming the logs")
+		}
+		if reader.next() {
+			heap.Fix(logs, 0)
+			continue
+		}
+		if err := reader.err(); err != nil {
+			glog.Warningf("Failed to read the rest of the log of %s of run %s: %v", reader.container.prefix(), runId, err)
+		}
+		reader.close()
+		heap.Pop(logs)
+	}
+	return nil
+}
+
+// DownloadRunLogs writes the logs of all the steps of all the tasks of a run
+// as a tar.gz archive, with one file per step named <task>/<step>.log. The
+// logs are read like ReadRunLogs does, and the logs which cannot be read are
+// left out.
+func (r *ResourceManager) DownloadRunLogs(runId string, timestamps bool, dst io.Writer) error {
+	run, containers, err := r.getRunLogContainers(runId)
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(dst)
+	tw := tar.NewWriter(gw)
+	now := time.Unix(r.time.Now().Unix(), 0)
+	archives := make(map[string]*runLogArchive)
+	for i := range containers {
+		content, err := r.readRunLog(run, &containers[i], timestamps, archives)
+		if err != nil {
+			glog.Warningf("Leaving the log of %s of run %s out of the download: %v", containers[i].prefix(), runId, err)
+			continue
+		}
+		header := &tar.Header{
+			Name:     fmt.Sprintf("%s/%s.log", containers[i].task, containers[i].step),
+			Mode:     0644,
+			Size:     int64(content.Len()),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return util.NewInternalServerError(err, "error in writing the logs archive")
+		}
+		if _, err := tw.Write(content.Bytes()); err != nil {
+			return util.NewInternalServerError(err, "error in writing the logs archive")
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return util.NewInternalServerError(err, "error in writing the logs archive")
+	}
+	if err := gw.Close(); err != nil {
+		return util.NewInternalServerError(err, "error in writing the logs archive")
+	}
+	return nil
+}
+
+// readRunLog reads the whole log of a container, which is needed for the size
+// of its file in the logs archive.
+func (r *ResourceManager) readRunLog(run *model.RunDetail, container *runLogContainer, timestamps bool, archives map[string]*runLogArchive) (*bytes.Buffer, error) {
+	var content bytes.Buffer
+	reader, err := r.openRunLogReader(run, container, 0, archives)
+	if err != nil || reader == nil {
+		return &content, err
+	}
+	defer reader.close()
+	for {
+		content.WriteString(reader.line.format(timestamps))
+		content.WriteByte('\n')
+		if !reader.next() {
+			break
+		}
+	}
+	if err := reader.err(); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+// getRunLogContainers returns the steps of the tasks of a run, found in the
+// runtime PipelineRun, sorted by task name then in the order of the steps.
+func (r *ResourceManager) getRunLogContainers(runId string) (*model.RunDetail, []runLogContainer, error) {
+	run, err := r.checkRunExist(runId)
+	if err != nil {
+		return nil, nil, util.NewBadRequestError(errors.New("logs cannot be read"), "Run does not exist")
+	}
+	if run.WorkflowRuntimeManifest == "" {
+		return nil, nil, util.NewBadRequestError(errors.New("logs cannot be read"), "Failed to retrieve the runtime workflow from the run")
+	}
+	workflow := new(util.Workflow)
+	if err := json.Unmarshal([]byte(run.WorkflowRuntimeManifest), workflow); err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to retrieve the runtime pipeline spec from the run")
+	}
+
+	var containers []runLogContainer
+	for _, taskRun := range workflow.Status.TaskRuns {
+		if taskRun == nil || taskRun.Status == nil || taskRun.Status.PodName == "" {
+			continue
+		}
+		steps := taskRun.Status.Steps
+		if len(steps) == 0 {
+			containers = append(containers, runLogContainer{
+				task:      taskRun.PipelineTaskName,
+				step:      strings.TrimPrefix(defaultLogContainer, "step-"),
+				pod:       taskRun.Status.PodName,
+				container: defaultLogContainer,
+			})
+			continue
+		}
+		for _, step := range steps {
+			container := step.ContainerName
+			if container == "" {
+				container = "step-" + step.Name
+			}
+			containers = append(containers, runLogContainer{
+				task:      taskRun.PipelineTaskName,
+				step:      step.Name,
+				pod:       taskRun.Status.PodName,
+				container: container,
+			})
+		}
+	}
+	sort.SliceStable(containers, func(i, j int) bool {
+		if containers[i].task != containers[j].task {
+			return containers[i].task < containers[j].task
+		}
+		return containers[i].pod < containers[j].pod
+	})
+	return run, containers, nil
+}
+
+// runLogArchive is the log archive of a pod, downloaded once for all of its
+// containers.
+type runLogArchive struct {
+	content []byte
+	err     error
+}
+
+// openRunLogReader opens the log of a container, from its pod or from the log
+// archive, and reads its first line. It returns nil for an empty log. The
+// archives are cached by pod in archives.
+func (r *ResourceManager) openRunLogReader(run *model.RunDetail, container *runLogContainer, index int, archives map[string]*runLogArchive) (*runLogReader, error) {
+	opts := archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Timestamps: true, Container: container.container}
+	podLogs, err := r.openRunLogFromPod(run, container.pod, false, opts)
+	if err == nil {
+		reader := newRunLogReader(container, index, podLogs)
+		if reader.next() {
+			return reader, nil
+		}
+		reader.close()
+		if err = reader.err(); err == nil {
+			return nil, nil
+		}
+	}
+	if r.logArchive == nil {
+		return nil, err
+	}
+
+	logArchive, ok := archives[container.pod]
+	if !ok {
+		logArchive = &runLogArchive{}
+		logArchive.content, logArchive.err = r.getRunLogArchive(run, container.pod)
+		archives[container.pod] = logArchive
+	}
+	if logArchive.err != nil {
+		return nil, logArchive.err
+	}
+	// The log is extracted from the archive while it is read.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(r.logArchive.CopyLogFromArchive(logArchive.content, pw, opts))
+	}()
+	reader := newRunLogReader(container, index, pr)
+	if !reader.next() {
+		reader.close()
+		if err := reader.err(); err != nil {
+			return nil, util.NewInternalServerError(err, "error in streaming the log")
+		}
+		return nil, nil
+	}
+	return reader, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func tarGz(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, file := range files {
+		err := tw.WriteHeader(&tar.Header{Name: file[0], Mode: 0644, Size: int64(len(file[1])), Typeflag: tar.TypeReg})
+		assert.Nil(t, err)
+		_, err = tw.Write([]byte(file[1]))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gw.Close())
+	return buf.Bytes()
+}
+
+// initWithRunLogs creates a run of two tasks, whose pods are gone and whose
+// logs are archived: prep has a single step, and train has two.
+func initWithRunLogs(t *testing.T) (*FakeClientManager, *ResourceManager, *model.RunDetail) {
+	store, manager, run := initWithRun(t)
+	workflow := util.NewWorkflow(&v1beta1.PipelineRun{
+		ObjectMeta: v1.ObjectMeta{Name: "workflow-name", Namespace: "ns1"},
+		Status: v1beta1.PipelineRunStatus{PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+			TaskRuns: map[string]*v1beta1.PipelineRunTaskRunStatus{
+				"workflow-name-train": {
+					PipelineTaskName: "train",
+					Status: &v1beta1.TaskRunStatus{TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+						PodName: "train-pod",
+						Steps: []v1beta1.StepState{
+							{Name: "main", ContainerName: "step-main"},
+							{Name: "upload", ContainerName: "step-upload"},
+						},
+					}},
+				},
+				"workflow-name-prep": {
+					PipelineTaskName: "prep",
+					Status: &v1beta1.TaskRunStatus{TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+						PodName: "prep-pod",
+					}},
+				},
+			},
+		}},
+	})
+	err := store.RunStore().UpdateRun(run.UUID, "Succeeded", 1, workflow.ToStringForStore())
+	assert.Nil(t, err)
+
+	key, err := store.LogArchive().GetLogObjectKey(workflow, "prep-pod")
+	assert.Nil(t, err)
+	err = store.ObjectStore().AddFile(context.Background(), []byte("2021-03-01T10:00:00Z prepared\n"), key)
+	assert.Nil(t, err)
+	key, err = store.LogArchive().GetLogObjectKey(workflow, "train-pod")
+	assert.Nil(t, err)
+	err = store.ObjectStore().AddFile(context.Background(), tarGz(t, [][2]string{
+		{"step-main.log", "2021-03-01T10:00:01Z training\n2021-03-01T10:00:03Z trained\n"},
+		{"step-upload.log", "2021-03-01T10:00:02Z uploading\nuploaded\n"},
+	}), key)
+	assert.Nil(t, err)
+	return store, manager, run
+}
+
+func TestReadRunLogs(t *testing.T) {
+	store, manager, run := initWithRunLogs(t)
+	defer store.Close()
+
+	var dst bytes.Buffer
+	err := manager.ReadRunLogs(run.UUID, false, &dst)
+	assert.Nil(t, err)
+	assert.Equal(t, "[prep/main] prepared\n"+
+		"[train/main] training\n"+
+		"[train/upload] uploading\n"+
+		"[train/upload] uploaded\n"+
+		"[train/main] trained\n", dst.String())
+
+	dst.Reset()
+	err = manager.ReadRunLogs(run.UUID, true, &dst)
+	assert.Nil(t, err)
+	assert.Contains(t, dst.String(), "[train/main] 2021-03-01T10:00:03Z trained\n")
+	assert.Contains(t, dst.String(), "[train/upload] uploaded\n")
+}
+
+func TestOpenLog(t *testing.T) {
+	store, manager, run := initWithRunLogs(t)
+	defer store.Close()
+
+	readLog := func(nodeId string, opts archive.ExtractLogOptions) (string, error) {
+		reader, err := manager.OpenLog(run.UUID, nodeId, false, opts)
+		if err != nil {
+			return "", err
+		}
+		defer reader.Close()
+		log, err := ioutil.ReadAll(reader)
+		return string(log), err
+	}
+
+	log, err := readLog("train-pod", archive.ExtractLogOptions{LogFormat: archive.LogFormatText})
+	assert.Nil(t, err)
+	assert.Equal(t, "training\ntrained\n", log)
+
+	log, err = readLog("train-pod", archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Container: "step-upload", Timestamps: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "2021-03-01T10:00:02Z uploading\nuploaded\n", log)
+
+	// The log of a missing container is not found before anything is read.
+	_, err = manager.OpenLog(run.UUID, "train-pod", false, archive.ExtractLogOptions{LogFormat: archive.LogFormatText, Container: "step-other"})
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	_, err = manager.OpenLog("not-a-run", "train-pod", false, archive.ExtractLogOptions{LogFormat: archive.LogFormatText})
+	assert.Equal(t, codes.Aborted, err.(*util.UserError).ExternalStatusCode())
+}
+
+// countingObjectStore counts the files downloaded from an object store.
+type countingObjectStore struct {
+	storage.ObjectStoreInterface
+	downloads map[string]int
+}
+
+func (s *countingObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
+	s.downloads[filePath]++
+	return s.ObjectStoreInterface.GetFile(ctx, filePath)
+}
+
+func TestReadRunLogs_ArchiveDownloadedOnce(t *testing.T) {
+	store, manager, run := initWithRunLogs(t)
+	defer store.Close()
+	objectStore := &countingObjectStore{ObjectStoreInterface: store.ObjectStore(), downloads: make(map[string]int)}
+	manager.objectStore = objectStore
+
+	var dst bytes.Buffer
+	err := manager.ReadRunLogs(run.UUID, false, &dst)
+	assert.Nil(t, err)
+	assert.Len(t, objectStore.downloads, 2)
+	for key, downloads := range objectStore.downloads {
+		assert.Equal(t, 1, downloads, key)
+	}
+
+	for key := range objectStore.downloads {
+		delete(objectStore.downloads, key)
+	}
+	dst.Reset()
+	err = manager.DownloadRunLogs(run.UUID, false, &dst)
+	assert.Nil(t, err)
+	assert.Len(t, objectStore.downloads, 2)
+	for key, downloads := range objectStore.downloads {
+		assert.Equal(t, 1, downloads, key)
+	}
+}
+
+func TestReadRunLogs_NotArchived(t *testing.T) {
+	store, manager, run := initWithRunLogs(t)
+	defer store.Close()
+	key, err := store.LogArchive().GetLogObjectKey(util.NewWorkflow(&v1beta1.PipelineRun{ObjectMeta: v1.ObjectMeta{Name: "workflow-name"}}), "prep-pod")
+	assert.Nil(t, err)
+	assert.Nil(t, store.ObjectStore().DeleteFile(key))
+
+	// The logs which cannot be read are reported first.
+	var dst bytes.Buffer
+	err = manager.ReadRunLogs(run.UUID, false, &dst)
+	assert.Nil(t, err)
+	assert.Regexp(t, `^\[prep/main\] The log is not available: .*\n\[train/main\] training\n`, dst.String())
+
+	err = manager.ReadRunLogs("not-a-run", false, &dst)
+	assert.Equal(t, codes.Aborted, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestDownloadRunLogs(t *testing.T) {
+	store, manager, run := initWithRunLogs(t)
+	defer store.Close()
+
+	var dst bytes.Buffer
+	err := manager.DownloadRunLogs(run.UUID, false, &dst)
+	assert.Nil(t, err)
+
+	gr, err := gzip.NewReader(&dst)
+	assert.Nil(t, err)
+	tr := tar.NewReader(gr)
+	files := make(map[string]string)
+	var names []string
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		content, err := ioutil.ReadAll(tr)
+		assert.Nil(t, err)
+		names = append(names, header.Name)
+		files[header.Name] = string(content)
+	}
+	assert.Equal(t, []string{"prep/main.log", "train/main.log", "train/upload.log"}, names)
+	assert.Equal(t, "training\ntrained\n", files["train/main.log"])
+	assert.Equal(t, "uploading\nuploaded\n", files["train/upload.log"])
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// The number of cleanups attempted per query.
+	runObjectCleanupBatchSize = 100
+	// Failed cleanups are retried with an exponential backoff between these bounds.
+	runObjectCleanupInitialBackoff = time.Minute
+	runObjectCleanupMaxBackoff     = 24 * time.Hour
+)
+
+// Metric variables. Please prefix the metric names with resource_manager_.
+var (
+	runObjectCleanupDeletedObjectCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_run_object_cleanup_deleted_objects",
+		Help: "The number of artifact, log and manifest objects of deleted runs deleted from the object store",
+	})
+
+	runObjectCleanupFailureCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_run_object_cleanup_failures",
+		Help: "The number of failed attempts to delete the objects of deleted runs",
+	})
+)
+
+// RunObjectCleanupWorker periodically deletes the objects of deleted runs from
+// the object store.
+type RunObjectCleanupWorker struct {
+	resourceManager *ResourceManager
+	interval        time.Duration
+}
+
+func NewRunObjectCleanupWorker(resourceManager *ResourceManager, interval time.Duration) *RunObjectCleanupWorker {
+	return &RunObjectCleanupWorker{resourceManager: resourceManager, interval: interval}
+}
+
+// Run attempts the due cleanups every interval until stopCh is closed.
+func (w *RunObjectCleanupWorker) Run(stopCh <-chan struct{}) {
+	glog.Infof("Starting run object cleanup worker with interval %v", w.interval)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if _, err := w.resourceManager.CleanupRunObjects(); err != nil {
+				glog.Errorf("Failed to clean up the objects of deleted runs. Error: %v", err)
+			}
+		}
+	}
+}
+
+// CleanupRunObjects attempts all the due cleanups of the objects of deleted
+// runs. A failed cleanup is retried later with an exponential backoff. Returns
+// the number of deleted objects.
+func (r *ResourceManager) CleanupRunObjects() (int, error) {
+	objectCount := 0
+	for {
+		cleanups, err := r.runObjectCleanupStore.ListDueRunObjectCleanups(runObjectCleanupBatchSize)
+		if err != nil {
+			return objectCount, util.Wrap(err, "Failed to clean up the objects of deleted runs")
+		}
+		for _, cleanup := range cleanups {
+			count, err := r.cleanupRunObjects(cleanup)
+			objectCount += count
+			if err != nil {
+				// The cleanup would stay due, so stop instead of attempting it again.
+				return objectCount, err
+			}
+		}
+		if len(cleanups) < runObjectCleanupBatchSize {
+			return objectCount, nil
+		}
+	}
+}
+
+// cleanupRunObjects attempts a cleanup of the objects of a deleted run. A failed
+// attempt is recorded on the cleanup and only returns an error if recording it
+// failed. Returns the number of deleted objects.
+func (r *ResourceManager) cleanupRunObjects(cleanup *model.RunObjectCleanup) (int, error) {
+	objectCount, err := r.deleteRunObjects(cleanup)
+	runObjectCleanupDeletedObjectCounter.Add(float64(objectCount))
+	if err == nil {
+		err = r.runObjectCleanupStore.DeleteRunObjectCleanup(cleanup.RunUUID)
+		if err != nil {
+			return objectCount, util.Wrapf(err, "Failed to complete the object cleanup of run %s", cleanup.RunUUID)
+		}
+		return objectCount, nil
+	}
+
+	runObjectCleanupFailureCounter.Inc()
+	backoff := runObjectCleanupBackoff(cleanup.Attempts)
+	glog.Errorf("Failed to delete the objects of run %s (attempt %d), retrying in %v. Error: %v",
+		cleanup.RunUUID, cleanup.Attempts+1, backoff, err)
+	nextAttemptAtInSec := r.time.Now().Add(backoff).Unix()
+	reportErr := r.runObjectCleanupStore.ReportRunObjectCleanupFailure(cleanup.RunUUID, nextAttemptAtInSec, err.Error())
+	if reportErr != nil {
+		return objectCount, util.Wrapf(reportErr, "Failed to record the failed object cleanup of run %s", cleanup.RunUUID)
+	}
+	return objectCount, nil
+}
+
+// deleteRunObjects deletes the artifacts and archived logs of a run, which are
+// keyed by the name of its PipelineRun, and its offloaded runtime manifests.
+// Returns the number of deleted objects.
+func (r *ResourceManager) deleteRunObjects(cleanup *model.RunObjectCleanup) (int, error) {
+	folders := []string{util.ObjectStoreArtifactFolder(cleanup.RunName), storage.RunManifestFolder(cleanup.RunUUID)}
+	if common.IsArchiveLogs() {
+		logFolder, err := r.logArchive.GetLogFolderKey(cleanup.RunName)
+		if err != nil {
+			return 0, util.NewInternalServerError(err, "Failed to get the archived log folder of %s", cleanup.RunName)
+		}
+		folders = append(folders, logFolder)
+	}
+	objectCount := 0
+	for _, folder := range folders {
+		count, err := r.objectStore.DeleteFolder(folder)
+		objectCount += count
+		if err != nil {
+			return objectCount, util.Wrapf(err, "Failed to delete the objects in %s", folder)
+		}
+	}
+	return objectCount, nil
+}
+
+// runObjectCleanupBackoff returns the delay before the next attempt of a
+// cleanup that already failed the given number of times.
+func runObjectCleanupBackoff(attempts int32) time.Duration {
+	backoff := runObjectCleanupInitialBackoff
+	for i := int32(0); i < attempts && backoff < runObjectCleanupMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > runObjectCleanupMaxBackoff {
+		return runObjectCleanupMaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDeleteRun_CleansUpRunObjects(t *testing.T) {
+	store, manager, _, runs := initWithRetentionRuns(t)
+	defer store.Close()
+	runDetail := runs[0]
+	artifactFolder := util.ObjectStoreArtifactFolder(runDetail.Name)
+	assert.Nil(t, store.ObjectStore().AddFile(context.Background(), []byte("a"), artifactFolder+"/step/a.tgz"))
+	assert.Nil(t, store.ObjectStore().AddFile(context.Background(), []byte("b"), artifactFolder+"/step/b.tgz"))
+	assert.Nil(t, store.ObjectStore().AddFile(context.Background(), []byte("c"), "artifacts/other-run/step/c.tgz"))
+	manifestFile := storage.RunManifestFolder(runDetail.UUID) + "/manifest.gz"
+	assert.Nil(t, store.ObjectStore().AddFile(context.Background(), []byte("m"), manifestFile))
+
+	err := manager.DeleteRun(runDetail.UUID)
+	assert.Nil(t, err)
+	// The objects are only deleted by the cleanup.
+	_, err = store.ObjectStore().GetFile(context.Background(), artifactFolder+"/step/a.tgz")
+	assert.Nil(t, err)
+
+	objectCount, err := manager.CleanupRunObjects()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, objectCount)
+	_, err = store.ObjectStore().GetFile(context.Background(), artifactFolder+"/step/a.tgz")
+	assert.NotNil(t, err)
+	_, err = store.ObjectStore().GetFile(context.Background(), manifestFile)
+	assert.NotNil(t, err)
+	_, err = store.ObjectStore().GetFile(context.Background(), "artifacts/other-run/step/c.tgz")
+	assert.Nil(t, err)
+	_, err = store.RunObjectCleanupStore().GetRunObjectCleanup(runDetail.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestCleanupRunObjects_RetriesFailedCleanup(t *testing.T) {
+	store, manager, _, runs := initWithRetentionRuns(t)
+	defer store.Close()
+	runDetail := runs[0]
+	err := manager.DeleteRun(runDetail.UUID)
+	assert.Nil(t, err)
+
+	manager.objectStore = &FakeBadObjectStore{}
+	objectCount, err := manager.CleanupRunObjects()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, objectCount)
+	cleanup, err := store.RunObjectCleanupStore().GetRunObjectCleanup(runDetail.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), cleanup.Attempts)
+	assert.Contains(t, cleanup.LastError, "Not implemented.")
+	assert.True(t, cleanup.NextAttemptAtInSec >= cleanup.CreatedAtInSec+int64(runObjectCleanupInitialBackoff.Seconds()))
+
+	// The failed cleanup is not attempted again before its backoff elapses.
+	manager.objectStore = store.ObjectStore()
+	_, err = manager.CleanupRunObjects()
+	assert.Nil(t, err)
+	_, err = store.RunObjectCleanupStore().GetRunObjectCleanup(runDetail.UUID)
+	assert.Nil(t, err)
+
+	// The cleanup is retried once its backoff elapsed.
+	store.runObjectCleanupStore = storage.NewRunObjectCleanupStore(store.DB(),
+		util.NewFakeTime(time.Unix(cleanup.NextAttemptAtInSec, 0)))
+	_, err = NewResourceManager(store).CleanupRunObjects()
+	assert.Nil(t, err)
+	_, err = store.RunObjectCleanupStore().GetRunObjectCleanup(runDetail.UUID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestRunObjectCleanupBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, runObjectCleanupBackoff(0))
+	assert.Equal(t, 2*time.Minute, runObjectCleanupBackoff(1))
+	assert.Equal(t, 8*time.Minute, runObjectCleanupBackoff(3))
+	assert.Equal(t, 24*time.Hour, runObjectCleanupBackoff(20))
+	assert.Equal(t, 24*time.Hour, runObjectCleanupBackoff(1000))
+}
@@ -45,6 +45,7 @@ func ToApiExperiment(experiment *model.Experiment) *api.Experiment {
 		CreatedAt:          &timestamp.Timestamp{Seconds: experiment.CreatedAtInSec},
 		ResourceReferences: resourceReferences,
 		StorageState:       api.Experiment_StorageState(api.Experiment_StorageState_value[experiment.StorageState]),
+		Labels:             experiment.Labels,
 	}
 }
 
@@ -56,6 +57,70 @@ func ToApiExperiments(experiments []*model.Experiment) []*api.Experiment {
 	return apiExperiments
 }
 
+func ToApiRetentionPolicy(policy *model.RetentionPolicy) *api.RetentionPolicy {
+	apiPolicy := &api.RetentionPolicy{
+		Id: policy.UUID,
+		ResourceKey: &api.ResourceKey{
+			Type: toApiResourceType(policy.ResourceType),
+			Id:   policy.ResourceUUID,
+		},
+		ArchiveAfterDays:   policy.ArchiveAfterDays,
+		DeleteAfterDays:    policy.DeleteAfterDays,
+		CreatedAt:          &timestamp.Timestamp{Seconds: policy.CreatedAtInSec},
+		ArchivedRunCount:   policy.ArchivedRunCount,
+		DeletedRunCount:    policy.DeletedRunCount,
+		DeletedObjectCount: policy.DeletedObjectCount,
+		Error:              policy.Error,
+	}
+	if policy.LastEnforcedAtInSec > 0 {
+		apiPolicy.LastEnforcedAt = &timestamp.Timestamp{Seconds: policy.LastEnforcedAtInSec}
+	}
+	return apiPolicy
+}
+
+func ToApiNamespaceQuota(quota *model.NamespaceQuota, usage *model.NamespaceQuotaUsage) *api.NamespaceQuota {
+	return &api.NamespaceQuota{
+		Namespace:      quota.Namespace,
+		MaxActiveRuns:  quota.MaxActiveRuns,
+		MaxRunsPerHour: quota.MaxRunsPerHour,
+		Usage: &api.QuotaUsage{
+			ActiveRuns:   usage.ActiveRuns,
+			RunsLastHour: usage.RunsLastHour,
+			QueuedRuns:   usage.QueuedRuns,
+		},
+	}
+}
+
+func ToApiRetentionPolicies(policies []*model.RetentionPolicy) []*api.RetentionPolicy {
+	apiPolicies := make([]*api.RetentionPolicy, 0)
+	for _, policy := range policies {
+		apiPolicies = append(apiPolicies, ToApiRetentionPolicy(policy))
+	}
+	return apiPolicies
+}
+
+func ToApiAuditEvent(event *model.AuditEvent) *api.AuditEvent {
+	return &api.AuditEvent{
+		Id:           event.UUID,
+		CreatedAt:    &timestamp.Timestamp{Seconds: event.CreatedAtInSec},
+		User:         event.User,
+		Method:       event.Method,
+		ResourceType: event.ResourceType,
+		ResourceId:   event.ResourceUUID,
+		Namespace:    event.Namespace,
+		Outcome:      api.AuditEvent_Outcome(api.AuditEvent_Outcome_value[event.Outcome]),
+		Error:        event.Error,
+	}
+}
+
+func ToApiAuditEvents(events []*model.AuditEvent) []*api.AuditEvent {
+	apiEvents := make([]*api.AuditEvent, 0)
+	for _, event := range events {
+		apiEvents = append(apiEvents, ToApiAuditEvent(event))
+	}
+	return apiEvents
+}
+
 func ToApiPipeline(pipeline *model.Pipeline) *api.Pipeline {
 	params, err := toApiParameters(pipeline.Parameters)
 	if err != nil {
@@ -107,6 +172,7 @@ func ToApiPipelineVersion(version *model.PipelineVersion) (*api.PipelineVersion,
 				Relationship: api.Relationship_OWNER,
 			},
 		},
+		Labels: version.Labels,
 	}, nil
 }
 
@@ -184,7 +250,57 @@ func toApiRun(run *model.Run) *api.Run {
 			Parameters:       params,
 		},
 		ResourceReferences: toApiResourceReferences(run.ResourceReferences),
+		Labels:             run.Labels,
+		Priority:           run.Priority,
+		QueuePosition:      run.QueuePosition,
+	}
+}
+
+func ToApiRunArtifacts(artifacts []*model.RunArtifact) []*api.RunArtifact {
+	apiArtifacts := make([]*api.RunArtifact, 0)
+	for _, artifact := range artifacts {
+		apiArtifact := &api.RunArtifact{
+			Name:       artifact.Name,
+			TaskName:   artifact.TaskName,
+			ObjectKey:  artifact.ObjectKey,
+			SizeBytes:  artifact.SizeBytes,
+			Type:       api.RunArtifact_Type(api.RunArtifact_Type_value[artifact.Type]),
+			SourcePath: artifact.SourcePath,
+		}
+		if artifact.LastModifiedAtInSec > 0 {
+			apiArtifact.LastModifiedAt = &timestamp.Timestamp{Seconds: artifact.LastModifiedAtInSec}
+		}
+		apiArtifacts = append(apiArtifacts, apiArtifact)
+	}
+	return apiArtifacts
+}
+
+func ToApiLineageGraph(graph *model.LineageGraph) *api.LineageGraph {
+	apiGraph := &api.LineageGraph{
+		Nodes: make([]*api.LineageNode, 0),
+		Edges: make([]*api.LineageEdge, 0),
+	}
+	for _, node := range graph.Nodes {
+		apiGraph.Nodes = append(apiGraph.Nodes, &api.LineageNode{
+			Id:         node.ID,
+			NodeType:   api.LineageNode_NodeType(api.LineageNode_NodeType_value[node.NodeType]),
+			TypeName:   node.TypeName,
+			Name:       node.Name,
+			Uri:        node.URI,
+			State:      node.State,
+			Properties: node.Properties,
+			Depth:      node.Depth,
+		})
+	}
+	for _, edge := range graph.Edges {
+		apiGraph.Edges = append(apiGraph.Edges, &api.LineageEdge{
+			Source:   edge.Source,
+			Target:   edge.Target,
+			EdgeType: api.LineageEdge_EdgeType(api.LineageEdge_EdgeType_value[edge.EdgeType]),
+			Name:     edge.Name,
+		})
 	}
+	return apiGraph
 }
 
 func ToApiRuns(runs []*model.Run) []*api.Run {
@@ -233,6 +349,7 @@ func ToApiJob(job *model.Job) *api.Job {
 			Parameters:       params,
 		},
 		ResourceReferences: toApiResourceReferences(job.ResourceReferences),
+		Labels:             job.Labels,
 	}
 }
 
@@ -0,0 +1,292 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// The types of audited resources which have no RBAC resource type.
+const (
+	auditResourceTypePipelineVersions  = "pipelineversions"
+	auditResourceTypeRetentionPolicies = "retentionpolicies"
+)
+
+// auditedServices maps the services whose mutating methods are audited to
+// the type of the resources they operate on. The resources of the label
+// service are given by the requests.
+var auditedServices = map[string]string{
+	"api.RunService":             common.RbacResourceTypeRuns,
+	"api.JobService":             common.RbacResourceTypeJobs,
+	"api.ExperimentService":      common.RbacResourceTypeExperiments,
+	"api.PipelineService":        common.RbacResourceTypePipelines,
+	"api.RetentionPolicyService": auditResourceTypeRetentionPolicies,
+	"api.QuotaService":           common.RbacResourceTypeQuotas,
+	"api.LabelService":           "",
+}
+
+// The prefixes of the names of the methods which mutate resources.
+var mutatingMethodPrefixes = []string{
+	"Create", "Update", "Delete", "Archive", "Unarchive", "Terminate", "Retry", "Enable", "Disable", "Batch",
+}
+
+// auditTarget is a resource targeted by an audited operation.
+type auditTarget struct {
+	resourceType string
+	id           string
+	namespace    string
+}
+
+// AuditInterceptor records an audit event for every call of a mutating
+// method of the API, with the user, the target resource and the outcome of
+// the call.
+type AuditInterceptor struct {
+	resourceManager *resource.ResourceManager
+}
+
+func NewAuditInterceptor(resourceManager *resource.ResourceManager) *AuditInterceptor {
+	return &AuditInterceptor{resourceManager: resourceManager}
+}
+
+// Intercept implements grpc.UnaryServerInterceptor.
+func (i *AuditInterceptor) Intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	service, method := splitFullMethod(info.FullMethod)
+	resourceType, ok := auditedServices[service]
+	if !ok || !isMutatingMethod(method) {
+		return handler(ctx, req)
+	}
+	if dryRun, ok := req.(interface{ GetDryRun() bool }); ok && dryRun.GetDryRun() {
+		return handler(ctx, req)
+	}
+
+	// The targets are resolved before the call, since the call may delete them.
+	targets := i.getRequestTargets(resourceType, req)
+	// The user is authenticated once, for the authorization of the call and
+	// for its audit events.
+	ctx, userInfo, authErr := i.resourceManager.AuthenticateRequestOnce(ctx)
+	resp, err := handler(ctx, req)
+
+	user := ""
+	if authErr == nil && userInfo != nil {
+		user = userInfo.Name
+	}
+	record := func(target auditTarget, outcome string, message string) {
+		_, recordErr := i.resourceManager.RecordAuditEvent(&model.AuditEvent{
+			User:         user,
+			Method:       info.FullMethod,
+			ResourceType: target.resourceType,
+			ResourceUUID: target.id,
+			Namespace:    target.namespace,
+			Outcome:      outcome,
+			Error:        message,
+		})
+		if recordErr != nil {
+			glog.Errorf("Failed to record audit event of %s. Error: %v", info.FullMethod, recordErr)
+		}
+	}
+
+	if batch, ok := resp.(*api.BatchRunsResponse); ok && err == nil {
+		resolved := make(map[string]auditTarget, len(targets))
+		for _, target := range targets {
+			resolved[target.id] = target
+		}
+		for _, result := range batch.GetResults() {
+			target, ok := resolved[result.GetRunId()]
+			if !ok {
+				target = i.getTarget(resourceType, result.GetRunId())
+			}
+			record(target, batchRunResultOutcome(result), result.GetMessage())
+		}
+		return resp, err
+	}
+	outcome, message := auditOutcome(err)
+	if len(targets) == 0 {
+		targets = []auditTarget{i.getResponseTarget(resourceType, req, resp)}
+	}
+	for _, target := range targets {
+		record(target, outcome, message)
+	}
+	return resp, err
+}
+
+// getRequestTargets returns the existing resources targeted by a request, or
+// none if the request creates a resource.
+func (i *AuditInterceptor) getRequestTargets(resourceType string, req interface{}) []auditTarget {
+	switch r := req.(type) {
+	case *api.UpdateLabelsRequest:
+		return []auditTarget{i.getTarget(toAuditResourceType(r.GetResourceKey().GetType()), r.GetResourceKey().GetId())}
+	case *api.UpdateNamespaceQuotaRequest:
+		return []auditTarget{{resourceType: resourceType, id: r.GetNamespace(), namespace: r.GetNamespace()}}
+	case *api.UpdatePipelineDefaultVersionRequest:
+		return []auditTarget{i.getTarget(resourceType, r.GetPipelineId())}
+	case *api.DeletePipelineVersionRequest:
+		return []auditTarget{i.getTarget(auditResourceTypePipelineVersions, r.GetVersionId())}
+	case interface{ GetIds() []string }:
+		var targets []auditTarget
+		for _, id := range r.GetIds() {
+			targets = append(targets, i.getTarget(resourceType, id))
+		}
+		return targets
+	case interface{ GetRunId() string }:
+		return []auditTarget{i.getTarget(resourceType, r.GetRunId())}
+	case interface{ GetId() string }:
+		return []auditTarget{i.getTarget(resourceType, r.GetId())}
+	}
+	return nil
+}
+
+// getResponseTarget returns the resource created by a request. If the
+// request failed, only the namespace the resource was to be created in is
+// known.
+func (i *AuditInterceptor) getResponseTarget(resourceType string, req interface{}, resp interface{}) auditTarget {
+	if _, ok := req.(*api.CreatePipelineVersionRequest); ok {
+		resourceType = auditResourceTypePipelineVersions
+	}
+	id := ""
+	switch r := resp.(type) {
+	case *api.RunDetail:
+		id = r.GetRun().GetId()
+	case interface{ GetId() string }:
+		id = r.GetId()
+	}
+	if id != "" {
+		return i.getTarget(resourceType, id)
+	}
+	var references []*api.ResourceReference
+	switch r := req.(type) {
+	case *api.CreateRunRequest:
+		references = r.GetRun().GetResourceReferences()
+	case *api.CreateJobRequest:
+		references = r.GetJob().GetResourceReferences()
+	case *api.CreateExperimentRequest:
+		references = r.GetExperiment().GetResourceReferences()
+	case *api.CreatePipelineRequest:
+		references = r.GetPipeline().GetResourceReferences()
+	case *api.CreatePipelineVersionRequest:
+		references = r.GetVersion().GetResourceReferences()
+	}
+	namespace := common.GetNamespaceFromAPIResourceReferences(references)
+	if experimentId := common.GetExperimentIDFromAPIResourceReferences(references); namespace == "" && experimentId != "" {
+		namespace, _ = i.resourceManager.GetNamespaceFromExperimentID(experimentId)
+	}
+	return auditTarget{resourceType: resourceType, namespace: namespace}
+}
+
+// getTarget returns a target resource with its namespace, if it exists.
+func (i *AuditInterceptor) getTarget(resourceType string, id string) auditTarget {
+	target := auditTarget{resourceType: resourceType, id: id}
+	if id == "" {
+		return target
+	}
+	var err error
+	switch resourceType {
+	case common.RbacResourceTypeRuns:
+		target.namespace, err = i.resourceManager.GetNamespaceFromRunID(id)
+	case common.RbacResourceTypeJobs:
+		target.namespace, err = i.resourceManager.GetNamespaceFromJobID(id)
+	case common.RbacResourceTypeExperiments:
+		target.namespace, err = i.resourceManager.GetNamespaceFromExperimentID(id)
+	case common.RbacResourceTypePipelines:
+		target.namespace, err = i.resourceManager.GetNamespaceFromPipelineID(id)
+	case auditResourceTypePipelineVersions:
+		target.namespace, err = i.resourceManager.GetNamespaceFromPipelineVersion(id)
+	case auditResourceTypeRetentionPolicies:
+		var policy *model.RetentionPolicy
+		policy, err = i.resourceManager.GetRetentionPolicy(id)
+		if err == nil && policy.ResourceType == common.Namespace {
+			target.namespace = policy.ResourceUUID
+		} else if err == nil {
+			target.namespace, err = i.resourceManager.GetNamespaceFromExperimentID(policy.ResourceUUID)
+		}
+	}
+	if err != nil {
+		// The resource does not exist, e.g. the request is invalid.
+		target.namespace = ""
+	}
+	return target
+}
+
+func toAuditResourceType(resourceType api.ResourceType) string {
+	switch resourceType {
+	case api.ResourceType_EXPERIMENT:
+		return common.RbacResourceTypeExperiments
+	case api.ResourceType_JOB:
+		return common.RbacResourceTypeJobs
+	case api.ResourceType_RUN:
+		return common.RbacResourceTypeRuns
+	case api.ResourceType_PIPELINE:
+		return common.RbacResourceTypePipelines
+	case api.ResourceType_PIPELINE_VERSION:
+		return auditResourceTypePipelineVersions
+	default:
+		return strings.ToLower(resourceType.String())
+	}
+}
+
+// auditOutcome returns the outcome and the error message of an audited call.
+func auditOutcome(err error) (string, string) {
+	if err == nil {
+		return model.AuditOutcomeSucceeded, ""
+	}
+	code := codes.Internal
+	if userError, ok := err.(*util.UserError); ok {
+		code = userError.ExternalStatusCode()
+	}
+	if code == codes.PermissionDenied || code == codes.Unauthenticated {
+		return model.AuditOutcomeDenied, err.Error()
+	}
+	return model.AuditOutcomeFailed, err.Error()
+}
+
+func batchRunResultOutcome(result *api.BatchRunsResponse_BatchRunResult) string {
+	switch result.GetStatus() {
+	case api.BatchRunsResponse_BatchRunResult_OK:
+		return model.AuditOutcomeSucceeded
+	case api.BatchRunsResponse_BatchRunResult_PERMISSION_DENIED:
+		return model.AuditOutcomeDenied
+	default:
+		return model.AuditOutcomeFailed
+	}
+}
+
+// splitFullMethod splits a full method name like /api.RunService/DeleteRun
+// into its service and method names.
+func splitFullMethod(fullMethod string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+	return parts[0], parts[1]
+}
+
+func isMutatingMethod(method string) bool {
+	for _, prefix := range mutatingMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
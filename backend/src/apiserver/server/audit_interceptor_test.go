@@ -0,0 +1,229 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func auditUserContext() context.Context {
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// listAuditEvents returns the audit events in the order they were recorded,
+// without their generated IDs and creation times.
+func listAuditEvents(t *testing.T, manager *resource.ResourceManager) []*api.AuditEvent {
+	response, err := NewAuditServer(manager).ListAuditEvents(nil, &api.ListAuditEventsRequest{SortBy: "created_at"})
+	assert.Nil(t, err)
+	for _, event := range response.AuditEvents {
+		assert.NotEmpty(t, event.Id)
+		event.Id = ""
+		event.CreatedAt = nil
+	}
+	return response.AuditEvents
+}
+
+func TestAuditInterceptor_DeleteRun(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return runServer.DeleteRun(ctx, req.(*api.DeleteRunRequest))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.RunService/DeleteRun"}
+
+	_, err := NewAuditInterceptor(manager).Intercept(auditUserContext(), &api.DeleteRunRequest{Id: run.UUID}, info, handler)
+	assert.Nil(t, err)
+	_, err = NewAuditInterceptor(manager).Intercept(auditUserContext(), &api.DeleteRunRequest{Id: run.UUID}, info, handler)
+	assert.NotNil(t, err)
+
+	viper.Set(common.MultiUserMode, "false")
+	assert.Equal(t, []*api.AuditEvent{
+		{
+			User:         "user@google.com",
+			Method:       "/api.RunService/DeleteRun",
+			ResourceType: common.RbacResourceTypeRuns,
+			ResourceId:   run.UUID,
+			Namespace:    "ns1",
+			Outcome:      api.AuditEvent_SUCCEEDED,
+		},
+		{
+			User:         "user@google.com",
+			Method:       "/api.RunService/DeleteRun",
+			ResourceType: common.RbacResourceTypeRuns,
+			ResourceId:   run.UUID,
+			Outcome:      api.AuditEvent_FAILED,
+			Error:        err.Error(),
+		},
+	}, listAuditEvents(t, manager))
+	assert.Contains(t, clients.AuditLogFake.String(),
+		`"user":"user@google.com","method":"/api.RunService/DeleteRun","resource_type":"runs","resource_id":"`+run.UUID+`","namespace":"ns1","outcome":"SUCCEEDED"}`)
+}
+
+// countingAuthenticator counts the requests it authenticates.
+type countingAuthenticator struct {
+	kfpauth.Authenticator
+	calls int
+}
+
+func (a *countingAuthenticator) GetUserInfo(ctx context.Context) (*kfpauth.UserInfo, error) {
+	a.calls++
+	return a.Authenticator.GetUserInfo(ctx)
+}
+
+func TestAuditInterceptor_AuthenticatesOnce(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clients, _, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	authenticator := &countingAuthenticator{Authenticator: kfpauth.NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix())}
+	clients.AuthenticatorsFake = []kfpauth.Authenticator{authenticator}
+	manager := resource.NewResourceManager(clients)
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return runServer.DeleteRun(ctx, req.(*api.DeleteRunRequest))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.RunService/DeleteRun"}
+
+	_, err := NewAuditInterceptor(manager).Intercept(auditUserContext(), &api.DeleteRunRequest{Id: run.UUID}, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, authenticator.calls)
+
+	viper.Set(common.MultiUserMode, "false")
+	events := listAuditEvents(t, manager)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "user@google.com", events[0].User)
+}
+
+func TestAuditInterceptor_NonMutatingMethod(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return runServer.GetRun(ctx, req.(*api.GetRunRequest))
+	}
+
+	_, err := NewAuditInterceptor(manager).Intercept(context.Background(), &api.GetRunRequest{RunId: run.UUID},
+		&grpc.UnaryServerInfo{FullMethod: "/api.RunService/GetRun"}, handler)
+	assert.Nil(t, err)
+	assert.Empty(t, listAuditEvents(t, manager))
+	assert.Empty(t, clients.AuditLogFake.String())
+}
+
+func TestAuditInterceptor_CreateExperiment(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.ExperimentService/CreateExperiment"}
+	request := &api.CreateExperimentRequest{Experiment: &api.Experiment{
+		Name: "exp2",
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns2"},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	}}
+
+	clients.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.NonDefaultFakeUUID, nil))
+	manager = resource.NewResourceManager(clients)
+	experimentServer := NewExperimentServer(manager, &ExperimentServerOptions{CollectMetrics: false})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return experimentServer.CreateExperiment(ctx, req.(*api.CreateExperimentRequest))
+	}
+	response, err := NewAuditInterceptor(manager).Intercept(auditUserContext(), request, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, resource.NonDefaultFakeUUID, response.(*api.Experiment).Id)
+
+	// A denied request is recorded with the namespace of the experiment it
+	// would have created.
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	manager = resource.NewResourceManager(clients)
+	experimentServer = NewExperimentServer(manager, &ExperimentServerOptions{CollectMetrics: false})
+	_, err = NewAuditInterceptor(manager).Intercept(auditUserContext(), request, info, handler)
+	AssertUserError(t, err, codes.PermissionDenied)
+
+	viper.Set(common.MultiUserMode, "false")
+	assert.Equal(t, []*api.AuditEvent{
+		{
+			User:         "user@google.com",
+			Method:       "/api.ExperimentService/CreateExperiment",
+			ResourceType: common.RbacResourceTypeExperiments,
+			ResourceId:   resource.NonDefaultFakeUUID,
+			Namespace:    "ns2",
+			Outcome:      api.AuditEvent_SUCCEEDED,
+		},
+		{
+			User:         "user@google.com",
+			Method:       "/api.ExperimentService/CreateExperiment",
+			ResourceType: common.RbacResourceTypeExperiments,
+			Namespace:    "ns2",
+			Outcome:      api.AuditEvent_DENIED,
+			Error:        err.Error(),
+		},
+	}, listAuditEvents(t, manager))
+}
+
+func TestAuditInterceptor_BatchDeleteRuns(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	runServer := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return runServer.BatchDeleteRuns(ctx, req.(*api.BatchDeleteRunsRequest))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.RunService/BatchDeleteRuns"}
+
+	// Dry runs are not audited.
+	_, err := NewAuditInterceptor(manager).Intercept(context.Background(),
+		&api.BatchDeleteRunsRequest{Ids: []string{run.UUID}, DryRun: true}, info, handler)
+	assert.Nil(t, err)
+	assert.Empty(t, listAuditEvents(t, manager))
+
+	response, err := NewAuditInterceptor(manager).Intercept(context.Background(),
+		&api.BatchDeleteRunsRequest{Ids: []string{run.UUID, "not-exist"}}, info, handler)
+	assert.Nil(t, err)
+	results := response.(*api.BatchRunsResponse).Results
+	assert.Len(t, results, 2)
+
+	events := listAuditEvents(t, manager)
+	assert.Len(t, events, 2)
+	for i, result := range results {
+		assert.Equal(t, result.RunId, events[i].ResourceId)
+		assert.Equal(t, "/api.RunService/BatchDeleteRuns", events[i].Method)
+		if result.Status == api.BatchRunsResponse_BatchRunResult_OK {
+			assert.Equal(t, api.AuditEvent_SUCCEEDED, events[i].Outcome)
+		} else {
+			assert.Equal(t, api.AuditEvent_FAILED, events[i].Outcome)
+			assert.Equal(t, result.Message, events[i].Error)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type AuditServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *AuditServer) ListAuditEvents(ctx context.Context, request *api.ListAuditEventsRequest) (
+	*api.ListAuditEventsResponse, error) {
+	opts, err := validatedListOptions(&model.AuditEvent{}, request.PageToken, int(request.PageSize), request.SortBy, request.Filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+
+	if key := request.ResourceReferenceKey; key != nil && key.Type != api.ResourceType_NAMESPACE {
+		return nil, util.NewInvalidInputError("Audit events can only be filtered by namespace. Got %v.", key.Type)
+	}
+	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
+	if err != nil {
+		return nil, util.Wrap(err, "Validating filter failed.")
+	}
+	if common.IsMultiUserMode() {
+		if request.ResourceReferenceKey == nil || request.ResourceReferenceKey.Id == "" {
+			return nil, util.NewInvalidInputError("ListAuditEvents requires filtering by namespace in multi-user mode.")
+		}
+		resourceAttributes := &authorizationv1.ResourceAttributes{
+			Namespace: request.ResourceReferenceKey.Id,
+			Verb:      common.RbacResourceVerbList,
+			Group:     common.RbacPipelinesGroup,
+			Version:   common.RbacPipelinesVersion,
+			Resource:  common.RbacResourceTypeAuditEvents,
+		}
+		err = isAuthorized(s.resourceManager, ctx, resourceAttributes)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize with API resource references")
+		}
+	}
+
+	events, total_size, nextPageToken, err := s.resourceManager.ListAuditEvents(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "List audit events failed.")
+	}
+	return &api.ListAuditEventsResponse{
+		AuditEvents:   ToApiAuditEvents(events),
+		TotalSize:     int32(total_size),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func NewAuditServer(resourceManager *resource.ResourceManager) *AuditServer {
+	return &AuditServer{resourceManager: resourceManager}
+}
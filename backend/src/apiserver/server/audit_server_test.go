@@ -0,0 +1,78 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	for _, namespace := range []string{"ns1", "ns2", "ns1"} {
+		_, err := manager.RecordAuditEvent(&model.AuditEvent{
+			User:         "user@google.com",
+			Method:       "/api.ExperimentService/DeleteExperiment",
+			ResourceType: common.RbacResourceTypeExperiments,
+			Namespace:    namespace,
+			Outcome:      model.AuditOutcomeSucceeded,
+		})
+		assert.Nil(t, err)
+	}
+	server := NewAuditServer(manager)
+
+	response, err := server.ListAuditEvents(nil, &api.ListAuditEventsRequest{
+		PageSize:             1,
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, response.AuditEvents, 1)
+	assert.Equal(t, int32(2), response.TotalSize)
+	assert.NotEmpty(t, response.NextPageToken)
+	assert.Equal(t, "ns1", response.AuditEvents[0].Namespace)
+	assert.Equal(t, api.AuditEvent_SUCCEEDED, response.AuditEvents[0].Outcome)
+
+	response, err = server.ListAuditEvents(nil, &api.ListAuditEventsRequest{
+		Filter: `{"predicates": [{"key": "namespace", "op": "EQUALS", "string_value": "ns2"}]}`,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, response.AuditEvents, 1)
+	assert.Equal(t, "ns2", response.AuditEvents[0].Namespace)
+}
+
+func TestListAuditEvents_InvalidResourceReferenceKey(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	_, err := NewAuditServer(manager).ListAuditEvents(nil, &api.ListAuditEventsRequest{
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestListAuditEvents_MultiuserNoNamespace(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	_, err := NewAuditServer(manager).ListAuditEvents(auditUserContext(), &api.ListAuditEventsRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
@@ -48,8 +48,6 @@ var (
 		Help: "The total number of UnarchiveExperiment requests",
 	})
 
-	// TODO(jingzhang36): error count and success count.
-
 	experimentCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "experiment_server_run_count",
 		Help: "The current number of experiments in Kubeflow Pipelines instance",
@@ -211,7 +209,7 @@ func ValidateCreateExperimentRequest(request *api.CreateExperimentRequest) error
 	} else if len(resourceReferences) > 0 {
 		return util.NewInvalidInputError("In single-user mode, CreateExperimentRequest shouldn't contain resource references.")
 	}
-	return nil
+	return validateLabels(request.Experiment.GetLabels())
 }
 
 // TODO(chensun): consider refactoring the code to get rid of double-query of experiment.
@@ -63,8 +63,6 @@ var (
 		Help: "The total number of EnableJob requests",
 	})
 
-	// TODO(jingzhang36): error count and success count.
-
 	jobCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "job_server_job_count",
 		Help: "The current number of jobs in Kubeflow Pipelines instance",
@@ -113,7 +111,7 @@ func (s *JobServer) CreateJob(ctx context.Context, request *api.CreateJobRequest
 		}
 	}
 
-	newJob, err := s.resourceManager.CreateJob(request.Job)
+	newJob, err := s.resourceManager.CreateJobWithIdempotencyKey(request.Job, request.IdempotencyKey)
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +146,10 @@ func (s *JobServer) ListJobs(ctx context.Context, request *api.ListJobsRequest)
 
 	opts, err := validatedListOptions(&model.Job{}, request.PageToken, int(request.PageSize), request.SortBy, request.Filter)
 
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+	opts.BasicView, err = isBasicListView(request.View)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
@@ -273,7 +275,10 @@ func (s *JobServer) validateCreateJobRequest(request *api.CreateJobRequest) erro
 				"Found invalid period schedule interval %v. Set at interval to least 1 second.", periodicScheduleInterval)
 		}
 	}
-	return nil
+	if err := validateIdempotencyKey(request.IdempotencyKey); err != nil {
+		return err
+	}
+	return validateLabels(job.GetLabels())
 }
 
 func (s *JobServer) enableJob(id string, enabled bool) (*empty.Empty, error) {
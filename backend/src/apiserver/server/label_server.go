@@ -0,0 +1,98 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type LabelServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *LabelServer) UpdateLabels(ctx context.Context, request *api.UpdateLabelsRequest) (*api.UpdateLabelsResponse, error) {
+	resourceType, err := s.validateUpdateLabelsRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate update labels request failed.")
+	}
+	id := request.ResourceKey.Id
+	err = s.canUpdateLabels(ctx, resourceType, id)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	labels, err := s.resourceManager.UpdateLabels(resourceType, id, request.AddLabels, request.RemoveLabelKeys)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to update labels.")
+	}
+	return &api.UpdateLabelsResponse{Labels: labels}, nil
+}
+
+func (s *LabelServer) validateUpdateLabelsRequest(request *api.UpdateLabelsRequest) (common.ResourceType, error) {
+	if request.ResourceKey == nil || request.ResourceKey.Id == "" {
+		return "", util.NewInvalidInputError("Resource key is empty. Please specify a valid resource key.")
+	}
+	var resourceType common.ResourceType
+	switch request.ResourceKey.Type {
+	case api.ResourceType_RUN:
+		resourceType = common.Run
+	case api.ResourceType_JOB:
+		resourceType = common.Job
+	case api.ResourceType_EXPERIMENT:
+		resourceType = common.Experiment
+	case api.ResourceType_PIPELINE_VERSION:
+		resourceType = common.PipelineVersion
+	default:
+		return "", util.NewInvalidInputError("Labels are not supported on resource type %v.", request.ResourceKey.Type)
+	}
+	if len(request.AddLabels) == 0 && len(request.RemoveLabelKeys) == 0 {
+		return "", util.NewInvalidInputError("No labels to add or remove.")
+	}
+	if err := validateLabels(request.AddLabels); err != nil {
+		return "", err
+	}
+	for _, key := range request.RemoveLabelKeys {
+		if _, ok := request.AddLabels[key]; ok {
+			return "", util.NewInvalidInputError("Label %q cannot be both added and removed.", key)
+		}
+		if err := validateLabelKey(key); err != nil {
+			return "", err
+		}
+	}
+	return resourceType, nil
+}
+
+func (s *LabelServer) canUpdateLabels(ctx context.Context, resourceType common.ResourceType, id string) error {
+	resourceAttributes := &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUpdate}
+	switch resourceType {
+	case common.Run:
+		return canAccessRun(s.resourceManager, ctx, id, resourceAttributes)
+	case common.Job:
+		return (&JobServer{resourceManager: s.resourceManager}).canAccessJob(ctx, id, resourceAttributes)
+	case common.Experiment:
+		return (&ExperimentServer{resourceManager: s.resourceManager}).canAccessExperiment(ctx, id, resourceAttributes)
+	default:
+		return (&PipelineServer{resourceManager: s.resourceManager}).CanAccessPipelineVersion(ctx, id, resourceAttributes)
+	}
+}
+
+func NewLabelServer(resourceManager *resource.ResourceManager) *LabelServer {
+	return &LabelServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateLabels_Run(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	runServer := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+	run, err := runServer.CreateRun(context.Background(), &api.CreateRunRequest{Run: &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+		Labels: map[string]string{"team": "a", "env": "dev"},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "a", "env": "dev"}, run.Run.Labels)
+
+	// The labels are set on the PipelineRun.
+	runDetail, err := resourceManager.GetRun(run.Run.Id)
+	assert.Nil(t, err)
+	pipelineRun, err := clientManager.TektonClientFake.PipelineRun(runDetail.Namespace).Get(context.Background(), runDetail.Name, v1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "a", pipelineRun.Labels["team"])
+	assert.Equal(t, "dev", pipelineRun.Labels["env"])
+
+	labelServer := NewLabelServer(resourceManager)
+	response, err := labelServer.UpdateLabels(context.Background(), &api.UpdateLabelsRequest{
+		ResourceKey:     &api.ResourceKey{Type: api.ResourceType_RUN, Id: run.Run.Id},
+		AddLabels:       map[string]string{"team": "b"},
+		RemoveLabelKeys: []string{"env"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, response.Labels)
+
+	got, err := runServer.GetRun(context.Background(), &api.GetRunRequest{RunId: run.Run.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, got.Run.Labels)
+}
+
+func TestUpdateLabels_Experiment(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	labelServer := NewLabelServer(resourceManager)
+
+	response, err := labelServer.UpdateLabels(context.Background(), &api.UpdateLabelsRequest{
+		ResourceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		AddLabels:   map[string]string{"example.com/team": "a"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"example.com/team": "a"}, response.Labels)
+
+	got, err := resourceManager.GetExperiment(experiment.UUID)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"example.com/team": "a"}, got.Labels)
+}
+
+func TestUpdateLabels_ResourceNotFound(t *testing.T) {
+	clientManager, resourceManager, _ := initWithExperiment(t)
+	defer clientManager.Close()
+	labelServer := NewLabelServer(resourceManager)
+
+	_, err := labelServer.UpdateLabels(context.Background(), &api.UpdateLabelsRequest{
+		ResourceKey: &api.ResourceKey{Type: api.ResourceType_JOB, Id: "notexist"},
+		AddLabels:   map[string]string{"team": "a"},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestUpdateLabels_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, resourceManager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clientManager.Close()
+	labelServer := NewLabelServer(resourceManager)
+
+	_, err := labelServer.UpdateLabels(ctx, &api.UpdateLabelsRequest{
+		ResourceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		AddLabels:   map[string]string{"team": "a"},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Unauthorized access")
+}
+
+func TestValidateUpdateLabelsRequest(t *testing.T) {
+	labelServer := NewLabelServer(nil)
+	tests := []struct {
+		name    string
+		request *api.UpdateLabelsRequest
+		wantErr string
+	}{
+		{
+			"missing resource key",
+			&api.UpdateLabelsRequest{AddLabels: map[string]string{"team": "a"}},
+			"Resource key is empty",
+		},
+		{
+			"unsupported resource type",
+			&api.UpdateLabelsRequest{
+				ResourceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+				AddLabels:   map[string]string{"team": "a"},
+			},
+			"not supported on resource type",
+		},
+		{
+			"no labels",
+			&api.UpdateLabelsRequest{ResourceKey: &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"}},
+			"No labels to add or remove",
+		},
+		{
+			"invalid key",
+			&api.UpdateLabelsRequest{
+				ResourceKey: &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"},
+				AddLabels:   map[string]string{"bad key": "a"},
+			},
+			"Invalid label key",
+		},
+		{
+			"invalid value",
+			&api.UpdateLabelsRequest{
+				ResourceKey: &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"},
+				AddLabels:   map[string]string{"team": "bad value"},
+			},
+			"Invalid value of label",
+		},
+		{
+			"reserved prefix",
+			&api.UpdateLabelsRequest{
+				ResourceKey: &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"},
+				AddLabels:   map[string]string{"pipelines.kubeflow.org/cache_enabled": "false"},
+			},
+			"is reserved",
+		},
+		{
+			"reserved prefix on removal",
+			&api.UpdateLabelsRequest{
+				ResourceKey:     &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"},
+				RemoveLabelKeys: []string{"pipeline/runid"},
+			},
+			"is reserved",
+		},
+		{
+			"key both added and removed",
+			&api.UpdateLabelsRequest{
+				ResourceKey:     &api.ResourceKey{Type: api.ResourceType_RUN, Id: "r1"},
+				AddLabels:       map[string]string{"team": "a"},
+				RemoveLabelKeys: []string{"team"},
+			},
+			"cannot be both added and removed",
+		},
+	}
+	for _, test := range tests {
+		_, err := labelServer.validateUpdateLabelsRequest(test.request)
+		assert.NotNil(t, err, test.name)
+		assert.Contains(t, err.Error(), test.wantErr, test.name)
+	}
+}
+
+func TestValidateCreateRunRequest_InvalidLabels(t *testing.T) {
+	clientManager, resourceManager, experiment := initWithExperiment(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+	err := server.validateCreateRunRequest(&api.CreateRunRequest{Run: &api.Run{
+		Name:         "run1",
+		PipelineSpec: &api.PipelineSpec{WorkflowManifest: testWorkflow.ToStringForStore()},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+		Labels: map[string]string{"tekton.dev/pipeline": "p"},
+	}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "is reserved")
+}
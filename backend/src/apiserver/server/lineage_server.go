@@ -0,0 +1,92 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type LineageServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *LineageServer) GetRunLineage(ctx context.Context, request *api.GetRunLineageRequest) (*api.LineageGraph, error) {
+	if request.RunId == "" {
+		return nil, util.NewInvalidInputError("Run ID is empty. Please specify a valid run ID.")
+	}
+	// The lineage of a run is visible to those who can get the run.
+	err := canAccessRun(s.resourceManager, ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	graph, err := s.resourceManager.GetRunLineage(ctx, request.RunId)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get the lineage of run '%s'.", request.RunId)
+	}
+	return ToApiLineageGraph(graph), nil
+}
+
+func (s *LineageServer) GetArtifactLineage(ctx context.Context, request *api.GetArtifactLineageRequest) (*api.LineageGraph, error) {
+	// The lineage of an artifact is visible to those who can get the runs
+	// which produced it, and only goes through the runs they can get.
+	var canGetRun func(runId string) (bool, error)
+	if common.IsMultiUserMode() {
+		authorized := make(map[string]bool)
+		canGetRun = func(runId string) (bool, error) {
+			if allowed, ok := authorized[runId]; ok {
+				return allowed, nil
+			}
+			err := canAccessRun(s.resourceManager, ctx, runId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+			if err != nil && !util.IsUserErrorCodeMatch(err, codes.PermissionDenied) {
+				return false, err
+			}
+			authorized[runId] = err == nil
+			return err == nil, nil
+		}
+
+		runs, err := s.resourceManager.GetArtifactRuns(ctx, request.Uri)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize the request")
+		}
+		if len(runs) == 0 {
+			return nil, util.NewPermissionDeniedError(errors.New("No run produced the artifact"),
+				"Failed to authorize the request, because no run which produced artifact '%s' is found.", request.Uri)
+		}
+		for _, run := range runs {
+			err = canAccessRun(s.resourceManager, ctx, run.UUID, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+			if err != nil {
+				return nil, util.Wrap(err, "Failed to authorize the request")
+			}
+			authorized[run.UUID] = true
+		}
+	}
+	graph, err := s.resourceManager.GetArtifactLineage(ctx, request.Uri, request.Direction.String(), int(request.MaxDepth), canGetRun)
+	if err != nil {
+		return nil, util.Wrapf(err, "Failed to get the lineage of artifact '%s'.", request.Uri)
+	}
+	return ToApiLineageGraph(graph), nil
+}
+
+func NewLineageServer(resourceManager *resource.ResourceManager) *LineageServer {
+	return &LineageServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLineageServer(t *testing.T) {
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	mlmd := clients.MetadataStoreFake
+	train := mlmd.AddExecution("kfp.ContainerExecution", "train", mlmdPb.Execution_COMPLETE, mlmd.AddContext("kfp.PipelineRun", run.UUID))
+	model := mlmd.AddArtifact("system.Model", "gs://bucket/model")
+	mlmd.AddEvent(train, model, mlmdPb.Event_OUTPUT, "model")
+	server := NewLineageServer(manager)
+
+	graph, err := server.GetRunLineage(context.Background(), &api.GetRunLineageRequest{RunId: run.UUID})
+	assert.Nil(t, err)
+	assert.Len(t, graph.Nodes, 2)
+	assert.Equal(t, api.LineageNode_EXECUTION, graph.Nodes[0].NodeType)
+	assert.Equal(t, api.LineageNode_ARTIFACT, graph.Nodes[1].NodeType)
+	assert.Equal(t, "gs://bucket/model", graph.Nodes[1].Uri)
+	assert.Equal(t, []*api.LineageEdge{
+		{Source: graph.Nodes[0].Id, Target: graph.Nodes[1].Id, EdgeType: api.LineageEdge_OUTPUT, Name: "model"},
+	}, graph.Edges)
+
+	graph, err = server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{
+		Uri:       "gs://bucket/model",
+		Direction: api.GetArtifactLineageRequest_UPSTREAM,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, graph.Nodes, 2)
+	assert.Equal(t, int32(1), graph.Nodes[0].Depth)
+}
+
+func TestLineageServer_Invalid(t *testing.T) {
+	clients, manager, _ := initWithOneTimeRun(t)
+	defer clients.Close()
+	server := NewLineageServer(manager)
+
+	_, err := server.GetRunLineage(context.Background(), &api.GetRunLineageRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+	_, err = server.GetArtifactLineage(context.Background(), &api.GetArtifactLineageRequest{Uri: "gs://bucket/model", MaxDepth: 11})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
+func TestGetArtifactLineage_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	clients, manager, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	mlmd := clients.MetadataStoreFake
+	train := mlmd.AddExecution("kfp.ContainerExecution", "train", mlmdPb.Execution_COMPLETE, mlmd.AddContext("kfp.PipelineRun", run.UUID))
+	mlmd.AddEvent(train, mlmd.AddArtifact("system.Model", "gs://bucket/model"), mlmdPb.Event_OUTPUT, "model")
+	mlmd.AddEvent(mlmd.AddExecution("kfp.ContainerExecution", "prep", mlmdPb.Execution_COMPLETE), mlmd.AddArtifact("system.Dataset", "gs://bucket/data"), mlmdPb.Event_OUTPUT, "data")
+
+	graph, err := NewLineageServer(manager).GetArtifactLineage(ctx, &api.GetArtifactLineageRequest{Uri: "gs://bucket/model"})
+	assert.Nil(t, err)
+	assert.Len(t, graph.Nodes, 2)
+
+	// Without a run which produced it, an artifact can't be authorized.
+	_, err = NewLineageServer(manager).GetArtifactLineage(ctx, &api.GetArtifactLineageRequest{Uri: "gs://bucket/data"})
+	AssertUserError(t, err, codes.PermissionDenied)
+
+	clients.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	manager = resource.NewResourceManager(clients)
+	_, err = NewLineageServer(manager).GetArtifactLineage(ctx, &api.GetArtifactLineageRequest{Uri: "gs://bucket/model"})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
+
+func TestGetArtifactLineage_MultiUserCrossNamespace(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	clients, _, run := initWithOneTimeRun(t)
+	defer clients.Close()
+	manager, otherRun := addRunInNamespace(t, clients, "ns2", "run2",
+		"123e4567-e89b-12d3-a456-426655442000", "123e4567-e89b-12d3-a456-426655443000")
+	sarClient := &recordingSubjectAccessReviewClient{deniedNamespaces: map[string]bool{"ns2": true}}
+	clients.SubjectAccessReviewClientFake = sarClient
+	manager = resource.NewResourceManager(clients)
+
+	// A run in ns2 prepares the data which a run in ns1 trains on, and
+	// evaluates the model.
+	mlmd := clients.MetadataStoreFake
+	runContext := mlmd.AddContext("kfp.PipelineRun", run.UUID)
+	otherRunContext := mlmd.AddContext("kfp.PipelineRun", otherRun.UUID)
+	prep := mlmd.AddExecution("kfp.ContainerExecution", "prep", mlmdPb.Execution_COMPLETE, otherRunContext)
+	train := mlmd.AddExecution("kfp.ContainerExecution", "train", mlmdPb.Execution_COMPLETE, runContext)
+	eval := mlmd.AddExecution("kfp.ContainerExecution", "eval", mlmdPb.Execution_COMPLETE, otherRunContext)
+	data := mlmd.AddArtifact("system.Dataset", "gs://bucket/data")
+	model := mlmd.AddArtifact("system.Model", "gs://bucket/model")
+	mlmd.AddEvent(prep, data, mlmdPb.Event_OUTPUT, "data")
+	mlmd.AddEvent(train, data, mlmdPb.Event_INPUT, "data")
+	mlmd.AddEvent(train, model, mlmdPb.Event_OUTPUT, "model")
+	mlmd.AddEvent(eval, model, mlmdPb.Event_INPUT, "model")
+
+	graph, err := NewLineageServer(manager).GetArtifactLineage(ctx, &api.GetArtifactLineageRequest{Uri: "gs://bucket/model"})
+	assert.Nil(t, err)
+	var nodes []string
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, node.Name+node.Uri)
+	}
+	// The executions of the run in ns2 are not walked through.
+	assert.Equal(t, []string{"train", "gs://bucket/data", "gs://bucket/model"}, nodes)
+	// Each run is authorized once.
+	assert.Len(t, sarClient.reviews, 2)
+}
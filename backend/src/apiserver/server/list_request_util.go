@@ -203,7 +203,7 @@ func validatedListOptions(listable list.Listable, pageToken string, pageSize int
 		return defaultOpts()
 	}
 
-	opts, err := list.NewOptionsFromToken(pageToken, pageSize)
+	opts, err := list.NewOptionsFromToken(listable, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -222,3 +222,16 @@ func validatedListOptions(listable list.Listable, pageToken string, pageSize int
 
 	return opts, nil
 }
+
+// isBasicListView returns whether a list request asks for the basic view of
+// the listed resources.
+func isBasicListView(view api.ListView) (bool, error) {
+	switch view {
+	case api.ListView_LIST_VIEW_UNSPECIFIED, api.ListView_FULL:
+		return false, nil
+	case api.ListView_BASIC:
+		return true, nil
+	default:
+		return false, util.NewInvalidInputError("Invalid list view %v. Please specify BASIC or FULL.", view)
+	}
+}
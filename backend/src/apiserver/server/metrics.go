@@ -0,0 +1,90 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metric variables. Please prefix the metric names with api_server_.
+var (
+	rpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_server_rpc_requests",
+		Help: "The number of handled gRPC requests by method and status code",
+	}, []string{"service", "method", "code"})
+
+	rpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_server_rpc_request_duration_seconds",
+		Help:    "The latency of the gRPC requests by method",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	rpcRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_server_rpc_requests_in_flight",
+		Help: "The number of gRPC requests being handled by method",
+	}, []string{"service", "method"})
+
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_server_http_requests",
+		Help: "The number of handled requests of the HTTP-only handlers by handler, HTTP method and status code",
+	}, []string{"handler", "method", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "api_server_http_request_duration_seconds",
+		Help:    "The latency of the requests of the HTTP-only handlers by handler and HTTP method",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	httpRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "api_server_http_requests_in_flight",
+		Help: "The number of requests being handled by the HTTP-only handlers by handler",
+	}, []string{"handler"})
+)
+
+// MetricsInterceptor implements UnaryServerInterceptor, recording the count,
+// the latency and the status codes of the gRPC requests. It has to be chained
+// before the interceptor converting the errors to gRPC errors, so that it
+// sees their codes.
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	service, method := splitFullMethod(info.FullMethod)
+	inFlight := rpcRequestsInFlight.WithLabelValues(service, method)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	rpcRequestDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+	rpcRequests.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	return resp, err
+}
+
+// InstrumentHTTPHandler wraps an HTTP-only handler, which is not served
+// through gRPC, to record the count, the latency and the status codes of its
+// requests under the given handler name.
+func InstrumentHTTPHandler(handlerName string, handler http.HandlerFunc) http.Handler {
+	labels := prometheus.Labels{"handler": handlerName}
+	return promhttp.InstrumentHandlerInFlight(httpRequestsInFlight.With(labels),
+		promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(labels),
+			promhttp.InstrumentHandlerCounter(httpRequests.MustCurryWith(labels), handler)))
+}
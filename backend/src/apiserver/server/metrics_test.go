@@ -0,0 +1,70 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.RunService/GetRun"}
+	okCounter := rpcRequests.WithLabelValues("api.RunService", "GetRun", "OK")
+	notFoundCounter := rpcRequests.WithLabelValues("api.RunService", "GetRun", "NotFound")
+	okBefore := testutil.ToFloat64(okCounter)
+	notFoundBefore := testutil.ToFloat64(notFoundCounter)
+
+	resp, err := MetricsInterceptor(context.Background(), "request", info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			assert.Equal(t, float64(1), testutil.ToFloat64(rpcRequestsInFlight.WithLabelValues("api.RunService", "GetRun")))
+			return "response", nil
+		})
+	assert.Nil(t, err)
+	assert.Equal(t, "response", resp)
+
+	_, err = MetricsInterceptor(context.Background(), "request", info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, util.ToGRPCError(util.NewNotFoundError(errors.New("not found"), "Run not found."))
+		})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, okBefore+1, testutil.ToFloat64(okCounter))
+	assert.Equal(t, notFoundBefore+1, testutil.ToFloat64(notFoundCounter))
+	assert.Equal(t, float64(0), testutil.ToFloat64(rpcRequestsInFlight.WithLabelValues("api.RunService", "GetRun")))
+}
+
+func TestInstrumentHTTPHandler(t *testing.T) {
+	counter := httpRequests.WithLabelValues("TestHandler", "get", "400")
+	before := testutil.ToFloat64(counter)
+
+	handler := InstrumentHTTPHandler("TestHandler", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, float64(1), testutil.ToFloat64(httpRequestsInFlight.WithLabelValues("TestHandler")))
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/apis/v1beta1/test", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+	assert.Equal(t, float64(0), testutil.ToFloat64(httpRequestsInFlight.WithLabelValues("TestHandler")))
+}
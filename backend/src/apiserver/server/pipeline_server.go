@@ -75,8 +75,6 @@ var (
 		Help: "The total number of DeletePipelineVersion requests",
 	})
 
-	// TODO(jingzhang36): error count and success count.
-
 	pipelineCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "pipeline_server_pipeline_count",
 		Help: "The current number of pipelines in Kubeflow Pipelines instance",
@@ -298,6 +296,9 @@ func (s *PipelineServer) CreatePipelineVersion(ctx context.Context, request *api
 		len(request.Version.PackageUrl.PipelineUrl) == 0 {
 		return nil, util.NewInvalidInputError("Pipeline URL is empty. Please specify a valid URL.")
 	}
+	if err := validateLabels(request.Version.GetLabels()); err != nil {
+		return nil, err
+	}
 	pipelineUrl := request.Version.PackageUrl.PipelineUrl
 	if _, err := url.ParseRequestURI(request.Version.PackageUrl.PipelineUrl); err != nil {
 		return nil, util.NewInvalidInputError("Invalid Pipeline URL %v. Please specify a valid URL", request.Version.PackageUrl.PipelineUrl)
@@ -369,6 +370,10 @@ func (s *PipelineServer) ListPipelineVersions(ctx context.Context, request *api.
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
+	opts.BasicView, err = isBasicListView(request.View)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
 
 	//Ensure resourceKey has been set
 	if request.ResourceKey == nil {
@@ -435,7 +440,7 @@ func (s *PipelineServer) GetPipelineVersionTemplate(ctx context.Context, request
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the requests.")
 	}
-	template, err := s.resourceManager.GetPipelineVersionTemplate(request.VersionId)
+	template, err := s.resourceManager.GetPipelineVersionTemplate(ctx, request.VersionId)
 	if err != nil {
 		return nil, util.Wrap(err, "Get pipeline template failed.")
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/jsonpb"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
@@ -53,8 +54,6 @@ var (
 		Name: "pipeline_version_upload_requests",
 		Help: "The number of pipeline version upload requests",
 	})
-
-	// TODO(jingzhang36): error count and success count.
 )
 
 type PipelineUploadServerOptions struct {
@@ -78,6 +77,9 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 	}
 
 	glog.Infof("Upload pipeline called")
+	// The user is authenticated once, for the authorization and the audit
+	// events of the upload.
+	r = withHTTPAuthentication(s.resourceManager, r)
 	file, header, err := r.FormFile(FormFileKey)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline from file"))
@@ -100,6 +102,7 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 
 	err = s.canUploadVersionedPipeline(r, pipelineNamespace)
 	if err != nil {
+		s.recordAuditEvent(r, common.RbacResourceTypePipelines, "", pipelineNamespace, err)
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Authorization to namespace failed."))
 		return
 	}
@@ -117,9 +120,11 @@ func (s *PipelineUploadServer) UploadPipeline(w http.ResponseWriter, r *http.Req
 	}
 	newPipeline, err := s.resourceManager.CreatePipeline(pipelineName, pipelineDescription, pipelineNamespace, pipelineFile)
 	if err != nil {
+		s.recordAuditEvent(r, common.RbacResourceTypePipelines, "", pipelineNamespace, err)
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline"))
 		return
 	}
+	s.recordAuditEvent(r, common.RbacResourceTypePipelines, newPipeline.UUID, pipelineNamespace, nil)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -142,6 +147,9 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 	}
 
 	glog.Infof("Upload pipeline version called")
+	// The user is authenticated once, for the authorization and the audit
+	// events of the upload.
+	r = withHTTPAuthentication(s.resourceManager, r)
 	file, header, err := r.FormFile(FormFileKey)
 	if err != nil {
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Failed to read pipeline version from file"))
@@ -177,6 +185,7 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 
 	err = s.canUploadVersionedPipeline(r, namespace)
 	if err != nil {
+		s.recordAuditEvent(r, auditResourceTypePipelineVersions, "", namespace, err)
 		s.writeErrorToResponse(w, http.StatusBadRequest, util.Wrap(err, "Authorization to namespace failed."))
 		return
 	}
@@ -195,9 +204,11 @@ func (s *PipelineUploadServer) UploadPipelineVersion(w http.ResponseWriter, r *h
 			},
 		}, pipelineFile, common.IsPipelineVersionUpdatedByDefault())
 	if err != nil {
+		s.recordAuditEvent(r, auditResourceTypePipelineVersions, "", namespace, err)
 		s.writeErrorToResponse(w, http.StatusInternalServerError, util.Wrap(err, "Error creating pipeline version"))
 		return
 	}
+	s.recordAuditEvent(r, auditResourceTypePipelineVersions, newPipelineVersion.UUID, namespace, nil)
 
 	w.Header().Set("Content-Type", "application/json")
 	marshaler := &jsonpb.Marshaler{EnumsAsInts: false, OrigName: true}
@@ -221,7 +232,10 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, names
 	if namespace == "" {
 		return nil
 	}
-	userIdentityHeader := r.Header.Get(common.GetKubeflowUserIDHeader())
+	userInfo, err := authenticateHTTPRequest(s.resourceManager, r)
+	if err != nil {
+		return util.Wrap(err, "Authentication Failure.")
+	}
 	resourceAttributes := &authorizationv1.ResourceAttributes{
 		Namespace: namespace,
 		Verb:      common.RbacResourceVerbCreate,
@@ -229,13 +243,35 @@ func (s *PipelineUploadServer) canUploadVersionedPipeline(r *http.Request, names
 		Version:   common.RbacPipelinesVersion,
 		Resource:  common.RbacResourceTypePipelines,
 	}
-	err := s.resourceManager.IsRequestAuthorized(userIdentityHeader, resourceAttributes)
+	err = s.resourceManager.IsRequestAuthorized(r.Context(), userInfo, resourceAttributes)
 	if err != nil {
 		return util.Wrap(err, "Authorization Failure.")
 	}
 	return nil
 }
 
+// recordAuditEvent records the upload of a pipeline or a pipeline version,
+// which is audited like the mutating methods of the gRPC API.
+func (s *PipelineUploadServer) recordAuditEvent(r *http.Request, resourceType string, id string, namespace string, err error) {
+	user := ""
+	if userInfo, authErr := s.resourceManager.AuthenticateRequest(httpRequestContext(r)); authErr == nil && userInfo != nil {
+		user = userInfo.Name
+	}
+	outcome, message := auditOutcome(err)
+	_, recordErr := s.resourceManager.RecordAuditEvent(&model.AuditEvent{
+		User:         user,
+		Method:       r.URL.Path,
+		ResourceType: resourceType,
+		ResourceUUID: id,
+		Namespace:    namespace,
+		Outcome:      outcome,
+		Error:        message,
+	})
+	if recordErr != nil {
+		glog.Errorf("Failed to record audit event of %s. Error: %v", r.URL.Path, recordErr)
+	}
+}
+
 func (s *PipelineUploadServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
 	glog.Errorf("Failed to upload pipelines. Error: %+v", err)
 	w.WriteHeader(code)
@@ -16,6 +16,7 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"mime/multipart"
@@ -23,11 +24,14 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,7 +60,7 @@ func TestUploadPipeline_YAML(t *testing.T) {
 
 	// Verify stored in object store
 	objStore := clientManager.ObjectStore()
-	template, err := objStore.GetFile(objStore.GetPipelineKey(resource.DefaultFakeUUID))
+	template, err := objStore.GetFile(context.Background(), objStore.GetPipelineKey(resource.DefaultFakeUUID))
 	assert.Nil(t, err)
 	assert.NotNil(t, template)
 
@@ -97,7 +101,7 @@ func TestUploadPipeline_YAML(t *testing.T) {
 
 	// Verify stored in object store
 	objStore = clientManager.ObjectStore()
-	template, err = objStore.GetFile(objStore.GetPipelineKey(fakeVersionUUID))
+	template, err = objStore.GetFile(context.Background(), objStore.GetPipelineKey(fakeVersionUUID))
 	assert.Nil(t, err)
 	assert.NotNil(t, template)
 	opts, err = list.NewOptions(&model.PipelineVersion{}, 2, "", nil)
@@ -130,6 +134,86 @@ func TestUploadPipeline_YAML(t *testing.T) {
 	assert.Equal(t, versionsExpect, versions)
 }
 
+func TestUploadPipeline_PipelineSpecJSON(t *testing.T) {
+	clientManager, server := setupClientManagerAndServer()
+	pipelineJob := `{
+	  "pipelineSpec": {
+	    "pipelineInfo": {"name": "hello-world"},
+	    "root": {
+	      "inputDefinitions": {"parameters": {"text": {"type": "STRING"}}},
+	      "dag": {"tasks": {}}
+	    },
+	    "deploymentSpec": {"executors": {}}
+	  },
+	  "runtimeConfig": {"parameters": {"text": {"stringValue": "Hello world!"}}}
+	}`
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.json", pipelineJob, writer)
+	response := uploadPipeline("/apis/v1beta1/pipelines/upload",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.UploadPipeline)
+	assert.Equal(t, 200, response.Code)
+
+	// The pipeline spec is stored as is, and its parameters are typed.
+	objStore := clientManager.ObjectStore()
+	template, err := objStore.GetFile(context.Background(), objStore.GetPipelineKey(resource.DefaultFakeUUID))
+	assert.Nil(t, err)
+	assert.Equal(t, pipelineJob, string(template))
+	pipeline, err := clientManager.PipelineStore().GetPipeline(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"name":"text","type":"string","description":"STRING","default":"Hello world!"}]`, pipeline.Parameters)
+}
+
+func TestUploadPipeline_InvalidPipelineSpecJSON(t *testing.T) {
+	_, server := setupClientManagerAndServer()
+	bytesBuffer, writer := setupWriter("")
+	setWriterWithBuffer("uploadfile", "hello-world.json", `{"pipelineInfo": {"name": "hello-world"}}`, writer)
+	response := uploadPipeline("/apis/v1beta1/pipelines/upload",
+		bytes.NewReader(bytesBuffer.Bytes()), writer, server.UploadPipeline)
+	// The upload server reports errors creating a pipeline as internal errors.
+	assert.Equal(t, 500, response.Code)
+	assert.Contains(t, response.Body.String(), "neither a root DAG nor tasks")
+}
+
+func TestUploadPipeline_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, server := setupClientManagerAndServer()
+	defer clientManager.Close()
+	upload := func(server PipelineUploadServer) *httptest.ResponseRecorder {
+		bytesBuffer, writer := setupWriter("")
+		setWriterWithBuffer("uploadfile", "hello-world.yaml", "apiVersion: tekton.dev/v1beta1\nkind: PipelineRun", writer)
+		req, _ := http.NewRequest("POST", "/apis/v1beta1/pipelines/upload?namespace=ns1", bytes.NewReader(bytesBuffer.Bytes()))
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix()+"user@google.com")
+		rr := httptest.NewRecorder()
+		http.HandlerFunc(server.UploadPipeline).ServeHTTP(rr, req)
+		return rr
+	}
+
+	authenticator := &countingAuthenticator{Authenticator: kfpauth.NewHTTPHeaderAuthenticator(common.GetKubeflowUserIDHeader(), common.GetKubeflowUserIDPrefix())}
+	clientManager.AuthenticatorsFake = []kfpauth.Authenticator{authenticator}
+	server = PipelineUploadServer{resourceManager: resource.NewResourceManager(clientManager), options: &PipelineUploadServerOptions{CollectMetrics: false}}
+	response := upload(server)
+	assert.Equal(t, 200, response.Code)
+	// The user is authenticated once for the authorization and the audit event.
+	assert.Equal(t, 1, authenticator.calls)
+
+	// The user identity header is forged when only JWTs are trusted.
+	clientManager.AuthenticatorsFake = jwtOnlyAuthenticators()
+	server = PipelineUploadServer{resourceManager: resource.NewResourceManager(clientManager), options: &PipelineUploadServerOptions{CollectMetrics: false}}
+	response = upload(server)
+	assert.Equal(t, 400, response.Code)
+	assert.Contains(t, response.Body.String(), "Failed to authenticate the request")
+
+	viper.Set(common.MultiUserMode, "false")
+	events := listAuditEvents(t, server.resourceManager)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "user@google.com", events[0].User)
+	assert.Equal(t, api.AuditEvent_SUCCEEDED, events[0].Outcome)
+	assert.Equal(t, "", events[1].User)
+	assert.Equal(t, api.AuditEvent_DENIED, events[1].Outcome)
+}
+
 func updateClientManager(clientManager *resource.FakeClientManager, uuid util.UUIDGeneratorInterface) PipelineUploadServer {
 	clientManager.UpdateUUID(uuid)
 	resourceManager := resource.NewResourceManager(clientManager)
@@ -0,0 +1,100 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type QuotaServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *QuotaServer) UpdateNamespaceQuota(ctx context.Context, request *api.UpdateNamespaceQuotaRequest) (*api.NamespaceQuota, error) {
+	err := ValidateUpdateNamespaceQuotaRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate update namespace quota request failed.")
+	}
+	// Quotas are set by the administrators of a namespace rather than its users.
+	err = isAuthorized(s.resourceManager, ctx, &authorizationv1.ResourceAttributes{
+		Namespace: request.Namespace,
+		Verb:      common.RbacResourceVerbUpdate,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeQuotas,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	_, err = s.resourceManager.UpdateNamespaceQuota(&model.NamespaceQuota{
+		Namespace:      request.Namespace,
+		MaxActiveRuns:  request.Quota.GetMaxActiveRuns(),
+		MaxRunsPerHour: request.Quota.GetMaxRunsPerHour(),
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Update namespace quota failed.")
+	}
+	return s.getNamespaceQuota(request.Namespace)
+}
+
+func (s *QuotaServer) GetNamespaceQuota(ctx context.Context, request *api.GetNamespaceQuotaRequest) (*api.NamespaceQuota, error) {
+	if request.Namespace == "" {
+		return nil, util.NewInvalidInputError("Namespace is empty. Please specify a valid namespace.")
+	}
+	// The quota of a namespace is visible to those who can list its runs.
+	err := isAuthorized(s.resourceManager, ctx, &authorizationv1.ResourceAttributes{
+		Namespace: request.Namespace,
+		Verb:      common.RbacResourceVerbList,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+	})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return s.getNamespaceQuota(request.Namespace)
+}
+
+func (s *QuotaServer) getNamespaceQuota(namespace string) (*api.NamespaceQuota, error) {
+	quota, usage, err := s.resourceManager.GetNamespaceQuota(namespace)
+	if err != nil {
+		return nil, util.Wrap(err, "Get namespace quota failed.")
+	}
+	return ToApiNamespaceQuota(quota, usage), nil
+}
+
+func ValidateUpdateNamespaceQuotaRequest(request *api.UpdateNamespaceQuotaRequest) error {
+	if request.Namespace == "" {
+		return util.NewInvalidInputError("Namespace is empty. Please specify a valid namespace.")
+	}
+	if request.Quota == nil {
+		return util.NewInvalidInputError("The quota is empty. Please specify a valid quota.")
+	}
+	if request.Quota.MaxActiveRuns < 0 || request.Quota.MaxRunsPerHour < 0 {
+		return util.NewInvalidInputError("The limits of a quota must not be negative.")
+	}
+	return nil
+}
+
+func NewQuotaServer(resourceManager *resource.ResourceManager) *QuotaServer {
+	return &QuotaServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestQuotaServer(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewQuotaServer(manager)
+
+	// A namespace without a quota has no limits.
+	quota, err := server.GetNamespaceQuota(nil, &api.GetNamespaceQuotaRequest{Namespace: "ns1"})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.NamespaceQuota{Namespace: "ns1", Usage: &api.QuotaUsage{}}, quota)
+
+	quota, err = server.UpdateNamespaceQuota(nil, &api.UpdateNamespaceQuotaRequest{
+		Namespace: "ns1",
+		Quota:     &api.NamespaceQuota{MaxActiveRuns: 2, MaxRunsPerHour: 10},
+	})
+	assert.Nil(t, err)
+	expected := &api.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 2, MaxRunsPerHour: 10, Usage: &api.QuotaUsage{}}
+	assert.Equal(t, expected, quota)
+	quota, err = server.GetNamespaceQuota(nil, &api.GetNamespaceQuotaRequest{Namespace: "ns1"})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, quota)
+}
+
+func TestValidateUpdateNamespaceQuotaRequest(t *testing.T) {
+	err := ValidateUpdateNamespaceQuotaRequest(&api.UpdateNamespaceQuotaRequest{Quota: &api.NamespaceQuota{}})
+	AssertUserError(t, err, codes.InvalidArgument)
+	err = ValidateUpdateNamespaceQuotaRequest(&api.UpdateNamespaceQuotaRequest{Namespace: "ns1"})
+	AssertUserError(t, err, codes.InvalidArgument)
+	err = ValidateUpdateNamespaceQuotaRequest(&api.UpdateNamespaceQuotaRequest{
+		Namespace: "ns1",
+		Quota:     &api.NamespaceQuota{MaxActiveRuns: -1},
+	})
+	AssertUserError(t, err, codes.InvalidArgument)
+	err = ValidateUpdateNamespaceQuotaRequest(&api.UpdateNamespaceQuotaRequest{
+		Namespace: "ns1",
+		Quota:     &api.NamespaceQuota{MaxActiveRuns: 0, MaxRunsPerHour: 5},
+	})
+	assert.Nil(t, err)
+}
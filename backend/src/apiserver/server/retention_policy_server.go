@@ -0,0 +1,214 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type RetentionPolicyServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+func (s *RetentionPolicyServer) CreateRetentionPolicy(ctx context.Context, request *api.CreateRetentionPolicyRequest) (
+	*api.RetentionPolicy, error) {
+	err := ValidateCreateRetentionPolicyRequest(request)
+	if err != nil {
+		return nil, util.Wrap(err, "Validate create retention policy request failed.")
+	}
+	err = s.canAccessRetentionPolicyResource(ctx, request.RetentionPolicy.ResourceKey, common.RbacResourceVerbUpdate)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	err = s.canEnforceRetentionPolicy(ctx, request.RetentionPolicy)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	newPolicy, err := s.resourceManager.CreateRetentionPolicy(request.RetentionPolicy)
+	if err != nil {
+		return nil, util.Wrap(err, "Create retention policy failed.")
+	}
+	return ToApiRetentionPolicy(newPolicy), nil
+}
+
+func (s *RetentionPolicyServer) GetRetentionPolicy(ctx context.Context, request *api.GetRetentionPolicyRequest) (
+	*api.RetentionPolicy, error) {
+	policy, err := s.canAccessRetentionPolicy(ctx, request.Id, common.RbacResourceVerbGet)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	return ToApiRetentionPolicy(policy), nil
+}
+
+func (s *RetentionPolicyServer) ListRetentionPolicies(ctx context.Context, request *api.ListRetentionPoliciesRequest) (
+	*api.ListRetentionPoliciesResponse, error) {
+	opts, err := validatedListOptions(&model.RetentionPolicy{}, request.PageToken, int(request.PageSize), request.SortBy, request.Filter)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
+
+	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
+	if err != nil {
+		return nil, util.Wrap(err, "Validating filter failed.")
+	}
+	if common.IsMultiUserMode() {
+		if request.ResourceReferenceKey == nil {
+			return nil, util.NewInvalidInputError("ListRetentionPolicies requires filtering by experiment or namespace in multi-user mode.")
+		}
+		err = s.canAccessRetentionPolicyResource(ctx, request.ResourceReferenceKey, common.RbacResourceVerbList)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize with API resource references")
+		}
+	}
+
+	policies, total_size, nextPageToken, err := s.resourceManager.ListRetentionPolicies(filterContext, opts)
+	if err != nil {
+		return nil, util.Wrap(err, "List retention policies failed.")
+	}
+	return &api.ListRetentionPoliciesResponse{
+		RetentionPolicies: ToApiRetentionPolicies(policies),
+		TotalSize:         int32(total_size),
+		NextPageToken:     nextPageToken,
+	}, nil
+}
+
+func (s *RetentionPolicyServer) DeleteRetentionPolicy(ctx context.Context, request *api.DeleteRetentionPolicyRequest) (
+	*empty.Empty, error) {
+	_, err := s.canAccessRetentionPolicy(ctx, request.Id, common.RbacResourceVerbUpdate)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+	err = s.resourceManager.DeleteRetentionPolicy(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func ValidateCreateRetentionPolicyRequest(request *api.CreateRetentionPolicyRequest) error {
+	policy := request.GetRetentionPolicy()
+	if policy == nil {
+		return util.NewInvalidInputError("The retention policy is empty. Please specify a valid retention policy.")
+	}
+	key := policy.GetResourceKey()
+	if key == nil || key.Id == "" {
+		return util.NewInvalidInputError("Resource key is empty. Please specify the experiment or namespace of the retention policy.")
+	}
+	switch key.Type {
+	case api.ResourceType_EXPERIMENT:
+	case api.ResourceType_NAMESPACE:
+		if !common.IsMultiUserMode() {
+			return util.NewInvalidInputError("Retention policies of namespaces are only supported in multi-user mode.")
+		}
+	default:
+		return util.NewInvalidInputError("Retention policies are not supported on resource type %v.", key.Type)
+	}
+	if policy.ArchiveAfterDays < 0 || policy.DeleteAfterDays < 0 {
+		return util.NewInvalidInputError("The number of days must not be negative.")
+	}
+	if policy.ArchiveAfterDays == 0 && policy.DeleteAfterDays == 0 {
+		return util.NewInvalidInputError("At least one of archive_after_days and delete_after_days must be specified.")
+	}
+	if policy.ArchiveAfterDays > 0 && policy.DeleteAfterDays > 0 && policy.DeleteAfterDays <= policy.ArchiveAfterDays {
+		return util.NewInvalidInputError("delete_after_days must be greater than archive_after_days.")
+	}
+	return nil
+}
+
+// canAccessRetentionPolicy authorizes verb on the experiment or namespace of
+// a retention policy, and returns the policy.
+func (s *RetentionPolicyServer) canAccessRetentionPolicy(ctx context.Context, policyId string, verb string) (*model.RetentionPolicy, error) {
+	policy, err := s.resourceManager.GetRetentionPolicy(policyId)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to get retention policy")
+	}
+	key := &api.ResourceKey{Id: policy.ResourceUUID, Type: api.ResourceType_EXPERIMENT}
+	if policy.ResourceType == common.Namespace {
+		key.Type = api.ResourceType_NAMESPACE
+	}
+	err = s.canAccessRetentionPolicyResource(ctx, key, verb)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// canAccessRetentionPolicyResource authorizes verb on the experiment or on the
+// experiments of the namespace identified by key.
+func (s *RetentionPolicyServer) canAccessRetentionPolicyResource(ctx context.Context, key *api.ResourceKey, verb string) error {
+	experimentServer := &ExperimentServer{resourceManager: s.resourceManager}
+	switch key.GetType() {
+	case api.ResourceType_EXPERIMENT:
+		return experimentServer.canAccessExperiment(ctx, key.Id, &authorizationv1.ResourceAttributes{Verb: verb})
+	case api.ResourceType_NAMESPACE:
+		if key.Id == "" {
+			return util.NewInvalidInputError("Namespace is empty. Please specify a valid namespace.")
+		}
+		return experimentServer.canAccessExperiment(ctx, "", &authorizationv1.ResourceAttributes{Namespace: key.Id, Verb: verb})
+	default:
+		return util.NewInvalidInputError("Retention policies are not supported on resource type %v.", key.GetType())
+	}
+}
+
+// canEnforceRetentionPolicy authorizes the verbs on runs that a retention
+// policy exercises on behalf of its creator, in the namespace of the policy:
+// deleting runs if the policy deletes runs and archiving runs if it archives
+// runs.
+func (s *RetentionPolicyServer) canEnforceRetentionPolicy(ctx context.Context, policy *api.RetentionPolicy) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+	namespace := policy.ResourceKey.Id
+	if policy.ResourceKey.Type == api.ResourceType_EXPERIMENT {
+		experiment, err := s.resourceManager.GetExperiment(policy.ResourceKey.Id)
+		if err != nil {
+			return util.Wrap(err, "Failed to authorize with the experiment ID.")
+		}
+		namespace = experiment.Namespace
+	}
+	var verbs []string
+	if policy.DeleteAfterDays > 0 {
+		verbs = append(verbs, common.RbacResourceVerbDelete)
+	}
+	if policy.ArchiveAfterDays > 0 {
+		verbs = append(verbs, common.RbacResourceVerbArchive)
+	}
+	for _, verb := range verbs {
+		err := isAuthorized(s.resourceManager, ctx, &authorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     common.RbacPipelinesGroup,
+			Version:   common.RbacPipelinesVersion,
+			Resource:  common.RbacResourceTypeRuns,
+		})
+		if err != nil {
+			return util.Wrap(err, "Failed to authorize the runs of the retention policy")
+		}
+	}
+	return nil
+}
+
+func NewRetentionPolicyServer(resourceManager *resource.ResourceManager) *RetentionPolicyServer {
+	return &RetentionPolicyServer{resourceManager: resourceManager}
+}
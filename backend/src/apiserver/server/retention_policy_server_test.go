@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRetentionPolicyServer(t *testing.T) {
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRetentionPolicyServer(manager)
+
+	policy, err := server.CreateRetentionPolicy(nil, &api.CreateRetentionPolicyRequest{
+		RetentionPolicy: &api.RetentionPolicy{
+			ResourceKey:      &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			ArchiveAfterDays: 7,
+			DeleteAfterDays:  30,
+		},
+	})
+	assert.Nil(t, err)
+	expected := &api.RetentionPolicy{
+		Id:               resource.DefaultFakeUUID,
+		ResourceKey:      &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+		ArchiveAfterDays: 7,
+		DeleteAfterDays:  30,
+		CreatedAt:        &timestamp.Timestamp{Seconds: 2},
+	}
+	assert.Equal(t, expected, policy)
+
+	policy, err = server.GetRetentionPolicy(nil, &api.GetRetentionPolicyRequest{Id: policy.Id})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, policy)
+
+	listResponse, err := server.ListRetentionPolicies(nil, &api.ListRetentionPoliciesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, &api.ListRetentionPoliciesResponse{
+		RetentionPolicies: []*api.RetentionPolicy{expected},
+		TotalSize:         1,
+	}, listResponse)
+
+	_, err = server.DeleteRetentionPolicy(nil, &api.DeleteRetentionPolicyRequest{Id: policy.Id})
+	assert.Nil(t, err)
+	_, err = server.GetRetentionPolicy(nil, &api.GetRetentionPolicyRequest{Id: policy.Id})
+	AssertUserError(t, err, codes.NotFound)
+	_, err = server.DeleteRetentionPolicy(nil, &api.DeleteRetentionPolicyRequest{Id: policy.Id})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestCreateRetentionPolicy_ExperimentNotFound(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRetentionPolicyServer(manager)
+
+	_, err := server.CreateRetentionPolicy(nil, &api.CreateRetentionPolicyRequest{
+		RetentionPolicy: &api.RetentionPolicy{
+			ResourceKey:     &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "unknown"},
+			DeleteAfterDays: 30,
+		},
+	})
+	AssertUserError(t, err, codes.NotFound)
+}
+
+func TestValidateCreateRetentionPolicyRequest(t *testing.T) {
+	experimentKey := &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: "exp1"}
+	tests := []struct {
+		name     string
+		policy   *api.RetentionPolicy
+		errorMsg string
+	}{
+		{"valid", &api.RetentionPolicy{ResourceKey: experimentKey, ArchiveAfterDays: 1, DeleteAfterDays: 2}, ""},
+		{"archive only", &api.RetentionPolicy{ResourceKey: experimentKey, ArchiveAfterDays: 1}, ""},
+		{"empty policy", nil, "The retention policy is empty"},
+		{"no resource key", &api.RetentionPolicy{DeleteAfterDays: 1}, "Resource key is empty"},
+		{
+			"namespace in single-user mode",
+			&api.RetentionPolicy{ResourceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"}, DeleteAfterDays: 1},
+			"only supported in multi-user mode",
+		},
+		{
+			"unsupported resource type",
+			&api.RetentionPolicy{ResourceKey: &api.ResourceKey{Type: api.ResourceType_JOB, Id: "job1"}, DeleteAfterDays: 1},
+			"not supported on resource type",
+		},
+		{"negative days", &api.RetentionPolicy{ResourceKey: experimentKey, DeleteAfterDays: -1}, "must not be negative"},
+		{"no days", &api.RetentionPolicy{ResourceKey: experimentKey}, "At least one of"},
+		{
+			"delete before archive",
+			&api.RetentionPolicy{ResourceKey: experimentKey, ArchiveAfterDays: 7, DeleteAfterDays: 7},
+			"must be greater than archive_after_days",
+		},
+	}
+	for _, tc := range tests {
+		err := ValidateCreateRetentionPolicyRequest(&api.CreateRetentionPolicyRequest{RetentionPolicy: tc.policy})
+		if tc.errorMsg == "" {
+			assert.Nil(t, err, tc.name)
+		} else {
+			assert.NotNil(t, err, tc.name)
+			assert.Contains(t, err.Error(), tc.errorMsg, tc.name)
+		}
+	}
+}
+
+func TestRetentionPolicyServer_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRetentionPolicyServer(manager)
+
+	namespaceKey := &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"}
+	policy, err := server.CreateRetentionPolicy(ctx, &api.CreateRetentionPolicyRequest{
+		RetentionPolicy: &api.RetentionPolicy{ResourceKey: namespaceKey, DeleteAfterDays: 30},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, namespaceKey, policy.ResourceKey)
+
+	_, err = server.ListRetentionPolicies(ctx, &api.ListRetentionPoliciesRequest{})
+	AssertUserError(t, err, codes.InvalidArgument)
+	listResponse, err := server.ListRetentionPolicies(ctx, &api.ListRetentionPoliciesRequest{ResourceReferenceKey: namespaceKey})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), listResponse.TotalSize)
+}
+
+func TestRetentionPolicyServer_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, experiment := initWithExperiment_SubjectAccessReview_Unauthorized(t)
+	defer clients.Close()
+	server := NewRetentionPolicyServer(manager)
+
+	_, err := server.CreateRetentionPolicy(ctx, &api.CreateRetentionPolicyRequest{
+		RetentionPolicy: &api.RetentionPolicy{
+			ResourceKey:     &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+			DeleteAfterDays: 30,
+		},
+	})
+	AssertUserError(t, err, codes.PermissionDenied)
+}
+
+// fakeSubjectAccessReviewClientDenyingRuns denies the requests on runs.
+type fakeSubjectAccessReviewClientDenyingRuns struct{}
+
+func (fakeSubjectAccessReviewClientDenyingRuns) Create(ctx context.Context, review *authorizationv1.SubjectAccessReview,
+	options metav1.CreateOptions) (*authorizationv1.SubjectAccessReview, error) {
+	allowed := review.Spec.ResourceAttributes.Resource != common.RbacResourceTypeRuns
+	return &authorizationv1.SubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+}
+
+func TestCreateRetentionPolicy_RunsUnauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clients, manager, experiment := initWithExperiment(t)
+	defer clients.Close()
+	clients.SubjectAccessReviewClientFake = fakeSubjectAccessReviewClientDenyingRuns{}
+	manager = resource.NewResourceManager(clients)
+	server := NewRetentionPolicyServer(manager)
+
+	// Updating the experiment is allowed, but deleting or archiving its runs
+	// is not.
+	for _, policy := range []*api.RetentionPolicy{
+		{ResourceKey: &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID}, DeleteAfterDays: 30},
+		{ResourceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"}, ArchiveAfterDays: 30},
+	} {
+		_, err := server.CreateRetentionPolicy(ctx, &api.CreateRetentionPolicyRequest{RetentionPolicy: policy})
+		AssertUserError(t, err, codes.PermissionDenied)
+	}
+}
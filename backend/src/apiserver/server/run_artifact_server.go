@@ -0,0 +1,145 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+const ArtifactNameKey = "artifact_name"
+
+type RunArtifactServer struct {
+	resourceManager *resource.ResourceManager
+}
+
+// Artifact download endpoint
+// This endpoint is not exposed through grpc endpoint, since grpc-gateway cannot stream the content of large artifacts.
+// It supports range requests, so interrupted downloads can be resumed.
+func (s *RunArtifactServer) DownloadArtifact(w http.ResponseWriter, r *http.Request) {
+	glog.Infof("Download artifact called")
+
+	vars := mux.Vars(r)
+	runId, ok := vars[RunKey]
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s')", RunKey))
+		return
+	}
+	nodeId, ok := vars[NodeKey]
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s')", NodeKey))
+		return
+	}
+	artifactName, ok := vars[ArtifactNameKey]
+	if !ok {
+		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s')", ArtifactNameKey))
+		return
+	}
+
+	if err := canReadRun(s.resourceManager, r, runId); err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
+
+	reader, info, err := s.resourceManager.OpenArtifact(runId, nodeId, artifactName)
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), util.Wrapf(err, "Failed to read artifact %s", artifactName))
+		return
+	}
+	defer reader.Close()
+
+	fileName := path.Base(info.Key)
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("Cache-Control", "no-cache, private")
+	if info.ETag != "" {
+		w.Header().Set("ETag", strconv.Quote(info.ETag))
+		// Content-MD5 is the checksum of the whole content, so it is only sent
+		// with complete responses.
+		if checksum, err := hex.DecodeString(info.ETag); err == nil && len(checksum) == 16 && r.Header.Get("Range") == "" {
+			w.Header().Set("Content-MD5", base64.StdEncoding.EncodeToString(checksum))
+		}
+	}
+	// ServeContent handles the Range, If-Range and conditional request headers.
+	http.ServeContent(w, r, fileName, info.LastModified, reader)
+}
+
+// canReadRun authorizes reading the artifacts or the logs of a run over HTTP
+// in multi-user mode.
+func canReadRun(resourceManager *resource.ResourceManager, r *http.Request, runId string) error {
+	if !common.IsMultiUserMode() {
+		return nil
+	}
+	runDetail, err := resourceManager.GetRun(runId)
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize with the run ID.")
+	}
+	userInfo, err := authenticateHTTPRequest(resourceManager, r)
+	if err != nil {
+		return err
+	}
+	resourceAttributes := &authorizationv1.ResourceAttributes{
+		Namespace: runDetail.Namespace,
+		Verb:      common.RbacResourceVerbGet,
+		Group:     common.RbacPipelinesGroup,
+		Version:   common.RbacPipelinesVersion,
+		Resource:  common.RbacResourceTypeRuns,
+		Name:      runDetail.Name,
+	}
+	return resourceManager.IsRequestAuthorized(r.Context(), userInfo, resourceAttributes)
+}
+
+// httpStatusFromError returns the HTTP status of the code of a user error.
+func httpStatusFromError(err error) int {
+	if userError, ok := err.(*util.UserError); ok {
+		return runtime.HTTPStatusFromCode(userError.ExternalStatusCode())
+	}
+	return http.StatusInternalServerError
+}
+
+func (s *RunArtifactServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
+	glog.Errorf("Failed to download artifact. Error: %+v", err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	errorResponse := api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
+	errBytes, err := json.Marshal(errorResponse)
+	if err != nil {
+		w.Write([]byte("Error downloading artifact"))
+	}
+	w.Write(errBytes)
+}
+
+func NewRunArtifactServer(resourceManager *resource.ResourceManager) *RunArtifactServer {
+	return &RunArtifactServer{resourceManager: resourceManager}
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	authzv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testArtifactContent = "0123456789abcdefghij"
+
+// initWithArtifact creates a run with an artifact named artifact1 of node node1.
+func initWithArtifact(t *testing.T) (*resource.FakeClientManager, *RunArtifactServer, string) {
+	clientManager, manager, runDetail := initWithOneTimeRun(t)
+	workflow := util.NewWorkflow(&v1beta1.PipelineRun{
+		TypeMeta:   v1.TypeMeta{APIVersion: "tekton.dev/v1beta1", Kind: "PipelineRun"},
+		ObjectMeta: v1.ObjectMeta{Name: runDetail.Name, Namespace: "ns1"},
+		Status: v1beta1.PipelineRunStatus{
+			PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+				TaskRuns: map[string]*v1beta1.PipelineRunTaskRunStatus{"node1": {PipelineTaskName: "task1"}},
+			},
+		},
+	})
+	err := clientManager.RunStore().UpdateRun(runDetail.UUID, "Succeeded", 1, workflow.ToStringForStore())
+	assert.Nil(t, err)
+	artifactKey := util.ObjectStoreArtifactFolder(runDetail.Name) + "/node1/artifact1.tgz"
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte(testArtifactContent), artifactKey))
+	return clientManager, NewRunArtifactServer(manager), runDetail.UUID
+}
+
+func downloadArtifact(server *RunArtifactServer, runId string, artifactName string, header http.Header) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/artifacts/{artifact_name}:download", server.DownloadArtifact)
+	req, _ := http.NewRequest("GET", "/apis/v1beta1/runs/"+runId+"/nodes/node1/artifacts/"+artifactName+":download", nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	clientManager, server, runId := initWithArtifact(t)
+	defer clientManager.Close()
+
+	rr := downloadArtifact(server, runId, "artifact1", nil)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, testArtifactContent, rr.Body.String())
+	assert.Equal(t, "application/octet-stream", rr.Header().Get("Content-Type"))
+	assert.Equal(t, strconv.Itoa(len(testArtifactContent)), rr.Header().Get("Content-Length"))
+	assert.Equal(t, "attachment; filename=artifact1.tgz", rr.Header().Get("Content-Disposition"))
+	assert.Equal(t, "bytes", rr.Header().Get("Accept-Ranges"))
+	sum := md5.Sum([]byte(testArtifactContent))
+	assert.Equal(t, strconv.Quote(hex.EncodeToString(sum[:])), rr.Header().Get("ETag"))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), rr.Header().Get("Content-MD5"))
+}
+
+func TestDownloadArtifact_Range(t *testing.T) {
+	clientManager, server, runId := initWithArtifact(t)
+	defer clientManager.Close()
+
+	rr := downloadArtifact(server, runId, "artifact1", http.Header{"Range": {"bytes=5-9"}})
+	assert.Equal(t, http.StatusPartialContent, rr.Code)
+	assert.Equal(t, "56789", rr.Body.String())
+	assert.Equal(t, "bytes 5-9/20", rr.Header().Get("Content-Range"))
+	// The checksum of the whole content does not apply to a part of it.
+	assert.Equal(t, "", rr.Header().Get("Content-MD5"))
+
+	rr = downloadArtifact(server, runId, "artifact1", http.Header{"Range": {"bytes=30-"}})
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, rr.Code)
+
+	// A range is ignored if the artifact changed since the client started downloading it.
+	rr = downloadArtifact(server, runId, "artifact1", http.Header{"Range": {"bytes=5-9"}, "If-Range": {`"stale"`}})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, testArtifactContent, rr.Body.String())
+}
+
+func TestDownloadArtifact_NotFound(t *testing.T) {
+	clientManager, server, runId := initWithArtifact(t)
+	defer clientManager.Close()
+
+	rr := downloadArtifact(server, runId, "artifact2", nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	rr = downloadArtifact(server, "run2", "artifact1", nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+// jwtOnlyAuthenticators returns the authenticators of an API server which only
+// trusts JWTs.
+func jwtOnlyAuthenticators() []kfpauth.Authenticator {
+	clock := util.NewFakeTimeForEpoch()
+	keySet := kfpauth.NewJWKSKeySet("https://issuer.example.com/jwks", time.Hour, clock)
+	return []kfpauth.Authenticator{kfpauth.NewJWTAuthenticator(
+		common.AuthorizationBearerTokenHeader,
+		common.AuthorizationBearerTokenPrefix,
+		kfpauth.JWTAuthenticatorOptions{Issuer: "https://issuer.example.com", UsernameClaim: "email"},
+		keySet,
+		clock,
+	)}
+}
+
+func TestDownloadArtifact_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, server, runId := initWithArtifact(t)
+	defer clientManager.Close()
+	userHeader := http.Header{common.GetKubeflowUserIDHeader(): {common.GetKubeflowUserIDPrefix() + "user@google.com"}}
+
+	rr := downloadArtifact(server, runId, "artifact1", userHeader)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = downloadArtifact(server, runId, "artifact1", nil)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	// The user identity header is forged when only JWTs are trusted.
+	clientManager.AuthenticatorsFake = jwtOnlyAuthenticators()
+	server = NewRunArtifactServer(resource.NewResourceManager(clientManager))
+	rr = downloadArtifact(server, runId, "artifact1", userHeader)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+// recordingSubjectAccessReviewClient records the SubjectAccessReviews, and
+// allows them all but those in deniedNamespaces.
+type recordingSubjectAccessReviewClient struct {
+	reviews          []*authzv1.SubjectAccessReview
+	deniedNamespaces map[string]bool
+}
+
+func (c *recordingSubjectAccessReviewClient) Create(ctx context.Context, review *authzv1.SubjectAccessReview, options v1.CreateOptions) (*authzv1.SubjectAccessReview, error) {
+	c.reviews = append(c.reviews, review)
+	allowed := !c.deniedNamespaces[review.Spec.ResourceAttributes.Namespace]
+	return &authzv1.SubjectAccessReview{Status: authzv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+}
+
+func TestDownloadArtifact_UserInfo(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, _, runId := initWithArtifact(t)
+	defer clientManager.Close()
+	sarClient := &recordingSubjectAccessReviewClient{}
+	clientManager.SubjectAccessReviewClientFake = sarClient
+	server := NewRunArtifactServer(resource.NewResourceManager(clientManager))
+
+	// The fake TokenReview authenticates the token as the user test.
+	rr := downloadArtifact(server, runId, "artifact1", http.Header{
+		common.AuthorizationBearerTokenHeader: {common.AuthorizationBearerTokenPrefix + "token"},
+	})
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Len(t, sarClient.reviews, 1)
+	assert.Equal(t, "test", sarClient.reviews[0].Spec.User)
+	assert.Equal(t, []string{"test-group"}, sarClient.reviews[0].Spec.Groups)
+	assert.Equal(t, map[string]authzv1.ExtraValue{"scopes": {"read"}}, sarClient.reviews[0].Spec.Extra)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang/glog"
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"google.golang.org/grpc/codes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// The maximum number of runs processed by a batch run operation.
+const maxBatchRunsSize = 1000
+
+// selectBatchRuns returns the runs selected by either ids or filterProto
+// within filterContext, and the total number of selected runs. At most
+// maxBatchRunsSize runs are returned.
+func (s *RunServer) selectBatchRuns(ids []string, filterProto *api.Filter, filterContext *common.FilterContext) ([]*model.Run, int, error) {
+	if (len(ids) == 0) == (filterProto == nil) {
+		return nil, 0, util.NewInvalidInputError("Exactly one of ids and filter must be specified.")
+	}
+	if len(ids) > maxBatchRunsSize {
+		return nil, 0, util.NewInvalidInputError("Too many run IDs. Support maximum %v runs per request.", maxBatchRunsSize)
+	}
+	if filterProto != nil && len(filterProto.GetPredicates()) == 0 && len(filterProto.GetFilters()) == 0 {
+		return nil, 0, util.NewInvalidInputError("The filter is empty. Please specify at least one predicate.")
+	}
+	if len(ids) > 0 {
+		filterProto = &api.Filter{
+			Predicates: []*api.Predicate{{
+				Key:   "id",
+				Op:    api.Predicate_IN,
+				Value: &api.Predicate_StringValues{StringValues: &api.StringValues{Values: ids}},
+			}},
+		}
+	}
+
+	opts, err := list.NewOptions(&model.Run{}, maxBatchRunsSize, "", filterProto)
+	if err != nil {
+		return nil, 0, util.Wrap(err, "Failed to create list options")
+	}
+	var runs []*model.Run
+	totalSize := 0
+	for {
+		page, size, nextPageToken, err := s.resourceManager.ListRuns(filterContext, opts)
+		if err != nil {
+			return nil, 0, util.Wrap(err, "Failed to list runs")
+		}
+		runs = append(runs, page...)
+		totalSize = size
+		if nextPageToken == "" || len(runs) >= maxBatchRunsSize {
+			break
+		}
+		opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, maxBatchRunsSize)
+		if err != nil {
+			return nil, 0, util.Wrap(err, "Failed to create list options")
+		}
+	}
+	if len(runs) > maxBatchRunsSize {
+		runs = runs[:maxBatchRunsSize]
+	}
+	return runs, totalSize, nil
+}
+
+// batchRuns applies operation to the runs selected by either ids or
+// filterProto, after authorizing verb on the namespace of each run. In
+// multi-user mode, runs selected by a filter must be scoped to the namespace
+// referenced by referenceKey. Runs that the caller cannot access are reported
+// as such when selected by ids, and skipped when selected by a filter.
+func (s *RunServer) batchRuns(ctx context.Context, ids []string, filterProto *api.Filter, referenceKey *api.ResourceKey,
+	dryRun bool, verb string, operation func(runId string) error) (*api.BatchRunsResponse, error) {
+	filterContext, err := ValidateFilter(referenceKey)
+	if err != nil {
+		return nil, util.Wrap(err, "Validating filter failed.")
+	}
+	if common.IsMultiUserMode() && filterProto != nil {
+		refKey := filterContext.ReferenceKey
+		if refKey == nil || refKey.Type != common.Namespace {
+			return nil, util.NewInvalidInputError("Batch run operations with a filter must filter by namespace resource reference in multi-user mode.")
+		}
+		if len(refKey.ID) == 0 {
+			return nil, util.NewInvalidInputError("Invalid resource references for batch run operations. Namespace is empty.")
+		}
+		err = s.canAccessRunsInNamespace(ctx, refKey.ID, verb)
+		if err != nil {
+			return nil, util.Wrap(err, "Failed to authorize with namespace resource reference.")
+		}
+	}
+	runs, totalSize, err := s.selectBatchRuns(ids, filterProto, filterContext)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &api.BatchRunsResponse{
+		Results: []*api.BatchRunsResponse_BatchRunResult{},
+		DryRun:  dryRun,
+	}
+	authorized := make(map[string]error)
+	found := make(map[string]bool)
+	for _, run := range runs {
+		found[run.UUID] = true
+		err, ok := authorized[run.Namespace]
+		if !ok {
+			err = s.canAccessRunsInNamespace(ctx, run.Namespace, verb)
+			if userError, ok := err.(*util.UserError); ok && userError.ExternalStatusCode() == codes.Unauthenticated {
+				return nil, util.Wrap(err, "Failed to authorize the request")
+			}
+			authorized[run.Namespace] = err
+		}
+		if err != nil && len(ids) == 0 {
+			totalSize--
+			continue
+		}
+		if err == nil && !dryRun {
+			err = operation(run.UUID)
+		}
+		runName := run.DisplayName
+		if err != nil {
+			// Don't reveal the names of runs the caller cannot access.
+			runName = ""
+		}
+		response.Results = append(response.Results, newBatchRunResult(run.UUID, runName, err))
+	}
+	for _, id := range ids {
+		if !found[id] {
+			found[id] = true
+			response.Results = append(response.Results,
+				newBatchRunResult(id, "", util.NewResourceNotFoundError("Run", id)))
+			totalSize++
+		}
+	}
+	response.TotalSize = int32(totalSize)
+	return response, nil
+}
+
+func (s *RunServer) canAccessRunsInNamespace(ctx context.Context, namespace string, verb string) error {
+	if !common.IsMultiUserMode() {
+		// Skip authorization if not multi-user mode.
+		return nil
+	}
+	if len(namespace) == 0 {
+		return util.NewInternalServerError(
+			errors.New("Empty namespace"),
+			"The run doesn't have a valid namespace.",
+		)
+	}
+	return canAccessRun(s.resourceManager, ctx, "", &authorizationv1.ResourceAttributes{Namespace: namespace, Verb: verb})
+}
+
+// newBatchRunResult turns error into a BatchRunResult.
+func newBatchRunResult(runId string, runName string, err error) *api.BatchRunsResponse_BatchRunResult {
+	result := &api.BatchRunsResponse_BatchRunResult{
+		RunId:   runId,
+		RunName: runName,
+	}
+	if err == nil {
+		result.Status = api.BatchRunsResponse_BatchRunResult_OK
+		return result
+	}
+	userError, ok := err.(*util.UserError)
+	if !ok {
+		result.Status = api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR
+		return result
+	}
+	switch userError.ExternalStatusCode() {
+	case codes.NotFound:
+		result.Status = api.BatchRunsResponse_BatchRunResult_NOT_FOUND
+	case codes.PermissionDenied, codes.Unauthenticated:
+		result.Status = api.BatchRunsResponse_BatchRunResult_PERMISSION_DENIED
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		result.Status = api.BatchRunsResponse_BatchRunResult_INVALID_ARGUMENT
+	default:
+		result.Status = api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR
+	}
+	result.Message = userError.ExternalMessage()
+	if result.Status == api.BatchRunsResponse_BatchRunResult_INTERNAL_ERROR {
+		glog.Errorf("Internal error '%v' when operating on run '%s'", err, runId)
+	}
+	return result
+}
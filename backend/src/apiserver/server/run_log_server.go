@@ -17,19 +17,32 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
 )
 
-// These are valid conditions of a ScheduledWorkflow.
+// These are the path and query parameters of the log endpoint.
 const (
-	RunKey  = "run_id"
-	NodeKey = "node_id"
-	Follow  = "follow"
+	RunKey        = "run_id"
+	NodeKey       = "node_id"
+	Follow        = "follow"
+	StepKey       = "step"
+	ContainerKey  = "container"
+	TailLinesKey  = "tailLines"
+	SinceTimeKey  = "sinceTime"
+	TimestampsKey = "timestamps"
+	FormatKey     = "format"
 )
 
 type RunLogServer struct {
@@ -49,6 +62,10 @@ func (s *RunLogServer) ReadRunLog(w http.ResponseWriter, r *http.Request) {
 		s.writeErrorToResponse(w, http.StatusBadRequest, fmt.Errorf("missing path parameter: '%s')", RunKey))
 		return
 	}
+	if err := canReadRun(s.resourceManager, r, runId); err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), util.Wrap(err, "Failed to authorize the request"))
+		return
+	}
 
 	nodeId, ok := vars[NodeKey]
 	if !ok {
@@ -56,20 +73,150 @@ func (s *RunLogServer) ReadRunLog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	follow := vars[Follow] == "true" // defaults to false
+	follow, opts, err := parseReadLogOptions(r.URL.Query())
+	if err != nil {
+		s.writeErrorToResponse(w, http.StatusBadRequest, err)
+		return
+	}
 
+	// The status is only sent once the log is open, so that errors opening it
+	// are reported with their status.
+	reader, err := s.resourceManager.OpenLog(runId, nodeId, follow, opts)
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+		return
+	}
+	defer reader.Close()
+
+	contentType := "text/plain"
+	if opts.LogFormat == archive.LogFormatJSON {
+		contentType = "application/x-ndjson"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache, private")
 	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, reader); err != nil {
+		glog.Errorf("Failed to stream the log of node %s of run %s. Error: %+v", nodeId, runId, err)
+	}
+}
+
+// Run logs endpoint
+// It streams the logs of all the steps of all the tasks of a run, interleaved
+// in timestamp order and prefixed by [task/step].
+func (s *RunLogServer) ReadRunLogs(w http.ResponseWriter, r *http.Request) {
+	glog.Infof("Read run logs called")
+
+	runId, timestamps, err := s.parseRunLogsRequest(r)
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+		return
+	}
+
+	// The logs are only written once they are all read, so errors can still
+	// be reported with their status.
 	w.Header().Set("Content-Type", "text/plain")
 	w.Header().Set("Cache-Control", "no-cache, private")
+	if err := s.resourceManager.ReadRunLogs(runId, timestamps, w); err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+	}
+}
+
+// Run logs download endpoint
+// It downloads the logs of all the steps of all the tasks of a run as a tar.gz
+// archive, with one file per step.
+func (s *RunLogServer) DownloadRunLogs(w http.ResponseWriter, r *http.Request) {
+	glog.Infof("Download run logs called")
+
+	runId, timestamps, err := s.parseRunLogsRequest(r)
+	if err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": runId + "-logs.tar.gz"}))
+	w.Header().Set("Cache-Control", "no-cache, private")
+	if err := s.resourceManager.DownloadRunLogs(runId, timestamps, w); err != nil {
+		s.writeErrorToResponse(w, httpStatusFromError(err), err)
+	}
+}
 
-	err := s.resourceManager.ReadLog(runId, nodeId, follow, w)
+func (s *RunLogServer) parseRunLogsRequest(r *http.Request) (string, bool, error) {
+	runId, ok := mux.Vars(r)[RunKey]
+	if !ok {
+		return "", false, util.NewInvalidInputError("missing path parameter: '%s'", RunKey)
+	}
+	if err := canReadRun(s.resourceManager, r, runId); err != nil {
+		return "", false, util.Wrap(err, "Failed to authorize the request")
+	}
+	timestamps, err := parseBoolQuery(r.URL.Query(), TimestampsKey)
+	if err != nil {
+		return "", false, util.NewInvalidInputError(err.Error())
+	}
+	return runId, timestamps, nil
+}
+
+// parseReadLogOptions parses the query parameters selecting the container, the
+// lines and the format of a log.
+func parseReadLogOptions(query url.Values) (bool, archive.ExtractLogOptions, error) {
+	opts := archive.ExtractLogOptions{LogFormat: archive.LogFormatText}
+	follow, err := parseBoolQuery(query, Follow)
+	if err != nil {
+		return false, opts, err
+	}
+	if opts.Timestamps, err = parseBoolQuery(query, TimestampsKey); err != nil {
+		return false, opts, err
+	}
+
+	step, container := query.Get(StepKey), query.Get(ContainerKey)
+	if step != "" && container != "" {
+		return false, opts, fmt.Errorf("query parameters '%s' and '%s' cannot be both set", StepKey, ContainerKey)
+	}
+	opts.Container = container
+	if step != "" {
+		// Tekton runs each step in a container named after it.
+		opts.Container = "step-" + step
+	}
+
+	if value := query.Get(TailLinesKey); value != "" {
+		tailLines, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || tailLines < 0 {
+			return false, opts, fmt.Errorf("invalid query parameter '%s': %q is not a non-negative integer", TailLinesKey, value)
+		}
+		opts.TailLines = &tailLines
+	}
+	if value := query.Get(SinceTimeKey); value != "" {
+		sinceTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return false, opts, fmt.Errorf("invalid query parameter '%s': %q is not an RFC 3339 time", SinceTimeKey, value)
+		}
+		opts.SinceTime = &sinceTime
+	}
+	switch format := archive.LogFormat(query.Get(FormatKey)); format {
+	case "", archive.LogFormatText:
+	case archive.LogFormatJSON:
+		opts.LogFormat = format
+	default:
+		return false, opts, fmt.Errorf("invalid query parameter '%s': %q is neither %q nor %q", FormatKey, format, archive.LogFormatText, archive.LogFormatJSON)
+	}
+	return follow, opts, nil
+}
+
+func parseBoolQuery(query url.Values, key string) (bool, error) {
+	value := query.Get(key)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
 	if err != nil {
-		s.writeErrorToResponse(w, http.StatusInternalServerError, err)
+		return false, fmt.Errorf("invalid query parameter '%s': %q is not a boolean", key, value)
 	}
+	return b, nil
 }
 
 func (s *RunLogServer) writeErrorToResponse(w http.ResponseWriter, code int, err error) {
 	glog.Errorf("Failed to read run log. Error: %+v", err)
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(code)
 	errorResponse := api.Error{ErrorMessage: err.Error(), ErrorDetails: fmt.Sprintf("%+v", err)}
 	errBytes, err := json.Marshal(errorResponse)
@@ -0,0 +1,186 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/archive"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// initWithRunLogs creates a run of a task named task1, whose pod is gone and
+// whose log is archived.
+func initWithRunLogs(t *testing.T) (*resource.FakeClientManager, *RunLogServer, string) {
+	clientManager, manager, runDetail := initWithOneTimeRun(t)
+	workflow := util.NewWorkflow(&v1beta1.PipelineRun{
+		TypeMeta:   v1.TypeMeta{APIVersion: "tekton.dev/v1beta1", Kind: "PipelineRun"},
+		ObjectMeta: v1.ObjectMeta{Name: runDetail.Name, Namespace: "ns1"},
+		Status: v1beta1.PipelineRunStatus{
+			PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+				TaskRuns: map[string]*v1beta1.PipelineRunTaskRunStatus{"node1": {
+					PipelineTaskName: "task1",
+					Status: &v1beta1.TaskRunStatus{TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+						PodName: "pod1",
+					}},
+				}},
+			},
+		},
+	})
+	err := clientManager.RunStore().UpdateRun(runDetail.UUID, "Succeeded", 1, workflow.ToStringForStore())
+	assert.Nil(t, err)
+	logKey, err := clientManager.LogArchive().GetLogObjectKey(workflow, "pod1")
+	assert.Nil(t, err)
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte("2021-03-01T10:00:00Z hello\n"), logKey))
+	return clientManager, NewRunLogServer(manager), runDetail.UUID
+}
+
+func getRunLogs(server *RunLogServer, path string) *httptest.ResponseRecorder {
+	return getRunLogsWithHeader(server, path, nil)
+}
+
+func getRunLogsWithHeader(server *RunLogServer, path string, header http.Header) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}/nodes/{node_id}/log", server.ReadRunLog)
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}/log", server.ReadRunLogs)
+	router.HandleFunc("/apis/v1beta1/runs/{run_id}/log:download", server.DownloadRunLogs)
+	req, _ := http.NewRequest("GET", path, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestReadRunLog(t *testing.T) {
+	clientManager, server, runId := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	rr := getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/nodes/pod1/log?timestamps=true")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "2021-03-01T10:00:00Z hello\n", rr.Body.String())
+
+	// The error opening the log is reported with its status.
+	rr = getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/nodes/pod2/log")
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	rr = getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/nodes/pod1/log?follow=maybe")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestReadRunLog_MultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	clientManager, server, runId := initWithRunLogs(t)
+	defer clientManager.Close()
+	userHeader := http.Header{common.GetKubeflowUserIDHeader(): {common.GetKubeflowUserIDPrefix() + "user@google.com"}}
+
+	rr := getRunLogsWithHeader(server, "/apis/v1beta1/runs/"+runId+"/nodes/pod1/log", userHeader)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "hello\n", rr.Body.String())
+
+	// The caller is authenticated before the request is validated.
+	rr = getRunLogsWithHeader(server, "/apis/v1beta1/runs/"+runId+"/nodes/pod1/log?follow=maybe", nil)
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "hello")
+}
+
+func TestReadRunLogs(t *testing.T) {
+	clientManager, server, runId := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	rr := getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/log?timestamps=true")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/plain", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "[task1/main] 2021-03-01T10:00:00Z hello\n", rr.Body.String())
+
+	rr = getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/log?timestamps=maybe")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestDownloadRunLogs(t *testing.T) {
+	clientManager, server, runId := initWithRunLogs(t)
+	defer clientManager.Close()
+
+	rr := getRunLogs(server, "/apis/v1beta1/runs/"+runId+"/log:download")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/gzip", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename="+runId+"-logs.tar.gz", rr.Header().Get("Content-Disposition"))
+	gr, err := gzip.NewReader(rr.Body)
+	assert.Nil(t, err)
+	header, err := tar.NewReader(gr).Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "task1/main.log", header.Name)
+	assert.Equal(t, int64(len("hello\n")), header.Size)
+}
+
+func TestParseReadLogOptions(t *testing.T) {
+	follow, opts, err := parseReadLogOptions(url.Values{})
+	assert.Nil(t, err)
+	assert.False(t, follow)
+	assert.Equal(t, archive.ExtractLogOptions{LogFormat: archive.LogFormatText}, opts)
+
+	query, err := url.ParseQuery("follow=true&step=prepare&tailLines=20&sinceTime=2021-03-01T10:00:00Z&timestamps=true&format=json-lines")
+	assert.Nil(t, err)
+	follow, opts, err = parseReadLogOptions(query)
+	assert.Nil(t, err)
+	assert.True(t, follow)
+	tailLines := int64(20)
+	sinceTime := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, archive.ExtractLogOptions{
+		LogFormat:  archive.LogFormatJSON,
+		Timestamps: true,
+		Container:  "step-prepare",
+		TailLines:  &tailLines,
+		SinceTime:  &sinceTime,
+	}, opts)
+
+	_, opts, err = parseReadLogOptions(url.Values{ContainerKey: {"istio-proxy"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "istio-proxy", opts.Container)
+}
+
+func TestParseReadLogOptions_Invalid(t *testing.T) {
+	for _, query := range []string{
+		"follow=yes",
+		"step=main&container=step-main",
+		"tailLines=-1",
+		"tailLines=ten",
+		"sinceTime=yesterday",
+		"format=xml",
+	} {
+		values, err := url.ParseQuery(query)
+		assert.Nil(t, err)
+		_, _, err = parseReadLogOptions(values)
+		assert.NotNil(t, err, query)
+	}
+}
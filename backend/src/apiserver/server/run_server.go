@@ -23,7 +23,6 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
-	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	authorizationv1 "k8s.io/api/authorization/v1"
@@ -72,6 +71,11 @@ var (
 		Help: "The total number of ReadArtifact requests",
 	})
 
+	listRunArtifactsRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_list_artifacts_requests",
+		Help: "The total number of ListRunArtifacts requests",
+	})
+
 	terminateRunRequests = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "run_server_terminate_requests",
 		Help: "The total number of TerminateRun requests",
@@ -82,7 +86,20 @@ var (
 		Help: "The total number of RetryRun requests",
 	})
 
-	// TODO(jingzhang36): error count and success count.
+	batchArchiveRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_archive_requests",
+		Help: "The total number of BatchArchiveRuns requests",
+	})
+
+	batchDeleteRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_delete_requests",
+		Help: "The total number of BatchDeleteRuns requests",
+	})
+
+	batchTerminateRunRequests = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "run_server_batch_terminate_requests",
+		Help: "The total number of BatchTerminateRuns requests",
+	})
 
 	runCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "run_server_run_count",
@@ -126,13 +143,13 @@ func (s *RunServer) CreateRun(ctx context.Context, request *api.CreateRunRequest
 			Verb:      common.RbacResourceVerbCreate,
 			Name:      request.Run.Name,
 		}
-		err = s.canAccessRun(ctx, "", resourceAttributes)
+		err = canAccessRun(s.resourceManager, ctx, "", resourceAttributes)
 		if err != nil {
 			return nil, util.Wrap(err, "Failed to authorize the request")
 		}
 	}
 
-	run, err := s.resourceManager.CreateRun(request.Run)
+	run, err := s.resourceManager.CreateRunWithIdempotencyKey(ctx, request.Run, request.IdempotencyKey)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create a new run.")
 	}
@@ -148,7 +165,7 @@ func (s *RunServer) GetRun(ctx context.Context, request *api.GetRunRequest) (*ap
 		getRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	err := canAccessRun(s.resourceManager, ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -169,6 +186,10 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to create list options")
 	}
+	opts.BasicView, err = isBasicListView(request.View)
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to create list options")
+	}
 
 	filterContext, err := ValidateFilter(request.ResourceReferenceKey)
 	if err != nil {
@@ -189,7 +210,7 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 				Namespace: namespace,
 				Verb:      common.RbacResourceVerbList,
 			}
-			err = s.canAccessRun(ctx, "", resourceAttributes)
+			err = canAccessRun(s.resourceManager, ctx, "", resourceAttributes)
 			if err != nil {
 				return nil, util.Wrap(err, "Failed to authorize with namespace resource reference.")
 			}
@@ -207,7 +228,7 @@ func (s *RunServer) ListRuns(ctx context.Context, request *api.ListRunsRequest)
 				Namespace: namespace,
 				Verb:      common.RbacResourceVerbList,
 			}
-			err = s.canAccessRun(ctx, "", resourceAttributes)
+			err = canAccessRun(s.resourceManager, ctx, "", resourceAttributes)
 			if err != nil {
 				return nil, util.Wrap(err, "Failed to authorize with namespace in experiment resource reference.")
 			}
@@ -228,7 +249,7 @@ func (s *RunServer) ArchiveRun(ctx context.Context, request *api.ArchiveRunReque
 		archiveRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbArchive})
+	err := canAccessRun(s.resourceManager, ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbArchive})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -244,7 +265,7 @@ func (s *RunServer) UnarchiveRun(ctx context.Context, request *api.UnarchiveRunR
 		unarchiveRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUnarchive})
+	err := canAccessRun(s.resourceManager, ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbUnarchive})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -260,7 +281,7 @@ func (s *RunServer) DeleteRun(ctx context.Context, request *api.DeleteRunRequest
 		deleteRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbDelete})
+	err := canAccessRun(s.resourceManager, ctx, request.Id, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbDelete})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -315,11 +336,34 @@ func (s *RunServer) ReadArtifact(ctx context.Context, request *api.ReadArtifactR
 	}, nil
 }
 
+func (s *RunServer) ListRunArtifacts(ctx context.Context, request *api.ListRunArtifactsRequest) (*api.ListRunArtifactsResponse, error) {
+	if s.options.CollectMetrics {
+		listRunArtifactsRequests.Inc()
+	}
+
+	err := canAccessRun(s.resourceManager, ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbGet})
+	if err != nil {
+		return nil, util.Wrap(err, "Failed to authorize the request")
+	}
+
+	artifacts, err := s.resourceManager.ListRunArtifacts(request.GetRunId(), request.GetTaskName())
+	if err != nil {
+		return nil, util.Wrapf(err, "failed to list the artifacts of run '%s'.", request.GetRunId())
+	}
+	return &api.ListRunArtifactsResponse{Artifacts: ToApiRunArtifacts(artifacts)}, nil
+}
+
 func (s *RunServer) validateCreateRunRequest(request *api.CreateRunRequest) error {
 	run := request.Run
 	if run.Name == "" {
 		return util.NewInvalidInputError("The run name is empty. Please specify a valid name.")
 	}
+	if err := validateLabels(run.GetLabels()); err != nil {
+		return err
+	}
+	if err := validateIdempotencyKey(request.IdempotencyKey); err != nil {
+		return err
+	}
 	return ValidatePipelineSpecAndResourceReferences(s.resourceManager, run.PipelineSpec, run.ResourceReferences)
 }
 
@@ -328,7 +372,7 @@ func (s *RunServer) TerminateRun(ctx context.Context, request *api.TerminateRunR
 		terminateRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbTerminate})
+	err := canAccessRun(s.resourceManager, ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbTerminate})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -344,7 +388,7 @@ func (s *RunServer) RetryRun(ctx context.Context, request *api.RetryRunRequest)
 		retryRunRequests.Inc()
 	}
 
-	err := s.canAccessRun(ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbRetry})
+	err := canAccessRun(s.resourceManager, ctx, request.RunId, &authorizationv1.ResourceAttributes{Verb: common.RbacResourceVerbRetry})
 	if err != nil {
 		return nil, util.Wrap(err, "Failed to authorize the request")
 	}
@@ -356,40 +400,40 @@ func (s *RunServer) RetryRun(ctx context.Context, request *api.RetryRunRequest)
 
 }
 
-func (s *RunServer) canAccessRun(ctx context.Context, runId string, resourceAttributes *authorizationv1.ResourceAttributes) error {
-	if common.IsMultiUserMode() == false {
-		// Skip authz if not multi-user mode.
-		return nil
+func (s *RunServer) BatchArchiveRuns(ctx context.Context, request *api.BatchArchiveRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchArchiveRunRequests.Inc()
 	}
 
-	if len(runId) > 0 {
-		runDetail, err := s.resourceManager.GetRun(runId)
-		if err != nil {
-			return util.Wrap(err, "Failed to authorize with the experiment ID.")
-		}
-		if len(resourceAttributes.Namespace) == 0 {
-			if len(runDetail.Namespace) == 0 {
-				return util.NewInternalServerError(
-					errors.New("Empty namespace"),
-					"The run doesn't have a valid namespace.",
-				)
-			}
-			resourceAttributes.Namespace = runDetail.Namespace
-		}
-		if len(resourceAttributes.Name) == 0 {
-			resourceAttributes.Name = runDetail.Name
-		}
+	return s.batchRuns(ctx, request.GetIds(), request.GetFilter(), request.GetResourceReferenceKey(),
+		request.GetDryRun(), common.RbacResourceVerbArchive,
+		s.resourceManager.ArchiveRun)
+}
+
+func (s *RunServer) BatchDeleteRuns(ctx context.Context, request *api.BatchDeleteRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchDeleteRunRequests.Inc()
 	}
 
-	resourceAttributes.Group = common.RbacPipelinesGroup
-	resourceAttributes.Version = common.RbacPipelinesVersion
-	resourceAttributes.Resource = common.RbacResourceTypeRuns
+	return s.batchRuns(ctx, request.GetIds(), request.GetFilter(), request.GetResourceReferenceKey(),
+		request.GetDryRun(), common.RbacResourceVerbDelete,
+		func(runId string) error {
+			err := s.resourceManager.DeleteRun(runId)
+			if err == nil && s.options.CollectMetrics {
+				runCount.Dec()
+			}
+			return err
+		})
+}
 
-	err := isAuthorized(s.resourceManager, ctx, resourceAttributes)
-	if err != nil {
-		return util.Wrap(err, "Failed to authorize with API resource references")
+func (s *RunServer) BatchTerminateRuns(ctx context.Context, request *api.BatchTerminateRunsRequest) (*api.BatchRunsResponse, error) {
+	if s.options.CollectMetrics {
+		batchTerminateRunRequests.Inc()
 	}
-	return nil
+
+	return s.batchRuns(ctx, request.GetIds(), request.GetFilter(), request.GetResourceReferenceKey(),
+		request.GetDryRun(), common.RbacResourceVerbTerminate,
+		s.resourceManager.TerminateRun)
 }
 
 func NewRunServer(resourceManager *resource.ResourceManager, options *RunServerOptions) *RunServer {
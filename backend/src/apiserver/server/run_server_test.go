@@ -2,17 +2,22 @@ package server
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/client"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Converted argo v1alpha1.workflow to tekton v1beta1.pipelinerun
@@ -29,7 +34,7 @@ func TestCreateRun(t *testing.T) {
 			WorkflowManifest: testWorkflow.ToStringForStore(),
 		},
 	}
-	_, err := server.CreateRun(nil, &api.CreateRunRequest{Run: run})
+	_, err := server.CreateRun(context.Background(), &api.CreateRunRequest{Run: run})
 	assert.Nil(t, err)
 
 	expectedRuntimeWorkflow := testWorkflow.DeepCopy()
@@ -49,7 +54,7 @@ func TestCreateRunPatch(t *testing.T) {
 			WorkflowManifest: testWorkflowPatch.ToStringForStore(),
 		},
 	}
-	_, err := server.CreateRun(nil, &api.CreateRunRequest{Run: run})
+	_, err := server.CreateRun(context.Background(), &api.CreateRunRequest{Run: run})
 	assert.Nil(t, err)
 
 	expectedRuntimeWorkflow := testWorkflowPatch.DeepCopy()
@@ -135,11 +140,40 @@ func TestListRun(t *testing.T) {
 			WorkflowManifest: testWorkflow.ToStringForStore(),
 		},
 	}
-	_, err := server.CreateRun(nil, &api.CreateRunRequest{Run: run})
+	_, err := server.CreateRun(context.Background(), &api.CreateRunRequest{Run: run})
 	assert.Nil(t, err)
 
 }
 
+func TestListRuns_View(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	run := &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+	}
+	_, err := server.CreateRun(context.Background(), &api.CreateRunRequest{Run: run})
+	assert.Nil(t, err)
+
+	response, err := server.ListRuns(nil, &api.ListRunsRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response.Runs))
+	assert.NotEmpty(t, response.Runs[0].PipelineSpec.WorkflowManifest)
+
+	response, err = server.ListRuns(nil, &api.ListRunsRequest{View: api.ListView_BASIC})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response.Runs))
+	assert.Equal(t, "run1", response.Runs[0].Name)
+	assert.Empty(t, response.Runs[0].PipelineSpec.WorkflowManifest)
+
+	_, err = server.ListRuns(nil, &api.ListRunsRequest{View: api.ListView(100)})
+	AssertUserError(t, err, codes.InvalidArgument)
+}
+
 func TestListRuns_Unauthorized(t *testing.T) {
 	viper.Set(common.MultiUserMode, "true")
 	defer viper.Set(common.MultiUserMode, "false")
@@ -221,6 +255,24 @@ func TestValidateCreateRunRequest_EmptyName(t *testing.T) {
 	assert.Contains(t, err.Error(), "The run name is empty")
 }
 
+func TestValidateCreateRunRequest_IdempotencyKeyTooLong(t *testing.T) {
+	clients, manager, _ := initWithExperiment(t)
+	defer clients.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	run := &api.Run{
+		Name:               "run1",
+		ResourceReferences: validReference,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+	}
+	err := server.validateCreateRunRequest(&api.CreateRunRequest{Run: run, IdempotencyKey: strings.Repeat("k", 128)})
+	assert.Nil(t, err)
+	err = server.validateCreateRunRequest(&api.CreateRunRequest{Run: run, IdempotencyKey: strings.Repeat("k", 129)})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "The idempotency key is longer than 128 characters")
+}
+
 func TestValidateCreateRunRequest_InvalidPipelineVersionReference(t *testing.T) {
 	clients, manager, _ := initWithExperiment(t)
 	defer clients.Close()
@@ -433,3 +485,292 @@ func TestReportRunMetrics_PartialFailures(t *testing.T) {
 }
 
 // Removed tests with old auth spec: "TestCanAccessRun_Unauthorized", "TestCanAccessRun_Authorized", "TestCanAccessRun_Unauthenticated"
+
+func initWithTwoRuns(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager) {
+	clientManager, _, runDetail := initWithOneTimeRun(t)
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(resource.NonDefaultFakeUUID, nil))
+	manager := resource.NewResourceManager(clientManager)
+	_, err := manager.CreateRun(context.Background(), &api.Run{
+		Name: "run2",
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: testWorkflow.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: runDetail.ExperimentUUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	return clientManager, manager
+}
+
+func TestBatchArchiveRuns_Ids(t *testing.T) {
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	response, err := server.BatchArchiveRuns(context.Background(), &api.BatchArchiveRunsRequest{
+		Ids: []string{resource.DefaultFakeUUID, "notexist"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), response.TotalSize)
+	assert.Equal(t, 2, len(response.Results))
+	assert.Equal(t, &api.BatchRunsResponse_BatchRunResult{
+		RunId:   resource.DefaultFakeUUID,
+		RunName: "run1",
+		Status:  api.BatchRunsResponse_BatchRunResult_OK,
+	}, response.Results[0])
+	assert.Equal(t, "notexist", response.Results[1].RunId)
+	assert.Equal(t, api.BatchRunsResponse_BatchRunResult_NOT_FOUND, response.Results[1].Status)
+
+	run, err := resourceManager.GetRun(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_ARCHIVED.String(), run.StorageState)
+	run, err = resourceManager.GetRun(resource.NonDefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+}
+
+func TestBatchArchiveRuns_DryRun(t *testing.T) {
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	response, err := server.BatchArchiveRuns(context.Background(), &api.BatchArchiveRunsRequest{
+		Ids:    []string{resource.DefaultFakeUUID, resource.NonDefaultFakeUUID},
+		DryRun: true,
+	})
+	assert.Nil(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, int32(2), response.TotalSize)
+	for _, result := range response.Results {
+		assert.Equal(t, api.BatchRunsResponse_BatchRunResult_OK, result.Status)
+	}
+
+	run, err := resourceManager.GetRun(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+}
+
+func TestBatchDeleteRuns_Filter(t *testing.T) {
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	response, err := server.BatchDeleteRuns(context.Background(), &api.BatchDeleteRunsRequest{
+		Filter: &api.Filter{
+			Predicates: []*api.Predicate{
+				{Key: "name", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "run2"}},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.TotalSize)
+	assert.Equal(t, []*api.BatchRunsResponse_BatchRunResult{{
+		RunId:   resource.NonDefaultFakeUUID,
+		RunName: "run2",
+		Status:  api.BatchRunsResponse_BatchRunResult_OK,
+	}}, response.Results)
+
+	_, err = resourceManager.GetRun(resource.NonDefaultFakeUUID)
+	AssertUserError(t, err, codes.NotFound)
+	_, err = resourceManager.GetRun(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+}
+
+func TestBatchTerminateRuns_DryRun(t *testing.T) {
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	response, err := server.BatchTerminateRuns(context.Background(), &api.BatchTerminateRunsRequest{
+		Ids:    []string{resource.DefaultFakeUUID, "notexist"},
+		DryRun: true,
+	})
+	assert.Nil(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, int32(2), response.TotalSize)
+	assert.Equal(t, api.BatchRunsResponse_BatchRunResult_OK, response.Results[0].Status)
+	assert.Equal(t, api.BatchRunsResponse_BatchRunResult_NOT_FOUND, response.Results[1].Status)
+
+	run, err := resourceManager.GetRun(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.NotEqual(t, model.RunTerminatingConditions, run.Conditions)
+}
+
+func TestBatchArchiveRuns_Unauthorized(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	clientManager.SubjectAccessReviewClientFake = client.NewFakeSubjectAccessReviewClientUnauthorized()
+	resourceManager = resource.NewResourceManager(clientManager)
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	// Runs selected by ID are reported as denied.
+	response, err := server.BatchArchiveRuns(ctx, &api.BatchArchiveRunsRequest{
+		Ids: []string{resource.DefaultFakeUUID},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.BatchRunsResponse_BatchRunResult{{
+		RunId:   resource.DefaultFakeUUID,
+		Status:  api.BatchRunsResponse_BatchRunResult_PERMISSION_DENIED,
+		Message: response.Results[0].Message,
+	}}, response.Results)
+
+	// Runs selected by filter are denied as a whole.
+	_, err = server.BatchArchiveRuns(ctx, &api.BatchArchiveRunsRequest{
+		Filter: &api.Filter{
+			Predicates: []*api.Predicate{
+				{Key: "name", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "run1"}},
+			},
+		},
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	AssertUserError(t, err, codes.PermissionDenied)
+
+	run, err := resourceManager.GetRun(resource.DefaultFakeUUID)
+	assert.Nil(t, err)
+	assert.Equal(t, api.Run_STORAGESTATE_AVAILABLE.String(), run.StorageState)
+}
+
+func TestBatchDeleteRuns_FilterMultiUser(t *testing.T) {
+	viper.Set(common.MultiUserMode, "true")
+	defer viper.Set(common.MultiUserMode, "false")
+	md := metadata.New(map[string]string{common.GoogleIAPUserIdentityHeader: common.GoogleIAPUserIdentityPrefix + "user@google.com"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	clientManager, _ := initWithTwoRuns(t)
+	defer clientManager.Close()
+	// Create a run with the same name in another namespace.
+	resourceManager, otherRun := addRunInNamespace(t, clientManager, "ns2", "run2",
+		"123e4567-e89b-12d3-a456-426655442000", "123e4567-e89b-12d3-a456-426655443000")
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+	filter := &api.Filter{
+		Predicates: []*api.Predicate{
+			{Key: "name", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "run2"}},
+		},
+	}
+
+	_, err := server.BatchDeleteRuns(ctx, &api.BatchDeleteRunsRequest{Filter: filter})
+	AssertUserError(t, err, codes.InvalidArgument)
+
+	response, err := server.BatchDeleteRuns(ctx, &api.BatchDeleteRunsRequest{
+		Filter:               filter,
+		ResourceReferenceKey: &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: "ns1"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), response.TotalSize)
+	assert.Equal(t, []*api.BatchRunsResponse_BatchRunResult{{
+		RunId:   resource.NonDefaultFakeUUID,
+		RunName: "run2",
+		Status:  api.BatchRunsResponse_BatchRunResult_OK,
+	}}, response.Results)
+
+	_, err = resourceManager.GetRun(resource.NonDefaultFakeUUID)
+	AssertUserError(t, err, codes.NotFound)
+	_, err = resourceManager.GetRun(otherRun.UUID)
+	assert.Nil(t, err)
+}
+
+func TestBatchRuns_InvalidRequest(t *testing.T) {
+	clientManager, resourceManager := initWithTwoRuns(t)
+	defer clientManager.Close()
+	server := NewRunServer(resourceManager, &RunServerOptions{CollectMetrics: false})
+
+	tooManyIds := make([]string, maxBatchRunsSize+1)
+	for i := range tooManyIds {
+		tooManyIds[i] = resource.DefaultFakeUUID
+	}
+	tests := []struct {
+		name    string
+		request *api.BatchArchiveRunsRequest
+		wantErr string
+	}{
+		{"neither ids nor filter", &api.BatchArchiveRunsRequest{}, "Exactly one of ids and filter"},
+		{
+			"both ids and filter",
+			&api.BatchArchiveRunsRequest{Ids: []string{resource.DefaultFakeUUID}, Filter: &api.Filter{}},
+			"Exactly one of ids and filter",
+		},
+		{"empty filter", &api.BatchArchiveRunsRequest{Filter: &api.Filter{}}, "The filter is empty"},
+		{"too many ids", &api.BatchArchiveRunsRequest{Ids: tooManyIds}, "Too many run IDs"},
+	}
+	for _, test := range tests {
+		_, err := server.BatchArchiveRuns(context.Background(), test.request)
+		assert.NotNil(t, err, test.name)
+		AssertUserError(t, err, codes.InvalidArgument)
+		assert.Contains(t, err.Error(), test.wantErr, test.name)
+	}
+}
+
+func TestListRunArtifacts(t *testing.T) {
+	clientManager, manager, runDetail := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	workflow := util.NewWorkflow(&v1beta1.PipelineRun{
+		TypeMeta: v1.TypeMeta{APIVersion: "tekton.dev/v1beta1", Kind: "PipelineRun"},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      runDetail.Name,
+			Namespace: "ns1",
+			Annotations: map[string]string{
+				common.ArtifactItemsAnnotation: `{"train": [["model", "$(results.model.path)"], ["mlpipeline-metrics", "/tmp/metrics.json"]]}`,
+			},
+		},
+	})
+	err := clientManager.RunStore().UpdateRun(runDetail.UUID, "Succeeded", 1, workflow.ToStringForStore())
+	assert.Nil(t, err)
+	artifactFolder := util.ObjectStoreArtifactFolder(runDetail.Name)
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte("model"), artifactFolder+"/train/model.tgz"))
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte("metrics"), artifactFolder+"/train/mlpipeline-metrics.tgz"))
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte("log"), artifactFolder+"/eval/main-log.tgz"))
+	assert.Nil(t, clientManager.ObjectStore().AddFile(context.Background(), []byte("other"), artifactFolder+"/eval/notes.txt"))
+
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+	response, err := server.ListRunArtifacts(context.Background(), &api.ListRunArtifactsRequest{RunId: runDetail.UUID})
+	assert.Nil(t, err)
+	assert.Equal(t, []*api.RunArtifact{
+		{
+			Name:      "main-log",
+			TaskName:  "eval",
+			ObjectKey: artifactFolder + "/eval/main-log.tgz",
+			SizeBytes: 3,
+			Type:      api.RunArtifact_LOG,
+		},
+		{
+			Name:       "mlpipeline-metrics",
+			TaskName:   "train",
+			ObjectKey:  artifactFolder + "/train/mlpipeline-metrics.tgz",
+			SizeBytes:  7,
+			Type:       api.RunArtifact_METRICS,
+			SourcePath: "/tmp/metrics.json",
+		},
+		{
+			Name:       "model",
+			TaskName:   "train",
+			ObjectKey:  artifactFolder + "/train/model.tgz",
+			SizeBytes:  5,
+			Type:       api.RunArtifact_DATA,
+			SourcePath: "$(results.model.path)",
+		},
+	}, response.Artifacts)
+
+	response, err = server.ListRunArtifacts(context.Background(), &api.ListRunArtifactsRequest{RunId: runDetail.UUID, TaskName: "eval"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(response.Artifacts))
+	assert.Equal(t, "main-log", response.Artifacts[0].Name)
+}
+
+func TestListRunArtifacts_RunNotFound(t *testing.T) {
+	clientManager, manager, _ := initWithOneTimeRun(t)
+	defer clientManager.Close()
+	server := NewRunServer(manager, &RunServerOptions{CollectMetrics: false})
+
+	_, err := server.ListRunArtifacts(context.Background(), &api.ListRunArtifactsRequest{RunId: "non-existent"})
+	assert.NotNil(t, err)
+	AssertUserError(t, err, codes.NotFound)
+}
@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"testing"
 
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
@@ -192,11 +193,49 @@ func initWithOneTimeRun(t *testing.T) (*resource.FakeClientManager, *resource.Re
 			},
 		},
 	}
-	runDetail, err := manager.CreateRun(apiRun)
+	runDetail, err := manager.CreateRun(context.Background(), apiRun)
 	assert.Nil(t, err)
 	return clientManager, manager, runDetail
 }
 
+// addRunInNamespace creates an experiment in namespace and a run named
+// runName in it, with the given UUIDs, and returns a resource manager using
+// the updated client manager.
+func addRunInNamespace(t *testing.T, clientManager *resource.FakeClientManager, namespace string, runName string,
+	experimentUUID string, runUUID string) (*resource.ResourceManager, *model.RunDetail) {
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(experimentUUID, nil))
+	manager := resource.NewResourceManager(clientManager)
+	experiment, err := manager.CreateExperiment(&api.Experiment{
+		Name: "exp-" + namespace,
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_NAMESPACE, Id: namespace},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	// The fake workflow client keeps the namespace of the workflow.
+	workflow := util.NewWorkflow(testWorkflow.DeepCopy())
+	workflow.Namespace = namespace
+	clientManager.UpdateUUID(util.NewFakeUUIDGeneratorOrFatal(runUUID, nil))
+	manager = resource.NewResourceManager(clientManager)
+	runDetail, err := manager.CreateRun(context.Background(), &api.Run{
+		Name: runName,
+		PipelineSpec: &api.PipelineSpec{
+			WorkflowManifest: workflow.ToStringForStore(),
+		},
+		ResourceReferences: []*api.ResourceReference{
+			{
+				Key:          &api.ResourceKey{Type: api.ResourceType_EXPERIMENT, Id: experiment.UUID},
+				Relationship: api.Relationship_OWNER,
+			},
+		},
+	})
+	assert.Nil(t, err)
+	return manager, runDetail
+}
+
 // Util function to create an initial state with pipeline uploaded
 func initWithPipeline(t *testing.T) (*resource.FakeClientManager, *resource.ResourceManager, *model.Pipeline) {
 	initEnvVars()
@@ -10,16 +10,21 @@ import (
 	"encoding/json"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/golang/glog"
 	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	kfpauth "github.com/kubeflow/pipelines/backend/src/apiserver/auth"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
 	"github.com/kubeflow/pipelines/backend/src/apiserver/resource"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // These are valid conditions of a ScheduledWorkflow.
@@ -60,13 +65,18 @@ func loadFile(fileReader io.Reader, maxFileLength int) ([]byte, error) {
 }
 
 func isSupportedPipelineFormat(fileName string, compressedFile []byte) bool {
-	return isYamlFile(fileName) || isCompressedTarballFile(compressedFile) || isZipFile(compressedFile)
+	return isYamlFile(fileName) || isJsonFile(fileName) || isCompressedTarballFile(compressedFile) || isZipFile(compressedFile)
 }
 
 func isYamlFile(fileName string) bool {
 	return strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml")
 }
 
+// A pipeline spec of KFP v2 is a JSON file.
+func isJsonFile(fileName string) bool {
+	return strings.HasSuffix(fileName, ".json")
+}
+
 func isPipelineYamlFile(fileName string) bool {
 	return fileName == "pipeline.yaml"
 }
@@ -168,12 +178,14 @@ func ReadPipelineFile(fileName string, fileReader io.Reader, maxFileLength int)
 	switch {
 	case isYamlFile(fileName):
 		processedFile = pipelineFileBytes
+	case isJsonFile(fileName):
+		processedFile = pipelineFileBytes
 	case isZipFile(pipelineFileBytes):
 		processedFile, err = DecompressPipelineZip(pipelineFileBytes)
 	case isCompressedTarballFile(pipelineFileBytes):
 		processedFile, err = DecompressPipelineTarball(pipelineFileBytes)
 	default:
-		return nil, util.NewInvalidInputError("Unexpected pipeline file format. Support .zip, .tar.gz or YAML.")
+		return nil, util.NewInvalidInputError("Unexpected pipeline file format. Support .zip, .tar.gz, YAML or KFP v2 pipeline spec JSON.")
 	}
 	if err != nil {
 		return nil, util.Wrap(err, "Error decompress the pipeline file")
@@ -262,6 +274,56 @@ func validateParameters(parameters []*api.Parameter) error {
 	return nil
 }
 
+// Label key prefixes reserved for the labels set by the system.
+var reservedLabelKeyDomains = []string{"kubeflow.org", "tekton.dev"}
+
+// validateLabels verifies that the labels are valid Kubernetes labels, and that
+// no label key uses a prefix reserved for the system.
+func validateLabels(labels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+		if errs := validation.IsValidLabelValue(labels[key]); len(errs) > 0 {
+			return util.NewInvalidInputError("Invalid value of label %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// maxIdempotencyKeyLength is the size of the idempotency key column.
+const maxIdempotencyKeyLength = 128
+
+func validateIdempotencyKey(idempotencyKey string) error {
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return util.NewInvalidInputError("The idempotency key is longer than %d characters.", maxIdempotencyKeyLength)
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return util.NewInvalidInputError("Invalid label key %q: %s", key, strings.Join(errs, "; "))
+	}
+	if i := strings.Index(key, "/"); i >= 0 {
+		prefix := key[:i]
+		if prefix == "pipeline" {
+			return util.NewInvalidInputError("Invalid label key %q: prefix %q is reserved", key, prefix)
+		}
+		for _, domain := range reservedLabelKeyDomains {
+			if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+				return util.NewInvalidInputError("Invalid label key %q: prefix %q is reserved", key, prefix)
+			}
+		}
+	}
+	return nil
+}
+
 func validatePipelineId(resourceManager *resource.ResourceManager, pipelineId string) error {
 	if pipelineId != "" {
 		// Verify pipeline exist
@@ -274,6 +336,10 @@ func validatePipelineId(resourceManager *resource.ResourceManager, pipelineId st
 
 func validateWorkflowManifest(workflowManifest string) error {
 	if workflowManifest != "" {
+		if util.IsPipelineSpec([]byte(workflowManifest)) {
+			_, err := util.ValidatePipelineSpec([]byte(workflowManifest))
+			return err
+		}
 		// Verify valid workflow template
 		var workflow util.Workflow
 		if err := json.Unmarshal([]byte(workflowManifest), &workflow); err != nil {
@@ -294,6 +360,76 @@ func getPipelineVersionIdFromResourceReferences(resourceManager *resource.Resour
 	return pipelineVersionId
 }
 
+// httpRequestContext returns the context of an HTTP request served outside of
+// gRPC, with the headers of the request as the incoming metadata, which the
+// authenticators read like they do for the gRPC requests.
+func httpRequestContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for name, values := range r.Header {
+		md.Append(name, values...)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+// withHTTPAuthentication authenticates an HTTP request served outside of gRPC
+// once, for its authorization and its audit events.
+func withHTTPAuthentication(resourceManager *resource.ResourceManager, r *http.Request) *http.Request {
+	ctx, _, _ := resourceManager.AuthenticateRequestOnce(httpRequestContext(r))
+	return r.WithContext(ctx)
+}
+
+// authenticateHTTPRequest returns the user of an HTTP request served outside of
+// gRPC, authenticated by the authenticators of the API server.
+func authenticateHTTPRequest(resourceManager *resource.ResourceManager, r *http.Request) (*kfpauth.UserInfo, error) {
+	userInfo, err := resourceManager.AuthenticateRequest(httpRequestContext(r))
+	if err != nil {
+		return nil, util.NewUnauthenticatedError(err, "Failed to authenticate the request.")
+	}
+	if len(userInfo.Name) == 0 {
+		return nil, util.NewUnauthenticatedError(errors.New("Request header error: user identity is empty."), "Request header error: user identity is empty.")
+	}
+	return userInfo, nil
+}
+
+// canAccessRun verifies whether the user identity in the context can perform
+// some action (verb) on a run. When runId is set, the namespace and name of the
+// run are filled into the resource attributes.
+func canAccessRun(resourceManager *resource.ResourceManager, ctx context.Context, runId string, resourceAttributes *authorizationv1.ResourceAttributes) error {
+	if common.IsMultiUserMode() == false {
+		// Skip authz if not multi-user mode.
+		return nil
+	}
+
+	if len(runId) > 0 {
+		runDetail, err := resourceManager.GetRun(runId)
+		if err != nil {
+			return util.Wrap(err, "Failed to authorize with the experiment ID.")
+		}
+		if len(resourceAttributes.Namespace) == 0 {
+			if len(runDetail.Namespace) == 0 {
+				return util.NewInternalServerError(
+					errors.New("Empty namespace"),
+					"The run doesn't have a valid namespace.",
+				)
+			}
+			resourceAttributes.Namespace = runDetail.Namespace
+		}
+		if len(resourceAttributes.Name) == 0 {
+			resourceAttributes.Name = runDetail.Name
+		}
+	}
+
+	resourceAttributes.Group = common.RbacPipelinesGroup
+	resourceAttributes.Version = common.RbacPipelinesVersion
+	resourceAttributes.Resource = common.RbacResourceTypeRuns
+
+	err := isAuthorized(resourceManager, ctx, resourceAttributes)
+	if err != nil {
+		return util.Wrap(err, "Failed to authorize with API resource references")
+	}
+	return nil
+}
+
 // isAuthorized verifies whether the user identity, which is contained in the context object,
 // can perform some action (verb) on a resource (resourceType/resourceName) living in the
 // target namespace. If the returned error is nil, the authorization passes. Otherwise,
@@ -311,23 +447,23 @@ func isAuthorized(resourceManager *resource.ResourceManager, ctx context.Context
 	}
 
 	glog.Info("Getting user identity...")
-	userIdentity, err := resourceManager.AuthenticateRequest(ctx)
+	userInfo, err := resourceManager.AuthenticateRequest(ctx)
 	if err != nil {
 		return err
 	}
 
-	if len(userIdentity) == 0 {
+	if len(userInfo.Name) == 0 {
 		return util.NewUnauthenticatedError(errors.New("Request header error: user identity is empty."), "Request header error: user identity is empty.")
 	}
 
-	glog.Infof("User: %s, ResourceAttributes: %+v", userIdentity, resourceAttributes)
+	glog.Infof("User: %s, Groups: %v, ResourceAttributes: %+v", userInfo.Name, userInfo.Groups, resourceAttributes)
 	glog.Info("Authorizing request...")
-	err = resourceManager.IsRequestAuthorized(userIdentity, resourceAttributes)
+	err = resourceManager.IsRequestAuthorized(ctx, userInfo, resourceAttributes)
 	if err != nil {
 		glog.Info(err.Error())
 		return err
 	}
 
-	glog.Infof("Authorized user '%s': %+v", userIdentity, resourceAttributes)
+	glog.Infof("Authorized user '%s': %+v", userInfo.Name, resourceAttributes)
 	return nil
 }
@@ -0,0 +1,193 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type AuditEventStoreInterface interface {
+	ListAuditEvents(filterContext *common.FilterContext, opts *list.Options) ([]*model.AuditEvent, int, string, error)
+	// Record an audit event. Its ID and creation time are generated.
+	CreateAuditEvent(*model.AuditEvent) (*model.AuditEvent, error)
+}
+
+type AuditEventStore struct {
+	db   *DB
+	time util.TimeInterface
+	uuid util.UUIDGeneratorInterface
+}
+
+var (
+	auditEventColumns = []string{
+		"UUID",
+		"CreatedAtInSec",
+		"User",
+		"Method",
+		"ResourceType",
+		"ResourceUUID",
+		"Namespace",
+		"Outcome",
+		"Error",
+	}
+)
+
+// Runs two SQL queries in a transaction to return a list of matching audit events, as well as their
+// total_size. The total_size does not reflect the page size.
+func (s *AuditEventStore) ListAuditEvents(filterContext *common.FilterContext, opts *list.Options) ([]*model.AuditEvent, int, string, error) {
+	errorF := func(err error) ([]*model.AuditEvent, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list audit events: %v", err)
+	}
+
+	// SQL for getting the filtered and paginated rows
+	sqlBuilder := s.filterByNamespace(sq.Select(auditEventColumns...).From("audit_events"), filterContext)
+	sqlBuilder = opts.AddFilterToSelect(sqlBuilder)
+
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// SQL for getting total size. This matches the query to get all the rows above, in order
+	// to do the same filter, but counts instead of scanning the rows.
+	sqlBuilder = s.filterByNamespace(sq.Select("count(*)").From("audit_events"), filterContext)
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		glog.Errorf("Failed to start transaction to list audit events")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	events, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	total_size, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		glog.Errorf("Failed to commit transaction to list audit events")
+		return errorF(err)
+	}
+
+	if len(events) <= opts.PageSize {
+		return events, total_size, "", nil
+	}
+
+	npt, err := opts.NextPageToken(events[opts.PageSize])
+	return events[:opts.PageSize], total_size, npt, err
+}
+
+func (s *AuditEventStore) filterByNamespace(sqlBuilder sq.SelectBuilder, filterContext *common.FilterContext) sq.SelectBuilder {
+	if filterContext.ReferenceKey != nil && filterContext.ReferenceKey.Type == common.Namespace {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{"Namespace": filterContext.ReferenceKey.ID})
+	}
+	return sqlBuilder
+}
+
+func (s *AuditEventStore) scanRows(rows *sql.Rows) ([]*model.AuditEvent, error) {
+	var events []*model.AuditEvent
+	for rows.Next() {
+		var uuid, user, method, resourceType, resourceUUID, namespace, outcome, eventError string
+		var createdAtInSec int64
+		err := rows.Scan(&uuid, &createdAtInSec, &user, &method, &resourceType, &resourceUUID, &namespace,
+			&outcome, &eventError)
+		if err != nil {
+			return events, err
+		}
+		events = append(events, &model.AuditEvent{
+			UUID:           uuid,
+			CreatedAtInSec: createdAtInSec,
+			User:           user,
+			Method:         method,
+			ResourceType:   resourceType,
+			ResourceUUID:   resourceUUID,
+			Namespace:      namespace,
+			Outcome:        outcome,
+			Error:          eventError,
+		})
+	}
+	return events, nil
+}
+
+func (s *AuditEventStore) CreateAuditEvent(event *model.AuditEvent) (*model.AuditEvent, error) {
+	newEvent := *event
+	newEvent.CreatedAtInSec = s.time.Now().Unix()
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create an audit event id.")
+	}
+	newEvent.UUID = id.String()
+
+	sql, args, err := sq.
+		Insert("audit_events").
+		SetMap(sq.Eq{
+			"UUID":           newEvent.UUID,
+			"CreatedAtInSec": newEvent.CreatedAtInSec,
+			"User":           newEvent.User,
+			"Method":         newEvent.Method,
+			"ResourceType":   newEvent.ResourceType,
+			"ResourceUUID":   newEvent.ResourceUUID,
+			"Namespace":      newEvent.Namespace,
+			"Outcome":        newEvent.Outcome,
+			"Error":          newEvent.Error,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert audit event to table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to add audit event to audit event table: %v",
+			err.Error())
+	}
+	return &newEvent, nil
+}
+
+// factory function for audit event store
+func NewAuditEventStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *AuditEventStore {
+	return &AuditEventStore{db: db, time: time, uuid: uuid}
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewAuditEventStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+
+	event, err := store.CreateAuditEvent(&model.AuditEvent{
+		User:         "user@example.com",
+		Method:       "/api.RunService/DeleteRun",
+		ResourceType: common.RbacResourceTypeRuns,
+		ResourceUUID: "run1",
+		Namespace:    "ns1",
+		Outcome:      model.AuditOutcomeSucceeded,
+	})
+	assert.Nil(t, err)
+	expected := &model.AuditEvent{
+		UUID:           fakeID,
+		CreatedAtInSec: 1,
+		User:           "user@example.com",
+		Method:         "/api.RunService/DeleteRun",
+		ResourceType:   common.RbacResourceTypeRuns,
+		ResourceUUID:   "run1",
+		Namespace:      "ns1",
+		Outcome:        model.AuditOutcomeSucceeded,
+	}
+	assert.Equal(t, expected, event)
+
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	_, err = store.CreateAuditEvent(&model.AuditEvent{
+		User:         "other@example.com",
+		Method:       "/api.ExperimentService/DeleteExperiment",
+		ResourceType: common.RbacResourceTypeExperiments,
+		ResourceUUID: "e1",
+		Namespace:    "ns2",
+		Outcome:      model.AuditOutcomeDenied,
+		Error:        "Unauthorized access",
+	})
+	assert.Nil(t, err)
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDThree, nil)
+	_, err = store.CreateAuditEvent(&model.AuditEvent{
+		User:         "user@example.com",
+		Method:       "/api.RunService/CreateRun",
+		ResourceType: common.RbacResourceTypeRuns,
+		ResourceUUID: "run2",
+		Namespace:    "ns1",
+		Outcome:      model.AuditOutcomeSucceeded,
+	})
+	assert.Nil(t, err)
+
+	// List with pagination, most recent first.
+	opts, err := list.NewOptions(&model.AuditEvent{}, 2, "created_at desc", nil)
+	assert.Nil(t, err)
+	events, totalSize, nextPageToken, err := store.ListAuditEvents(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, totalSize)
+	assert.Equal(t, []string{fakeIDThree, fakeIDTwo}, []string{events[0].UUID, events[1].UUID})
+	assert.NotEmpty(t, nextPageToken)
+	opts, err = list.NewOptionsFromToken(&model.AuditEvent{}, nextPageToken, 2)
+	assert.Nil(t, err)
+	events, _, nextPageToken, err = store.ListAuditEvents(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.AuditEvent{expected}, events)
+	assert.Empty(t, nextPageToken)
+
+	// List by namespace and filter.
+	opts, err = list.NewOptions(&model.AuditEvent{}, 10, "", &api.Filter{
+		Predicates: []*api.Predicate{{
+			Key:   "resource_id",
+			Op:    api.Predicate_EQUALS,
+			Value: &api.Predicate_StringValue{StringValue: "run1"},
+		}},
+	})
+	assert.Nil(t, err)
+	events, totalSize, _, err = store.ListAuditEvents(&common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns1"},
+	}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, []*model.AuditEvent{expected}, events)
+
+	opts, err = list.NewOptions(&model.AuditEvent{}, 10, "", nil)
+	assert.Nil(t, err)
+	events, totalSize, _, err = store.ListAuditEvents(&common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns2"},
+	}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, fakeIDTwo, events[0].UUID)
+}
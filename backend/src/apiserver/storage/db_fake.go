@@ -38,6 +38,14 @@ func NewFakeDb() (*DB, error) {
 		&model.ResourceReference{},
 		&model.RunDetail{},
 		&model.RunMetric{},
+		&model.RunParameter{},
+		&model.Label{},
+		&model.IdempotencyKey{},
+		&model.NamespaceQuota{},
+		&model.QueuedRun{},
+		&model.RetentionPolicy{},
+		&model.AuditEvent{},
+		&model.RunObjectCleanup{},
 		&model.DBStatus{},
 		&model.DefaultExperiment{})
 
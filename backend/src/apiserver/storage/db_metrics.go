@@ -0,0 +1,308 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metric variables. Please prefix the metric names with storage_db_.
+var (
+	dbOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "storage_db_operation_duration_seconds",
+		Help:    "The latency of the SQL statements and transaction operations",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	dbOperationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "storage_db_operation_errors",
+		Help: "The number of failed SQL statements and transaction operations",
+	}, []string{"operation"})
+)
+
+// The operations of the database which are timed.
+const (
+	dbOperationExec     = "exec"
+	dbOperationQuery    = "query"
+	dbOperationBegin    = "begin"
+	dbOperationCommit   = "commit"
+	dbOperationRollback = "rollback"
+)
+
+// OpenDB opens a database with a registered SQL driver, like sql.Open. The
+// statements and transaction operations on the database are timed and
+// traced.
+func OpenDB(driverName string, dataSourceName string) (*sql.DB, error) {
+	db, err := sql.Open(driverName, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to get the %s driver", driverName)
+	}
+	sqlDriver := db.Driver()
+	db.Close()
+	sqlDriver = tracing.WrapSQLDriver(&metricsDriver{sqlDriver}, driverName)
+	return sql.OpenDB(&dsnConnector{dataSourceName: dataSourceName, driver: sqlDriver}), nil
+}
+
+// dsnConnector opens the connections to a database with a data source name.
+type dsnConnector struct {
+	dataSourceName string
+	driver         driver.Driver
+}
+
+func (c *dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dataSourceName)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+// observeDBOperation records the latency of a database operation, and its
+// error if any. driver.ErrSkip is not an error but makes database/sql fall
+// back to another operation, which is recorded instead.
+func observeDBOperation(operation string, start time.Time, err error) {
+	if err == driver.ErrSkip {
+		return
+	}
+	dbOperationDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil {
+		dbOperationErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// metricsDriver wraps a SQL driver to time the statements and transaction
+// operations of its connections.
+type metricsDriver struct {
+	driver.Driver
+}
+
+func (d *metricsDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsConn{conn}, nil
+}
+
+// metricsConn implements the optional interfaces of driver.Conn by
+// delegating to the wrapped connection if it implements them.
+type metricsConn struct {
+	driver.Conn
+}
+
+func (c *metricsConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *metricsConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &metricsStmt{stmt}, nil
+}
+
+func (c *metricsConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *metricsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	start := time.Now()
+	var tx driver.Tx
+	var err error
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.Conn.Begin()
+	}
+	observeDBOperation(dbOperationBegin, start, err)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsTx{tx}, nil
+}
+
+func (c *metricsConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	observeDBOperation(dbOperationExec, start, err)
+	return result, err
+}
+
+func (c *metricsConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	observeDBOperation(dbOperationQuery, start, err)
+	return rows, err
+}
+
+func (c *metricsConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *metricsConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *metricsConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+// metricsStmt times the executions of a prepared statement.
+type metricsStmt struct {
+	driver.Stmt
+}
+
+func (s *metricsStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	var result driver.Result
+	var err error
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		result, err = s.Stmt.Exec(namedValuesToValues(args))
+	}
+	observeDBOperation(dbOperationExec, start, err)
+	return result, err
+}
+
+func (s *metricsStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	var rows driver.Rows
+	var err error
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		rows, err = s.Stmt.Query(namedValuesToValues(args))
+	}
+	observeDBOperation(dbOperationQuery, start, err)
+	return rows, err
+}
+
+func (s *metricsStmt) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	return driver.ErrSkip
+}
+
+func namedValuesToValues(namedValues []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(namedValues))
+	for i, namedValue := range namedValues {
+		values[i] = namedValue.Value
+	}
+	return values
+}
+
+// metricsTx times the commit and the rollback of a transaction.
+type metricsTx struct {
+	driver.Tx
+}
+
+func (t *metricsTx) Commit() error {
+	start := time.Now()
+	err := t.Tx.Commit()
+	observeDBOperation(dbOperationCommit, start, err)
+	return err
+}
+
+func (t *metricsTx) Rollback() error {
+	start := time.Now()
+	err := t.Tx.Rollback()
+	observeDBOperation(dbOperationRollback, start, err)
+	return err
+}
+
+// dbStatsCollector exports the statistics of the connection pool of a
+// database.
+type dbStatsCollector struct {
+	db                 *sql.DB
+	maxOpenConnections *prometheus.Desc
+	openConnections    *prometheus.Desc
+	inUseConnections   *prometheus.Desc
+	idleConnections    *prometheus.Desc
+	waitCount          *prometheus.Desc
+	waitDuration       *prometheus.Desc
+}
+
+// NewDBStatsCollector returns a Prometheus collector of the statistics of the
+// connection pool of db.
+func NewDBStatsCollector(db *DB) prometheus.Collector {
+	return &dbStatsCollector{
+		db: db.DB,
+		maxOpenConnections: prometheus.NewDesc("storage_db_max_open_connections",
+			"The maximum number of open connections to the database", nil, nil),
+		openConnections: prometheus.NewDesc("storage_db_open_connections",
+			"The number of established connections to the database, in use or idle", nil, nil),
+		inUseConnections: prometheus.NewDesc("storage_db_in_use_connections",
+			"The number of connections to the database currently in use", nil, nil),
+		idleConnections: prometheus.NewDesc("storage_db_idle_connections",
+			"The number of idle connections to the database", nil, nil),
+		waitCount: prometheus.NewDesc("storage_db_wait_count",
+			"The total number of connections waited for", nil, nil),
+		waitDuration: prometheus.NewDesc("storage_db_wait_duration_seconds",
+			"The total time blocked waiting for a new connection", nil, nil),
+	}
+}
+
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpenConnections
+	ch <- c.openConnections
+	ch <- c.inUseConnections
+	ch <- c.idleConnections
+	ch <- c.waitCount
+	ch <- c.waitDuration
+}
+
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.db.Stats()
+	ch <- prometheus.MustNewConstMetric(c.maxOpenConnections, prometheus.GaugeValue, float64(stats.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.openConnections, prometheus.GaugeValue, float64(stats.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(c.inUseConnections, prometheus.GaugeValue, float64(stats.InUse))
+	ch <- prometheus.MustNewConstMetric(c.idleConnections, prometheus.GaugeValue, float64(stats.Idle))
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stats.WaitCount))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds())
+}
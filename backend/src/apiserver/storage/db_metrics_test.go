@@ -0,0 +1,82 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func dbOperationCount(t *testing.T, operation string) uint64 {
+	var metric dto.Metric
+	err := dbOperationDuration.WithLabelValues(operation).(prometheus.Histogram).Write(&metric)
+	assert.Nil(t, err)
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestOpenDB_RecordsOperations(t *testing.T) {
+	db, err := OpenDB("sqlite3", ":memory:")
+	assert.Nil(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+	errorsBefore := testutil.ToFloat64(dbOperationErrors.WithLabelValues(dbOperationExec))
+	countsBefore := map[string]uint64{}
+	for _, operation := range []string{dbOperationExec, dbOperationQuery, dbOperationBegin, dbOperationCommit} {
+		countsBefore[operation] = dbOperationCount(t, operation)
+	}
+
+	_, err = db.Exec("CREATE TABLE foo (id INTEGER)")
+	assert.Nil(t, err)
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	_, err = tx.Exec("INSERT INTO foo (id) VALUES (?)", 1)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT COUNT(*) FROM foo").Scan(&count))
+	assert.Equal(t, 1, count)
+	_, err = db.Exec("INSERT INTO bar (id) VALUES (1)")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, errorsBefore+1, testutil.ToFloat64(dbOperationErrors.WithLabelValues(dbOperationExec)))
+	expectedCounts := map[string]uint64{
+		dbOperationExec:   3,
+		dbOperationQuery:  1,
+		dbOperationBegin:  1,
+		dbOperationCommit: 1,
+	}
+	for operation, expected := range expectedCounts {
+		assert.Equal(t, countsBefore[operation]+expected, dbOperationCount(t, operation), operation)
+	}
+}
+
+func TestDBStatsCollector(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+
+	expected := `
+# HELP storage_db_in_use_connections The number of connections to the database currently in use
+# TYPE storage_db_in_use_connections gauge
+storage_db_in_use_connections 0
+`
+	err := testutil.CollectAndCompare(NewDBStatsCollector(db), strings.NewReader(expected), "storage_db_in_use_connections")
+	assert.Nil(t, err)
+	assert.Equal(t, 6, testutil.CollectAndCount(NewDBStatsCollector(db)))
+}
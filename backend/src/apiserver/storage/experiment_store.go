@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 
 	"fmt"
@@ -29,6 +30,8 @@ type ExperimentStore struct {
 	uuid                   util.UUIDGeneratorInterface
 	resourceReferenceStore *ResourceReferenceStore
 	defaultExperimentStore *DefaultExperimentStore
+	labelStore             *LabelStore
+	retentionPolicyStore   *RetentionPolicyStore
 }
 
 var (
@@ -109,6 +112,17 @@ func (s *ExperimentStore) ListExperiments(filterContext *common.FilterContext, o
 		return errorF(err)
 	}
 
+	ids := make([]string, 0, len(exps))
+	for _, exp := range exps {
+		ids = append(ids, exp.UUID)
+	}
+	err = s.labelStore.attachLabels(common.Experiment, ids, func(i int, labels map[string]string) {
+		exps[i].Labels = labels
+	})
+	if err != nil {
+		return errorF(err)
+	}
+
 	if len(exps) <= opts.PageSize {
 		return exps, total_size, "", nil
 	}
@@ -140,6 +154,11 @@ func (s *ExperimentStore) GetExperiment(uuid string) (*model.Experiment, error)
 	if len(experiments) == 0 {
 		return nil, util.NewResourceNotFoundError("Experiment", fmt.Sprint(uuid))
 	}
+	labels, err := s.labelStore.GetLabels(common.Experiment, uuid)
+	if err != nil {
+		return nil, err
+	}
+	experiments[0].Labels = labels
 	return experiments[0], nil
 }
 
@@ -202,8 +221,14 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 		return nil, util.NewInternalServerError(err, "Failed to create query to insert experiment to experiment table: %v",
 			err.Error())
 	}
-	_, err = s.db.Exec(sql, args...)
+	// Use a transaction to make sure both experiment and its labels are stored.
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to create experiment.")
+	}
+	_, err = tx.Exec(sql, args...)
 	if err != nil {
+		tx.Rollback()
 		if s.db.IsDuplicateError(err) {
 			return nil, util.NewAlreadyExistError(
 				"Failed to create a new experiment. The name %v already exists. Please specify a new name.", experiment.Name)
@@ -211,6 +236,15 @@ func (s *ExperimentStore) CreateExperiment(experiment *model.Experiment) (*model
 		return nil, util.NewInternalServerError(err, "Failed to add experiment to experiment table: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(context.Background(), tx, common.Experiment, newExperiment.UUID, newExperiment.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for experiment %v ", experiment.Name)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to store experiment %v and its labels to table", experiment.Name)
+	}
 	return &newExperiment, nil
 }
 
@@ -240,6 +274,16 @@ func (s *ExperimentStore) DeleteExperiment(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for experiment %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, common.Experiment, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for experiment %v ", id)
+	}
+	err = s.retentionPolicyStore.DeleteRetentionPolicyByResource(tx, common.Experiment, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete retention policy from table for experiment %v ", id)
+	}
 	err = tx.Commit()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to delete experiment %v and its resource references from table", id)
@@ -400,5 +444,7 @@ func NewExperimentStore(db *DB, time util.TimeInterface, uuid util.UUIDGenerator
 		uuid:                   uuid,
 		resourceReferenceStore: NewResourceReferenceStore(db),
 		defaultExperimentStore: NewDefaultExperimentStore(db),
+		labelStore:             NewLabelStore(db),
+		retentionPolicyStore:   NewRetentionPolicyStore(db, time, uuid),
 	}
 }
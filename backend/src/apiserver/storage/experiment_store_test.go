@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"testing"
 
 	"fmt"
@@ -86,7 +87,7 @@ func TestListExperiments_Pagination(t *testing.T) {
 	}
 	experimentsExpected2 := []*model.Experiment{expectedExperiment2, expectedExperiment3}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Experiment{}, nextPageToken, 2)
 	assert.Nil(t, err)
 
 	experiments, total_size, nextPageToken, err = experimentStore.ListExperiments(&common.FilterContext{}, opts)
@@ -149,7 +150,7 @@ func TestListExperiments_Pagination_Descend(t *testing.T) {
 	}
 	experimentsExpected2 := []*model.Experiment{expectedExperiment4, expectedExperiment1}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Experiment{}, nextPageToken, 2)
 	assert.Nil(t, err)
 
 	experiments, total_size, nextPageToken, err = experimentStore.ListExperiments(&common.FilterContext{}, opts)
@@ -407,7 +408,7 @@ func TestListExperiments_Filtering(t *testing.T) {
 	assert.Equal(t, 3, total_size)
 
 	// Next page should give experiment4.
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Experiment{}, nextPageToken, 2)
 	assert.Nil(t, err)
 
 	experiments, total_size, nextPageToken, err = experimentStore.ListExperiments(&common.FilterContext{}, opts)
@@ -496,8 +497,8 @@ func TestArchiveAndUnarchiveExperiment(t *testing.T) {
 			WorkflowRuntimeManifest: "workflow1",
 		},
 	}
-	runStore.CreateRun(run1)
-	runStore.CreateRun(run2)
+	runStore.CreateRun(context.Background(), run1)
+	runStore.CreateRun(context.Background(), run2)
 	jobStore := NewJobStore(db, util.NewFakeTimeForEpoch())
 	job1 := &model.Job{
 		UUID:        "1",
@@ -0,0 +1,246 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	fileObjectContentType = "application/octet-stream"
+	// The prefix of the files being written. They are renamed to their keys
+	// once complete, so readers never see a partially written file.
+	fileObjectTempPrefix = ".tmp-"
+)
+
+// FileObjectStore keeps the objects as files under a root directory, e.g. on
+// a persistent volume, with the keys as their paths relative to the root.
+type FileObjectStore struct {
+	rootDir    string
+	baseFolder string
+}
+
+// GetPipelineKey adds the configured base folder to pipeline id.
+func (s *FileObjectStore) GetPipelineKey(pipelineID string) string {
+	return path.Join(s.baseFolder, pipelineID)
+}
+
+// filePath returns the path of the file of a key. It fails for keys which
+// would resolve to a path outside of the root directory.
+func (s *FileObjectStore) filePath(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if cleanKey == "/" || strings.Contains(key, "\\") || strings.Contains("/"+key+"/", "/../") {
+		return "", util.NewInvalidInputError("Invalid object key %q", key)
+	}
+	return filepath.Join(s.rootDir, filepath.FromSlash(cleanKey)), nil
+}
+
+func (s *FileObjectStore) AddFile(ctx context.Context, file []byte, filePath string) error {
+	fullPath, err := s.filePath(filePath)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(fullPath)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	tempFile, err := ioutil.TempFile(dir, fileObjectTempPrefix)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	_, err = tempFile.Write(file)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFile.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), fullPath)
+	}
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return util.NewInternalServerError(err, "Failed to store %v", filePath)
+	}
+	return nil
+}
+
+func (s *FileObjectStore) DeleteFile(filePath string) error {
+	fullPath, err := s.filePath(filePath)
+	if err != nil {
+		return err
+	}
+	// Deleting a missing file succeeds, like deleting a missing object of a bucket.
+	if err = os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
+	}
+	s.removeEmptyDirs(filepath.Dir(fullPath))
+	return nil
+}
+
+// removeEmptyDirs removes a directory and its parents up to the root directory
+// as long as they are empty, so that deleted folders do not pile up.
+func (s *FileObjectStore) removeEmptyDirs(dir string) {
+	root := filepath.Clean(s.rootDir)
+	for dir != root && strings.HasPrefix(dir, root+string(filepath.Separator)) {
+		// Remove fails for directories which are not empty.
+		if err := os.Remove(dir); err != nil {
+			if !os.IsNotExist(err) {
+				return
+			}
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func (s *FileObjectStore) DeleteFolder(folderPath string) (int, error) {
+	if folderPath == "" || folderPath == "/" {
+		return 0, util.NewInvalidInputError("Failed to delete folder. The folder path is empty.")
+	}
+	files, err := s.ListFiles(folderPath)
+	if err != nil {
+		return 0, err
+	}
+	for i, file := range files {
+		err = s.DeleteFile(file.Key)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(files), nil
+}
+
+func (s *FileObjectStore) ListFiles(folderPath string) ([]*FileInfo, error) {
+	folder, err := s.filePath(folderPath)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*FileInfo, 0)
+	err = filepath.Walk(folder, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		// Only the files under the folder are listed, like the objects with its prefix.
+		if info.IsDir() || fullPath == folder || strings.HasPrefix(info.Name(), fileObjectTempPrefix) {
+			return nil
+		}
+		relPath, err := filepath.Rel(s.rootDir, fullPath)
+		if err != nil {
+			return err
+		}
+		files = append(files, &FileInfo{
+			Key:          filepath.ToSlash(relPath),
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+			ContentType:  fileObjectContentType,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list files under %v", folderPath)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+	return files, nil
+}
+
+func (s *FileObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
+	fullPath, err := s.filePath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(fullPath)
+	if os.IsNotExist(err) {
+		return nil, util.NewResourceNotFoundError("file", filePath)
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return content, nil
+}
+
+func (s *FileObjectStore) GetFileReader(filePath string) (FileReader, *FileInfo, error) {
+	fullPath, err := s.filePath(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := os.Open(fullPath)
+	if os.IsNotExist(err) {
+		return nil, nil, util.NewResourceNotFoundError("file", filePath)
+	}
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	stat, err := file.Stat()
+	if err == nil && stat.IsDir() {
+		err = errors.New("the file is a directory")
+	}
+	if err != nil {
+		file.Close()
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	return file, &FileInfo{
+		Key:          filePath,
+		Size:         stat.Size(),
+		LastModified: stat.ModTime(),
+		ContentType:  fileObjectContentType,
+		ETag:         fileETag(stat),
+	}, nil
+}
+
+// fileETag returns the entity tag of a file, derived from its size and
+// modification time so that the file is not read to compute it. Files are only
+// replaced by renames, so a new content comes with a new modification time.
+func fileETag(stat os.FileInfo) string {
+	return fmt.Sprintf("%x-%x", stat.ModTime().UnixNano(), stat.Size())
+}
+
+func (s *FileObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(s, o, filePath)
+}
+
+func (s *FileObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(s, o, filePath)
+}
+
+// NewFileObjectStore creates an object store keeping the objects under a
+// root directory, which is created if it does not exist.
+func NewFileObjectStore(rootDir string, baseFolder string) (*FileObjectStore, error) {
+	if rootDir == "" {
+		return nil, util.NewInvalidInputError("The root directory of the file object store is empty.")
+	}
+	absRootDir, err := filepath.Abs(rootDir)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to resolve the root directory %v", rootDir)
+	}
+	if err = os.MkdirAll(absRootDir, 0755); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create the root directory %v", rootDir)
+	}
+	glog.Infof("Storing objects under %v", absRootDir)
+	return &FileObjectStore{rootDir: absRootDir, baseFolder: baseFolder}, nil
+}
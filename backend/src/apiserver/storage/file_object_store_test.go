@@ -0,0 +1,177 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func initFileObjectStore(t *testing.T) (*FileObjectStore, func()) {
+	rootDir, err := ioutil.TempDir("", "file-object-store")
+	assert.Nil(t, err)
+	store, err := NewFileObjectStore(rootDir, "pipelines")
+	assert.Nil(t, err)
+	return store, func() { os.RemoveAll(rootDir) }
+}
+
+func TestNewFileObjectStore_EmptyRootDir(t *testing.T) {
+	_, err := NewFileObjectStore("", "pipelines")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestFileObjectStore_AddFileAndGetFile(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), store.GetPipelineKey("1")))
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abcdef"), store.GetPipelineKey("1")))
+	content, err := store.GetFile(context.Background(), "pipelines/1")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("abcdef"), content)
+	// The archived logs are keyed with a leading slash.
+	assert.Nil(t, store.AddFile(context.Background(), []byte("log"), "/artifacts/run1/node1/main.tgz"))
+	content, err = store.GetFile(context.Background(), "artifacts/run1/node1/main.tgz")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("log"), content)
+	_, err = os.Stat(filepath.Join(store.rootDir, "artifacts", "run1", "node1", "main.tgz"))
+	assert.Nil(t, err)
+}
+
+func TestFileObjectStore_GetFile_NotFound(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	_, err := store.GetFile(context.Background(), store.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	_, _, err = store.GetFileReader(store.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestFileObjectStore_InvalidKey(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	for _, key := range []string{"", "/", "../outside", "pipelines/../../outside", "pipelines\\1"} {
+		err := store.AddFile(context.Background(), []byte("abc"), key)
+		assert.NotNil(t, err, key)
+		assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode(), key)
+	}
+}
+
+func TestFileObjectStore_GetFileReader(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abcdef"), store.GetPipelineKey("1")))
+	reader, info, err := store.GetFileReader(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	defer reader.Close()
+	assert.Equal(t, "pipelines/1", info.Key)
+	assert.Equal(t, int64(6), info.Size)
+	stat, err := os.Stat(filepath.Join(store.rootDir, "pipelines", "1"))
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%x-6", stat.ModTime().UnixNano()), info.ETag)
+	assert.Equal(t, "application/octet-stream", info.ContentType)
+	_, err = reader.Seek(3, io.SeekStart)
+	assert.Nil(t, err)
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("def"), content)
+}
+
+func TestFileObjectStore_GetFileReader_ETagChangesWithContent(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abcdef"), store.GetPipelineKey("1")))
+	reader, info, err := store.GetFileReader(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	reader.Close()
+
+	// The new file has the same size, and is modified later.
+	assert.Nil(t, store.AddFile(context.Background(), []byte("ghijkl"), store.GetPipelineKey("1")))
+	modTime := info.LastModified.Add(time.Second)
+	assert.Nil(t, os.Chtimes(filepath.Join(store.rootDir, "pipelines", "1"), modTime, modTime))
+	reader, newInfo, err := store.GetFileReader(store.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	reader.Close()
+	assert.NotEqual(t, info.ETag, newInfo.ETag)
+}
+
+func TestFileObjectStore_ListFiles(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), "artifacts/run1/task2/b.tgz"))
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abcdef"), "artifacts/run1/task1/a.tgz"))
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), "artifacts/run10/task1/a.tgz"))
+	// Files being written are not listed.
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(store.rootDir, "artifacts", "run1", fileObjectTempPrefix+"1"), []byte("a"), 0644))
+
+	files, err := store.ListFiles("artifacts/run1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(files))
+	assert.Equal(t, "artifacts/run1/task1/a.tgz", files[0].Key)
+	assert.Equal(t, int64(6), files[0].Size)
+	assert.Equal(t, "artifacts/run1/task2/b.tgz", files[1].Key)
+
+	files, err = store.ListFiles("artifacts/run2")
+	assert.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestFileObjectStore_DeleteFolder(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), "/artifacts/run1/node1/main.tgz"))
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), "/artifacts/run1/node2/main.tgz"))
+	assert.Nil(t, store.AddFile(context.Background(), []byte("abc"), "/artifacts/run2/node1/main.tgz"))
+
+	count, err := store.DeleteFolder("/artifacts/run1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	_, err = os.Stat(filepath.Join(store.rootDir, "artifacts", "run1"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = store.GetFile(context.Background(), "artifacts/run2/node1/main.tgz")
+	assert.Nil(t, err)
+	// Deleting a missing file succeeds.
+	assert.Nil(t, store.DeleteFile("artifacts/run1/node1/main.tgz"))
+
+	_, err = store.DeleteFolder("")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestFileObjectStore_YamlFile(t *testing.T) {
+	store, cleanup := initFileObjectStore(t)
+	defer cleanup()
+
+	type Foo struct{ ID int }
+	assert.Nil(t, store.AddAsYamlFile(Foo{ID: 1}, "foo.yaml"))
+	var foo Foo
+	assert.Nil(t, store.GetFromYamlFile(&foo, "foo.yaml"))
+	assert.Equal(t, Foo{ID: 1}, foo)
+}
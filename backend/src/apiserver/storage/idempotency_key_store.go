@@ -0,0 +1,200 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/pkg/errors"
+)
+
+type IdempotencyKeyStoreInterface interface {
+	// Retrieve the idempotency key of the request which created a resource of
+	// the given type.
+	GetIdempotencyKey(ctx context.Context, resourceType common.ResourceType, key string) (*model.IdempotencyKey, error)
+
+	// Reserve an idempotency key before creating the resource of the request,
+	// so that concurrent requests with the same key don't create the resource
+	// twice. The reserved key refers to no resource until the resource is stored.
+	// A key reserved for longer than idempotencyKeyReservationTimeout without
+	// referring to a resource is reclaimed, since its request never completed.
+	ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) error
+
+	// Release an idempotency key whose resource failed to be created.
+	ReleaseIdempotencyKey(ctx context.Context, resourceType common.ResourceType, key string) error
+}
+
+// The time after which a reserved idempotency key which refers to no resource
+// is considered abandoned, e.g. by a crashed API server, and can be reserved
+// again.
+const idempotencyKeyReservationTimeout = 10 * time.Minute
+
+type IdempotencyKeyStore struct {
+	db   *DB
+	time util.TimeInterface
+}
+
+func (s *IdempotencyKeyStore) ReserveIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) error {
+	now := s.time.Now().Unix()
+	keySql, keyArgs, err := sq.
+		Insert("idempotency_keys").
+		SetMap(sq.Eq{
+			"ResourceType":    key.ResourceType,
+			"IdempotencyKey":  key.Key,
+			"ResourceUUID":    "",
+			"RequestHash":     key.RequestHash,
+			"ReservedAtInSec": now,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to reserve idempotency key %s", key.Key)
+	}
+	_, err = s.db.ExecContext(ctx, keySql, keyArgs...)
+	if err != nil && !s.db.IsDuplicateError(err) {
+		return util.NewInternalServerError(err, "Failed to reserve idempotency key %s", key.Key)
+	}
+	if err != nil {
+		reclaimed, err := s.reclaimIdempotencyKey(ctx, key, now)
+		if err != nil {
+			return err
+		}
+		if !reclaimed {
+			return util.NewAlreadyExistError("Idempotency key %s was already used to create a %s", key.Key, key.ResourceType)
+		}
+	}
+	key.ReservedAtInSec = now
+	return nil
+}
+
+// reclaimIdempotencyKey reserves an idempotency key again if its reservation
+// is stale, and returns whether it did.
+func (s *IdempotencyKeyStore) reclaimIdempotencyKey(ctx context.Context, key *model.IdempotencyKey, now int64) (bool, error) {
+	staleBefore := now - int64(idempotencyKeyReservationTimeout/time.Second)
+	keySql, keyArgs, err := sq.
+		Update("idempotency_keys").
+		SetMap(sq.Eq{"RequestHash": key.RequestHash, "ReservedAtInSec": now}).
+		Where(sq.Eq{"ResourceType": key.ResourceType, "IdempotencyKey": key.Key, "ResourceUUID": ""}).
+		Where(sq.Lt{"ReservedAtInSec": staleBefore}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create query to reclaim idempotency key %s", key.Key)
+	}
+	result, err := s.db.ExecContext(ctx, keySql, keyArgs...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to reclaim idempotency key %s", key.Key)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to reclaim idempotency key %s", key.Key)
+	}
+	return rowsAffected == 1, nil
+}
+
+func (s *IdempotencyKeyStore) ReleaseIdempotencyKey(ctx context.Context, resourceType common.ResourceType, key string) error {
+	keySql, keyArgs, err := sq.
+		Delete("idempotency_keys").
+		Where(sq.Eq{"ResourceType": resourceType, "IdempotencyKey": key, "ResourceUUID": ""}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to release idempotency key %s", key)
+	}
+	_, err = s.db.ExecContext(ctx, keySql, keyArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to release idempotency key %s", key)
+	}
+	return nil
+}
+
+// Point the reserved idempotency key of the request which created a resource,
+// if any, to the resource.
+// This is always in company with creating the resource so a transaction is needed as input.
+func (s *IdempotencyKeyStore) CompleteIdempotencyKey(ctx context.Context, tx *sql.Tx, key *model.IdempotencyKey) error {
+	if key == nil {
+		return nil
+	}
+	keySql, keyArgs, err := sq.
+		Update("idempotency_keys").
+		SetMap(sq.Eq{"ResourceUUID": key.ResourceUUID}).
+		Where(sq.Eq{"ResourceType": key.ResourceType, "IdempotencyKey": key.Key, "ResourceUUID": ""}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store idempotency key for %s %s",
+			key.ResourceType, key.ResourceUUID)
+	}
+	result, err := tx.ExecContext(ctx, keySql, keyArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store idempotency key for %s %s",
+			key.ResourceType, key.ResourceUUID)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store idempotency key for %s %s",
+			key.ResourceType, key.ResourceUUID)
+	}
+	if rowsAffected != 1 {
+		return util.NewInternalServerError(errors.New("idempotency key is not reserved"),
+			"Failed to store idempotency key %s for %s %s", key.Key, key.ResourceType, key.ResourceUUID)
+	}
+	return nil
+}
+
+// Delete the idempotency key of a resource, so that the key can be reused.
+// This is always in company with deleting the resource so a transaction is needed as input.
+func (s *IdempotencyKeyStore) DeleteIdempotencyKeys(tx *sql.Tx, resourceType common.ResourceType, id string) error {
+	keySql, keyArgs, err := sq.
+		Delete("idempotency_keys").
+		Where(sq.Eq{"ResourceUUID": id, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete idempotency keys for %s %s", resourceType, id)
+	}
+	_, err = tx.Exec(keySql, keyArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete idempotency keys for %s %s", resourceType, id)
+	}
+	return nil
+}
+
+func (s *IdempotencyKeyStore) GetIdempotencyKey(ctx context.Context, resourceType common.ResourceType, key string) (*model.IdempotencyKey, error) {
+	keySql, keyArgs, err := sq.
+		Select("ResourceType", "IdempotencyKey", "ResourceUUID", "RequestHash", "ReservedAtInSec").
+		From("idempotency_keys").
+		Where(sq.Eq{"ResourceType": resourceType, "IdempotencyKey": key}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get idempotency key %s: %v", key, err.Error())
+	}
+	var idempotencyKey model.IdempotencyKey
+	err = s.db.QueryRowContext(ctx, keySql, keyArgs...).Scan(&idempotencyKey.ResourceType, &idempotencyKey.Key,
+		&idempotencyKey.ResourceUUID, &idempotencyKey.RequestHash, &idempotencyKey.ReservedAtInSec)
+	if err == sql.ErrNoRows {
+		return nil, util.NewResourceNotFoundError("IdempotencyKey", key)
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get idempotency key %s: %v", key, err.Error())
+	}
+	return &idempotencyKey, nil
+}
+
+func NewIdempotencyKeyStore(db *DB, time util.TimeInterface) *IdempotencyKeyStore {
+	return &IdempotencyKeyStore{db: db, time: time}
+}
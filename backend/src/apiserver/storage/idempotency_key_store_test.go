@@ -0,0 +1,128 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestIdempotencyKeyStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewIdempotencyKeyStore(db, util.NewFakeTimeForEpoch())
+	runKey := &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash1"}
+	jobKey := &model.IdempotencyKey{ResourceType: common.Job, Key: "key1", RequestHash: "hash2"}
+
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), runKey))
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), jobKey))
+
+	// A reserved key refers to no resource.
+	key, err := store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, runKey, key)
+
+	// A key can only be reserved once per resource type.
+	err = store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash3"})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+
+	runKey.ResourceUUID = "run1"
+	jobKey.ResourceUUID = "job1"
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, store.CompleteIdempotencyKey(context.Background(), tx, runKey))
+	assert.Nil(t, store.CompleteIdempotencyKey(context.Background(), tx, jobKey))
+	assert.Nil(t, store.CompleteIdempotencyKey(context.Background(), tx, nil))
+	assert.Nil(t, tx.Commit())
+
+	key, err = store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, runKey, key)
+	key, err = store.GetIdempotencyKey(context.Background(), common.Job, "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, jobKey, key)
+
+	// A key which refers to a resource can't be completed or released again.
+	tx, err = db.Begin()
+	assert.Nil(t, err)
+	err = store.CompleteIdempotencyKey(context.Background(), tx, &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", ResourceUUID: "run2"})
+	assert.NotNil(t, err)
+	tx.Rollback()
+	assert.Nil(t, store.ReleaseIdempotencyKey(context.Background(), common.Run, "key1"))
+	key, err = store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, "run1", key.ResourceUUID)
+
+	tx, err = db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, store.DeleteIdempotencyKeys(tx, common.Run, "run1"))
+	assert.Nil(t, tx.Commit())
+	_, err = store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+	_, err = store.GetIdempotencyKey(context.Background(), common.Job, "key1")
+	assert.Nil(t, err)
+}
+
+func TestIdempotencyKeyStore_Release(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewIdempotencyKeyStore(db, util.NewFakeTimeForEpoch())
+
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash1"}))
+	assert.Nil(t, store.ReleaseIdempotencyKey(context.Background(), common.Run, "key1"))
+	_, err := store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	// A released key can be reserved again.
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash2"}))
+}
+
+func TestIdempotencyKeyStore_ReclaimStaleReservation(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewIdempotencyKeyStore(db, util.NewFakeTimeForEpoch())
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash1"}))
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key2", RequestHash: "hash1"}))
+	completedKey := &model.IdempotencyKey{ResourceType: common.Run, Key: "key2", ResourceUUID: "run1"}
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	assert.Nil(t, store.CompleteIdempotencyKey(context.Background(), tx, completedKey))
+	assert.Nil(t, tx.Commit())
+
+	// A pending reservation is kept until it is stale.
+	err = store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash2"})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+
+	// Once the reservation is stale, the key is reserved for the new request.
+	store.time = util.NewFakeTime(time.Unix(0, 0).Add(idempotencyKeyReservationTimeout + time.Minute))
+	key := &model.IdempotencyKey{ResourceType: common.Run, Key: "key1", RequestHash: "hash2"}
+	assert.Nil(t, store.ReserveIdempotencyKey(context.Background(), key))
+	reserved, err := store.GetIdempotencyKey(context.Background(), common.Run, "key1")
+	assert.Nil(t, err)
+	assert.Equal(t, key, reserved)
+	assert.Equal(t, "", reserved.ResourceUUID)
+	assert.Equal(t, "hash2", reserved.RequestHash)
+
+	// A key which refers to a resource is never reclaimed.
+	err = store.ReserveIdempotencyKey(context.Background(), &model.IdempotencyKey{ResourceType: common.Run, Key: "key2", RequestHash: "hash2"})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+}
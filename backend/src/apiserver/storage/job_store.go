@@ -15,6 +15,7 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -32,6 +33,9 @@ var jobColumns = []string{"UUID", "DisplayName", "Name", "Namespace", "ServiceAc
 	"PipelineId", "PipelineName", "PipelineSpecManifest", "WorkflowSpecManifest", "Parameters", "Conditions",
 }
 
+// The spec manifests are left out of jobs listed with the basic view.
+var jobBasicListColumns = omitColumns(jobColumns, "PipelineSpecManifest", "WorkflowSpecManifest")
+
 type JobStoreInterface interface {
 	ListJobs(filterContext *common.FilterContext, opts *list.Options) ([]*model.Job, int, string, error)
 	GetJob(id string) (*model.Job, error)
@@ -44,6 +48,8 @@ type JobStoreInterface interface {
 type JobStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
+	labelStore             *LabelStore
+	idempotencyKeyStore    *IdempotencyKeyStore
 	time                   util.TimeInterface
 }
 
@@ -102,6 +108,17 @@ func (s *JobStore) ListJobs(
 		return errorF(err)
 	}
 
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.UUID)
+	}
+	err = s.labelStore.attachLabels(common.Job, ids, func(i int, labels map[string]string) {
+		jobs[i].Labels = labels
+	})
+	if err != nil {
+		return errorF(err)
+	}
+
 	if len(jobs) <= opts.PageSize {
 		return jobs, total_size, "", nil
 	}
@@ -116,12 +133,16 @@ func (s *JobStore) buildSelectJobsQuery(selectCount bool, opts *list.Options,
 	var filteredSelectBuilder sq.SelectBuilder
 	var err error
 
+	columns := jobColumns
+	if opts.BasicView {
+		columns = jobBasicListColumns
+	}
 	refKey := filterContext.ReferenceKey
 	if refKey != nil && refKey.Type == common.Namespace {
-		filteredSelectBuilder, err = list.FilterOnNamespace("jobs", jobColumns,
+		filteredSelectBuilder, err = list.FilterOnNamespace("jobs", columns,
 			selectCount, refKey.ID)
 	} else {
-		filteredSelectBuilder, err = list.FilterOnResourceReference("jobs", jobColumns,
+		filteredSelectBuilder, err = list.FilterOnResourceReference("jobs", columns,
 			common.Job, selectCount, filterContext)
 	}
 	if err != nil {
@@ -167,6 +188,11 @@ func (s *JobStore) GetJob(id string) (*model.Job, error) {
 	if len(jobs) == 0 {
 		return nil, util.NewResourceNotFoundError("Job", fmt.Sprint(id))
 	}
+	labels, err := s.labelStore.GetLabels(common.Job, id)
+	if err != nil {
+		return nil, err
+	}
+	jobs[0].Labels = labels
 	return jobs[0], nil
 }
 
@@ -259,6 +285,16 @@ func (s *JobStore) DeleteJob(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for job %v ", id)
 	}
+	err = s.labelStore.DeleteLabels(tx, common.Job, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for job %v ", id)
+	}
+	err = s.idempotencyKeyStore.DeleteIdempotencyKeys(tx, common.Job, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete idempotency keys from table for job %v ", id)
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -310,11 +346,21 @@ func (s *JobStore) CreateJob(j *model.Job) (*model.Job, error) {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store job %v to table", j.Name)
 	}
-	err = s.resourceReferenceStore.CreateResourceReferences(tx, j.ResourceReferences)
+	err = s.resourceReferenceStore.CreateResourceReferences(context.Background(), tx, j.ResourceReferences)
 	if err != nil {
 		tx.Rollback()
 		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for job %v ", j.Name)
 	}
+	err = s.labelStore.CreateLabels(context.Background(), tx, common.Job, j.UUID, j.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store labels to table for job %v ", j.Name)
+	}
+	err = s.idempotencyKeyStore.CompleteIdempotencyKey(context.Background(), tx, j.IdempotencyKey)
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store idempotency key to table for job %v ", j.Name)
+	}
 
 	err = tx.Commit()
 	if err != nil {
@@ -403,6 +449,8 @@ func NewJobStore(db *DB, time util.TimeInterface) *JobStore {
 	return &JobStore{
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db),
+		labelStore:             NewLabelStore(db),
+		idempotencyKeyStore:    NewIdempotencyKeyStore(db, time),
 		time:                   time,
 	}
 }
@@ -177,7 +177,7 @@ func TestListJobs_Pagination(t *testing.T) {
 			},
 		}}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	opts, err = list.NewOptionsFromToken(&model.Job{}, nextPageToken, 1)
 	assert.Nil(t, err)
 	jobs, total_size, newToken, err := jobStore.ListJobs(&common.FilterContext{}, opts)
 	assert.Nil(t, err)
@@ -296,7 +296,7 @@ func TestListJobs_Pagination_Descent(t *testing.T) {
 			},
 		}}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Job{}, nextPageToken, 2)
 	assert.Nil(t, err)
 	jobs, total_size, newToken, err := jobStore.ListJobs(&common.FilterContext{}, opts)
 	assert.Nil(t, err)
@@ -955,3 +955,39 @@ func TestDeleteJob_InternalError(t *testing.T) {
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
 		"Expected delete job to return internal error")
 }
+
+func TestListJobs_BasicView(t *testing.T) {
+	db, jobStore := initializeDbAndStore()
+	defer db.Close()
+	_, err := jobStore.CreateJob(&model.Job{
+		UUID:        "3",
+		DisplayName: "pp 3",
+		Name:        "pp3",
+		Namespace:   "n3",
+		Enabled:     true,
+		PipelineSpec: model.PipelineSpec{
+			PipelineSpecManifest: "pipeline spec",
+			WorkflowSpecManifest: "workflow spec",
+			Parameters:           `[{"name":"param1","value":"value1"}]`,
+		},
+	})
+	assert.Nil(t, err)
+	filterContext := &common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "n3"}}
+
+	opts, err := list.NewOptions(&model.Job{}, 10, "", nil)
+	assert.Nil(t, err)
+	jobs, _, _, err := jobStore.ListJobs(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, "workflow spec", jobs[0].WorkflowSpecManifest)
+	assert.Equal(t, "pipeline spec", jobs[0].PipelineSpecManifest)
+
+	opts.BasicView = true
+	jobs, _, _, err = jobStore.ListJobs(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(jobs))
+	assert.Equal(t, "pp 3", jobs[0].DisplayName)
+	assert.Equal(t, model.PipelineSpec{
+		Parameters: `[{"name":"param1","value":"value1"}]`,
+	}, jobs[0].PipelineSpec)
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type LabelStoreInterface interface {
+	// Retrieve the labels of a resource.
+	GetLabels(resourceType common.ResourceType, id string) (map[string]string, error)
+	// Add and remove labels of a resource. Returns the resulting labels.
+	UpdateLabels(resourceType common.ResourceType, id string, addLabels map[string]string,
+		removeLabelKeys []string) (map[string]string, error)
+}
+
+type LabelStore struct {
+	db *DB
+}
+
+// Create the labels of a resource.
+// This is always in company with creating a parent resource so a transaction is needed as input.
+func (s *LabelStore) CreateLabels(ctx context.Context, tx *sql.Tx, resourceType common.ResourceType, id string, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	sqlBuilder := sq.Insert("labels").Columns("ResourceUUID", "ResourceType", "LabelKey", "LabelValue")
+	for _, key := range sortedKeys(labels) {
+		sqlBuilder = sqlBuilder.Values(id, resourceType, key, labels[key])
+	}
+	labelSql, labelArgs, err := sqlBuilder.ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to store labels for %s %s", resourceType, id)
+	}
+	_, err = tx.ExecContext(ctx, labelSql, labelArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store labels for %s %s", resourceType, id)
+	}
+	return nil
+}
+
+// Delete all labels of a resource.
+// This is always in company with deleting a parent resource so a transaction is needed as input.
+func (s *LabelStore) DeleteLabels(tx *sql.Tx, resourceType common.ResourceType, id string) error {
+	labelSql, labelArgs, err := sq.
+		Delete("labels").
+		Where(sq.Eq{"ResourceUUID": id, "ResourceType": resourceType}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete labels for %s %s", resourceType, id)
+	}
+	_, err = tx.Exec(labelSql, labelArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete labels for %s %s", resourceType, id)
+	}
+	return nil
+}
+
+func (s *LabelStore) GetLabels(resourceType common.ResourceType, id string) (map[string]string, error) {
+	labels, err := s.ListLabels(resourceType, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	return labels[id], nil
+}
+
+// Retrieve the labels of a list of resources of the same type, keyed by resource ID.
+// Resources without labels are absent from the result.
+func (s *LabelStore) ListLabels(resourceType common.ResourceType, ids []string) (map[string]map[string]string, error) {
+	result := make(map[string]map[string]string)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	labelSql, labelArgs, err := sq.
+		Select("ResourceUUID", "LabelKey", "LabelValue").
+		From("labels").
+		Where(sq.Eq{"ResourceType": resourceType, "ResourceUUID": ids}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get labels for %s", resourceType)
+	}
+	rows, err := s.db.Query(labelSql, labelArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get labels for %s", resourceType)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, key, value string
+		if err := rows.Scan(&id, &key, &value); err != nil {
+			return nil, util.NewInternalServerError(err, "Failed to parse labels for %s", resourceType)
+		}
+		if result[id] == nil {
+			result[id] = make(map[string]string)
+		}
+		result[id][key] = value
+	}
+	return result, nil
+}
+
+func (s *LabelStore) UpdateLabels(resourceType common.ResourceType, id string, addLabels map[string]string,
+	removeLabelKeys []string) (map[string]string, error) {
+	keys := append(sortedKeys(addLabels), removeLabelKeys...)
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to update labels.")
+	}
+	if len(keys) > 0 {
+		deleteSql, deleteArgs, err := sq.
+			Delete("labels").
+			Where(sq.Eq{"ResourceUUID": id, "ResourceType": resourceType, "LabelKey": keys}).
+			ToSql()
+		if err != nil {
+			tx.Rollback()
+			return nil, util.NewInternalServerError(err, "Failed to create query to update labels for %s %s", resourceType, id)
+		}
+		if _, err = tx.Exec(deleteSql, deleteArgs...); err != nil {
+			tx.Rollback()
+			return nil, util.NewInternalServerError(err, "Failed to update labels for %s %s", resourceType, id)
+		}
+	}
+	if err = s.CreateLabels(context.Background(), tx, resourceType, id, addLabels); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to update labels for %s %s", resourceType, id)
+	}
+	return s.GetLabels(resourceType, id)
+}
+
+// attachLabels sets the labels of each resource returned by a list call.
+func (s *LabelStore) attachLabels(resourceType common.ResourceType, ids []string, set func(i int, labels map[string]string)) error {
+	labels, err := s.ListLabels(resourceType, ids)
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		if l, ok := labels[id]; ok {
+			set(i, l)
+		}
+	}
+	return nil
+}
+
+func NewLabelStore(db *DB) *LabelStore {
+	return &LabelStore{db: db}
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/kubeflow/pipelines/backend/api/go_client"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewLabelStore(db)
+
+	// Create labels
+	tx, _ := db.Begin()
+	err := store.CreateLabels(context.Background(), tx, common.Run, "r1", map[string]string{"team": "a", "env": "prod"})
+	assert.Nil(t, err)
+	err = store.CreateLabels(context.Background(), tx, common.Job, "r1", map[string]string{"team": "b"})
+	assert.Nil(t, err)
+	err = store.CreateLabels(context.Background(), tx, common.Run, "r2", nil)
+	assert.Nil(t, err)
+	err = tx.Commit()
+	assert.Nil(t, err)
+
+	labels, err := store.GetLabels(common.Run, "r1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "a", "env": "prod"}, labels)
+	labels, err = store.GetLabels(common.Run, "r2")
+	assert.Nil(t, err)
+	assert.Nil(t, labels)
+
+	// List labels
+	allLabels, err := store.ListLabels(common.Run, []string{"r1", "r2"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]map[string]string{"r1": {"team": "a", "env": "prod"}}, allLabels)
+
+	// Update labels
+	labels, err = store.UpdateLabels(common.Run, "r1", map[string]string{"team": "c", "owner": "x"}, []string{"env", "notexist"})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "c", "owner": "x"}, labels)
+	labels, err = store.GetLabels(common.Job, "r1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, labels)
+
+	// Delete labels
+	tx, _ = db.Begin()
+	err = store.DeleteLabels(tx, common.Run, "r1")
+	assert.Nil(t, err)
+	err = tx.Commit()
+	assert.Nil(t, err)
+	labels, err = store.GetLabels(common.Run, "r1")
+	assert.Nil(t, err)
+	assert.Nil(t, labels)
+	labels, err = store.GetLabels(common.Job, "r1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "b"}, labels)
+}
+
+func TestListExperiments_WithLabels(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	expStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpId, nil))
+	_, err := expStore.CreateExperiment(&model.Experiment{Name: "e1", Labels: map[string]string{"team": "a"}})
+	assert.Nil(t, err)
+	expStore = NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(defaultFakeExpIdTwo, nil))
+	_, err = expStore.CreateExperiment(&model.Experiment{Name: "e2", Labels: map[string]string{"team": "b"}})
+	assert.Nil(t, err)
+
+	experiment, err := expStore.GetExperiment(defaultFakeExpId)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, experiment.Labels)
+
+	opts, err := list.NewOptions(&model.Experiment{}, 10, "", &api.Filter{
+		Predicates: []*api.Predicate{
+			{Key: "label:team", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "b"}},
+		},
+	})
+	assert.Nil(t, err)
+	experiments, totalSize, _, err := expStore.ListExperiments(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, 1, len(experiments))
+	assert.Equal(t, defaultFakeExpIdTwo, experiments[0].UUID)
+	assert.Equal(t, map[string]string{"team": "b"}, experiments[0].Labels)
+
+	// Labels are deleted along with the experiment.
+	err = expStore.DeleteExperiment(defaultFakeExpIdTwo)
+	assert.Nil(t, err)
+	labels, err := NewLabelStore(db).GetLabels(common.Experiment, defaultFakeExpIdTwo)
+	assert.Nil(t, err)
+	assert.Nil(t, labels)
+}
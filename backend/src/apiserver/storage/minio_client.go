@@ -24,7 +24,9 @@ import (
 type MinioClientInterface interface {
 	PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (n int64, err error)
 	GetObject(bucketName, objectName string, opts minio.GetObjectOptions) (io.Reader, error)
+	StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
 	DeleteObject(bucketName, objectName string) error
+	ListObjects(bucketName, objectPrefix string, recursive bool) ([]minio.ObjectInfo, error)
 }
 
 type MinioClient struct {
@@ -39,6 +41,24 @@ func (c *MinioClient) GetObject(bucketName, objectName string, opts minio.GetObj
 	return c.Client.GetObject(bucketName, objectName, opts)
 }
 
+func (c *MinioClient) StatObject(bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return c.Client.StatObject(bucketName, objectName, opts)
+}
+
 func (c *MinioClient) DeleteObject(bucketName, objectName string) error {
 	return c.Client.RemoveObject(bucketName, objectName)
 }
+
+// ListObjects returns the objects with the given prefix.
+func (c *MinioClient) ListObjects(bucketName, objectPrefix string, recursive bool) ([]minio.ObjectInfo, error) {
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	var objects []minio.ObjectInfo
+	for object := range c.Client.ListObjects(bucketName, objectPrefix, recursive, doneCh) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
@@ -16,7 +16,11 @@ package storage
 
 import (
 	"bytes"
+	"crypto/md5"
+	"encoding/hex"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/minio/minio-go"
 	"github.com/pkg/errors"
@@ -48,6 +52,21 @@ func (c *FakeMinioClient) GetObject(bucketName, objectName string,
 	return bytes.NewReader(c.minioClient[objectName]), nil
 }
 
+func (c *FakeMinioClient) StatObject(bucketName, objectName string,
+	opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	content, ok := c.minioClient[objectName]
+	if !ok {
+		return minio.ObjectInfo{}, minio.ErrorResponse{Code: "NoSuchKey", Message: "object not found"}
+	}
+	sum := md5.Sum(content)
+	return minio.ObjectInfo{
+		Key:         objectName,
+		Size:        int64(len(content)),
+		ContentType: "application/octet-stream",
+		ETag:        hex.EncodeToString(sum[:]),
+	}, nil
+}
+
 func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
 	if _, ok := c.minioClient[objectName]; !ok {
 		return errors.New("object not found")
@@ -56,6 +75,29 @@ func (c *FakeMinioClient) DeleteObject(bucketName, objectName string) error {
 	return nil
 }
 
+func (c *FakeMinioClient) ListObjects(bucketName, objectPrefix string, recursive bool) ([]minio.ObjectInfo, error) {
+	var objectNames []string
+	for objectName := range c.minioClient {
+		if !strings.HasPrefix(objectName, objectPrefix) {
+			continue
+		}
+		if !recursive && strings.Contains(objectName[len(objectPrefix):], "/") {
+			continue
+		}
+		objectNames = append(objectNames, objectName)
+	}
+	sort.Strings(objectNames)
+	var objects []minio.ObjectInfo
+	for _, objectName := range objectNames {
+		object, err := c.StatObject(bucketName, objectName, minio.StatObjectOptions{})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
 func (c *FakeMinioClient) GetObjectCount() int {
 	return len(c.minioClient)
 }
@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type NamespaceQuotaStoreInterface interface {
+	GetNamespaceQuota(namespace string) (*model.NamespaceQuota, error)
+	// Create or replace the quota of a namespace.
+	UpdateNamespaceQuota(quota *model.NamespaceQuota) (*model.NamespaceQuota, error)
+}
+
+type NamespaceQuotaStore struct {
+	db   *DB
+	time util.TimeInterface
+}
+
+func (s *NamespaceQuotaStore) GetNamespaceQuota(namespace string) (*model.NamespaceQuota, error) {
+	quotaSql, quotaArgs, err := sq.
+		Select("Namespace", "MaxActiveRuns", "MaxRunsPerHour", "UpdatedAtInSec").
+		From("namespace_quota").
+		Where(sq.Eq{"Namespace": namespace}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get quota of namespace %s: %v", namespace, err.Error())
+	}
+	var quota model.NamespaceQuota
+	err = s.db.QueryRow(quotaSql, quotaArgs...).Scan(&quota.Namespace, &quota.MaxActiveRuns, &quota.MaxRunsPerHour, &quota.UpdatedAtInSec)
+	if err == sql.ErrNoRows {
+		return nil, util.NewResourceNotFoundError("NamespaceQuota", namespace)
+	}
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get quota of namespace %s: %v", namespace, err.Error())
+	}
+	return &quota, nil
+}
+
+func (s *NamespaceQuotaStore) UpdateNamespaceQuota(quota *model.NamespaceQuota) (*model.NamespaceQuota, error) {
+	newQuota := *quota
+	newQuota.UpdatedAtInSec = s.time.Now().Unix()
+	deleteSql, deleteArgs, err := sq.Delete("namespace_quota").Where(sq.Eq{"Namespace": quota.Namespace}).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to update quota of namespace %s", quota.Namespace)
+	}
+	insertSql, insertArgs, err := sq.
+		Insert("namespace_quota").
+		SetMap(sq.Eq{
+			"Namespace":      newQuota.Namespace,
+			"MaxActiveRuns":  newQuota.MaxActiveRuns,
+			"MaxRunsPerHour": newQuota.MaxRunsPerHour,
+			"UpdatedAtInSec": newQuota.UpdatedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to update quota of namespace %s", quota.Namespace)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to update quota of namespace.")
+	}
+	if _, err = tx.Exec(deleteSql, deleteArgs...); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to update quota of namespace %s", quota.Namespace)
+	}
+	if _, err = tx.Exec(insertSql, insertArgs...); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to update quota of namespace %s", quota.Namespace)
+	}
+	if err = tx.Commit(); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to update quota of namespace %s", quota.Namespace)
+	}
+	return &newQuota, nil
+}
+
+// factory function for namespace quota store
+func NewNamespaceQuotaStore(db *DB, time util.TimeInterface) *NamespaceQuotaStore {
+	return &NamespaceQuotaStore{db: db, time: time}
+}
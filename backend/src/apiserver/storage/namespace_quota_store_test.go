@@ -0,0 +1,47 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNamespaceQuotaStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewNamespaceQuotaStore(db, util.NewFakeTimeForEpoch())
+
+	_, err := store.GetNamespaceQuota("ns1")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	quota, err := store.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 2, MaxRunsPerHour: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 2, MaxRunsPerHour: 10, UpdatedAtInSec: 1}, quota)
+
+	// An update replaces the previous quota.
+	_, err = store.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 5})
+	assert.Nil(t, err)
+	quota, err = store.GetNamespaceQuota("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 5, UpdatedAtInSec: 2}, quota)
+
+	_, err = store.GetNamespaceQuota("ns2")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
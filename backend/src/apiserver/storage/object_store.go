@@ -16,12 +16,22 @@ package storage
 
 import (
 	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
 	"path"
 	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -30,14 +40,177 @@ const (
 
 // Interface for object store.
 type ObjectStoreInterface interface {
-	AddFile(template []byte, filePath string) error
+	// Add a file. The ctx of the request adding it, if any, traces the call.
+	AddFile(ctx context.Context, template []byte, filePath string) error
 	DeleteFile(filePath string) error
-	GetFile(filePath string) ([]byte, error)
+	// Delete all files under a folder. Returns the number of deleted files.
+	DeleteFolder(folderPath string) (int, error)
+	// List all files under a folder, sorted by key.
+	ListFiles(folderPath string) ([]*FileInfo, error)
+	// Get the content of a file. The ctx of the request getting it, if any,
+	// traces the call.
+	GetFile(ctx context.Context, filePath string) ([]byte, error)
+	// Open a file for streaming its content without reading it into memory.
+	// The caller closes the returned reader.
+	GetFileReader(filePath string) (FileReader, *FileInfo, error)
 	AddAsYamlFile(o interface{}, filePath string) error
 	GetFromYamlFile(o interface{}, filePath string) error
 	GetPipelineKey(pipelineId string) string
 }
 
+// FileReader streams the content of a file in the object store. It is
+// seekable so that byte ranges of the file can be served.
+type FileReader interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// FileInfo describes a file in the object store.
+type FileInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+	ContentType  string
+	// The entity tag of the file, which is the MD5 checksum of its content
+	// unless it was uploaded in multiple parts.
+	ETag string
+}
+
+// nopFileReader adds a no-op Close to a reader which holds no resources.
+type nopFileReader struct {
+	io.ReadSeeker
+}
+
+func (nopFileReader) Close() error { return nil }
+
+// chunkSignaturePattern matches the single part signatures which are removed
+// from the content of files when multipart upload is disabled.
+var chunkSignaturePattern = regexp.MustCompile(`\w+;chunk-signature=\w+`)
+
+// The number of bytes read from the beginning of a file to find whether it
+// starts with a single part signature.
+const chunkSignaturePeekSize = 256
+
+// The number of bytes of a signed file read at once.
+const signatureStrippingReadSize = 32 * 1024
+
+// hasChunkSignature returns whether a file starts with a single part
+// signature. The file is read from its start afterwards.
+func hasChunkSignature(file FileReader) (bool, error) {
+	head := make([]byte, chunkSignaturePeekSize)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+	loc := chunkSignaturePattern.FindIndex(head[:n])
+	return loc != nil && loc[0] == 0, nil
+}
+
+// isSignatureByte returns whether a byte can be part of a single part
+// signature.
+func isSignatureByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == ';' || c == '-' || c == '='
+}
+
+// signatureStrippingReader streams a file whose content has single part
+// signatures, removing them while it is read. The content read is filtered up
+// to its last byte which cannot be part of a signature, and the rest is kept
+// until more is read. Seeking backward reads the file again from its start.
+type signatureStrippingReader struct {
+	file FileReader
+	// The content read from the file which is not filtered yet.
+	unfiltered []byte
+	// The filtered content which is not returned yet.
+	filtered []byte
+	// The offset of the next byte returned in the filtered content.
+	offset int64
+	// The size of the filtered content, or -1 until the file is read through.
+	size int64
+	eof  bool
+}
+
+func (r *signatureStrippingReader) Read(p []byte) (int, error) {
+	for len(r.filtered) == 0 {
+		if r.eof {
+			if r.size < 0 {
+				r.size = r.offset
+			}
+			return 0, io.EOF
+		}
+		if err := r.fill(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.filtered)
+	r.filtered = r.filtered[n:]
+	r.offset += int64(n)
+	return n, nil
+}
+
+// fill reads more of the file and filters the content read so far.
+func (r *signatureStrippingReader) fill() error {
+	buf := make([]byte, signatureStrippingReadSize)
+	n, err := r.file.Read(buf)
+	if err == io.EOF {
+		r.eof = true
+	} else if err != nil {
+		return err
+	}
+	r.unfiltered = append(r.unfiltered, buf[:n]...)
+	end := len(r.unfiltered)
+	if !r.eof {
+		for end > 0 && isSignatureByte(r.unfiltered[end-1]) {
+			end--
+		}
+	}
+	r.filtered = chunkSignaturePattern.ReplaceAllLiteral(r.unfiltered[:end], nil)
+	r.unfiltered = append([]byte(nil), r.unfiltered[end:]...)
+	return nil
+}
+
+func (r *signatureStrippingReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		if r.size < 0 {
+			if _, err := io.Copy(ioutil.Discard, r); err != nil {
+				return 0, err
+			}
+		}
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	if offset < r.offset {
+		if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+			return 0, err
+		}
+		r.unfiltered, r.filtered, r.offset, r.eof = nil, nil, 0, false
+	}
+	if r.size >= 0 && offset >= r.size {
+		// Nothing is left to read, so the file is not read through again.
+		r.unfiltered, r.filtered, r.offset, r.eof = nil, nil, offset, true
+		return offset, nil
+	}
+	if _, err := io.CopyN(ioutil.Discard, r, offset-r.offset); err != nil && err != io.EOF {
+		return 0, err
+	}
+	return offset, nil
+}
+
+func (r *signatureStrippingReader) Close() error {
+	return r.file.Close()
+}
+
 // Managing pipeline using Minio
 type MinioObjectStore struct {
 	minioClient      MinioClientInterface
@@ -46,12 +219,20 @@ type MinioObjectStore struct {
 	disableMultipart bool
 }
 
+// startSpan starts the span of a call of the Minio client on an object, as a
+// child of the span of the request in ctx if any.
+func (m *MinioObjectStore) startSpan(ctx context.Context, operation string, objectName string) trace.Span {
+	_, span := tracing.StartSpan(ctx, "MinioObjectStore."+operation,
+		attribute.String("minio.bucket", m.bucketName), attribute.String("minio.object", objectName))
+	return span
+}
+
 // GetPipelineKey adds the configured base folder to pipeline id.
 func (m *MinioObjectStore) GetPipelineKey(pipelineID string) string {
 	return path.Join(m.baseFolder, pipelineID)
 }
 
-func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
+func (m *MinioObjectStore) AddFile(ctx context.Context, file []byte, filePath string) error {
 
 	var parts int64
 
@@ -61,9 +242,11 @@ func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
 		parts = multipartDefaultSize
 	}
 
+	span := m.startSpan(ctx, "PutObject", filePath)
 	_, err := m.minioClient.PutObject(
 		m.bucketName, filePath, bytes.NewReader(file),
 		parts, minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to store %v", filePath)
 	}
@@ -71,47 +254,157 @@ func (m *MinioObjectStore) AddFile(file []byte, filePath string) error {
 }
 
 func (m *MinioObjectStore) DeleteFile(filePath string) error {
+	span := m.startSpan(context.Background(), "DeleteObject", filePath)
 	err := m.minioClient.DeleteObject(m.bucketName, filePath)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to delete %v", filePath)
 	}
 	return nil
 }
 
-func (m *MinioObjectStore) GetFile(filePath string) ([]byte, error) {
+func (m *MinioObjectStore) DeleteFolder(folderPath string) (int, error) {
+	if folderPath == "" || folderPath == "/" {
+		return 0, util.NewInvalidInputError("Failed to delete folder. The folder path is empty.")
+	}
+	files, err := m.ListFiles(folderPath)
+	if err != nil {
+		return 0, err
+	}
+	for i, file := range files {
+		err = m.DeleteFile(file.Key)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(files), nil
+}
+
+func (m *MinioObjectStore) ListFiles(folderPath string) ([]*FileInfo, error) {
+	prefix := strings.TrimSuffix(folderPath, "/") + "/"
+	span := m.startSpan(context.Background(), "ListObjects", prefix)
+	objects, err := m.minioClient.ListObjects(m.bucketName, prefix, true)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list files under %v", prefix)
+	}
+	files := make([]*FileInfo, 0, len(objects))
+	for _, object := range objects {
+		files = append(files, &FileInfo{
+			Key:          object.Key,
+			Size:         object.Size,
+			LastModified: object.LastModified,
+			ContentType:  object.ContentType,
+			ETag:         object.ETag,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+	return files, nil
+}
+
+func (m *MinioObjectStore) GetFile(ctx context.Context, filePath string) ([]byte, error) {
+	span := m.startSpan(ctx, "GetObject", filePath)
 	reader, err := m.minioClient.GetObject(m.bucketName, filePath, minio.GetObjectOptions{})
 	if err != nil {
+		tracing.EndSpan(span, err)
 		return nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
 	}
 
+	// The object is downloaded while it is read.
 	buf := new(bytes.Buffer)
-	buf.ReadFrom(reader)
+	_, err = buf.ReadFrom(reader)
+	tracing.EndSpan(span, err)
 
 	bytes := buf.Bytes()
 
 	// Remove single part signature if exists
 	if m.disableMultipart {
-		re := regexp.MustCompile(`\w+;chunk-signature=\w+`)
-		bytes = []byte(re.ReplaceAllString(string(bytes), ""))
+		bytes = chunkSignaturePattern.ReplaceAllLiteral(bytes, nil)
 	}
 
 	return bytes, nil
 }
 
+func (m *MinioObjectStore) GetFileReader(filePath string) (FileReader, *FileInfo, error) {
+	span := m.startSpan(context.Background(), "StatObject", filePath)
+	objectInfo, err := m.minioClient.StatObject(m.bucketName, filePath, minio.StatObjectOptions{})
+	tracing.EndSpan(span, err)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, nil, util.NewResourceNotFoundError("file", filePath)
+		}
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	info := &FileInfo{
+		Key:          filePath,
+		Size:         objectInfo.Size,
+		LastModified: objectInfo.LastModified,
+		ContentType:  objectInfo.ContentType,
+		ETag:         objectInfo.ETag,
+	}
+	reader, err := m.minioClient.GetObject(m.bucketName, filePath, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	var file FileReader
+	switch r := reader.(type) {
+	case FileReader:
+		file = r
+	case io.ReadSeeker:
+		file = nopFileReader{r}
+	default:
+		return nil, nil, util.NewInternalServerError(
+			errors.New("object reader is not seekable"), "Failed to get %v", filePath)
+	}
+	if !m.disableMultipart {
+		return file, info, nil
+	}
+
+	// A file uploaded in a single part may start with a signature, in which
+	// case its content is filtered like GetFile does while it is streamed.
+	signed, err := hasChunkSignature(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	if !signed {
+		return file, info, nil
+	}
+	filtered := &signatureStrippingReader{file: file, size: -1}
+	info.Size, err = filtered.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = filtered.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		filtered.Close()
+		return nil, nil, util.NewInternalServerError(err, "Failed to get %v", filePath)
+	}
+	info.ETag = ""
+	return filtered, info, nil
+}
+
 func (m *MinioObjectStore) AddAsYamlFile(o interface{}, filePath string) error {
+	return addAsYamlFile(m, o, filePath)
+}
+
+func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
+	return getFromYamlFile(m, o, filePath)
+}
+
+func addAsYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
 	bytes, err := yaml.Marshal(o)
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to marshal %v: %v", filePath, err.Error())
 	}
-	err = m.AddFile(bytes, filePath)
+	err = store.AddFile(context.Background(), bytes, filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to add a yaml file.")
 	}
 	return nil
 }
 
-func (m *MinioObjectStore) GetFromYamlFile(o interface{}, filePath string) error {
-	bytes, err := m.GetFile(filePath)
+func getFromYamlFile(store ObjectStoreInterface, o interface{}, filePath string) error {
+	bytes, err := store.GetFile(context.Background(), filePath)
 	if err != nil {
 		return util.Wrap(err, "Failed to read from a yaml file.")
 	}
@@ -16,13 +16,19 @@ package storage
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"io/ioutil"
 	"testing"
 
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	minio "github.com/minio/minio-go"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/grpc/codes"
 )
 
@@ -40,14 +46,23 @@ func (c *FakeBadMinioClient) GetObject(bucketName, objectName string,
 	return nil, errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) StatObject(bucketName, objectName string,
+	opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return minio.ObjectInfo{}, errors.New("some error")
+}
+
 func (c *FakeBadMinioClient) DeleteObject(bucketName, objectName string) error {
 	return errors.New("some error")
 }
 
+func (c *FakeBadMinioClient) ListObjects(bucketName, objectPrefix string, recursive bool) ([]minio.ObjectInfo, error) {
+	return nil, errors.New("some error")
+}
+
 func TestAddFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
-	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	error := manager.AddFile(context.Background(), []byte("abc"), manager.GetPipelineKey("1"))
 	assert.Nil(t, error)
 	assert.Equal(t, 1, minioClient.GetObjectCount())
 	assert.True(t, minioClient.ExistObject("pipeline/1"))
@@ -55,28 +70,144 @@ func TestAddFile(t *testing.T) {
 
 func TestAddFileError(t *testing.T) {
 	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
-	error := manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	error := manager.AddFile(context.Background(), []byte("abc"), manager.GetPipelineKey("1"))
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
+func TestAddFileAndGetFile_TracedAsPartOfRequest(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(tracerProvider)
+
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	ctx, request := tracing.StartSpan(context.Background(), "request")
+	assert.Nil(t, manager.AddFile(ctx, []byte("abc"), manager.GetPipelineKey("1")))
+	_, err := manager.GetFile(ctx, manager.GetPipelineKey("1"))
+	assert.Nil(t, err)
+	tracing.EndSpan(request, nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "MinioObjectStore.PutObject", spans[0].Name())
+	assert.Equal(t, request.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, "MinioObjectStore.GetObject", spans[1].Name())
+	assert.Equal(t, request.SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
+
 func TestGetFile(t *testing.T) {
 	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
-	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
-	file, error := manager.GetFile(manager.GetPipelineKey("1"))
+	manager.AddFile(context.Background(), []byte("abc"), manager.GetPipelineKey("1"))
+	file, error := manager.GetFile(context.Background(), manager.GetPipelineKey("1"))
 	assert.Nil(t, error)
 	assert.Equal(t, file, []byte("abc"))
 }
 
 func TestGetFileError(t *testing.T) {
 	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
-	_, error := manager.GetFile(manager.GetPipelineKey("1"))
+	_, error := manager.GetFile(context.Background(), manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestGetFileReader(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile(context.Background(), []byte("abcdef"), manager.GetPipelineKey("1"))
+	reader, info, error := manager.GetFileReader(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	defer reader.Close()
+	assert.Equal(t, "pipeline/1", info.Key)
+	assert.Equal(t, int64(6), info.Size)
+	assert.Equal(t, "e80b5017098950fc58aad83c8c14978e", info.ETag)
+	_, error = reader.Seek(3, io.SeekStart)
+	assert.Nil(t, error)
+	content, error := ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("def"), content)
+}
+
+func TestGetFileReader_DisableMultipart(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline", disableMultipart: true}
+	manager.AddFile(context.Background(), []byte("abcdef"), manager.GetPipelineKey("1"))
+	reader, info, error := manager.GetFileReader(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	defer reader.Close()
+	assert.Equal(t, int64(6), info.Size)
+	assert.Equal(t, "e80b5017098950fc58aad83c8c14978e", info.ETag)
+	content, error := ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("abcdef"), content)
+}
+
+func TestGetFileReader_DisableMultipartSignedFile(t *testing.T) {
+	var signed bytes.Buffer
+	for i := 0; i < 3000; i++ {
+		signed.WriteString("5;chunk-signature=abc123\r\nhello\r\n")
+	}
+	signed.WriteString("0;chunk-signature=def456\r\n\r\n")
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline", disableMultipart: true}
+	manager.AddFile(context.Background(), signed.Bytes(), manager.GetPipelineKey("1"))
+	expected, error := manager.GetFile(context.Background(), manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	assert.Equal(t, []byte("\r\nhello\r\n"), expected[:9])
+
+	reader, info, error := manager.GetFileReader(manager.GetPipelineKey("1"))
+	assert.Nil(t, error)
+	defer reader.Close()
+	assert.Equal(t, int64(len(expected)), info.Size)
+	assert.Equal(t, "", info.ETag)
+	content, error := ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, expected, content)
+
+	// Reading a range seeks backward.
+	offset, error := reader.Seek(20000, io.SeekStart)
+	assert.Nil(t, error)
+	assert.Equal(t, int64(20000), offset)
+	part := make([]byte, 100)
+	_, error = io.ReadFull(reader, part)
+	assert.Nil(t, error)
+	assert.Equal(t, expected[20000:20100], part)
+
+	offset, error = reader.Seek(-5, io.SeekEnd)
+	assert.Nil(t, error)
+	assert.Equal(t, int64(len(expected)-5), offset)
+	content, error = ioutil.ReadAll(reader)
+	assert.Nil(t, error)
+	assert.Equal(t, expected[len(expected)-5:], content)
+}
+
+func TestGetFileReaderError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	_, _, error := manager.GetFileReader(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.NotFound, error.(*util.UserError).ExternalStatusCode())
+	manager = &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
+	_, _, error = manager.GetFileReader(manager.GetPipelineKey("1"))
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+}
+
+func TestListFiles(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: NewFakeMinioClient(), baseFolder: "pipeline"}
+	manager.AddFile(context.Background(), []byte("abc"), "artifacts/run1/task2/b.tgz")
+	manager.AddFile(context.Background(), []byte("abcdef"), "artifacts/run1/task1/a.tgz")
+	manager.AddFile(context.Background(), []byte("abc"), "artifacts/run10/task1/a.tgz")
+	files, error := manager.ListFiles("artifacts/run1")
+	assert.Nil(t, error)
+	assert.Equal(t, 2, len(files))
+	assert.Equal(t, "artifacts/run1/task1/a.tgz", files[0].Key)
+	assert.Equal(t, int64(6), files[0].Size)
+	assert.Equal(t, "artifacts/run1/task2/b.tgz", files[1].Key)
+}
+
+func TestListFilesError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}, baseFolder: "pipeline"}
+	_, error := manager.ListFiles("artifacts/run1")
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
 func TestDeleteFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
-	manager.AddFile([]byte("abc"), manager.GetPipelineKey("1"))
+	manager.AddFile(context.Background(), []byte("abc"), manager.GetPipelineKey("1"))
 	error := manager.DeleteFile(manager.GetPipelineKey("1"))
 	assert.Nil(t, error)
 	assert.Equal(t, 0, minioClient.GetObjectCount())
@@ -88,6 +219,27 @@ func TestDeleteFileError(t *testing.T) {
 	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
 }
 
+func TestDeleteFolder(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
+	manager.AddFile(context.Background(), []byte("abc"), "artifacts/run1/node1/a.tgz")
+	manager.AddFile(context.Background(), []byte("abc"), "artifacts/run1/node2/b.tgz")
+	manager.AddFile(context.Background(), []byte("abc"), "artifacts/run10/node1/a.tgz")
+	count, error := manager.DeleteFolder("artifacts/run1")
+	assert.Nil(t, error)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+	assert.True(t, minioClient.ExistObject("artifacts/run10/node1/a.tgz"))
+}
+
+func TestDeleteFolderError(t *testing.T) {
+	manager := &MinioObjectStore{minioClient: &FakeBadMinioClient{}}
+	_, error := manager.DeleteFolder("artifacts/run1")
+	assert.Equal(t, codes.Internal, error.(*util.UserError).ExternalStatusCode())
+	_, error = manager.DeleteFolder("")
+	assert.Equal(t, codes.InvalidArgument, error.(*util.UserError).ExternalStatusCode())
+}
+
 func TestAddAsYamlFile(t *testing.T) {
 	minioClient := NewFakeMinioClient()
 	manager := &MinioObjectStore{minioClient: minioClient, baseFolder: "pipeline"}
@@ -15,6 +15,7 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
@@ -55,6 +56,9 @@ var pipelineVersionColumns = []string{
 	"pipeline_versions.CodeSourceUrl",
 }
 
+// The parameters are left out of pipeline versions listed with the basic view.
+var pipelineVersionBasicListColumns = omitColumns(pipelineVersionColumns, "Parameters")
+
 type PipelineStoreInterface interface {
 	ListPipelines(filterContext *common.FilterContext, opts *list.Options) ([]*model.Pipeline, int, string, error)
 	GetPipeline(pipelineId string) (*model.Pipeline, error)
@@ -77,9 +81,10 @@ type PipelineStoreInterface interface {
 }
 
 type PipelineStore struct {
-	db   *DB
-	time util.TimeInterface
-	uuid util.UUIDGeneratorInterface
+	db         *DB
+	time       util.TimeInterface
+	uuid       util.UUIDGeneratorInterface
+	labelStore *LabelStore
 }
 
 // Runs two SQL queries in a transaction to return a list of matching pipelines, as well as their
@@ -262,9 +267,36 @@ func (s *PipelineStore) DeletePipeline(id string) error {
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to create query to delete pipeline: %v", err.Error())
 	}
+	// The versions of the pipeline are deleted by the database, so the labels
+	// of the versions are deleted here.
+	versionsSql, versionsArgs, err := sq.Select("UUID").From("pipeline_versions").Where(sq.Eq{"PipelineId": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete pipeline: %v", err.Error())
+	}
+	labelsSql, labelsArgs, err := sq.
+		Delete("labels").
+		Where(sq.Eq{"ResourceType": common.PipelineVersion}).
+		Where(fmt.Sprintf("ResourceUUID in (%s)", versionsSql), versionsArgs...).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete pipeline: %v", err.Error())
+	}
 
-	_, err = s.db.Exec(sql, args...)
+	tx, err := s.db.Begin()
 	if err != nil {
+		return util.NewInternalServerError(err, "Failed to start a transaction to delete pipeline: %v", err.Error())
+	}
+	_, err = tx.Exec(labelsSql, labelsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels of pipeline versions: %v", err.Error())
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete pipeline: %v", err.Error())
+	}
+	if err := tx.Commit(); err != nil {
 		return util.NewInternalServerError(err, "Failed to delete pipeline: %v", err.Error())
 	}
 	return nil
@@ -366,6 +398,11 @@ func (s *PipelineStore) CreatePipeline(p *model.Pipeline) (*model.Pipeline, erro
 			"Failed to add pipeline version to pipeline_versions table: %v",
 			err.Error())
 	}
+	err = s.labelStore.CreateLabels(context.Background(), tx, common.PipelineVersion, newPipeline.DefaultVersion.UUID, newPipeline.DefaultVersion.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, util.NewInternalServerError(err,
 			`Failed to update pipelines and pipeline_versions in a
@@ -459,7 +496,7 @@ func (s *PipelineStore) UpdatePipelineAndVersionsStatus(id string, status model.
 
 // factory function for pipeline store
 func NewPipelineStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *PipelineStore {
-	return &PipelineStore{db: db, time: time, uuid: uuid}
+	return &PipelineStore{db: db, time: time, uuid: uuid, labelStore: NewLabelStore(db)}
 }
 
 func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion, updatePipelineDefaultVersion bool) (*model.PipelineVersion, error) {
@@ -522,6 +559,12 @@ func (s *PipelineStore) CreatePipelineVersion(v *model.PipelineVersion, updatePi
 			err.Error())
 	}
 
+	err = s.labelStore.CreateLabels(context.Background(), tx, common.PipelineVersion, newPipelineVersion.UUID, newPipelineVersion.Labels)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	if updatePipelineDefaultVersion {
 		_, err = tx.Exec(pipelineSql, pipelineArgs...)
 		if err != nil {
@@ -583,6 +626,11 @@ func (s *PipelineStore) GetPipelineVersionWithStatus(versionId string, status mo
 	if len(versions) == 0 {
 		return nil, util.NewResourceNotFoundError("Version", fmt.Sprint(versionId))
 	}
+	labels, err := s.labelStore.GetLabels(common.PipelineVersion, versionId)
+	if err != nil {
+		return nil, err
+	}
+	versions[0].Labels = labels
 	return versions[0], nil
 }
 
@@ -628,8 +676,12 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 	}
 
 	// SQL for pipeline version list
+	columns := pipelineVersionColumns
+	if opts.BasicView {
+		columns = pipelineVersionBasicListColumns
+	}
 	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(
-		buildQuery(sq.Select(pipelineVersionColumns...))).ToSql()
+		buildQuery(sq.Select(columns...))).ToSql()
 	if err != nil {
 		return errorF(err)
 	}
@@ -678,6 +730,17 @@ func (s *PipelineStore) ListPipelineVersions(pipelineId string, opts *list.Optio
 		return errorF(err)
 	}
 
+	ids := make([]string, 0, len(pipelineVersions))
+	for _, version := range pipelineVersions {
+		ids = append(ids, version.UUID)
+	}
+	err = s.labelStore.attachLabels(common.PipelineVersion, ids, func(i int, labels map[string]string) {
+		pipelineVersions[i].Labels = labels
+	})
+	if err != nil {
+		return errorF(err)
+	}
+
 	if len(pipelineVersions) <= opts.PageSize {
 		return pipelineVersions, total_size, "", nil
 	}
@@ -711,6 +774,11 @@ func (s *PipelineStore) DeletePipelineVersion(versionId string) error {
 			"Failed to delete pipeline version: %v",
 			err.Error())
 	}
+	err = s.labelStore.DeleteLabels(tx, common.PipelineVersion, versionId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	// (2) check whether this version is used as default version.
 	r, err := tx.Query(
@@ -233,7 +233,7 @@ func TestListPipelines_Pagination(t *testing.T) {
 		}}
 	pipelinesExpected2 := []*model.Pipeline{expectedPipeline2, expectedPipeline3}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Pipeline{}, nextPageToken, 2)
 	assert.Nil(t, err)
 
 	pipelines, total_size, nextPageToken, err = pipelineStore.ListPipelines(&common.FilterContext{}, opts)
@@ -327,7 +327,7 @@ func TestListPipelines_Pagination_Descend(t *testing.T) {
 		}}
 	pipelinesExpected2 := []*model.Pipeline{expectedPipeline4, expectedPipeline1}
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.Pipeline{}, nextPageToken, 2)
 	assert.Nil(t, err)
 	pipelines, total_size, nextPageToken, err = pipelineStore.ListPipelines(&common.FilterContext{}, opts)
 	assert.Nil(t, err)
@@ -1060,7 +1060,7 @@ func TestListPipelineVersions_Pagination(t *testing.T) {
 		},
 	})
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.PipelineVersion{}, nextPageToken, 2)
 	assert.Nil(t, err)
 	pipelineVersions, total_size, nextPageToken, err =
 		pipelineStore.ListPipelineVersions(fakeUUID, opts)
@@ -1175,7 +1175,7 @@ func TestListPipelineVersions_Pagination_Descend(t *testing.T) {
 		},
 	})
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 2)
+	opts, err = list.NewOptionsFromToken(&model.PipelineVersion{}, nextPageToken, 2)
 	assert.Nil(t, err)
 	pipelineVersions, total_size, nextPageToken, err =
 		pipelineStore.ListPipelineVersions(fakeUUID, opts)
@@ -1408,3 +1408,44 @@ func TestUpdatePipelineVersionStatusError(t *testing.T) {
 		fakeUUID, model.PipelineVersionDeleting)
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode())
 }
+
+func TestListPipelineVersions_BasicView(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	pipelineStore := NewPipelineStore(
+		db,
+		util.NewFakeTimeForEpoch(),
+		util.NewFakeUUIDGeneratorOrFatal(fakeUUID, nil))
+	_, err := pipelineStore.CreatePipeline(
+		&model.Pipeline{
+			Name:       "pipeline_1",
+			Parameters: `[{"Name": "param1"}]`,
+			Status:     model.PipelineReady,
+		})
+	assert.Nil(t, err)
+	pipelineStore.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeUUIDTwo, nil)
+	_, err = pipelineStore.CreatePipelineVersion(
+		&model.PipelineVersion{
+			Name:          "pipeline_version_1",
+			Parameters:    `[{"Name": "param1"}]`,
+			PipelineId:    fakeUUID,
+			Status:        model.PipelineVersionReady,
+			CodeSourceUrl: "code_source_url",
+		}, true)
+	assert.Nil(t, err)
+
+	opts, err := list.NewOptions(&model.PipelineVersion{}, 10, "", nil)
+	assert.Nil(t, err)
+	versions, _, _, err := pipelineStore.ListPipelineVersions(fakeUUID, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(versions))
+	assert.Equal(t, `[{"Name": "param1"}]`, versions[0].Parameters)
+
+	opts.BasicView = true
+	versions, _, _, err = pipelineStore.ListPipelineVersions(fakeUUID, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(versions))
+	assert.Equal(t, "", versions[0].Parameters)
+	assert.Equal(t, "pipeline_version_1", versions[0].Name)
+	assert.Equal(t, "code_source_url", versions[0].CodeSourceUrl)
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 
 	"fmt"
@@ -27,13 +28,13 @@ type ResourceReferenceStore struct {
 
 // Create a resource reference.
 // This is always in company with creating a parent resource so a transaction is needed as input.
-func (s *ResourceReferenceStore) CreateResourceReferences(tx *sql.Tx, refs []*model.ResourceReference) error {
+func (s *ResourceReferenceStore) CreateResourceReferences(ctx context.Context, tx *sql.Tx, refs []*model.ResourceReference) error {
 	if len(refs) > 0 {
 		resourceRefSqlBuilder := sq.
 			Insert("resource_references").
 			Columns("ResourceUUID", "ResourceType", "ReferenceUUID", "ReferenceName", "ReferenceType", "Relationship", "Payload")
 		for _, ref := range refs {
-			if !s.checkReferenceExist(tx, ref.ReferenceUUID, ref.ReferenceType) {
+			if !s.checkReferenceExist(ctx, tx, ref.ReferenceUUID, ref.ReferenceType) {
 				return util.NewResourceNotFoundError(string(ref.ReferenceType), ref.ReferenceUUID)
 			}
 			payload, err := json.Marshal(ref)
@@ -47,7 +48,7 @@ func (s *ResourceReferenceStore) CreateResourceReferences(tx *sql.Tx, refs []*mo
 		if err != nil {
 			return util.NewInternalServerError(err, "Failed to create query to store resource references.")
 		}
-		_, err = tx.Exec(refSql, refArgs...)
+		_, err = tx.ExecContext(ctx, refSql, refArgs...)
 		if err != nil {
 			return util.NewInternalServerError(err, "Failed to store resource references.")
 		}
@@ -55,7 +56,7 @@ func (s *ResourceReferenceStore) CreateResourceReferences(tx *sql.Tx, refs []*mo
 	return nil
 }
 
-func (s *ResourceReferenceStore) checkReferenceExist(tx *sql.Tx, referenceId string, referenceType common.ResourceType) bool {
+func (s *ResourceReferenceStore) checkReferenceExist(ctx context.Context, tx *sql.Tx, referenceId string, referenceType common.ResourceType) bool {
 	var selectBuilder sq.SelectBuilder
 	switch referenceType {
 	case common.Job:
@@ -76,7 +77,7 @@ func (s *ResourceReferenceStore) checkReferenceExist(tx *sql.Tx, referenceId str
 		return false
 	}
 	var exists bool
-	err = tx.QueryRow(fmt.Sprintf("SELECT exists (%s)", query), args...).Scan(&exists)
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT exists (%s)", query), args...).Scan(&exists)
 	if err != nil && err != sql.ErrNoRows {
 		return false
 	}
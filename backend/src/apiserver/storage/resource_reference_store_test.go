@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"testing"
 
 	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
@@ -39,14 +40,14 @@ func TestResourceReferenceStore(t *testing.T) {
 
 	// Create resource reference
 	tx, _ := db.Begin()
-	err := store.CreateResourceReferences(tx, []*model.ResourceReference{testRefOne, testRefTwo, testRefThree})
+	err := store.CreateResourceReferences(context.Background(), tx, []*model.ResourceReference{testRefOne, testRefTwo, testRefThree})
 	assert.Nil(t, err)
 	err = tx.Commit()
 	assert.Nil(t, err)
 
 	// Create resource reference - reference not exist
 	tx, _ = db.Begin()
-	err = store.CreateResourceReferences(tx, []*model.ResourceReference{
+	err = store.CreateResourceReferences(context.Background(), tx, []*model.ResourceReference{
 		{
 			ResourceUUID: "notexist", ResourceType: common.Job,
 			ReferenceUUID: "e1", ReferenceType: common.Experiment,
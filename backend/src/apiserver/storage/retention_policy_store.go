@@ -0,0 +1,335 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type RetentionPolicyStoreInterface interface {
+	ListRetentionPolicies(filterContext *common.FilterContext, opts *list.Options) ([]*model.RetentionPolicy, int, string, error)
+	GetRetentionPolicy(uuid string) (*model.RetentionPolicy, error)
+	GetRetentionPolicyByResource(resourceType common.ResourceType, id string) (*model.RetentionPolicy, error)
+	CreateRetentionPolicy(*model.RetentionPolicy) (*model.RetentionPolicy, error)
+	DeleteRetentionPolicy(uuid string) error
+	// Set the time a policy was last enforced to enforcedAtInSec, unless it was
+	// last enforced at or after claimableBeforeInSec. Returns whether the time
+	// was set, i.e. whether the policy was claimed for enforcement.
+	ClaimRetentionPolicyEnforcement(uuid string, enforcedAtInSec int64, claimableBeforeInSec int64) (bool, error)
+	// Record the result of enforcing a policy. The run and object counts are
+	// added to the totals of the policy.
+	ReportRetentionPolicyEnforcement(uuid string, enforcedAtInSec int64, archivedRunCount int64,
+		deletedRunCount int64, deletedObjectCount int64, enforcementError string) error
+}
+
+type RetentionPolicyStore struct {
+	db   *DB
+	time util.TimeInterface
+	uuid util.UUIDGeneratorInterface
+}
+
+var (
+	retentionPolicyColumns = []string{
+		"UUID",
+		"ResourceType",
+		"ResourceUUID",
+		"ArchiveAfterDays",
+		"DeleteAfterDays",
+		"CreatedAtInSec",
+		"LastEnforcedAtInSec",
+		"ArchivedRunCount",
+		"DeletedRunCount",
+		"DeletedObjectCount",
+		"Error",
+	}
+)
+
+// Runs two SQL queries in a transaction to return a list of matching retention policies, as well as their
+// total_size. The total_size does not reflect the page size.
+func (s *RetentionPolicyStore) ListRetentionPolicies(filterContext *common.FilterContext, opts *list.Options) ([]*model.RetentionPolicy, int, string, error) {
+	errorF := func(err error) ([]*model.RetentionPolicy, int, string, error) {
+		return nil, 0, "", util.NewInternalServerError(err, "Failed to list retention policies: %v", err)
+	}
+
+	// SQL for getting the filtered and paginated rows
+	sqlBuilder := s.filterByResource(sq.Select(retentionPolicyColumns...).From("retention_policies"), filterContext)
+	sqlBuilder = opts.AddFilterToSelect(sqlBuilder)
+
+	rowsSql, rowsArgs, err := opts.AddPaginationToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// SQL for getting total size. This matches the query to get all the rows above, in order
+	// to do the same filter, but counts instead of scanning the rows.
+	sqlBuilder = s.filterByResource(sq.Select("count(*)").From("retention_policies"), filterContext)
+	sizeSql, sizeArgs, err := opts.AddFilterToSelect(sqlBuilder).ToSql()
+	if err != nil {
+		return errorF(err)
+	}
+
+	// Use a transaction to make sure we're returning the total_size of the same rows queried
+	tx, err := s.db.Begin()
+	if err != nil {
+		glog.Errorf("Failed to start transaction to list retention policies")
+		return errorF(err)
+	}
+
+	rows, err := tx.Query(rowsSql, rowsArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	policies, err := s.scanRows(rows)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	rows.Close()
+
+	sizeRow, err := tx.Query(sizeSql, sizeArgs...)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	total_size, err := list.ScanRowToTotalSize(sizeRow)
+	if err != nil {
+		tx.Rollback()
+		return errorF(err)
+	}
+	sizeRow.Close()
+
+	err = tx.Commit()
+	if err != nil {
+		glog.Errorf("Failed to commit transaction to list retention policies")
+		return errorF(err)
+	}
+
+	if len(policies) <= opts.PageSize {
+		return policies, total_size, "", nil
+	}
+
+	npt, err := opts.NextPageToken(policies[opts.PageSize])
+	return policies[:opts.PageSize], total_size, npt, err
+}
+
+func (s *RetentionPolicyStore) filterByResource(sqlBuilder sq.SelectBuilder, filterContext *common.FilterContext) sq.SelectBuilder {
+	if filterContext.ReferenceKey != nil {
+		sqlBuilder = sqlBuilder.Where(sq.Eq{
+			"ResourceType": filterContext.ReferenceKey.Type,
+			"ResourceUUID": filterContext.ReferenceKey.ID,
+		})
+	}
+	return sqlBuilder
+}
+
+func (s *RetentionPolicyStore) GetRetentionPolicy(uuid string) (*model.RetentionPolicy, error) {
+	return s.getRetentionPolicy(sq.Eq{"UUID": uuid}, uuid)
+}
+
+func (s *RetentionPolicyStore) GetRetentionPolicyByResource(resourceType common.ResourceType, id string) (*model.RetentionPolicy, error) {
+	return s.getRetentionPolicy(sq.Eq{"ResourceType": resourceType, "ResourceUUID": id}, fmt.Sprintf("%s %s", resourceType, id))
+}
+
+func (s *RetentionPolicyStore) getRetentionPolicy(where sq.Eq, name string) (*model.RetentionPolicy, error) {
+	sql, args, err := sq.
+		Select(retentionPolicyColumns...).
+		From("retention_policies").
+		Where(where).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get retention policy: %v", err.Error())
+	}
+	r, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get retention policy: %v", err.Error())
+	}
+	defer r.Close()
+	policies, err := s.scanRows(r)
+	if err != nil || len(policies) > 1 {
+		return nil, util.NewInternalServerError(err, "Failed to get retention policy: %v", err)
+	}
+	if len(policies) == 0 {
+		return nil, util.NewResourceNotFoundError("RetentionPolicy", name)
+	}
+	return policies[0], nil
+}
+
+func (s *RetentionPolicyStore) scanRows(rows *sql.Rows) ([]*model.RetentionPolicy, error) {
+	var policies []*model.RetentionPolicy
+	for rows.Next() {
+		var uuid, resourceType, resourceUUID, enforcementError string
+		var archiveAfterDays, deleteAfterDays int32
+		var createdAtInSec, lastEnforcedAtInSec, archivedRunCount, deletedRunCount, deletedObjectCount int64
+		err := rows.Scan(&uuid, &resourceType, &resourceUUID, &archiveAfterDays, &deleteAfterDays, &createdAtInSec,
+			&lastEnforcedAtInSec, &archivedRunCount, &deletedRunCount, &deletedObjectCount, &enforcementError)
+		if err != nil {
+			return policies, err
+		}
+		policies = append(policies, &model.RetentionPolicy{
+			UUID:                uuid,
+			ResourceType:        common.ResourceType(resourceType),
+			ResourceUUID:        resourceUUID,
+			ArchiveAfterDays:    archiveAfterDays,
+			DeleteAfterDays:     deleteAfterDays,
+			CreatedAtInSec:      createdAtInSec,
+			LastEnforcedAtInSec: lastEnforcedAtInSec,
+			ArchivedRunCount:    archivedRunCount,
+			DeletedRunCount:     deletedRunCount,
+			DeletedObjectCount:  deletedObjectCount,
+			Error:               enforcementError,
+		})
+	}
+	return policies, nil
+}
+
+func (s *RetentionPolicyStore) CreateRetentionPolicy(policy *model.RetentionPolicy) (*model.RetentionPolicy, error) {
+	newPolicy := *policy
+	newPolicy.CreatedAtInSec = s.time.Now().Unix()
+	id, err := s.uuid.NewRandom()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a retention policy id.")
+	}
+	newPolicy.UUID = id.String()
+	newPolicy.LastEnforcedAtInSec = 0
+	newPolicy.ArchivedRunCount = 0
+	newPolicy.DeletedRunCount = 0
+	newPolicy.DeletedObjectCount = 0
+	newPolicy.Error = ""
+
+	sql, args, err := sq.
+		Insert("retention_policies").
+		SetMap(sq.Eq{
+			"UUID":                newPolicy.UUID,
+			"ResourceType":        newPolicy.ResourceType,
+			"ResourceUUID":        newPolicy.ResourceUUID,
+			"ArchiveAfterDays":    newPolicy.ArchiveAfterDays,
+			"DeleteAfterDays":     newPolicy.DeleteAfterDays,
+			"CreatedAtInSec":      newPolicy.CreatedAtInSec,
+			"LastEnforcedAtInSec": newPolicy.LastEnforcedAtInSec,
+			"ArchivedRunCount":    newPolicy.ArchivedRunCount,
+			"DeletedRunCount":     newPolicy.DeletedRunCount,
+			"DeletedObjectCount":  newPolicy.DeletedObjectCount,
+			"Error":               newPolicy.Error,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to insert retention policy to table: %v",
+			err.Error())
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		if s.db.IsDuplicateError(err) {
+			return nil, util.NewAlreadyExistError(
+				"Failed to create a new retention policy. A retention policy already exists for %s %s.",
+				newPolicy.ResourceType, newPolicy.ResourceUUID)
+		}
+		return nil, util.NewInternalServerError(err, "Failed to add retention policy to retention policy table: %v",
+			err.Error())
+	}
+	return &newPolicy, nil
+}
+
+func (s *RetentionPolicyStore) DeleteRetentionPolicy(uuid string) error {
+	sql, args, err := sq.Delete("retention_policies").Where(sq.Eq{"UUID": uuid}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete retention policy: %s", uuid)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete retention policy %s from table", uuid)
+	}
+	return nil
+}
+
+// Delete the retention policy of a resource.
+// This is always in company with deleting a parent resource so a transaction is needed as input.
+func (s *RetentionPolicyStore) DeleteRetentionPolicyByResource(tx *sql.Tx, resourceType common.ResourceType, id string) error {
+	sql, args, err := sq.
+		Delete("retention_policies").
+		Where(sq.Eq{"ResourceType": resourceType, "ResourceUUID": id}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to delete retention policy of %s %s", resourceType, id)
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete retention policy of %s %s", resourceType, id)
+	}
+	return nil
+}
+
+func (s *RetentionPolicyStore) ClaimRetentionPolicyEnforcement(uuid string, enforcedAtInSec int64,
+	claimableBeforeInSec int64) (bool, error) {
+	sql, args, err := sq.
+		Update("retention_policies").
+		SetMap(sq.Eq{"LastEnforcedAtInSec": enforcedAtInSec}).
+		Where(sq.Eq{"UUID": uuid}).
+		Where(sq.Lt{"LastEnforcedAtInSec": claimableBeforeInSec}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err,
+			"Failed to create query to claim retention policy %s", uuid)
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to claim retention policy %s", uuid)
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to claim retention policy %s", uuid)
+	}
+	return r > 0, nil
+}
+
+func (s *RetentionPolicyStore) ReportRetentionPolicyEnforcement(uuid string, enforcedAtInSec int64, archivedRunCount int64,
+	deletedRunCount int64, deletedObjectCount int64, enforcementError string) error {
+	sql, args, err := sq.
+		Update("retention_policies").
+		SetMap(sq.Eq{
+			"LastEnforcedAtInSec": enforcedAtInSec,
+			"ArchivedRunCount":    sq.Expr("ArchivedRunCount + ?", archivedRunCount),
+			"DeletedRunCount":     sq.Expr("DeletedRunCount + ?", deletedRunCount),
+			"DeletedObjectCount":  sq.Expr("DeletedObjectCount + ?", deletedObjectCount),
+			"Error":               enforcementError,
+		}).
+		Where(sq.Eq{"UUID": uuid}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to update retention policy %s", uuid)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to update retention policy %s", uuid)
+	}
+	return nil
+}
+
+// factory function for retention policy store
+func NewRetentionPolicyStore(db *DB, time util.TimeInterface, uuid util.UUIDGeneratorInterface) *RetentionPolicyStore {
+	return &RetentionPolicyStore{db: db, time: time, uuid: uuid}
+}
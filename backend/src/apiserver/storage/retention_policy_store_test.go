@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRetentionPolicyStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewRetentionPolicyStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+
+	policy, err := store.CreateRetentionPolicy(&model.RetentionPolicy{
+		ResourceType:     common.Experiment,
+		ResourceUUID:     "e1",
+		ArchiveAfterDays: 7,
+		DeleteAfterDays:  30,
+	})
+	assert.Nil(t, err)
+	expected := &model.RetentionPolicy{
+		UUID:             fakeID,
+		ResourceType:     common.Experiment,
+		ResourceUUID:     "e1",
+		ArchiveAfterDays: 7,
+		DeleteAfterDays:  30,
+		CreatedAtInSec:   1,
+	}
+	assert.Equal(t, expected, policy)
+
+	// Only one policy per resource.
+	store.uuid = util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil)
+	_, err = store.CreateRetentionPolicy(&model.RetentionPolicy{
+		ResourceType:    common.Experiment,
+		ResourceUUID:    "e1",
+		DeleteAfterDays: 1,
+	})
+	assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).ExternalStatusCode())
+	_, err = store.CreateRetentionPolicy(&model.RetentionPolicy{
+		ResourceType:    common.Namespace,
+		ResourceUUID:    "ns1",
+		DeleteAfterDays: 1,
+	})
+	assert.Nil(t, err)
+
+	policy, err = store.GetRetentionPolicy(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, policy)
+	policy, err = store.GetRetentionPolicyByResource(common.Namespace, "ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, fakeIDTwo, policy.UUID)
+
+	// Enforcement results are accumulated.
+	err = store.ReportRetentionPolicyEnforcement(fakeID, 100, 2, 1, 5, "")
+	assert.Nil(t, err)
+	err = store.ReportRetentionPolicyEnforcement(fakeID, 200, 1, 0, 0, "some error")
+	assert.Nil(t, err)
+	policy, err = store.GetRetentionPolicy(fakeID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), policy.LastEnforcedAtInSec)
+	assert.Equal(t, int64(3), policy.ArchivedRunCount)
+	assert.Equal(t, int64(1), policy.DeletedRunCount)
+	assert.Equal(t, int64(5), policy.DeletedObjectCount)
+	assert.Equal(t, "some error", policy.Error)
+
+	// A policy is only claimed once until it can be claimed again.
+	claimed, err := store.ClaimRetentionPolicyEnforcement(fakeID, 300, 250)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	claimed, err = store.ClaimRetentionPolicyEnforcement(fakeID, 301, 251)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+	claimed, err = store.ClaimRetentionPolicyEnforcement(fakeID, 400, 350)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	// List with and without resource filter.
+	opts, err := list.NewOptions(&model.RetentionPolicy{}, 10, "", nil)
+	assert.Nil(t, err)
+	policies, totalSize, nextPageToken, err := store.ListRetentionPolicies(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, totalSize)
+	assert.Equal(t, 2, len(policies))
+	assert.Equal(t, "", nextPageToken)
+	policies, totalSize, _, err = store.ListRetentionPolicies(&common.FilterContext{
+		ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "ns1"},
+	}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, fakeIDTwo, policies[0].UUID)
+
+	// Delete
+	err = store.DeleteRetentionPolicy(fakeID)
+	assert.Nil(t, err)
+	_, err = store.GetRetentionPolicy(fakeID)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestDeleteExperiment_DeletesRetentionPolicy(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	experimentStore := NewExperimentStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeID, nil))
+	_, err := experimentStore.CreateExperiment(createExperiment("experiment1"))
+	assert.Nil(t, err)
+	store := NewRetentionPolicyStore(db, util.NewFakeTimeForEpoch(), util.NewFakeUUIDGeneratorOrFatal(fakeIDTwo, nil))
+	_, err = store.CreateRetentionPolicy(&model.RetentionPolicy{
+		ResourceType:    common.Experiment,
+		ResourceUUID:    fakeID,
+		DeleteAfterDays: 1,
+	})
+	assert.Nil(t, err)
+
+	err = experimentStore.DeleteExperiment(fakeID)
+	assert.Nil(t, err)
+	_, err = store.GetRetentionPolicy(fakeIDTwo)
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+const (
+	// The prefix of a manifest column holding a reference to an offloaded
+	// manifest instead of the manifest itself.
+	runManifestReferencePrefix = "objectstore:"
+	runManifestFolder          = "run_manifests"
+)
+
+// RunManifestFolder returns the folder of the offloaded manifests of a run.
+func RunManifestFolder(runId string) string {
+	return path.Join(runManifestFolder, runId)
+}
+
+// RunManifestStore stores the runtime manifests of runs gzip-compressed in the
+// object store. The manifests of a run are keyed by the sha256 of their
+// content under the folder of the run, so an unchanged manifest is not
+// written again and all of them are deleted along with the run.
+type RunManifestStore struct {
+	objectStore ObjectStoreInterface
+}
+
+func isRunManifestReference(value string) bool {
+	return strings.HasPrefix(value, runManifestReferencePrefix)
+}
+
+// OffloadManifest stores a manifest of a run in the object store and returns
+// the reference to keep in the database instead. Empty manifests and
+// references are returned unchanged.
+func (s *RunManifestStore) OffloadManifest(ctx context.Context, runId string, manifest string) (string, error) {
+	if manifest == "" || isRunManifestReference(manifest) {
+		return manifest, nil
+	}
+	sum := sha256.Sum256([]byte(manifest))
+	key := path.Join(RunManifestFolder(runId), hex.EncodeToString(sum[:])+".gz")
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write([]byte(manifest)); err != nil {
+		return "", util.NewInternalServerError(err, "Failed to compress the manifest of run %s", runId)
+	}
+	if err := writer.Close(); err != nil {
+		return "", util.NewInternalServerError(err, "Failed to compress the manifest of run %s", runId)
+	}
+	if err := s.objectStore.AddFile(ctx, compressed.Bytes(), key); err != nil {
+		return "", util.Wrapf(err, "Failed to store the manifest of run %s", runId)
+	}
+	return runManifestReferencePrefix + key, nil
+}
+
+// RehydrateManifest returns the manifest a database value refers to. Values
+// which are not references are the manifests themselves.
+func (s *RunManifestStore) RehydrateManifest(value string) (string, error) {
+	if !isRunManifestReference(value) {
+		return value, nil
+	}
+	key := strings.TrimPrefix(value, runManifestReferencePrefix)
+	compressed, err := s.objectStore.GetFile(context.Background(), key)
+	if err != nil {
+		return "", util.Wrapf(err, "Failed to get the run manifest %s", key)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to decompress the run manifest %s", key)
+	}
+	defer reader.Close()
+	manifest, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", util.NewInternalServerError(err, "Failed to decompress the run manifest %s", key)
+	}
+	return string(manifest), nil
+}
+
+// DeleteManifest deletes the object a database value refers to, if any.
+func (s *RunManifestStore) DeleteManifest(value string) error {
+	if !isRunManifestReference(value) {
+		return nil
+	}
+	return s.objectStore.DeleteFile(strings.TrimPrefix(value, runManifestReferencePrefix))
+}
+
+// factory function for run manifest store
+func NewRunManifestStore(objectStore ObjectStoreInterface) *RunManifestStore {
+	return &RunManifestStore{objectStore: objectStore}
+}
@@ -0,0 +1,203 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/common"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/list"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const largeManifest = "apiVersion: tekton.dev/v1beta1\nkind: PipelineRun\n"
+
+func initializeRunStoreWithObjectStore(offloadManifests bool) (*DB, *RunStore, *FakeMinioClient) {
+	db := NewFakeDbOrFatal()
+	minioClient := NewFakeMinioClient()
+	objectStore := NewMinioObjectStore(minioClient, "", "pipelines", false)
+	runStore := NewRunStoreWithObjectStore(db, util.NewFakeTimeForEpoch(), objectStore, offloadManifests)
+	return db, runStore, minioClient
+}
+
+func createManifestRun(t *testing.T, runStore *RunStore, runId string) {
+	_, err := runStore.CreateRun(context.Background(), &model.RunDetail{
+		Run: model.Run{UUID: runId, Name: "run-" + runId, StorageState: "STORAGESTATE_AVAILABLE", Conditions: "Running"},
+		PipelineRuntime: model.PipelineRuntime{
+			WorkflowRuntimeManifest: strings.Repeat(largeManifest, 100),
+			PipelineRuntimeManifest: "pipeline runtime manifest",
+		},
+	})
+	assert.Nil(t, err)
+}
+
+func getStoredManifests(t *testing.T, db *DB, runId string) (string, string) {
+	var workflowRuntimeManifest, pipelineRuntimeManifest string
+	err := db.QueryRow(`SELECT WorkflowRuntimeManifest, PipelineRuntimeManifest FROM run_details WHERE UUID = ?`, runId).
+		Scan(&workflowRuntimeManifest, &pipelineRuntimeManifest)
+	assert.Nil(t, err)
+	return workflowRuntimeManifest, pipelineRuntimeManifest
+}
+
+func TestRunManifestStore(t *testing.T) {
+	minioClient := NewFakeMinioClient()
+	manifestStore := NewRunManifestStore(NewMinioObjectStore(minioClient, "", "pipelines", false))
+	manifest := strings.Repeat(largeManifest, 100)
+
+	reference, err := manifestStore.OffloadManifest(context.Background(), "run1", manifest)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(reference, "objectstore:run_manifests/run1/"))
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+	// The manifest is stored compressed.
+	key := strings.TrimPrefix(reference, runManifestReferencePrefix)
+	assert.True(t, minioClient.ExistObject(key))
+	assert.Less(t, len(minioClient.minioClient[key]), len(manifest))
+
+	// The same content is stored at the same key.
+	sameReference, err := manifestStore.OffloadManifest(context.Background(), "run1", manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, reference, sameReference)
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+
+	rehydrated, err := manifestStore.RehydrateManifest(reference)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, rehydrated)
+
+	// Values which are not references are the manifests themselves.
+	rehydrated, err = manifestStore.RehydrateManifest(manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, rehydrated)
+	emptyReference, err := manifestStore.OffloadManifest(context.Background(), "run1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "", emptyReference)
+
+	assert.Nil(t, manifestStore.DeleteManifest(reference))
+	assert.Equal(t, 0, minioClient.GetObjectCount())
+	_, err = manifestStore.RehydrateManifest(reference)
+	assert.NotNil(t, err)
+}
+
+func TestRunStore_OffloadsManifests(t *testing.T) {
+	db, runStore, minioClient := initializeRunStoreWithObjectStore(true)
+	defer db.Close()
+	createManifestRun(t, runStore, "run1")
+
+	workflowRuntimeManifest, pipelineRuntimeManifest := getStoredManifests(t, db, "run1")
+	assert.True(t, isRunManifestReference(workflowRuntimeManifest))
+	assert.True(t, isRunManifestReference(pipelineRuntimeManifest))
+	assert.Equal(t, 2, minioClient.GetObjectCount())
+
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, strings.Repeat(largeManifest, 100), runDetail.WorkflowRuntimeManifest)
+	assert.Equal(t, "pipeline runtime manifest", runDetail.PipelineRuntimeManifest)
+
+	// An update replaces the previous workflow manifest object.
+	err = runStore.UpdateRun("run1", "Succeeded", 1, "updated workflow runtime manifest")
+	assert.Nil(t, err)
+	updatedManifest, _ := getStoredManifests(t, db, "run1")
+	assert.NotEqual(t, workflowRuntimeManifest, updatedManifest)
+	assert.False(t, minioClient.ExistObject(strings.TrimPrefix(workflowRuntimeManifest, runManifestReferencePrefix)))
+	assert.Equal(t, 2, minioClient.GetObjectCount())
+	runDetail, err = runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, "updated workflow runtime manifest", runDetail.WorkflowRuntimeManifest)
+
+	// Updating the same manifest keeps its object.
+	err = runStore.UpdateRun("run1", "Succeeded", 1, "updated workflow runtime manifest")
+	assert.Nil(t, err)
+	assert.True(t, minioClient.ExistObject(strings.TrimPrefix(updatedManifest, runManifestReferencePrefix)))
+}
+
+func TestRunStore_OffloadRunManifests(t *testing.T) {
+	db, runStore, minioClient := initializeRunStoreWithObjectStore(false)
+	defer db.Close()
+	createManifestRun(t, runStore, "run1")
+	createManifestRun(t, runStore, "run2")
+	createManifestRun(t, runStore, "run3")
+	workflowRuntimeManifest, _ := getStoredManifests(t, db, "run1")
+	assert.False(t, isRunManifestReference(workflowRuntimeManifest))
+	assert.Equal(t, 0, minioClient.GetObjectCount())
+
+	// Nothing is migrated unless the manifests are offloaded.
+	count, err := runStore.OffloadRunManifests(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	runStore.offloadManifests = true
+	count, err = runStore.OffloadRunManifests(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	for _, runId := range []string{"run1", "run2", "run3"} {
+		workflowRuntimeManifest, pipelineRuntimeManifest := getStoredManifests(t, db, runId)
+		assert.True(t, isRunManifestReference(workflowRuntimeManifest))
+		assert.True(t, isRunManifestReference(pipelineRuntimeManifest))
+		runDetail, err := runStore.GetRun(runId)
+		assert.Nil(t, err)
+		assert.Equal(t, strings.Repeat(largeManifest, 100), runDetail.WorkflowRuntimeManifest)
+		assert.Equal(t, "pipeline runtime manifest", runDetail.PipelineSpecManifest)
+	}
+	assert.Equal(t, 6, minioClient.GetObjectCount())
+	opts, err := list.NewOptions(&model.Run{}, 10, "", nil)
+	assert.Nil(t, err)
+	runs, _, _, err := runStore.ListRuns(&common.FilterContext{}, opts)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 3)
+	for _, run := range runs {
+		assert.Equal(t, "pipeline runtime manifest", run.PipelineSpecManifest)
+	}
+
+	// The migration is idempotent.
+	count, err = runStore.OffloadRunManifests(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	// The offloaded manifests remain readable once offloading is turned off.
+	runStore.offloadManifests = false
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, "pipeline runtime manifest", runDetail.PipelineRuntimeManifest)
+}
+
+func TestRunStore_UpdateRunKeepsSharedManifest(t *testing.T) {
+	db, runStore, minioClient := initializeRunStoreWithObjectStore(true)
+	defer db.Close()
+	// Manifests with the same content are stored in the same object.
+	_, err := runStore.CreateRun(context.Background(), &model.RunDetail{
+		Run: model.Run{UUID: "run1", Name: "run-run1", StorageState: "STORAGESTATE_AVAILABLE", Conditions: "Running"},
+		PipelineRuntime: model.PipelineRuntime{
+			WorkflowRuntimeManifest: largeManifest,
+			PipelineRuntimeManifest: largeManifest,
+		},
+	})
+	assert.Nil(t, err)
+	workflowRuntimeManifest, pipelineRuntimeManifest := getStoredManifests(t, db, "run1")
+	assert.Equal(t, workflowRuntimeManifest, pipelineRuntimeManifest)
+	assert.Equal(t, 1, minioClient.GetObjectCount())
+
+	// Replacing the workflow manifest keeps the object of the pipeline
+	// manifest.
+	err = runStore.UpdateRun("run1", "Succeeded", 1, "updated workflow runtime manifest")
+	assert.Nil(t, err)
+	assert.True(t, minioClient.ExistObject(strings.TrimPrefix(pipelineRuntimeManifest, runManifestReferencePrefix)))
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, largeManifest, runDetail.PipelineRuntimeManifest)
+	assert.Equal(t, largeManifest, runDetail.PipelineSpecManifest)
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type RunObjectCleanupStoreInterface interface {
+	// Retrieve at most limit cleanups whose next attempt is due, the most
+	// overdue first.
+	ListDueRunObjectCleanups(limit int) ([]*model.RunObjectCleanup, error)
+	GetRunObjectCleanup(runId string) (*model.RunObjectCleanup, error)
+	// Delete a cleanup once all the objects of the run are deleted.
+	DeleteRunObjectCleanup(runId string) error
+	// Record a failed attempt and schedule the next one.
+	ReportRunObjectCleanupFailure(runId string, nextAttemptAtInSec int64, cleanupError string) error
+}
+
+type RunObjectCleanupStore struct {
+	db   *DB
+	time util.TimeInterface
+}
+
+var runObjectCleanupColumns = []string{
+	"RunUUID",
+	"RunName",
+	"CreatedAtInSec",
+	"Attempts",
+	"NextAttemptAtInSec",
+	"LastError",
+}
+
+// Create a cleanup of the objects of a run, which is due immediately.
+// This is always in company with deleting the run so a transaction is needed as input.
+func (s *RunObjectCleanupStore) CreateRunObjectCleanup(tx *sql.Tx, runId string, runName string) error {
+	now := s.time.Now().Unix()
+	sql, args, err := sq.
+		Insert("run_object_cleanups").
+		SetMap(sq.Eq{
+			"RunUUID":            runId,
+			"RunName":            runName,
+			"CreatedAtInSec":     now,
+			"Attempts":           0,
+			"NextAttemptAtInSec": now,
+			"LastError":          "",
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to add object cleanup of run %s", runId)
+	}
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to add object cleanup of run %s", runId)
+	}
+	return nil
+}
+
+func (s *RunObjectCleanupStore) ListDueRunObjectCleanups(limit int) ([]*model.RunObjectCleanup, error) {
+	sql, args, err := sq.
+		Select(runObjectCleanupColumns...).
+		From("run_object_cleanups").
+		Where(sq.LtOrEq{"NextAttemptAtInSec": s.time.Now().Unix()}).
+		OrderBy("NextAttemptAtInSec", "RunUUID").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list run object cleanups: %v", err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run object cleanups: %v", err.Error())
+	}
+	defer rows.Close()
+	cleanups, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list run object cleanups: %v", err.Error())
+	}
+	return cleanups, nil
+}
+
+func (s *RunObjectCleanupStore) GetRunObjectCleanup(runId string) (*model.RunObjectCleanup, error) {
+	sql, args, err := sq.
+		Select(runObjectCleanupColumns...).
+		From("run_object_cleanups").
+		Where(sq.Eq{"RunUUID": runId}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get object cleanup of run %s: %v", runId, err.Error())
+	}
+	rows, err := s.db.Query(sql, args...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get object cleanup of run %s: %v", runId, err.Error())
+	}
+	defer rows.Close()
+	cleanups, err := s.scanRows(rows)
+	if err != nil || len(cleanups) > 1 {
+		return nil, util.NewInternalServerError(err, "Failed to get object cleanup of run %s: %v", runId, err)
+	}
+	if len(cleanups) == 0 {
+		return nil, util.NewResourceNotFoundError("RunObjectCleanup", runId)
+	}
+	return cleanups[0], nil
+}
+
+func (s *RunObjectCleanupStore) scanRows(rows *sql.Rows) ([]*model.RunObjectCleanup, error) {
+	var cleanups []*model.RunObjectCleanup
+	for rows.Next() {
+		var cleanup model.RunObjectCleanup
+		err := rows.Scan(&cleanup.RunUUID, &cleanup.RunName, &cleanup.CreatedAtInSec, &cleanup.Attempts,
+			&cleanup.NextAttemptAtInSec, &cleanup.LastError)
+		if err != nil {
+			return cleanups, err
+		}
+		cleanups = append(cleanups, &cleanup)
+	}
+	return cleanups, nil
+}
+
+func (s *RunObjectCleanupStore) DeleteRunObjectCleanup(runId string) error {
+	sql, args, err := sq.Delete("run_object_cleanups").Where(sq.Eq{"RunUUID": runId}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to delete object cleanup of run %s", runId)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to delete object cleanup of run %s", runId)
+	}
+	return nil
+}
+
+func (s *RunObjectCleanupStore) ReportRunObjectCleanupFailure(runId string, nextAttemptAtInSec int64, cleanupError string) error {
+	sql, args, err := sq.
+		Update("run_object_cleanups").
+		SetMap(sq.Eq{
+			"Attempts":           sq.Expr("Attempts + 1"),
+			"NextAttemptAtInSec": nextAttemptAtInSec,
+			"LastError":          cleanupError,
+		}).
+		Where(sq.Eq{"RunUUID": runId}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to update object cleanup of run %s", runId)
+	}
+	_, err = s.db.Exec(sql, args...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to update object cleanup of run %s", runId)
+	}
+	return nil
+}
+
+// factory function for run object cleanup store
+func NewRunObjectCleanupStore(db *DB, time util.TimeInterface) *RunObjectCleanupStore {
+	return &RunObjectCleanupStore{db: db, time: time}
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func createRunObjectCleanup(t *testing.T, db *DB, store *RunObjectCleanupStore, runId string, runName string) {
+	tx, err := db.Begin()
+	assert.Nil(t, err)
+	err = store.CreateRunObjectCleanup(tx, runId, runName)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+}
+
+func TestRunObjectCleanupStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	store := NewRunObjectCleanupStore(db, util.NewFakeTimeForEpoch())
+
+	createRunObjectCleanup(t, db, store, "1", "run1")
+	createRunObjectCleanup(t, db, store, "2", "run2")
+
+	cleanups, err := store.ListDueRunObjectCleanups(10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.RunObjectCleanup{
+		{RunUUID: "1", RunName: "run1", CreatedAtInSec: 1, NextAttemptAtInSec: 1},
+		{RunUUID: "2", RunName: "run2", CreatedAtInSec: 2, NextAttemptAtInSec: 2},
+	}, cleanups)
+	cleanups, err = store.ListDueRunObjectCleanups(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cleanups))
+
+	// A failed cleanup is not due until its next attempt.
+	err = store.ReportRunObjectCleanupFailure("1", 100, "some error")
+	assert.Nil(t, err)
+	err = store.ReportRunObjectCleanupFailure("1", 200, "another error")
+	assert.Nil(t, err)
+	cleanup, err := store.GetRunObjectCleanup("1")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.RunObjectCleanup{
+		RunUUID:            "1",
+		RunName:            "run1",
+		CreatedAtInSec:     1,
+		Attempts:           2,
+		NextAttemptAtInSec: 200,
+		LastError:          "another error",
+	}, cleanup)
+	cleanups, err = store.ListDueRunObjectCleanups(10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(cleanups))
+	assert.Equal(t, "2", cleanups[0].RunUUID)
+
+	err = store.DeleteRunObjectCleanup("2")
+	assert.Nil(t, err)
+	_, err = store.GetRunObjectCleanup("2")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
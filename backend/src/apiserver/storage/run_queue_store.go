@@ -0,0 +1,229 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+)
+
+type RunQueueStoreInterface interface {
+	// Retrieve the queued runs of all namespaces in the order they are
+	// dispatched, by descending priority first if byPriority is set.
+	ListQueuedRuns(byPriority bool) ([]*model.QueuedRun, error)
+	GetQueuedRun(runId string) (*model.QueuedRun, error)
+	// Retrieve the position of a queued run in the queue of its namespace,
+	// starting from 1.
+	GetQueuePosition(queuedRun *model.QueuedRun, byPriority bool) (int32, error)
+	CountQueuedRuns(namespace string) (int, error)
+	// Claim a queued run to dispatch it, unless it is claimed since
+	// staleBeforeInSec. Returns whether the run was claimed.
+	ClaimQueuedRun(runId string, nowInSec int64, staleBeforeInSec int64) (bool, error)
+	// Release the claim of a queued run which failed to be dispatched.
+	ReleaseQueuedRun(runId string) error
+}
+
+type RunQueueStore struct {
+	db *DB
+}
+
+var queuedRunColumns = []string{"RunUUID", "Namespace", "Priority", "QueuedAtInSec", "ClaimedAtInSec"}
+
+// Add a run to the queue of its namespace.
+// This is always in company with creating the run so a transaction is needed as input.
+func (s *RunQueueStore) CreateQueuedRun(ctx context.Context, tx *sql.Tx, queuedRun *model.QueuedRun) error {
+	queueSql, queueArgs, err := sq.
+		Insert("queued_runs").
+		SetMap(sq.Eq{
+			"RunUUID":       queuedRun.RunUUID,
+			"Namespace":     queuedRun.Namespace,
+			"Priority":      queuedRun.Priority,
+			"QueuedAtInSec": queuedRun.QueuedAtInSec,
+		}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to queue run %s", queuedRun.RunUUID)
+	}
+	_, err = tx.ExecContext(ctx, queueSql, queueArgs...)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to queue run %s", queuedRun.RunUUID)
+	}
+	return nil
+}
+
+// Remove a run from the queue of its namespace. Returns whether the run was
+// queued.
+// This is always in company with updating or deleting the run so a transaction is needed as input.
+func (s *RunQueueStore) DeleteQueuedRun(tx *sql.Tx, runId string) (bool, error) {
+	queueSql, queueArgs, err := sq.Delete("queued_runs").Where(sq.Eq{"RunUUID": runId}).ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create query to dequeue run %s", runId)
+	}
+	result, err := tx.Exec(queueSql, queueArgs...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to dequeue run %s", runId)
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to dequeue run %s", runId)
+	}
+	return r > 0, nil
+}
+
+func (s *RunQueueStore) ListQueuedRuns(byPriority bool) ([]*model.QueuedRun, error) {
+	sqlBuilder := sq.Select(queuedRunColumns...).From("queued_runs")
+	if byPriority {
+		sqlBuilder = sqlBuilder.OrderBy("Priority DESC")
+	}
+	queueSql, queueArgs, err := sqlBuilder.OrderBy("QueuedAtInSec", "RunUUID").ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to list queued runs: %v", err.Error())
+	}
+	rows, err := s.db.Query(queueSql, queueArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list queued runs: %v", err.Error())
+	}
+	defer rows.Close()
+	queuedRuns, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to list queued runs: %v", err.Error())
+	}
+	return queuedRuns, nil
+}
+
+func (s *RunQueueStore) GetQueuedRun(runId string) (*model.QueuedRun, error) {
+	queueSql, queueArgs, err := sq.
+		Select(queuedRunColumns...).
+		From("queued_runs").
+		Where(sq.Eq{"RunUUID": runId}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get queued run %s: %v", runId, err.Error())
+	}
+	rows, err := s.db.Query(queueSql, queueArgs...)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get queued run %s: %v", runId, err.Error())
+	}
+	defer rows.Close()
+	queuedRuns, err := s.scanRows(rows)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get queued run %s: %v", runId, err.Error())
+	}
+	if len(queuedRuns) == 0 {
+		return nil, util.NewResourceNotFoundError("QueuedRun", runId)
+	}
+	return queuedRuns[0], nil
+}
+
+func (s *RunQueueStore) GetQueuePosition(queuedRun *model.QueuedRun, byPriority bool) (int32, error) {
+	// The runs ahead were queued earlier, or at the same time with a smaller ID.
+	ahead := sq.Or{
+		sq.Lt{"QueuedAtInSec": queuedRun.QueuedAtInSec},
+		sq.And{sq.Eq{"QueuedAtInSec": queuedRun.QueuedAtInSec}, sq.Lt{"RunUUID": queuedRun.RunUUID}},
+	}
+	if byPriority {
+		ahead = sq.Or{
+			sq.Gt{"Priority": queuedRun.Priority},
+			sq.And{sq.Eq{"Priority": queuedRun.Priority}, ahead},
+		}
+	}
+	queueSql, queueArgs, err := sq.
+		Select("count(*)").
+		From("queued_runs").
+		Where(sq.Eq{"Namespace": queuedRun.Namespace}).
+		Where(ahead).
+		ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to get queue position of run %s", queuedRun.RunUUID)
+	}
+	var count int32
+	if err := s.db.QueryRow(queueSql, queueArgs...).Scan(&count); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to get queue position of run %s", queuedRun.RunUUID)
+	}
+	return count + 1, nil
+}
+
+func (s *RunQueueStore) CountQueuedRuns(namespace string) (int, error) {
+	queueSql, queueArgs, err := sq.Select("count(*)").From("queued_runs").Where(sq.Eq{"Namespace": namespace}).ToSql()
+	if err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to create query to count queued runs of namespace %s", namespace)
+	}
+	var count int
+	if err := s.db.QueryRow(queueSql, queueArgs...).Scan(&count); err != nil {
+		return 0, util.NewInternalServerError(err, "Failed to count queued runs of namespace %s", namespace)
+	}
+	return count, nil
+}
+
+func (s *RunQueueStore) ClaimQueuedRun(runId string, nowInSec int64, staleBeforeInSec int64) (bool, error) {
+	// A claim older than staleBeforeInSec was left by a dispatch which did not
+	// finish, e.g. because the API server restarted.
+	queueSql, queueArgs, err := sq.
+		Update("queued_runs").
+		SetMap(sq.Eq{"ClaimedAtInSec": nowInSec}).
+		Where(sq.Eq{"RunUUID": runId}).
+		Where(sq.Or{sq.Eq{"ClaimedAtInSec": 0}, sq.Lt{"ClaimedAtInSec": staleBeforeInSec}}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create query to claim queued run %s", runId)
+	}
+	result, err := s.db.Exec(queueSql, queueArgs...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to claim queued run %s", runId)
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to claim queued run %s", runId)
+	}
+	return r > 0, nil
+}
+
+func (s *RunQueueStore) ReleaseQueuedRun(runId string) error {
+	queueSql, queueArgs, err := sq.
+		Update("queued_runs").
+		SetMap(sq.Eq{"ClaimedAtInSec": 0}).
+		Where(sq.Eq{"RunUUID": runId}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to release queued run %s", runId)
+	}
+	if _, err := s.db.Exec(queueSql, queueArgs...); err != nil {
+		return util.NewInternalServerError(err, "Failed to release queued run %s", runId)
+	}
+	return nil
+}
+
+func (s *RunQueueStore) scanRows(rows *sql.Rows) ([]*model.QueuedRun, error) {
+	var queuedRuns []*model.QueuedRun
+	for rows.Next() {
+		var queuedRun model.QueuedRun
+		err := rows.Scan(&queuedRun.RunUUID, &queuedRun.Namespace, &queuedRun.Priority, &queuedRun.QueuedAtInSec, &queuedRun.ClaimedAtInSec)
+		if err != nil {
+			return queuedRuns, err
+		}
+		queuedRuns = append(queuedRuns, &queuedRun)
+	}
+	return queuedRuns, nil
+}
+
+// factory function for run queue store
+func NewRunQueueStore(db *DB) *RunQueueStore {
+	return &RunQueueStore{db: db}
+}
@@ -0,0 +1,241 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/pipelines/backend/src/apiserver/model"
+	"github.com/kubeflow/pipelines/backend/src/common/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func createQueueTestRun(t *testing.T, runStore *RunStore, runId string, namespace string, conditions string, priority int32, createdAtInSec int64) {
+	_, err := runStore.CreateRun(context.Background(), &model.RunDetail{
+		Run: model.Run{
+			UUID:           runId,
+			Name:           "run-" + runId,
+			Namespace:      namespace,
+			Conditions:     conditions,
+			Priority:       priority,
+			CreatedAtInSec: createdAtInSec,
+		},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: "workflow"},
+	})
+	assert.Nil(t, err)
+}
+
+func queuedRunIds(queuedRuns []*model.QueuedRun) []string {
+	ids := []string{}
+	for _, queuedRun := range queuedRuns {
+		ids = append(ids, queuedRun.RunUUID)
+	}
+	return ids
+}
+
+func TestRunQueueStore(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	queueStore := NewRunQueueStore(db)
+	createQueueTestRun(t, runStore, "run1", "ns1", model.RunQueuedConditions, 0, 1)
+	createQueueTestRun(t, runStore, "run2", "ns1", model.RunQueuedConditions, 5, 2)
+	createQueueTestRun(t, runStore, "run3", "ns2", model.RunQueuedConditions, 0, 3)
+	createQueueTestRun(t, runStore, "run4", "ns1", "Running", 0, 4)
+
+	queuedRuns, err := queueStore.ListQueuedRuns(false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"run1", "run2", "run3"}, queuedRunIds(queuedRuns))
+	queuedRuns, err = queueStore.ListQueuedRuns(true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"run2", "run1", "run3"}, queuedRunIds(queuedRuns))
+
+	queuedRun, err := queueStore.GetQueuedRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.QueuedRun{RunUUID: "run1", Namespace: "ns1", Priority: 0, QueuedAtInSec: 1}, queuedRun)
+	position, err := queueStore.GetQueuePosition(queuedRun, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), position)
+	position, err = queueStore.GetQueuePosition(queuedRun, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), position)
+	_, err = queueStore.GetQueuedRun("run4")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+
+	count, err := queueStore.CountQueuedRuns("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	// Deleting a run removes it from the queue.
+	assert.Nil(t, runStore.DeleteRun("run1"))
+	count, err = queueStore.CountQueuedRuns("ns1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestRunQueueStore_ClaimQueuedRun(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	queueStore := NewRunQueueStore(db)
+	createQueueTestRun(t, runStore, "run1", "ns1", model.RunQueuedConditions, 0, 1)
+
+	claimed, err := queueStore.ClaimQueuedRun("run1", 100, -200)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+	queuedRun, err := queueStore.GetQueuedRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), queuedRun.ClaimedAtInSec)
+
+	// A claimed run is only claimed again once its claim is stale.
+	claimed, err = queueStore.ClaimQueuedRun("run1", 101, -199)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+	claimed, err = queueStore.ClaimQueuedRun("run1", 500, 200)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	// A released run is claimed again.
+	assert.Nil(t, queueStore.ReleaseQueuedRun("run1"))
+	claimed, err = queueStore.ClaimQueuedRun("run1", 501, -99)
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = queueStore.ClaimQueuedRun("run2", 502, -98)
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+}
+
+func TestRunStore_AdmitQueuedRun(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	createQueueTestRun(t, runStore, "run1", "ns1", model.RunQueuedConditions, 0, 1)
+
+	err := runStore.AdmitQueuedRun("run1", "Running", 10, "created workflow")
+	assert.Nil(t, err)
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Running", runDetail.Conditions)
+	assert.Equal(t, int64(10), runDetail.ScheduledAtInSec)
+	assert.Equal(t, "created workflow", runDetail.WorkflowRuntimeManifest)
+
+	// A run can only be admitted once.
+	err = runStore.AdmitQueuedRun("run1", "Running", 11, "created workflow")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestRunStore_AdmitQueuedRun_AlreadyReported(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	createQueueTestRun(t, runStore, "run1", "ns1", model.RunQueuedConditions, 0, 1)
+	// The persistence agent reports the PipelineRun before the run is admitted.
+	assert.Nil(t, runStore.UpdateRun("run1", "Succeeded", 5, "reported workflow"))
+
+	err := runStore.AdmitQueuedRun("run1", "Running", 10, "created workflow")
+	assert.Nil(t, err)
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Succeeded", runDetail.Conditions)
+	assert.Equal(t, int64(10), runDetail.ScheduledAtInSec)
+	assert.Equal(t, "reported workflow", runDetail.WorkflowRuntimeManifest)
+}
+
+func TestRunStore_CancelQueuedRun(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	createQueueTestRun(t, runStore, "run1", "ns1", model.RunQueuedConditions, 0, 1)
+	createQueueTestRun(t, runStore, "run2", "ns1", "Running", 0, 2)
+
+	assert.Nil(t, runStore.CancelQueuedRun("run1"))
+	runDetail, err := runStore.GetRun("run1")
+	assert.Nil(t, err)
+	assert.Equal(t, model.RunCancelledConditions, runDetail.Conditions)
+	assert.NotZero(t, runDetail.FinishedAtInSec)
+	_, err = NewRunQueueStore(db).GetQueuedRun("run1")
+	assert.NotNil(t, err)
+
+	err = runStore.CancelQueuedRun("run2")
+	assert.Equal(t, codes.InvalidArgument, err.(*util.UserError).ExternalStatusCode())
+	// A cancelled run is not admitted.
+	err = runStore.AdmitQueuedRun("run1", "Running", 10, "created workflow")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestRunStore_GetNamespaceRunUsage(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	createQueueTestRun(t, runStore, "run1", "ns1", "Running", 0, 1)
+	createQueueTestRun(t, runStore, "run2", "ns1", "Running", 0, 100)
+	createQueueTestRun(t, runStore, "run3", "ns1", model.RunQueuedConditions, 0, 101)
+	createQueueTestRun(t, runStore, "run4", "ns2", "Running", 0, 102)
+	assert.Nil(t, runStore.UpdateRun("run1", "Succeeded", 50, "workflow"))
+
+	workflowRunIds := []string{"run2", "run3", "run4"}
+	usage, err := runStore.GetNamespaceRunUsage("ns1",
+		&model.NamespaceQuotaUsageOptions{SinceInSec: 10, WorkflowRunIds: workflowRunIds, GraceSinceInSec: 1000})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 1, QueuedRuns: 1}, usage)
+
+	// An admitted run counts from the time it was scheduled.
+	assert.Nil(t, runStore.AdmitQueuedRun("run3", "Running", 5, "workflow"))
+	usage, err = runStore.GetNamespaceRunUsage("ns1",
+		&model.NamespaceQuotaUsageOptions{SinceInSec: 1, WorkflowRunIds: workflowRunIds, GraceSinceInSec: 1000})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 2, RunsLastHour: 3, QueuedRuns: 0}, usage)
+
+	// A run whose PipelineRun was deleted is not active, unless it was created
+	// within the grace period.
+	usage, err = runStore.GetNamespaceRunUsage("ns1",
+		&model.NamespaceQuotaUsageOptions{SinceInSec: 1, WorkflowRunIds: []string{"run3"}, GraceSinceInSec: 1000})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 3, QueuedRuns: 0}, usage)
+	usage, err = runStore.GetNamespaceRunUsage("ns1",
+		&model.NamespaceQuotaUsageOptions{SinceInSec: 1, WorkflowRunIds: []string{}, GraceSinceInSec: 101})
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 3, QueuedRuns: 0}, usage)
+}
+
+func TestRunStore_QueueRun(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	quotaStore := NewNamespaceQuotaStore(db, util.NewFakeTimeForEpoch())
+	_, err := quotaStore.UpdateNamespaceQuota(&model.NamespaceQuota{Namespace: "ns1", MaxActiveRuns: 1})
+	assert.Nil(t, err)
+	createQueueTestRun(t, runStore, "run1", "ns1", "Running", 0, 1)
+	opts := &model.NamespaceQuotaUsageOptions{SinceInSec: 0, WorkflowRunIds: []string{"run1"}, GraceSinceInSec: 1000}
+
+	// The usage is the one before the run is queued.
+	usage, err := runStore.QueueRun(context.Background(), &model.RunDetail{
+		Run: model.Run{UUID: "run2", Name: "run-run2", Namespace: "ns1", CreatedAtInSec: 2},
+	}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 1, QueuedRuns: 0}, usage)
+	usage, err = runStore.QueueRun(context.Background(), &model.RunDetail{
+		Run: model.Run{UUID: "run3", Name: "run-run3", Namespace: "ns1", CreatedAtInSec: 3},
+	}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, &model.NamespaceQuotaUsage{ActiveRuns: 1, RunsLastHour: 1, QueuedRuns: 1}, usage)
+
+	queuedRuns, err := NewRunQueueStore(db).ListQueuedRuns(false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"run2", "run3"}, queuedRunIds(queuedRuns))
+}
@@ -15,8 +15,12 @@
 package storage
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -31,18 +35,31 @@ import (
 	"k8s.io/apimachinery/pkg/util/json"
 )
 
+// maxRunParameterValueLength is the maximum length of a parameter value stored
+// in run_parameters, which is bounded so that the value column can be indexed.
+const maxRunParameterValueLength = 255
+
 var runColumns = []string{"UUID", "ExperimentUUID", "DisplayName", "Name", "StorageState", "Namespace", "ServiceAccount", "Description",
 	"CreatedAtInSec", "ScheduledAtInSec", "FinishedAtInSec", "Conditions", "PipelineId", "PipelineName", "PipelineSpecManifest",
 	"WorkflowSpecManifest", "Parameters", "pipelineRuntimeManifest", "WorkflowRuntimeManifest",
 }
 
+// The workflow runtime manifest is not part of the listed runs, and the
+// pipeline manifests, which the listed runs carry as their pipeline spec
+// manifest, and the workflow spec manifest are left out of runs listed with the
+// basic view.
+var (
+	runListColumns      = omitColumns(runColumns, "WorkflowRuntimeManifest")
+	runBasicListColumns = omitColumns(runListColumns, "PipelineSpecManifest", "WorkflowSpecManifest", "pipelineRuntimeManifest")
+)
+
 type RunStoreInterface interface {
 	GetRun(runId string) (*model.RunDetail, error)
 
 	ListRuns(filterContext *common.FilterContext, opts *list.Options) ([]*model.Run, int, string, error)
 
 	// Create a run entry in the database
-	CreateRun(run *model.RunDetail) (*model.RunDetail, error)
+	CreateRun(ctx context.Context, run *model.RunDetail) (*model.RunDetail, error)
 
 	// Update run table. Only condition and runtime manifest is allowed to be updated.
 	UpdateRun(id string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error)
@@ -64,12 +81,37 @@ type RunStoreInterface interface {
 
 	// Terminate a run
 	TerminateRun(runId string) error
+
+	// Remove a run from the queue of its namespace and record that its
+	// PipelineRun was created. Fails if the run is no longer queued.
+	AdmitQueuedRun(runId string, condition string, scheduledAtInSec int64, workflowRuntimeManifest string) error
+
+	// Remove a run from the queue of its namespace without running it.
+	CancelQueuedRun(runId string) error
+
+	// Count the runs of a namespace counted against its quota.
+	GetNamespaceRunUsage(namespace string, opts *model.NamespaceQuotaUsageOptions) (*model.NamespaceQuotaUsage, error)
+
+	// Store a queued run of a namespace with a quota, and return the usage of
+	// the quota before the run was queued. The usage of the namespace is
+	// checked and the run queued in one transaction, so that the runs queued
+	// concurrently count against each other.
+	QueueRun(ctx context.Context, run *model.RunDetail, opts *model.NamespaceQuotaUsageOptions) (*model.NamespaceQuotaUsage, error)
 }
 
 type RunStore struct {
 	db                     *DB
 	resourceReferenceStore *ResourceReferenceStore
-	time                   util.TimeInterface
+	labelStore             *LabelStore
+	idempotencyKeyStore    *IdempotencyKeyStore
+	runObjectCleanupStore  *RunObjectCleanupStore
+	runQueueStore          *RunQueueStore
+	// Rehydrates the runtime manifests offloaded to the object store. Nil if
+	// the object store is not available.
+	manifestStore *RunManifestStore
+	// Whether the runtime manifests are offloaded when runs are written.
+	offloadManifests bool
+	time             util.TimeInterface
 }
 
 // Runs two SQL queries in a transaction to return a list of matching runs, as well as their
@@ -128,9 +170,22 @@ func (s *RunStore) ListRuns(
 	}
 
 	var runs []*model.Run
+	var ids []string
 	for _, rd := range runDetails {
 		r := rd.Run
+		// The pipeline spec manifest is read from the pipeline runtime
+		// manifest column, which may be offloaded.
+		if r.PipelineSpecManifest, err = s.rehydrateManifest(r.PipelineSpecManifest); err != nil {
+			return errorF(err)
+		}
 		runs = append(runs, &r)
+		ids = append(ids, r.UUID)
+	}
+	err = s.labelStore.attachLabels(common.Run, ids, func(i int, labels map[string]string) {
+		runs[i].Labels = labels
+	})
+	if err != nil {
+		return errorF(err)
 	}
 
 	if len(runs) <= opts.PageSize {
@@ -147,17 +202,21 @@ func (s *RunStore) buildSelectRunsQuery(selectCount bool, opts *list.Options,
 	var filteredSelectBuilder sq.SelectBuilder
 	var err error
 
+	columns := runListColumns
+	if opts.BasicView {
+		columns = runBasicListColumns
+	}
 	refKey := filterContext.ReferenceKey
 	if refKey != nil && refKey.Type == common.Experiment {
 		// for performance reasons need to special treat experiment ID filter on runs
 		// currently only the run table have experiment UUID column
-		filteredSelectBuilder, err = list.FilterOnExperiment("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnExperiment("run_details", columns,
 			selectCount, refKey.ID)
 	} else if refKey != nil && refKey.Type == common.Namespace {
-		filteredSelectBuilder, err = list.FilterOnNamespace("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnNamespace("run_details", columns,
 			selectCount, refKey.ID)
 	} else {
-		filteredSelectBuilder, err = list.FilterOnResourceReference("run_details", runColumns,
+		filteredSelectBuilder, err = list.FilterOnResourceReference("run_details", columns,
 			common.Run, selectCount, filterContext)
 	}
 	if err != nil {
@@ -210,6 +269,20 @@ func (s *RunStore) GetRun(runId string) (*model.RunDetail, error) {
 		// This can only happen when workflow reporting is failed.
 		return nil, util.NewResourceNotFoundError("Failed to get run: %s", runId)
 	}
+	if runs[0].WorkflowRuntimeManifest, err = s.rehydrateManifest(runs[0].WorkflowRuntimeManifest); err != nil {
+		return nil, util.Wrapf(err, "Failed to get run %s", runId)
+	}
+	if runs[0].PipelineRuntimeManifest, err = s.rehydrateManifest(runs[0].PipelineRuntimeManifest); err != nil {
+		return nil, util.Wrapf(err, "Failed to get run %s", runId)
+	}
+	// The pipeline spec manifest is read from the pipeline runtime manifest
+	// column.
+	runs[0].PipelineSpecManifest = runs[0].PipelineRuntimeManifest
+	labels, err := s.labelStore.GetLabels(common.Run, runId)
+	if err != nil {
+		return nil, err
+	}
+	runs[0].Labels = labels
 	return runs[0], nil
 }
 
@@ -222,6 +295,25 @@ func Map(vs []string, f func(string) string) []string {
 	return vsm
 }
 
+// omitColumns replaces the omitted columns with empty strings of the same
+// name, so that they are not read from the database but the rows keep their
+// shape.
+func omitColumns(columns []string, omitted ...string) []string {
+	isOmitted := make(map[string]bool)
+	for _, name := range omitted {
+		isOmitted[name] = true
+	}
+	result := make([]string, 0, len(columns))
+	for _, column := range columns {
+		name := column[strings.LastIndex(column, ".")+1:]
+		if isOmitted[name] {
+			column = fmt.Sprintf("'' AS %s", name)
+		}
+		result = append(result, column)
+	}
+	return result
+}
+
 func (s *RunStore) addMetricsAndResourceReferences(filteredSelectBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
 	var r model.Run
 	resourceRefConcatQuery := s.db.Concat([]string{`"["`, s.db.GroupConcat("rr.Payload", ","), `"]"`}, "")
@@ -349,14 +441,48 @@ func parseResourceReferences(resourceRefString sql.NullString) ([]*model.Resourc
 	return refs, nil
 }
 
-func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
+// CreateRun stores run r. The statements are traced as part of the request
+// in ctx, if any.
+func (s *RunStore) CreateRun(ctx context.Context, r *model.RunDetail) (*model.RunDetail, error) {
+	runSql, runArgs, err := s.createRunQuery(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use a transaction to make sure both run and its resource references are stored.
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to create run.")
+	}
+	if err = s.createRun(ctx, tx, r, runSql, runArgs); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to store run %v and its resource references to table", r.Name)
+	}
+	return r, nil
+}
+
+// createRunQuery returns the query storing run r to run_details table, after
+// offloading its runtime manifests if needed.
+func (s *RunStore) createRunQuery(ctx context.Context, r *model.RunDetail) (string, []interface{}, error) {
 	if r.StorageState == "" {
 		r.StorageState = api.Run_STORAGESTATE_AVAILABLE.String()
 	} else if r.StorageState != api.Run_STORAGESTATE_AVAILABLE.String() &&
 		r.StorageState != api.Run_STORAGESTATE_ARCHIVED.String() {
-		return nil, util.NewInvalidInputError("Invalid value for StorageState field: %q.", r.StorageState)
+		return "", nil, util.NewInvalidInputError("Invalid value for StorageState field: %q.", r.StorageState)
+	}
+	workflowRuntimeManifest, err := s.offloadManifest(ctx, r.UUID, r.WorkflowRuntimeManifest)
+	if err != nil {
+		return "", nil, err
+	}
+	pipelineRuntimeManifest, err := s.offloadManifest(ctx, r.UUID, r.PipelineRuntimeManifest)
+	if err != nil {
+		return "", nil, err
 	}
-
 	runSql, runArgs, err := sq.
 		Insert("run_details").
 		SetMap(sq.Eq{
@@ -372,8 +498,8 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 			"ScheduledAtInSec":        r.ScheduledAtInSec,
 			"FinishedAtInSec":         r.FinishedAtInSec,
 			"Conditions":              r.Conditions,
-			"WorkflowRuntimeManifest": r.WorkflowRuntimeManifest,
-			"PipelineRuntimeManifest": r.PipelineRuntimeManifest,
+			"WorkflowRuntimeManifest": workflowRuntimeManifest,
+			"PipelineRuntimeManifest": pipelineRuntimeManifest,
 			"PipelineId":              r.PipelineId,
 			"PipelineName":            r.PipelineName,
 			"PipelineSpecManifest":    r.PipelineSpecManifest,
@@ -381,39 +507,176 @@ func (s *RunStore) CreateRun(r *model.RunDetail) (*model.RunDetail, error) {
 			"Parameters":              r.Parameters,
 		}).ToSql()
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create query to store run to run table: '%v/%v",
+		return "", nil, util.NewInternalServerError(err, "Failed to create query to store run to run table: '%v/%v",
 			r.Namespace, r.Name)
 	}
+	return runSql, runArgs, nil
+}
 
-	// Use a transaction to make sure both run and its resource references are stored.
-	tx, err := s.db.Begin()
+// createRun stores run r along with its resource references, parameters,
+// labels, idempotency key and queue entry.
+func (s *RunStore) createRun(ctx context.Context, tx *sql.Tx, r *model.RunDetail, runSql string, runArgs []interface{}) error {
+	_, err := tx.ExecContext(ctx, runSql, runArgs...)
 	if err != nil {
-		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to create run.")
+		return util.NewInternalServerError(err, "Failed to store run %v to table", r.Name)
 	}
-	_, err = tx.Exec(runSql, runArgs...)
+
+	err = s.resourceReferenceStore.CreateResourceReferences(ctx, tx, r.ResourceReferences)
 	if err != nil {
-		tx.Rollback()
-		return nil, util.NewInternalServerError(err, "Failed to store run %v to table", r.Name)
+		return util.NewInternalServerError(err, "Failed to store resource references to table for run %v ", r.Name)
+	}
+	err = s.createRunParameters(ctx, tx, r)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store parameters to table for run %v ", r.Name)
+	}
+	err = s.labelStore.CreateLabels(ctx, tx, common.Run, r.UUID, r.Labels)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store labels to table for run %v ", r.Name)
+	}
+	err = s.idempotencyKeyStore.CompleteIdempotencyKey(ctx, tx, r.IdempotencyKey)
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to store idempotency key to table for run %v ", r.Name)
 	}
+	if r.Conditions == model.RunQueuedConditions {
+		err = s.runQueueStore.CreateQueuedRun(ctx, tx, &model.QueuedRun{
+			RunUUID:       r.UUID,
+			Namespace:     r.Namespace,
+			Priority:      r.Priority,
+			QueuedAtInSec: r.CreatedAtInSec,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	err = s.resourceReferenceStore.CreateResourceReferences(tx, r.ResourceReferences)
+// createRunParameters stores the parameters of run r to run_parameters table,
+// so that runs can be filtered and sorted by parameter values. Parameters with
+// values longer than maxRunParameterValueLength are not stored.
+func (s *RunStore) createRunParameters(ctx context.Context, tx *sql.Tx, r *model.RunDetail) error {
+	values, err := r.ParameterValues()
 	if err != nil {
-		tx.Rollback()
-		return nil, util.NewInternalServerError(err, "Failed to store resource references to table for run %v ", r.Name)
+		return util.NewInternalServerError(err, "Failed to parse parameters of run %v", r.Name)
 	}
-	err = tx.Commit()
+	if len(values) == 0 {
+		return nil
+	}
+
+	sqlBuilder := sq.Insert("run_parameters").Columns("RunUUID", "Name", "Value", "NumberValue", "SortValue")
+	count := 0
+	for _, name := range sortedKeys(values) {
+		value := values[name]
+		if len(value) > maxRunParameterValueLength {
+			continue
+		}
+		var numberValue *float64
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			numberValue = &f
+		}
+		sqlBuilder = sqlBuilder.Values(r.UUID, name, value, numberValue, model.RunParameterSortValue(value))
+		count++
+	}
+	if count == 0 {
+		return nil
+	}
+	sql, args, err := sqlBuilder.ToSql()
 	if err != nil {
-		tx.Rollback()
-		return nil, util.NewInternalServerError(err, "Failed to store run %v and its resource references to table", r.Name)
+		return util.NewInternalServerError(err, "Failed to create query to store parameters of run %v", r.Name)
+	}
+	_, err = tx.ExecContext(ctx, sql, args...)
+	return err
+}
+
+// BackfillRunParameters stores the parameters of the existing runs which have
+// none in run_parameters table, batchSize runs per transaction. Returns the
+// number of runs which were backfilled.
+func (s *RunStore) BackfillRunParameters(batchSize int) (int, error) {
+	backfilled := 0
+	lastRunId := ""
+	for {
+		sql, args, err := sq.
+			Select("UUID", "Name", "Parameters").
+			From("run_details").
+			Where(sq.Gt{"UUID": lastRunId}).
+			Where(sq.NotEq{"Parameters": ""}).
+			Where("NOT EXISTS (SELECT 1 FROM run_parameters WHERE run_parameters.RunUUID = run_details.UUID)").
+			OrderBy("UUID").
+			Limit(uint64(batchSize)).
+			ToSql()
+		if err != nil {
+			return backfilled, util.NewInternalServerError(err, "Failed to create query to list the runs to backfill parameters of")
+		}
+		rows, err := s.db.Query(sql, args...)
+		if err != nil {
+			return backfilled, util.NewInternalServerError(err, "Failed to list the runs to backfill parameters of")
+		}
+		var runs []*model.RunDetail
+		for rows.Next() {
+			r := &model.RunDetail{}
+			if err := rows.Scan(&r.UUID, &r.Name, &r.Parameters); err != nil {
+				rows.Close()
+				return backfilled, util.NewInternalServerError(err, "Failed to scan parameters of runs")
+			}
+			runs = append(runs, r)
+		}
+		rows.Close()
+		if len(runs) == 0 {
+			return backfilled, nil
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			return backfilled, util.NewInternalServerError(err, "Failed to create a new transaction to backfill run parameters.")
+		}
+		for _, r := range runs {
+			if err := s.createRunParameters(context.Background(), tx, r); err != nil {
+				tx.Rollback()
+				return backfilled, util.NewInternalServerError(err, "Failed to backfill parameters of run %v", r.UUID)
+			}
+		}
+		if err := tx.Commit(); err != nil {
+			tx.Rollback()
+			return backfilled, util.NewInternalServerError(err, "Failed to backfill run parameters")
+		}
+		backfilled += len(runs)
+		lastRunId = runs[len(runs)-1].UUID
+		if len(runs) < batchSize {
+			return backfilled, nil
+		}
 	}
-	return r, nil
+}
+
+func sortedKeys(values map[string]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int64, workflowRuntimeManifest string) (err error) {
+	workflowRuntimeManifest, err = s.offloadManifest(context.Background(), runID, workflowRuntimeManifest)
+	if err != nil {
+		return err
+	}
 	tx, err := s.db.DB.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "transaction creation failed")
 	}
+	// The previous offloaded manifest is deleted once it is replaced, unless
+	// the pipeline runtime manifest has the same content, and so the same
+	// object.
+	var previousManifest, pipelineRuntimeManifest string
+	if isRunManifestReference(workflowRuntimeManifest) {
+		err = tx.QueryRow(`SELECT WorkflowRuntimeManifest, PipelineRuntimeManifest FROM run_details WHERE UUID = ?`, runID).
+			Scan(&previousManifest, &pipelineRuntimeManifest)
+		if err != nil && err != sql.ErrNoRows {
+			tx.Rollback()
+			return util.NewInternalServerError(err, "Failed to get the manifest of run %s", runID)
+		}
+	}
 
 	sql, args, err := sq.
 		Update("run_details").
@@ -451,11 +714,17 @@ func (s *RunStore) UpdateRun(runID string, condition string, finishedAtInSec int
 	if err := tx.Commit(); err != nil {
 		return util.NewInternalServerError(err, "failed to commit transaction")
 	}
+	if previousManifest != "" && previousManifest != workflowRuntimeManifest && previousManifest != pipelineRuntimeManifest {
+		if err := s.manifestStore.DeleteManifest(previousManifest); err != nil {
+			// The object is left behind until the run is deleted.
+			glog.Warningf("Failed to delete the previous manifest of run %s. Error: %v", runID, err)
+		}
+	}
 	return nil
 }
 
 func (s *RunStore) CreateOrUpdateRun(runDetail *model.RunDetail) error {
-	_, createError := s.CreateRun(runDetail)
+	_, createError := s.CreateRun(context.Background(), runDetail)
 	if createError == nil {
 		return nil
 	}
@@ -522,10 +791,29 @@ func (s *RunStore) DeleteRun(id string) error {
 			"Failed to create query to delete run: %s", id)
 	}
 	// Use a transaction to make sure both run and its resource references are stored.
+	nameSql, nameArgs, err := sq.Select("Name").From("run_details").Where(sq.Eq{"UUID": id}).ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err,
+			"Failed to create query to get the name of run: %s", id)
+	}
 	tx, err := s.db.Begin()
 	if err != nil {
 		return util.NewInternalServerError(err, "Failed to create a new transaction to delete run.")
 	}
+	// The objects of the run are deleted asynchronously, keyed by the name of its PipelineRun.
+	var runName string
+	err = tx.QueryRow(nameSql, nameArgs...).Scan(&runName)
+	if err != nil && err != sql.ErrNoRows {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to get the name of run %s", id)
+	}
+	if err == nil {
+		err = s.runObjectCleanupStore.CreateRunObjectCleanup(tx, id, runName)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
 	_, err = tx.Exec(runSql, runArgs...)
 	if err != nil {
 		tx.Rollback()
@@ -536,6 +824,31 @@ func (s *RunStore) DeleteRun(id string) error {
 		tx.Rollback()
 		return util.NewInternalServerError(err, "Failed to delete resource references from table for run %v ", id)
 	}
+	paramSql, paramArgs, err := sq.Delete("run_parameters").Where(sq.Eq{"RunUUID": id}).ToSql()
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to create query to delete parameters of run: %s", id)
+	}
+	_, err = tx.Exec(paramSql, paramArgs...)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete parameters from table for run %v ", id)
+	}
+	err = s.labelStore.DeleteLabels(tx, common.Run, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete labels from table for run %v ", id)
+	}
+	err = s.idempotencyKeyStore.DeleteIdempotencyKeys(tx, common.Run, id)
+	if err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to delete idempotency keys from table for run %v ", id)
+	}
+	_, err = s.runQueueStore.DeleteQueuedRun(tx, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		tx.Rollback()
@@ -597,10 +910,138 @@ func NewRunStore(db *DB, time util.TimeInterface) *RunStore {
 	return &RunStore{
 		db:                     db,
 		resourceReferenceStore: NewResourceReferenceStore(db),
+		labelStore:             NewLabelStore(db),
+		idempotencyKeyStore:    NewIdempotencyKeyStore(db, time),
+		runObjectCleanupStore:  NewRunObjectCleanupStore(db, time),
+		runQueueStore:          NewRunQueueStore(db),
 		time:                   time,
 	}
 }
 
+// NewRunStoreWithObjectStore returns a run store which rehydrates the runtime
+// manifests offloaded to the object store, and offloads them when runs are
+// written if offloadManifests is set.
+func NewRunStoreWithObjectStore(db *DB, time util.TimeInterface, objectStore ObjectStoreInterface, offloadManifests bool) *RunStore {
+	runStore := NewRunStore(db, time)
+	runStore.manifestStore = NewRunManifestStore(objectStore)
+	runStore.offloadManifests = offloadManifests
+	return runStore
+}
+
+// offloadManifest returns the value to store for a runtime manifest of a run,
+// which is a reference to the manifest in the object store when offloading.
+func (s *RunStore) offloadManifest(ctx context.Context, runId string, manifest string) (string, error) {
+	if !s.offloadManifests {
+		return manifest, nil
+	}
+	return s.manifestStore.OffloadManifest(ctx, runId, manifest)
+}
+
+// rehydrateManifest returns the runtime manifest a stored value refers to.
+func (s *RunStore) rehydrateManifest(value string) (string, error) {
+	if !isRunManifestReference(value) {
+		return value, nil
+	}
+	if s.manifestStore == nil {
+		return "", util.NewInternalServerError(errors.New("object store is not available"),
+			"Failed to get the offloaded run manifest %s", value)
+	}
+	return s.manifestStore.RehydrateManifest(value)
+}
+
+// OffloadRunManifests moves the runtime manifests still stored in the
+// database to the object store, batchSize runs at a time. A run updated
+// concurrently is skipped, since the update offloads its manifests already.
+// Returns the number of migrated runs.
+func (s *RunStore) OffloadRunManifests(batchSize int) (int, error) {
+	if !s.offloadManifests {
+		return 0, nil
+	}
+	notOffloaded := func(column string) sq.Sqlizer {
+		return sq.And{sq.NotEq{column: ""}, sq.Expr(column+" NOT LIKE ?", runManifestReferencePrefix+"%")}
+	}
+	migrated := 0
+	lastRunId := ""
+	for {
+		sql, args, err := sq.
+			Select("UUID", "WorkflowRuntimeManifest", "PipelineRuntimeManifest").
+			From("run_details").
+			Where(sq.Gt{"UUID": lastRunId}).
+			Where(sq.Or{notOffloaded("WorkflowRuntimeManifest"), notOffloaded("PipelineRuntimeManifest")}).
+			OrderBy("UUID").
+			Limit(uint64(batchSize)).
+			ToSql()
+		if err != nil {
+			return migrated, util.NewInternalServerError(err, "Failed to create query to list the run manifests to offload")
+		}
+		rows, err := s.db.Query(sql, args...)
+		if err != nil {
+			return migrated, util.NewInternalServerError(err, "Failed to list the run manifests to offload")
+		}
+		var runs []*model.RunDetail
+		for rows.Next() {
+			var run model.RunDetail
+			if err := rows.Scan(&run.UUID, &run.WorkflowRuntimeManifest, &run.PipelineRuntimeManifest); err != nil {
+				rows.Close()
+				return migrated, util.NewInternalServerError(err, "Failed to list the run manifests to offload")
+			}
+			runs = append(runs, &run)
+		}
+		rows.Close()
+
+		for _, run := range runs {
+			lastRunId = run.UUID
+			ok, err := s.offloadRunManifests(run)
+			if err != nil {
+				return migrated, err
+			}
+			if ok {
+				migrated++
+			}
+		}
+		if len(runs) < batchSize {
+			return migrated, nil
+		}
+	}
+}
+
+// offloadRunManifests offloads the runtime manifests of a run, unless the run
+// was updated since they were read. Returns whether the run was updated.
+func (s *RunStore) offloadRunManifests(run *model.RunDetail) (bool, error) {
+	workflowRuntimeManifest, err := s.offloadManifest(context.Background(), run.UUID, run.WorkflowRuntimeManifest)
+	if err != nil {
+		return false, err
+	}
+	pipelineRuntimeManifest, err := s.offloadManifest(context.Background(), run.UUID, run.PipelineRuntimeManifest)
+	if err != nil {
+		return false, err
+	}
+	sql, args, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{
+			"WorkflowRuntimeManifest": workflowRuntimeManifest,
+			"PipelineRuntimeManifest": pipelineRuntimeManifest,
+		}).
+		Where(sq.Eq{
+			"UUID":                    run.UUID,
+			"WorkflowRuntimeManifest": run.WorkflowRuntimeManifest,
+			"PipelineRuntimeManifest": run.PipelineRuntimeManifest,
+		}).
+		ToSql()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to create query to offload the manifests of run %s", run.UUID)
+	}
+	result, err := s.db.Exec(sql, args...)
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to offload the manifests of run %s", run.UUID)
+	}
+	r, err := result.RowsAffected()
+	if err != nil {
+		return false, util.NewInternalServerError(err, "Failed to offload the manifests of run %s", run.UUID)
+	}
+	return r == 1, nil
+}
+
 func (s *RunStore) TerminateRun(runId string) error {
 	result, err := s.db.Exec(`
 		UPDATE run_details
@@ -620,17 +1061,205 @@ func (s *RunStore) TerminateRun(runId string) error {
 	return nil
 }
 
+// AdmitQueuedRun removes a run from the queue of its namespace and records the
+// PipelineRun created for it. The condition and manifest are only set while
+// the run is still queued, since the persistence agent may already have
+// reported a newer state of the PipelineRun.
+func (s *RunStore) AdmitQueuedRun(runId string, condition string, scheduledAtInSec int64, workflowRuntimeManifest string) error {
+	workflowRuntimeManifest, err := s.offloadManifest(context.Background(), runId, workflowRuntimeManifest)
+	if err != nil {
+		return err
+	}
+	scheduleSql, scheduleArgs, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{"ScheduledAtInSec": scheduledAtInSec}).
+		Where(sq.Eq{"UUID": runId}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to admit run %s", runId)
+	}
+	runSql, runArgs, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{
+			"Conditions":              condition,
+			"WorkflowRuntimeManifest": workflowRuntimeManifest}).
+		Where(sq.Eq{"UUID": runId, "Conditions": model.RunQueuedConditions}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to admit run %s", runId)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to admit run.")
+	}
+	queued, err := s.runQueueStore.DeleteQueuedRun(tx, runId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if !queued {
+		tx.Rollback()
+		return util.NewResourceNotFoundError("QueuedRun", runId)
+	}
+	if _, err := tx.Exec(scheduleSql, scheduleArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to admit run %s", runId)
+	}
+	if _, err := tx.Exec(runSql, runArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to admit run %s", runId)
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to admit run %s", runId)
+	}
+	return nil
+}
+
+// CancelQueuedRun removes a run from the queue of its namespace and marks it
+// cancelled.
+func (s *RunStore) CancelQueuedRun(runId string) error {
+	runSql, runArgs, err := sq.
+		Update("run_details").
+		SetMap(sq.Eq{
+			"Conditions":      model.RunCancelledConditions,
+			"FinishedAtInSec": s.time.Now().Unix()}).
+		Where(sq.Eq{"UUID": runId, "Conditions": model.RunQueuedConditions}).
+		ToSql()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create query to cancel run %s", runId)
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return util.NewInternalServerError(err, "Failed to create a new transaction to cancel run.")
+	}
+	queued, err := s.runQueueStore.DeleteQueuedRun(tx, runId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if !queued {
+		tx.Rollback()
+		return util.NewInvalidInputError("Failed to cancel run %s. Run is not queued.", runId)
+	}
+	if _, err := tx.Exec(runSql, runArgs...); err != nil {
+		tx.Rollback()
+		return util.NewInternalServerError(err, "Failed to cancel run %s", runId)
+	}
+	if err := tx.Commit(); err != nil {
+		return util.NewInternalServerError(err, "Failed to cancel run %s", runId)
+	}
+	return nil
+}
+
+// GetNamespaceRunUsage counts the active runs of a namespace, the runs started
+// since opts.SinceInSec and the queued runs. Queued runs are not counted as
+// active or started until they are admitted.
+func (s *RunStore) GetNamespaceRunUsage(namespace string, opts *model.NamespaceQuotaUsageOptions) (*model.NamespaceQuotaUsage, error) {
+	return s.getNamespaceRunUsage(context.Background(), s.db, namespace, opts)
+}
+
+func (s *RunStore) QueueRun(ctx context.Context, r *model.RunDetail, opts *model.NamespaceQuotaUsageOptions) (*model.NamespaceQuotaUsage, error) {
+	r.Conditions = model.RunQueuedConditions
+	runSql, runArgs, err := s.createRunQuery(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	// Updating the quota of the namespace locks it until the transaction ends,
+	// so that concurrent transactions queue their runs one after another.
+	lockSql, lockArgs, err := sq.
+		Update("namespace_quota").
+		Set("UpdatedAtInSec", sq.Expr("UpdatedAtInSec")).
+		Where(sq.Eq{"Namespace": r.Namespace}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to lock the quota of namespace %s", r.Namespace)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create a new transaction to queue run.")
+	}
+	if _, err = tx.ExecContext(ctx, lockSql, lockArgs...); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to lock the quota of namespace %s", r.Namespace)
+	}
+	usage, err := s.getNamespaceRunUsage(ctx, tx, r.Namespace, opts)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err = s.createRun(ctx, tx, r, runSql, runArgs); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		tx.Rollback()
+		return nil, util.NewInternalServerError(err, "Failed to queue run %v", r.Name)
+	}
+	return usage, nil
+}
+
+// queryRower is implemented by both DB and transactions.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getNamespaceRunUsage counts the runs of a namespace counted against its
+// quota, in a transaction or not.
+func (s *RunStore) getNamespaceRunUsage(ctx context.Context, db queryRower, namespace string,
+	opts *model.NamespaceQuotaUsageOptions) (*model.NamespaceQuotaUsage, error) {
+	// Unfinished runs are active while their PipelineRun exists.
+	existingSql, existingArgs, err := sq.Or{
+		sq.Eq{"UUID": opts.WorkflowRunIds},
+		sq.GtOrEq{"CreatedAtInSec": opts.GraceSinceInSec},
+		sq.GtOrEq{"ScheduledAtInSec": opts.GraceSinceInSec},
+	}.ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get the run usage of namespace %s", namespace)
+	}
+	usageSql, usageArgs, err := sq.
+		Select().
+		Column(sq.Expr("COALESCE(SUM(CASE WHEN FinishedAtInSec = 0 AND "+existingSql+" THEN 1 ELSE 0 END), 0)", existingArgs...)).
+		Column(sq.Expr("COALESCE(SUM(CASE WHEN CreatedAtInSec >= ? OR ScheduledAtInSec >= ? THEN 1 ELSE 0 END), 0)", opts.SinceInSec, opts.SinceInSec)).
+		From("run_details").
+		Where(sq.Eq{"Namespace": namespace}).
+		Where(sq.NotEq{"Conditions": []string{model.RunQueuedConditions, model.RunCancelledConditions}}).
+		ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to get the run usage of namespace %s", namespace)
+	}
+	var usage model.NamespaceQuotaUsage
+	if err := db.QueryRowContext(ctx, usageSql, usageArgs...).Scan(&usage.ActiveRuns, &usage.RunsLastHour); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to get the run usage of namespace %s", namespace)
+	}
+	queueSql, queueArgs, err := sq.Select("count(*)").From("queued_runs").Where(sq.Eq{"Namespace": namespace}).ToSql()
+	if err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to create query to count queued runs of namespace %s", namespace)
+	}
+	if err := db.QueryRowContext(ctx, queueSql, queueArgs...).Scan(&usage.QueuedRuns); err != nil {
+		return nil, util.NewInternalServerError(err, "Failed to count queued runs of namespace %s", namespace)
+	}
+	return &usage, nil
+}
+
 // Add a metric as a new field to the select clause by join the passed-in SQL query with run_metrics table.
 // With the metric as a field in the select clause enable sorting on this metric afterwards.
+// Sorting by a run parameter is done the same way by joining with run_parameters table.
 // TODO(jingzhang36): example of resulting SQL query and explanation for it.
 func (s *RunStore) AddSortByRunMetricToSelect(sqlBuilder sq.SelectBuilder, opts *list.Options) sq.SelectBuilder {
 	var r model.Run
 	if r.IsRegularField(opts.SortByFieldName) {
 		return sqlBuilder
 	}
+	if paramName, ok := model.GetRunParameterName(opts.SortByFieldName); ok {
+		return sq.
+			Select("selected_runs.*, run_parameters.sortvalue as "+opts.SortByFieldName).
+			FromSelect(sqlBuilder, "selected_runs").
+			LeftJoin("run_parameters ON selected_runs.uuid=run_parameters.runuuid AND run_parameters.name=?", paramName)
+	}
 	// TODO(jingzhang36): address the case where runs doesn't have the specified metric.
 	return sq.
 		Select("selected_runs.*, run_metrics.numbervalue as "+opts.SortByFieldName).
 		FromSelect(sqlBuilder, "selected_runs").
-		LeftJoin("run_metrics ON selected_runs.uuid=run_metrics.runuuid AND run_metrics.name='" + opts.SortByFieldName + "'")
+		LeftJoin("run_metrics ON selected_runs.uuid=run_metrics.runuuid AND run_metrics.name=?", opts.SortByFieldName)
 }
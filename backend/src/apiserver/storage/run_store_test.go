@@ -15,6 +15,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"testing"
@@ -112,9 +113,9 @@ func initializeRunStore() (*DB, *RunStore) {
 			WorkflowRuntimeManifest: "workflow3",
 		},
 	}
-	runStore.CreateRun(run1)
-	runStore.CreateRun(run2)
-	runStore.CreateRun(run3)
+	runStore.CreateRun(context.Background(), run1)
+	runStore.CreateRun(context.Background(), run2)
+	runStore.CreateRun(context.Background(), run3)
 
 	metric1 := &model.RunMetric{
 		RunUUID:     "1",
@@ -207,7 +208,7 @@ func TestListRuns_Pagination(t *testing.T) {
 	assert.Equal(t, expectedFirstPageRuns, runs, "Unexpected Run listed.")
 	assert.NotEmpty(t, nextPageToken)
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 1)
 	assert.Nil(t, err)
 	runs, total_size, nextPageToken, err = runStore.ListRuns(
 		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
@@ -289,7 +290,7 @@ func TestListRuns_Pagination_WithSortingOnMetrics(t *testing.T) {
 	assert.Equal(t, expectedFirstPageRuns, runs, "Unexpected Run listed.")
 	assert.NotEmpty(t, nextPageToken)
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 1)
 	assert.Nil(t, err)
 	runs, total_size, nextPageToken, err = runStore.ListRuns(
 		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
@@ -309,7 +310,7 @@ func TestListRuns_Pagination_WithSortingOnMetrics(t *testing.T) {
 	assert.Equal(t, expectedSecondPageRuns, runs, "Unexpected Run listed.")
 	assert.NotEmpty(t, nextPageToken)
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 1)
 	assert.Nil(t, err)
 	runs, total_size, nextPageToken, err = runStore.ListRuns(
 		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
@@ -431,7 +432,7 @@ func TestListRuns_Pagination_Descend(t *testing.T) {
 	assert.Equal(t, expectedFirstPageRuns, runs, "Unexpected Run listed.")
 	assert.NotEmpty(t, nextPageToken)
 
-	opts, err = list.NewOptionsFromToken(nextPageToken, 1)
+	opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 1)
 	assert.Nil(t, err)
 	runs, total_size, nextPageToken, err = runStore.ListRuns(
 		&common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Experiment, ID: defaultFakeExpId}}, opts)
@@ -768,7 +769,7 @@ func TestCreateOrUpdateRun_NoStorageStateValue(t *testing.T) {
 		},
 	}
 
-	run, err := runStore.CreateRun(runDetail)
+	run, err := runStore.CreateRun(context.Background(), runDetail)
 	assert.Nil(t, err)
 	assert.Equal(t, run.StorageState, api.Run_STORAGESTATE_AVAILABLE.String())
 }
@@ -809,7 +810,7 @@ func TestCreateOrUpdateRun_BadStorageStateValue(t *testing.T) {
 		},
 	}
 
-	_, err := runStore.CreateRun(runDetail)
+	_, err := runStore.CreateRun(context.Background(), runDetail)
 	assert.NotNil(t, err)
 	assert.Contains(t, err.Error(), "Invalid value for StorageState field")
 }
@@ -1204,3 +1205,316 @@ func TestDeleteRun_InternalError(t *testing.T) {
 	assert.Equal(t, codes.Internal, err.(*util.UserError).ExternalStatusCode(),
 		"Expected delete run to return internal error")
 }
+
+func initializeRunStoreWithParameters() (*DB, *RunStore) {
+	db := NewFakeDbOrFatal()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+
+	for i, params := range []struct {
+		learningRate string
+		optimizer    string
+		accuracy     float64
+	}{
+		{"0.1", "adam", 0.8},
+		{"0.01", "sgd", 0.95},
+		{"0.001", "adam", 0.92},
+	} {
+		id := fmt.Sprint(i + 1)
+		runStore.CreateRun(context.Background(), &model.RunDetail{
+			Run: model.Run{
+				UUID:           id,
+				Name:           "run" + id,
+				DisplayName:    "run" + id,
+				CreatedAtInSec: int64(i + 1),
+				Conditions:     "done",
+				PipelineSpec: model.PipelineSpec{
+					Parameters: fmt.Sprintf(`[{"name":"learning_rate","value":"%s"},{"name":"optimizer","value":"%s"}]`,
+						params.learningRate, params.optimizer),
+				},
+			},
+			PipelineRuntime: model.PipelineRuntime{
+				WorkflowRuntimeManifest: "workflow" + id,
+			},
+		})
+		runStore.ReportMetric(&model.RunMetric{
+			RunUUID:     id,
+			NodeID:      "node" + id,
+			Name:        "accuracy",
+			NumberValue: params.accuracy,
+			Format:      "RAW",
+		})
+	}
+	return db, runStore
+}
+
+func runIDs(runs []*model.Run) []string {
+	var ids []string
+	for _, run := range runs {
+		ids = append(ids, run.UUID)
+	}
+	return ids
+}
+
+func TestListRuns_FilterOnParametersAndMetrics(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	tests := []struct {
+		filter *api.Filter
+		want   []string
+	}{
+		{
+			&api.Filter{
+				Predicates: []*api.Predicate{
+					{Key: "param:learning_rate", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "0.01"}},
+					{Key: "metric:accuracy", Op: api.Predicate_GREATER_THAN, Value: &api.Predicate_DoubleValue{DoubleValue: 0.9}},
+				},
+			},
+			[]string{"2"},
+		},
+		{
+			&api.Filter{
+				Predicates: []*api.Predicate{
+					{Key: "param:learning_rate", Op: api.Predicate_LESS_THAN, Value: &api.Predicate_DoubleValue{DoubleValue: 0.05}},
+				},
+			},
+			[]string{"2", "3"},
+		},
+		{
+			&api.Filter{
+				Predicates: []*api.Predicate{
+					{Key: "param:optimizer", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "adam"}},
+					{Key: "metric:accuracy", Op: api.Predicate_GREATER_THAN_EQUALS, Value: &api.Predicate_DoubleValue{DoubleValue: 0.9}},
+				},
+			},
+			[]string{"3"},
+		},
+		{
+			&api.Filter{
+				Combinator: api.Filter_OR,
+				Predicates: []*api.Predicate{
+					{Key: "param:optimizer", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "sgd"}},
+					{Key: "metric:accuracy", Op: api.Predicate_LESS_THAN, Value: &api.Predicate_DoubleValue{DoubleValue: 0.9}},
+				},
+			},
+			[]string{"1", "2"},
+		},
+		{
+			&api.Filter{
+				Predicates: []*api.Predicate{
+					{Key: "param:missing", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "adam"}},
+				},
+			},
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		opts, err := list.NewOptions(&model.Run{}, 10, "", test.filter)
+		assert.Nil(t, err)
+
+		runs, totalSize, _, err := runStore.ListRuns(&common.FilterContext{}, opts)
+		assert.Nil(t, err)
+		assert.Equal(t, len(test.want), totalSize)
+		assert.Equal(t, test.want, runIDs(runs), "Unexpected runs listed for filter %+v", test.filter)
+	}
+}
+
+func TestListRuns_FilterOnMetricWithStringValue_Fail(t *testing.T) {
+	_, err := list.NewOptions(&model.Run{}, 10, "", &api.Filter{
+		Predicates: []*api.Predicate{
+			{Key: "metric:accuracy", Op: api.Predicate_EQUALS, Value: &api.Predicate_StringValue{StringValue: "high"}},
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "cannot use value type")
+}
+
+func TestListRuns_Pagination_WithSortingOnParameter(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	for _, test := range []struct {
+		sortBy string
+		want   []string
+	}{
+		{"param:learning_rate", []string{"3", "2", "1"}},
+		{"param:learning_rate desc", []string{"1", "2", "3"}},
+		{"param:optimizer", []string{"1", "3", "2"}},
+	} {
+		opts, err := list.NewOptions(&model.Run{}, 1, test.sortBy, nil)
+		assert.Nil(t, err)
+
+		var got []string
+		for {
+			runs, totalSize, nextPageToken, err := runStore.ListRuns(&common.FilterContext{}, opts)
+			assert.Nil(t, err)
+			assert.Equal(t, 3, totalSize)
+			got = append(got, runIDs(runs)...)
+			if nextPageToken == "" {
+				break
+			}
+			opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 1)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, test.want, got, "Unexpected runs listed when sorting by %q", test.sortBy)
+	}
+}
+
+func TestListRuns_Pagination_WithSortingOnNumericParameter(t *testing.T) {
+	db := NewFakeDbOrFatal()
+	defer db.Close()
+	runStore := NewRunStore(db, util.NewFakeTimeForEpoch())
+	for i, epochs := range []string{"9", "10", "-2", "many", "1e2"} {
+		id := fmt.Sprint(i + 1)
+		_, err := runStore.CreateRun(context.Background(), &model.RunDetail{
+			Run: model.Run{
+				UUID:           id,
+				Name:           "run" + id,
+				DisplayName:    "run" + id,
+				CreatedAtInSec: int64(i + 1),
+				Conditions:     "done",
+				PipelineSpec: model.PipelineSpec{
+					Parameters: fmt.Sprintf(`[{"name":"epochs","value":"%s"}]`, epochs),
+				},
+			},
+		})
+		assert.Nil(t, err)
+	}
+
+	// Numbers sort numerically, and before the other values.
+	opts, err := list.NewOptions(&model.Run{}, 2, "param:epochs", &api.Filter{
+		Predicates: []*api.Predicate{
+			{Key: "param:epochs", Op: api.Predicate_NOT_EQUALS, Value: &api.Predicate_StringValue{StringValue: "10"}},
+		},
+	})
+	assert.Nil(t, err)
+	var got []string
+	for {
+		runs, totalSize, nextPageToken, err := runStore.ListRuns(&common.FilterContext{}, opts)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, totalSize)
+		got = append(got, runIDs(runs)...)
+		if nextPageToken == "" {
+			break
+		}
+		opts, err = list.NewOptionsFromToken(&model.Run{}, nextPageToken, 2)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, []string{"3", "1", "5", "4"}, got)
+}
+
+func TestCreateRun_StoresParameters(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT Name, Value, NumberValue, SortValue FROM run_parameters WHERE RunUUID = '2' ORDER BY Name`)
+	assert.Nil(t, err)
+	var params []model.RunParameter
+	for rows.Next() {
+		var p model.RunParameter
+		assert.Nil(t, rows.Scan(&p.Name, &p.Value, &p.NumberValue, &p.SortValue))
+		params = append(params, p)
+	}
+	rows.Close()
+	learningRate := 0.01
+	assert.Equal(t, []model.RunParameter{
+		{Name: "learning_rate", Value: "0.01", NumberValue: &learningRate, SortValue: model.RunParameterSortValue("0.01")},
+		{Name: "optimizer", Value: "sgd", SortValue: "1sgd"},
+	}, params)
+
+	// Parameters are deleted together with the run.
+	assert.Nil(t, runStore.DeleteRun("2"))
+	var count int
+	assert.Nil(t, db.QueryRow(`SELECT count(*) FROM run_parameters WHERE RunUUID = '2'`).Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestBackfillRunParameters(t *testing.T) {
+	db, runStore := initializeRunStoreWithParameters()
+	defer db.Close()
+
+	_, err := db.Exec(`DELETE FROM run_parameters WHERE RunUUID != '2'`)
+	assert.Nil(t, err)
+
+	// Only the runs without parameters are backfilled, in batches.
+	count, err := runStore.BackfillRunParameters(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Nil(t, db.QueryRow(`SELECT count(*) FROM run_parameters`).Scan(&count))
+	assert.Equal(t, 6, count)
+
+	// Backfilling again is a no-op.
+	count, err = runStore.BackfillRunParameters(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Nil(t, db.QueryRow(`SELECT count(*) FROM run_parameters`).Scan(&count))
+	assert.Equal(t, 6, count)
+}
+
+func TestDeleteRun_CreatesRunObjectCleanup(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+
+	err := runStore.DeleteRun("1")
+	assert.Nil(t, err)
+	cleanup, err := runStore.runObjectCleanupStore.GetRunObjectCleanup("1")
+	assert.Nil(t, err)
+	assert.Equal(t, &model.RunObjectCleanup{
+		RunUUID:            "1",
+		RunName:            "run1",
+		CreatedAtInSec:     1,
+		NextAttemptAtInSec: 1,
+	}, cleanup)
+
+	// Deleting a run that doesn't exist doesn't create a cleanup.
+	err = runStore.DeleteRun("unknown")
+	assert.Nil(t, err)
+	_, err = runStore.runObjectCleanupStore.GetRunObjectCleanup("unknown")
+	assert.Equal(t, codes.NotFound, err.(*util.UserError).ExternalStatusCode())
+}
+
+func TestListRuns_BasicView(t *testing.T) {
+	db, runStore := initializeRunStore()
+	defer db.Close()
+	_, err := runStore.CreateRun(context.Background(), &model.RunDetail{
+		Run: model.Run{
+			UUID:           "basic",
+			ExperimentUUID: defaultFakeExpIdTwo,
+			Name:           "run3",
+			DisplayName:    "run3",
+			StorageState:   api.Run_STORAGESTATE_AVAILABLE.String(),
+			Namespace:      "basic",
+			CreatedAtInSec: 3,
+			Conditions:     "Running",
+			PipelineSpec: model.PipelineSpec{
+				PipelineSpecManifest: "pipeline spec",
+				WorkflowSpecManifest: "workflow spec",
+				Parameters:           `[{"name":"param1","value":"value1"}]`,
+			},
+		},
+		PipelineRuntime: model.PipelineRuntime{WorkflowRuntimeManifest: "workflow3", PipelineRuntimeManifest: "pipeline runtime"},
+	})
+	assert.Nil(t, err)
+	filterContext := &common.FilterContext{ReferenceKey: &common.ReferenceKey{Type: common.Namespace, ID: "basic"}}
+
+	opts, err := list.NewOptions(&model.Run{}, 10, "", nil)
+	assert.Nil(t, err)
+	runs, totalSize, _, err := runStore.ListRuns(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, model.PipelineSpec{
+		PipelineSpecManifest: "pipeline runtime",
+		WorkflowSpecManifest: "workflow spec",
+		Parameters:           `[{"name":"param1","value":"value1"}]`,
+	}, runs[0].PipelineSpec)
+
+	opts.BasicView = true
+	runs, totalSize, _, err = runStore.ListRuns(filterContext, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, totalSize)
+	assert.Equal(t, "run3", runs[0].DisplayName)
+	assert.Equal(t, model.PipelineSpec{
+		Parameters: `[{"name":"param1","value":"value1"}]`,
+	}, runs[0].PipelineSpec)
+}
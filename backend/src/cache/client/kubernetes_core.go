@@ -5,6 +5,7 @@ import (
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
@@ -31,6 +32,7 @@ func createKubernetesCore(clientParams util.ClientParameters) (KubernetesCoreInt
 	}
 	restConfig.QPS = float32(clientParams.QPS)
 	restConfig.Burst = clientParams.Burst
+	restConfig.Wrap(tracing.NewTransport)
 
 	clientSet, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/glog"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/pkg/errors"
 
 	// "k8s.io/client-go/kubernetes"
@@ -33,6 +34,7 @@ func createTektonClient() (TektonInterface, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to initialize kubernetes client.")
 	}
+	restConfig.Wrap(tracing.NewTransport)
 
 	clientSet, err := tektoncdclientset.NewForConfig(restConfig)
 	if err != nil {
@@ -15,12 +15,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net/http"
 	"path/filepath"
 
 	"github.com/kubeflow/pipelines/backend/src/cache/server"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 )
 
@@ -58,6 +60,7 @@ type WhSvrDBParameters struct {
 func main() {
 	var params WhSvrDBParameters
 	var clientParams util.ClientParameters
+	var tracingConfig tracing.Config
 	flag.StringVar(&params.dbDriver, "db_driver", mysqlDBDriverDefault, "Database driver name, mysql is the default value")
 	flag.StringVar(&params.dbHost, "db_host", mysqlDBHostDefault, "Database host name.")
 	flag.StringVar(&params.dbPort, "db_port", mysqlDBPortDefault, "Database port number.")
@@ -70,9 +73,19 @@ func main() {
 	// k8s.io/client-go/rest/config.go#RESTClientFor
 	flag.Float64Var(&clientParams.QPS, "kube_client_qps", 5, "The maximum QPS to the master from this client.")
 	flag.IntVar(&clientParams.Burst, "kube_client_burst", 10, "Maximum burst for throttle from this client.")
+	flag.BoolVar(&tracingConfig.Enabled, "tracing_enabled", false, "Whether to export traces over OTLP.")
+	flag.StringVar(&tracingConfig.Endpoint, "otlp_endpoint", "", "The host:port of the OTLP gRPC receiver of the traces.")
+	flag.BoolVar(&tracingConfig.Insecure, "otlp_insecure", true, "Whether to disable TLS for the connection to the OTLP receiver.")
+	flag.Float64Var(&tracingConfig.SampleRatio, "tracing_sample_ratio", 1, "The fraction of the traces started by the cache server which are sampled.")
 
 	flag.Parse()
 
+	shutdownTracing, err := tracing.Init("cache-server", tracingConfig)
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	log.Println("Initing client manager....")
 	clientManager := NewClientManager(params, clientParams)
 
@@ -87,7 +100,7 @@ func main() {
 		// We listen on port 8443 such that we do not need root privileges or extra capabilities for this server.
 		// The Service object will take care of mapping this port to the HTTPS port 443.
 		Addr:    WebhookPort,
-		Handler: mux,
+		Handler: tracing.NewHandler(mux, "cache-server"),
 	}
 	log.Fatal(server.ListenAndServeTLS(certPath, keyPath))
 }
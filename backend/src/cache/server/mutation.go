@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -27,7 +28,9 @@ import (
 	"github.com/kubeflow/pipelines/backend/src/cache/client"
 	"github.com/kubeflow/pipelines/backend/src/cache/model"
 	"github.com/kubeflow/pipelines/backend/src/cache/storage"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	tektonv1beta1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"k8s.io/api/admission/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -88,6 +91,11 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 	zapLog, _ := zap.NewProduction()
 	logger := zapLog.Sugar()
 	defer zapLog.Sync()
+	// Admission requests carry no trace context, so every request starts a
+	// trace.
+	_, span := tracing.StartSpan(context.Background(), "MutatePodIfCached",
+		attribute.String("k8s.namespace.name", req.Namespace), attribute.String("k8s.pod.name", req.Name))
+	defer span.End()
 
 	logger.Infof("Request received: %#v", req.Resource)
 	// This handler should only get called on Pod objects as per the MutatingWebhookConfiguration in the YAML file.
@@ -102,7 +110,9 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 	raw := req.Object.Raw
 	pod := corev1.Pod{}
 	if _, _, err := universalDeserializer.Decode(raw, nil, &pod); err != nil {
-		return nil, fmt.Errorf("could not deserialize pod object: %v", err)
+		err = fmt.Errorf("could not deserialize pod object: %v", err)
+		tracing.RecordError(span, err)
+		return nil, err
 	}
 
 	// Pod filtering to only cache KFP tekton pods except TFX pods
@@ -177,6 +187,7 @@ func MutatePodIfCached(req *v1beta1.AdmissionRequest, clientMgr ClientManagerInt
 	if err != nil {
 		logger.Warnf("Failed when try to get cache from storage: %v", err)
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", cachedExecution != nil))
 	// Found cached execution, add cached output and cache_id and replace container images.
 	if cachedExecution != nil {
 		logger.Infof("Cached output: " + cachedExecution.ExecutionOutput)
@@ -7,6 +7,7 @@ import (
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/kubeflow/pipelines/backend/src/common/util"
 	"github.com/pkg/errors"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -50,11 +51,13 @@ func NewHTTPRuntime(clientConfig clientcmd.ClientConfig, debug bool) (
 		return nil, errors.Wrapf(err, "Error while creating K8 client")
 	}
 
-	// Create API client
-	httpClient := k8Client.RESTClient().(*rest.RESTClient).Client
+	// Create API client. The trace context of the requests is propagated to
+	// the API server.
+	httpClient := *k8Client.RESTClient().(*rest.RESTClient).Client
+	httpClient.Transport = tracing.NewTransport(httpClient.Transport)
 	masterIPAndPort := util.ExtractMasterIPAndPort(config)
 	runtime := httptransport.NewWithClient(masterIPAndPort, fmt.Sprintf(apiServerBasePath, namespace),
-		nil, httpClient)
+		nil, &httpClient)
 
 	if debug {
 		runtime.SetDebug(true)
@@ -0,0 +1,146 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing sets up OpenTelemetry tracing for the pipeline services and
+// provides helpers to instrument their code with spans.
+package tracing
+
+import (
+	"context"
+	"database/sql/driver"
+	"net/http"
+
+	"github.com/XSAM/otelsql"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const instrumentationName = "github.com/kubeflow/pipelines/backend"
+
+// Config configures the export of traces over OTLP.
+type Config struct {
+	// Enabled turns on the export of traces. Spans are not recorded when it is
+	// off.
+	Enabled bool
+	// Endpoint is the host:port of the OTLP gRPC receiver. If empty, the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable or localhost:4317 is
+	// used.
+	Endpoint string
+	// Insecure disables TLS for the connection to the receiver.
+	Insecure bool
+	// SampleRatio is the fraction of traces started by the service which are
+	// sampled. Traces started by a caller follow the caller's decision.
+	SampleRatio float64
+}
+
+// Init sets up the global tracer provider of a service and the propagation of
+// the trace context in the W3C format. It returns a function flushing and
+// stopping the export of traces, to be called on shutdown.
+func Init(serviceName string, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !config.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{}
+	if config.Endpoint != "" {
+		options = append(options, otlptracegrpc.WithEndpoint(config.Endpoint))
+	}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create the OTLP trace exporter")
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span with the given name and attributes, as a child of
+// the span in ctx if any.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends a span, recording err as its error if not nil.
+func EndSpan(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// RecordError records err as the error of a span if not nil.
+func RecordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// NewTransport wraps an HTTP transport to record a span for every request and
+// to propagate the trace context of the request to the server.
+func NewTransport(transport http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(transport)
+}
+
+// NewHandler wraps an HTTP handler to record a span for every request,
+// continuing the trace propagated by the client if any. Requests to the
+// skipped paths, e.g. health checks, are not traced.
+func NewHandler(handler http.Handler, operation string, skippedPaths ...string) http.Handler {
+	return otelhttp.NewHandler(handler, operation, otelhttp.WithFilter(func(r *http.Request) bool {
+		for _, path := range skippedPaths {
+			if r.URL.Path == path {
+				return false
+			}
+		}
+		return true
+	}))
+}
+
+// WrapSQLDriver wraps a SQL driver to record a span for every SQL statement.
+// Statements run with the context of a request, as when a run is created, are
+// part of its trace. Statements run without a span in their context start
+// their own trace.
+func WrapSQLDriver(sqlDriver driver.Driver, dbSystem string) driver.Driver {
+	return otelsql.WrapDriver(sqlDriver, dbSystem,
+		otelsql.WithSpanOptions(otelsql.SpanOptions{AllowRoot: true, DisableErrSkip: true}))
+}
+
+// UnaryServerInterceptor records a span for every call of a gRPC server,
+// continuing the trace propagated by the client if any.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor()
+}
+
+// UnaryClientInterceptor records a span for every call of a gRPC client and
+// propagates the trace context of the call to the server.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return otelgrpc.UnaryClientInterceptor()
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func initRecorder(t *testing.T) *tracetest.SpanRecorder {
+	_, err := Init("test", Config{})
+	assert.Nil(t, err)
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func TestInit_Disabled(t *testing.T) {
+	shutdown, err := Init("test", Config{})
+	assert.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+	_, span := StartSpan(context.Background(), "span")
+	assert.False(t, span.IsRecording())
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := initRecorder(t)
+	ctx, parent := StartSpan(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child", attribute.String("key", "value"))
+	EndSpan(child, errors.New("failed"))
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, spans[0].Attributes())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "failed", spans[0].Status().Description)
+	assert.Equal(t, "parent", spans[1].Name())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestTransportAndHandler(t *testing.T) {
+	recorder := initRecorder(t)
+	var serverSpanContext trace.SpanContext
+	server := httptest.NewServer(NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverSpanContext = trace.SpanContextFromContext(r.Context())
+	}), "test"))
+	defer server.Close()
+
+	ctx, span := StartSpan(context.Background(), "client")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.Nil(t, err)
+	response, err := (&http.Client{Transport: NewTransport(http.DefaultTransport)}).Do(request)
+	assert.Nil(t, err)
+	response.Body.Close()
+	EndSpan(span, nil)
+
+	// The trace context is propagated to the server.
+	assert.Equal(t, span.SpanContext().TraceID(), serverSpanContext.TraceID())
+	assert.Len(t, recorder.Ended(), 3)
+}
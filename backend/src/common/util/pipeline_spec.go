@@ -0,0 +1,69 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// IsPipelineSpec returns whether a pipeline file is a pipeline spec of KFP v2
+// in JSON, i.e. a PipelineJob or a PipelineSpec, rather than a PipelineRun.
+func IsPipelineSpec(template []byte) bool {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(template, &fields); err != nil {
+		return false
+	}
+	_, isPipelineJob := fields["pipelineSpec"]
+	_, isPipelineSpec := fields["pipelineInfo"]
+	return isPipelineJob || isPipelineSpec
+}
+
+// ValidatePipelineSpec parses a pipeline spec of KFP v2. A PipelineSpec is
+// returned as a PipelineJob without runtime config.
+func ValidatePipelineSpec(template []byte) (*pb.PipelineJob, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(template, &fields); err != nil {
+		return nil, NewInvalidInputErrorWithDetails(err, "Failed to parse the pipeline spec.")
+	}
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	job := &pb.PipelineJob{}
+	if _, ok := fields["pipelineSpec"]; ok {
+		if err := unmarshaler.Unmarshal(bytes.NewReader(template), job); err != nil {
+			return nil, NewInvalidInputErrorWithDetails(err, "Failed to parse the pipeline job.")
+		}
+	} else {
+		job.PipelineSpec = &structpb.Struct{}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(template), job.PipelineSpec); err != nil {
+			return nil, NewInvalidInputErrorWithDetails(err, "Failed to parse the pipeline spec.")
+		}
+	}
+
+	specFields := job.GetPipelineSpec().GetFields()
+	if specFields["pipelineInfo"].GetStructValue().GetFields()["name"].GetStringValue() == "" {
+		return nil, NewInvalidInputError("The pipeline spec has no pipeline name.")
+	}
+	if specFields["root"] == nil && specFields["tasks"] == nil {
+		return nil, NewInvalidInputError("The pipeline spec has neither a root DAG nor tasks.")
+	}
+	if specFields["deploymentSpec"] == nil && specFields["deploymentConfig"] == nil {
+		return nil, NewInvalidInputError("The pipeline spec has no deployment spec.")
+	}
+	return job, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+const pipelineSpec = `{
+  "pipelineInfo": {"name": "hello-world"},
+  "root": {"dag": {"tasks": {}}},
+  "deploymentSpec": {"executors": {}}
+}`
+
+func TestIsPipelineSpec(t *testing.T) {
+	assert.True(t, IsPipelineSpec([]byte(pipelineSpec)))
+	assert.True(t, IsPipelineSpec([]byte(`{"pipelineSpec": {}, "runtimeConfig": {}}`)))
+	assert.False(t, IsPipelineSpec([]byte(`{"apiVersion": "tekton.dev/v1beta1", "kind": "PipelineRun"}`)))
+	assert.False(t, IsPipelineSpec([]byte("apiVersion: tekton.dev/v1beta1\nkind: PipelineRun\n")))
+}
+
+func TestValidatePipelineSpec(t *testing.T) {
+	job, err := ValidatePipelineSpec([]byte(pipelineSpec))
+	assert.Nil(t, err)
+	assert.Equal(t, "hello-world",
+		job.GetPipelineSpec().GetFields()["pipelineInfo"].GetStructValue().GetFields()["name"].GetStringValue())
+	assert.Nil(t, job.GetRuntimeConfig())
+}
+
+func TestValidatePipelineSpec_PipelineJob(t *testing.T) {
+	job, err := ValidatePipelineSpec([]byte(`{
+	  "pipelineSpec": ` + pipelineSpec + `,
+	  "runtimeConfig": {"parameters": {"text": {"stringValue": "hi"}}}
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "hi", job.GetRuntimeConfig().GetParameters()["text"].GetStringValue())
+}
+
+func TestValidatePipelineSpec_Invalid(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr string
+	}{
+		{`{"pipelineInfo": {}}`, "no pipeline name"},
+		{`{"pipelineInfo": {"name": "p"}, "deploymentSpec": {}}`, "neither a root DAG nor tasks"},
+		{`{"pipelineInfo": {"name": "p"}, "root": {}}`, "no deployment spec"},
+		{`{"pipelineSpec": {}, "runtimeConfig": {"parameters": 1}}`, "Failed to parse the pipeline job"},
+	}
+	for _, test := range tests {
+		_, err := ValidatePipelineSpec([]byte(test.spec))
+		assert.NotNil(t, err, test.spec)
+		assert.Equal(t, codes.InvalidArgument, err.(*UserError).ExternalStatusCode(), test.spec)
+		assert.Contains(t, err.Error(), test.wantErr, test.spec)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	"github.com/kubeflow/pipelines/backend/src/common/tracing"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"k8s.io/client-go/kubernetes"
@@ -74,7 +75,7 @@ func GetKubernetesClientFromClientConfig(clientConfig clientcmd.ClientConfig) (
 }
 
 func GetRpcConnection(address string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()))
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create gRPC connection")
 	}
@@ -268,10 +268,16 @@ func (w *Workflow) FindObjectStoreArtifactKeyOrEmpty(nodeID string, artifactName
 		return ""
 	}
 	var s3Key string
-	s3Key = "artifacts/" + w.ObjectMeta.Name + "/" + nodeID + "/" + artifactName + ".tgz"
+	s3Key = ObjectStoreArtifactFolder(w.ObjectMeta.Name) + "/" + nodeID + "/" + artifactName + ".tgz"
 	return s3Key
 }
 
+// ObjectStoreArtifactFolder returns the folder that contains the artifacts of
+// all the nodes of a workflow.
+func ObjectStoreArtifactFolder(workflowName string) string {
+	return "artifacts/" + workflowName
+}
+
 // FindTaskRunByPodName loops through all workflow task runs and look up by the pod name.
 func (w *Workflow) FindTaskRunByPodName(podName string) (*workflowapi.PipelineRunTaskRunStatus, string) {
 	for id, taskRun := range w.Status.TaskRuns {
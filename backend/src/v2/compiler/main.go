@@ -27,9 +27,11 @@ import (
 	"encoding/json"
 
 	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/tekton"
 )
 
 var pipelineSpecPath = flag.String("pipeline_spec_path", "../../../../samples/v2/hello_world/hw_pipeline_job.json", "Path to pipeline spec file generated by KFP SDK v2")
+var target = flag.String("target", "argo", "The engine to compile for, can be 'argo' or 'tekton'")
 
 func main() {
 	flag.Parse()
@@ -61,9 +63,17 @@ func main() {
 		glog.Fatalf("Failed to unmarshal deployment config: %v", err)
 	}
 	// fmt.Println(a)
-	workflow, err := CompilePipelineSpec(spec, deploymentConfig)
+	var workflow interface{}
+	switch *target {
+	case "argo":
+		workflow, err = CompilePipelineSpec(spec, deploymentConfig)
+	case "tekton":
+		workflow, err = tekton.CompilePipelineJob(job, nil)
+	default:
+		glog.Fatalf("Unsupported target: %s", *target)
+	}
 	if err != nil {
-		glog.Fatalf("Failed to compile pipeline IR to argo workflow: %v", err)
+		glog.Fatalf("Failed to compile pipeline IR to %s workflow: %v", *target, err)
 	}
 	workflowInJson, err := json.MarshalIndent(workflow, "", "  ")
 	if err != nil {
@@ -0,0 +1,542 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tekton compiles a KFP v2 pipeline spec into a Tekton PipelineRun.
+//
+// Like the Argo compiler, a driver of the root DAG records the pipeline in
+// MLMD first. Each task of the pipeline then becomes three Tekton tasks:
+//   - <task>-driver records the execution of the task in MLMD and resolves
+//     its input parameters, which it returns as results.
+//   - <task> runs the container of the executor with the resolved inputs and
+//     returns its output parameters as results.
+//   - <task>-publisher records the output parameters in MLMD and completes
+//     the execution, so that the drivers of downstream tasks can read them.
+//
+// The executor and the publisher only run when the driver finds the task
+// triggered, i.e. its condition is true. A task whose component is a DAG
+// becomes the driver of the DAG, the tasks of the DAG, whose names are
+// prefixed with the one of the task, and a publisher completing the DAG.
+package tekton
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"github.com/kubeflow/pipelines/backend/src/v2/compiler/util"
+	"github.com/pkg/errors"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+const (
+	rootDagDriverTaskName = "kfp-root-driver"
+
+	driverTaskSuffix    = "-driver"
+	publisherTaskSuffix = "-publisher"
+)
+
+// The names of the parameters become the names of Tekton results.
+var parameterNamePattern = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// CompilePipelineSpec compiles a pipeline spec and its deployment config into
+// a PipelineRun.
+func CompilePipelineSpec(
+	pipelineSpec *pb.PipelineSpec,
+	deploymentConfig *pb.PipelineDeploymentConfig,
+) (*workflowapi.PipelineRun, error) {
+	// validation
+	if pipelineSpec.GetPipelineInfo().GetName() == "" {
+		return nil, errors.New("Name is empty")
+	}
+
+	runtimeParameters, err := runtimeParameterNames(pipelineSpec)
+	if err != nil {
+		return nil, err
+	}
+	rootTaskSpecJSON, err := rootTaskSpec(pipelineSpec)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := generateSpec(pipelineSpec, deploymentConfig, rootTaskSpecJSON, runtimeParameters)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to generate pipeline spec")
+	}
+
+	var pipelineRun workflowapi.PipelineRun
+	pipelineRun.APIVersion = "tekton.dev/v1beta1"
+	pipelineRun.Kind = "PipelineRun"
+	pipelineRun.GenerateName = util.SanitizeK8sName(pipelineSpec.GetPipelineInfo().GetName()) + "-"
+	pipelineRun.Spec.PipelineSpec = spec
+	// The runtime parameters are params of the PipelineRun, so that their
+	// values can be replaced for each run like the ones of other PipelineRuns.
+	// Parameters without value are required by the pipeline.
+	for _, name := range runtimeParameters {
+		parameter := pipelineSpec.GetRuntimeParameters()[name]
+		paramSpec := workflowapi.ParamSpec{
+			Name:        name,
+			Type:        workflowapi.ParamTypeString,
+			Description: parameter.GetType().String(),
+		}
+		if parameter.GetDefaultValue() != nil {
+			value := formatParameterValue(parameter.GetDefaultValue())
+			paramSpec.Default = workflowapi.NewArrayOrString(value)
+			pipelineRun.Spec.Params = append(pipelineRun.Spec.Params, stringParam(name, value))
+		}
+		spec.Params = append(spec.Params, paramSpec)
+	}
+	return &pipelineRun, nil
+}
+
+// runtimeParameterNames returns the sorted names of the runtime parameters of
+// the pipeline, which become params of the PipelineRun.
+func runtimeParameterNames(pipelineSpec *pb.PipelineSpec) ([]string, error) {
+	names := make(map[string]bool)
+	for name := range pipelineSpec.GetRuntimeParameters() {
+		names[name] = true
+	}
+	return parameterNames(names)
+}
+
+// rootTaskSpec returns the task spec of the root DAG in JSON. Its inputs are
+// the runtime parameters of the pipeline with their default values, or the
+// zero values of their types. The root driver replaces them by the values of
+// the params of the PipelineRun, which it parses by these types.
+func rootTaskSpec(pipelineSpec *pb.PipelineSpec) (string, error) {
+	taskSpec := &pb.PipelineTaskSpec{
+		TaskInfo: &pb.PipelineTaskInfo{Name: pipelineSpec.GetPipelineInfo().GetName()},
+		Inputs:   &pb.TaskInputsSpec{Parameters: make(map[string]*pb.TaskInputsSpec_InputParameterSpec)},
+	}
+	for name, parameter := range pipelineSpec.GetRuntimeParameters() {
+		value := parameter.GetDefaultValue()
+		if value == nil {
+			value = zeroParameterValue(parameter.GetType())
+		}
+		taskSpec.Inputs.Parameters[name] = &pb.TaskInputsSpec_InputParameterSpec{
+			Kind: &pb.TaskInputsSpec_InputParameterSpec_RuntimeValue{
+				RuntimeValue: &pb.ValueOrRuntimeParameter{
+					Value: &pb.ValueOrRuntimeParameter_ConstantValue{ConstantValue: value},
+				},
+			},
+		}
+	}
+	taskSpecJSON, err := (&jsonpb.Marshaler{}).MarshalToString(taskSpec)
+	if err != nil {
+		return "", errors.Wrapf(err, "Failed to marshal root task spec to JSON")
+	}
+	return taskSpecJSON, nil
+}
+
+func generateSpec(
+	pipelineSpec *pb.PipelineSpec,
+	deploymentConfig *pb.PipelineDeploymentConfig,
+	rootTaskSpecJSON string,
+	runtimeParameters []string,
+) (*workflowapi.PipelineSpec, error) {
+	rootDriver := workflowapi.PipelineTask{
+		Name:     rootDagDriverTaskName,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *rootDriverTaskSpec(runtimeParameters)},
+		Params: []workflowapi.Param{
+			// root has no parent
+			stringParam(driverParamParentContextName, ""),
+			stringParam(driverParamTaskSpec, rootTaskSpecJSON),
+		},
+	}
+	for _, name := range runtimeParameters {
+		rootDriver.Params = append(rootDriver.Params, stringParam(name, paramRef(name)))
+	}
+
+	tasks := pipelineSpec.GetTasks()
+	if pipelineSpec.GetRoot() != nil {
+		if pipelineSpec.GetRoot().GetDag() == nil {
+			return nil, errors.New("Root of the pipeline spec is not a DAG")
+		}
+		var err error
+		if tasks, err = dagTasks(pipelineSpec, pipelineSpec.GetRoot().GetDag()); err != nil {
+			return nil, err
+		}
+	}
+	c := &dagCompiler{
+		pipelineSpec:      pipelineSpec,
+		executors:         deploymentConfig.GetExecutors(),
+		pipelineTaskNames: map[string]bool{rootDagDriverTaskName: true},
+		tasks:             []workflowapi.PipelineTask{rootDriver},
+	}
+	if _, err := c.compileDag(tasks, dagScope{contextName: taskResultRef(rootDagDriverTaskName, driverResultContextName)}); err != nil {
+		return nil, err
+	}
+	return &workflowapi.PipelineSpec{Tasks: c.tasks}, nil
+}
+
+// dagTasks returns the tasks of a DAG sorted by name, with the executor labels
+// and outputs of their components. The tasks of DAG components have no
+// executor label.
+func dagTasks(pipelineSpec *pb.PipelineSpec, dag *pb.DagSpec) ([]*pb.PipelineTaskSpec, error) {
+	var tasks []*pb.PipelineTaskSpec
+	for _, name := range sortedTaskNames(dag.GetTasks()) {
+		task := proto.Clone(dag.GetTasks()[name]).(*pb.PipelineTaskSpec)
+		if task.GetTaskInfo().GetName() == "" {
+			task.TaskInfo = &pb.PipelineTaskInfo{Name: name}
+		}
+		componentName := task.GetComponentRef().GetName()
+		component, ok := pipelineSpec.GetComponents()[componentName]
+		if !ok {
+			return nil, errors.Errorf("Component '%s' of task '%s' cannot be found", componentName, name)
+		}
+		if component.GetDag() == nil {
+			if task.GetExecutorLabel() == "" {
+				task.ExecutorLabel = component.GetExecutorLabel()
+			}
+			if task.GetOutputs() == nil && len(component.GetOutputDefinitions().GetParameters()) > 0 {
+				task.Outputs = &pb.TaskOutputsSpec{Parameters: make(map[string]*pb.TaskOutputsSpec_OutputParameterSpec)}
+				for parameterName, parameter := range component.GetOutputDefinitions().GetParameters() {
+					task.Outputs.Parameters[parameterName] = &pb.TaskOutputsSpec_OutputParameterSpec{Type: parameter.GetType()}
+				}
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// dagScope is the scope in which the tasks of a DAG, or of an iteration of a
+// DAG, are compiled.
+type dagScope struct {
+	// The name of the MLMD context of the DAG, which is the parent context of
+	// its tasks.
+	contextName string
+	// The prefix of the names of the Tekton tasks of the DAG, which keeps the
+	// tasks of nested DAGs and iterations apart.
+	namePrefix string
+	// The when expressions of the drivers of the tasks of the DAG.
+	whenExpressions workflowapi.WhenExpressions
+}
+
+// dagCompiler compiles the tasks of the DAGs of a pipeline spec into the
+// tasks of a Tekton pipeline.
+type dagCompiler struct {
+	pipelineSpec      *pb.PipelineSpec
+	executors         map[string]*pb.PipelineDeploymentConfig_ExecutorSpec
+	pipelineTaskNames map[string]bool
+	tasks             []workflowapi.PipelineTask
+}
+
+// addTaskName reserves the name of a Tekton task of a task of the pipeline.
+func (c *dagCompiler) addTaskName(taskName string, pipelineTaskName string) error {
+	if c.pipelineTaskNames[pipelineTaskName] {
+		return errors.Errorf("Task '%s' has the same sanitized name as another task: %s", taskName, pipelineTaskName)
+	}
+	c.pipelineTaskNames[pipelineTaskName] = true
+	return nil
+}
+
+// compileDag compiles the tasks of a DAG in a scope, and returns the names of
+// the Tekton tasks they are compiled into.
+//
+// A task which is not triggered, by its condition or because its DAG is not,
+// is still recorded in MLMD by its driver, but its executor and publisher are
+// skipped by a when expression on the condition result of its driver. Tekton
+// skips the tasks which depend on a skipped task, so only the default trigger
+// strategy, ALL_UPSTREAM_TASKS_SUCCEEDED, is supported.
+func (c *dagCompiler) compileDag(tasks []*pb.PipelineTaskSpec, scope dagScope) ([]string, error) {
+	// Collect the sanitized names of the tasks first to resolve dependencies.
+	taskNames := make(map[string]string)
+	dagTaskNames := make(map[string]bool)
+	for index, task := range tasks {
+		name := task.GetTaskInfo().GetName()
+		if name == "" {
+			return nil, errors.Errorf("Task name is empty for task with index %v and spec: %s", index, task.String())
+		}
+		sanitizedName := scope.namePrefix + util.SanitizeK8sName(name)
+		pipelineTaskNames := []string{sanitizedName + driverTaskSuffix, sanitizedName + publisherTaskSuffix}
+		if c.isDag(task) {
+			dagTaskNames[name] = true
+		} else {
+			pipelineTaskNames = append(pipelineTaskNames, sanitizedName)
+		}
+		for _, pipelineTaskName := range pipelineTaskNames {
+			if err := c.addTaskName(name, pipelineTaskName); err != nil {
+				return nil, err
+			}
+		}
+		taskNames[name] = sanitizedName
+	}
+
+	var compiledTaskNames []string
+	for _, task := range tasks {
+		name := taskNames[task.GetTaskInfo().GetName()]
+		if task.GetTriggerPolicy().GetStrategy() == pb.PipelineTaskSpec_TriggerPolicy_ALL_UPSTREAM_TASKS_COMPLETED {
+			return nil, errors.Errorf("Trigger strategy %v of task '%s' is not supported",
+				task.GetTriggerPolicy().GetStrategy(), task.GetTaskInfo().GetName())
+		}
+
+		// The driver reads the output parameters of the upstream tasks from
+		// MLMD, so it runs after their publishers.
+		dependencies, err := getTaskDependencies(task)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to get task dependencies for task: %s", task.String())
+		}
+		var runAfter []string
+		for _, dependency := range dependencies {
+			upstreamName, ok := taskNames[dependency]
+			if !ok {
+				return nil, errors.Errorf("Failed to find dependency '%s' for task: %s", dependency, task.String())
+			}
+			runAfter = append(runAfter, upstreamName+publisherTaskSuffix)
+		}
+		for _, parameter := range task.GetInputs().GetParameters() {
+			if producerTask := parameter.GetTaskOutputParameter().GetProducerTask(); dagTaskNames[producerTask] {
+				return nil, errors.Errorf("Output parameters of DAG task '%s' are not supported", producerTask)
+			}
+		}
+
+		var names []string
+		if c.isDag(task) {
+			names, err = c.compileDagTask(task, name, runAfter, scope)
+		} else {
+			names, err = c.compileExecutorTask(task, name, runAfter, scope)
+		}
+		if err != nil {
+			return nil, err
+		}
+		compiledTaskNames = append(compiledTaskNames, names...)
+	}
+	return compiledTaskNames, nil
+}
+
+// isDag returns whether the component of a task is a DAG.
+func (c *dagCompiler) isDag(task *pb.PipelineTaskSpec) bool {
+	return task.GetExecutorLabel() == "" && c.pipelineSpec.GetComponents()[task.GetComponentRef().GetName()].GetDag() != nil
+}
+
+// compileExecutorTask compiles a task running a container into its driver,
+// its executor and its publisher.
+func (c *dagCompiler) compileExecutorTask(task *pb.PipelineTaskSpec, name string, runAfter []string, scope dagScope) ([]string, error) {
+	marshaler := &jsonpb.Marshaler{}
+	executorLabel := task.GetExecutorLabel()
+	executorSpec := c.executors[executorLabel]
+	if executorSpec == nil {
+		return nil, errors.Errorf("Executor with label '%v' cannot be found in deployment config", executorLabel)
+	}
+	if executorSpec.GetContainer() == nil {
+		return nil, errors.Errorf("Executor with label '%v' is not a container executor", executorLabel)
+	}
+	if task.GetParameterIterator() != nil || task.GetArtifactIterator() != nil {
+		return nil, errors.Errorf("Task '%s' has an iterator, which is only supported for DAG tasks", task.GetTaskInfo().GetName())
+	}
+	taskSpecJSON, err := marshaler.MarshalToString(task)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal task spec to JSON: %s", task.String())
+	}
+	executorSpecJSON, err := marshaler.MarshalToString(executorSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal executor spec to JSON: %s", executorSpec.String())
+	}
+	// TODO(Bobgy): Task outputs spec is deprecated. Get outputs spec from component output spec once data is ready.
+	outputsSpec := task.GetOutputs()
+	if outputsSpec == nil {
+		// For tasks without outputs spec, marshal an emtpy outputs spec.
+		outputsSpec = &pb.TaskOutputsSpec{}
+	}
+	outputsSpecJSON, err := marshaler.MarshalToString(outputsSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal outputs spec to JSON: %s", outputsSpec.String())
+	}
+	inputParameters, err := inputParameterNames(task.GetInputs())
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid input parameter of task '%s'", task.GetTaskInfo().GetName())
+	}
+	outputParameters, err := outputParameterNames(outputsSpec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid output parameter of task '%s'", task.GetTaskInfo().GetName())
+	}
+
+	driverName := name + driverTaskSuffix
+	driver := workflowapi.PipelineTask{
+		Name:     driverName,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *driverTaskSpec(driverTypeExecutor, executorSpecJSON, inputParameters)},
+		RunAfter: runAfter,
+		Params: []workflowapi.Param{
+			stringParam(driverParamParentContextName, scope.contextName),
+			stringParam(driverParamTaskSpec, taskSpecJSON),
+		},
+		WhenExpressions: scope.whenExpressions,
+	}
+
+	container := executorSpec.GetContainer()
+	executor := workflowapi.PipelineTask{
+		Name: name,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *executorTaskSpec(
+			container.GetImage(), container.GetCommand(), container.GetArgs(), inputParameters, outputParameters)},
+		RunAfter:        []string{driverName},
+		WhenExpressions: triggeredWhenExpressions(driverName),
+	}
+	for _, parameter := range inputParameters {
+		executor.Params = append(executor.Params, stringParam(parameter, taskResultRef(driverName, parameter)))
+	}
+
+	publisher := workflowapi.PipelineTask{
+		Name:     name + publisherTaskSuffix,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *publisherTaskSpec(outputsSpecJSON, outputParameters)},
+		RunAfter: []string{name},
+		Params: []workflowapi.Param{
+			stringParam(publisherParamExecutionID, taskResultRef(driverName, driverResultExecutionID)),
+		},
+		WhenExpressions: triggeredWhenExpressions(driverName),
+	}
+	for _, parameter := range outputParameters {
+		publisher.Params = append(publisher.Params, stringParam(parameter, taskResultRef(name, parameter)))
+	}
+
+	c.tasks = append(c.tasks, driver, executor, publisher)
+	return []string{driver.Name, executor.Name, publisher.Name}, nil
+}
+
+// compileDagTask compiles a task whose component is a DAG into its driver, the
+// tasks of the DAG and its publisher, which completes the execution of the
+// DAG once its tasks are done.
+//
+// The tasks of a DAG with a parameter iterator are compiled once per
+// iteration, in the context of the iteration which the driver creates. Tekton
+// cannot fan tasks out at run time, so the items of the iterator must be raw.
+func (c *dagCompiler) compileDagTask(task *pb.PipelineTaskSpec, name string, runAfter []string, scope dagScope) ([]string, error) {
+	if task.GetArtifactIterator() != nil {
+		return nil, errors.Errorf("Artifact iterator of task '%s' is not supported", task.GetTaskInfo().GetName())
+	}
+	iterations := -1
+	if iterator := task.GetParameterIterator(); iterator != nil {
+		var items []json.RawMessage
+		if err := json.Unmarshal([]byte(iterator.GetItems().GetRaw()), &items); err != nil {
+			return nil, errors.Errorf("Parameter iterator of task '%s' must have raw items as a JSON array", task.GetTaskInfo().GetName())
+		}
+		iterations = len(items)
+	}
+	tasks, err := dagTasks(c.pipelineSpec, c.pipelineSpec.GetComponents()[task.GetComponentRef().GetName()].GetDag())
+	if err != nil {
+		return nil, err
+	}
+	taskSpecJSON, err := (&jsonpb.Marshaler{}).MarshalToString(task)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal task spec to JSON: %s", task.String())
+	}
+
+	driverName := name + driverTaskSuffix
+	driver := workflowapi.PipelineTask{
+		Name:     driverName,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *dagDriverTaskSpec(iterations >= 0)},
+		RunAfter: runAfter,
+		Params: []workflowapi.Param{
+			stringParam(driverParamParentContextName, scope.contextName),
+			stringParam(driverParamTaskSpec, taskSpecJSON),
+		},
+		WhenExpressions: scope.whenExpressions,
+	}
+	c.tasks = append(c.tasks, driver)
+	compiledTaskNames := []string{driverName}
+
+	contextName := taskResultRef(driverName, driverResultContextName)
+	var dagNames []string
+	if iterations < 0 {
+		dagNames, err = c.compileDag(tasks, dagScope{contextName: contextName, namePrefix: name + "-"})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for index := 0; index < iterations; index++ {
+		// The driver names the contexts of the iterations after its own, and
+		// only creates them when the task is triggered.
+		iterationNames, err := c.compileDag(tasks, dagScope{
+			contextName:     fmt.Sprintf("%s-%d", contextName, index),
+			namePrefix:      fmt.Sprintf("%s-%d-", name, index),
+			whenExpressions: triggeredWhenExpressions(driverName),
+		})
+		if err != nil {
+			return nil, err
+		}
+		dagNames = append(dagNames, iterationNames...)
+	}
+	compiledTaskNames = append(compiledTaskNames, dagNames...)
+
+	publisher := workflowapi.PipelineTask{
+		Name:     name + publisherTaskSuffix,
+		TaskSpec: &workflowapi.EmbeddedTask{TaskSpec: *publisherTaskSpec("{}", nil)},
+		RunAfter: append([]string{driverName}, dagNames...),
+		Params: []workflowapi.Param{
+			stringParam(publisherParamExecutionID, taskResultRef(driverName, driverResultExecutionID)),
+		},
+		WhenExpressions: triggeredWhenExpressions(driverName),
+	}
+	c.tasks = append(c.tasks, publisher)
+	return append(compiledTaskNames, publisher.Name), nil
+}
+
+// triggeredWhenExpressions returns the when expressions of the tasks which only
+// run when the task of a driver is triggered.
+func triggeredWhenExpressions(driverName string) workflowapi.WhenExpressions {
+	return workflowapi.WhenExpressions{{
+		Input:    taskResultRef(driverName, driverResultCondition),
+		Operator: selection.In,
+		Values:   []string{"true"},
+	}}
+}
+
+// parameterNames returns the sorted names of parameters, which must be valid
+// names of Tekton results and must not clash with the internal ones.
+func parameterNames(names map[string]bool) ([]string, error) {
+	for name := range names {
+		if !parameterNamePattern.MatchString(name) || strings.HasPrefix(name, paramPrefixKfpInternal) {
+			return nil, errors.Errorf("Parameter name '%s' is not supported", name)
+		}
+	}
+	return sortedKeys(names), nil
+}
+
+func inputParameterNames(inputsSpec *pb.TaskInputsSpec) ([]string, error) {
+	names := make(map[string]bool)
+	for name := range inputsSpec.GetParameters() {
+		names[name] = true
+	}
+	return parameterNames(names)
+}
+
+func outputParameterNames(outputsSpec *pb.TaskOutputsSpec) ([]string, error) {
+	names := make(map[string]bool)
+	for name := range outputsSpec.GetParameters() {
+		names[name] = true
+	}
+	return parameterNames(names)
+}
+
+// getTaskDependencies returns the names of the tasks a task depends on,
+// either explicitly or by consuming their output parameters, sorted by name.
+func getTaskDependencies(task *pb.PipelineTaskSpec) ([]string, error) {
+	dependencies := make(map[string]bool)
+	for _, name := range task.GetDependentTasks() {
+		dependencies[name] = true
+	}
+	for _, parameter := range task.GetInputs().GetParameters() {
+		if parameter.GetTaskOutputParameter() != nil {
+			producerTask := parameter.GetTaskOutputParameter().GetProducerTask()
+			if producerTask == "" {
+				return nil, errors.Errorf("Invalid task input parameter spec, producer task is empty: %v", parameter.String())
+			}
+			dependencies[producerTask] = true
+		}
+	}
+	return sortedKeys(dependencies), nil
+}
@@ -0,0 +1,359 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekton
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// A producer and a consumer of a parameter, like the two step sample of the
+// v2 compiler.
+const twoStepPipelineSpec = `{
+  "pipelineInfo": {"name": "two-step-pipeline"},
+  "runtimeParameters": {"text": {"type": "STRING", "defaultValue": {"stringValue": "Hello world!"}}},
+  "tasks": [
+    {
+      "taskInfo": {"name": "producer"},
+      "inputs": {"parameters": {"input_text": {"componentInputParameter": "text"}}},
+      "outputs": {"parameters": {"output_value": {"type": "STRING"}}},
+      "executorLabel": "producer"
+    },
+    {
+      "taskInfo": {"name": "consumer"},
+      "inputs": {"parameters": {"input_value": {"taskOutputParameter": {"producerTask": "producer", "outputParameterKey": "output_value"}}}},
+      "executorLabel": "consumer"
+    }
+  ]
+}`
+
+func twoStepDeploymentConfig() *pb.PipelineDeploymentConfig {
+	return &pb.PipelineDeploymentConfig{
+		Executors: map[string]*pb.PipelineDeploymentConfig_ExecutorSpec{
+			"producer": {Spec: &pb.PipelineDeploymentConfig_ExecutorSpec_Container{
+				Container: &pb.PipelineDeploymentConfig_PipelineContainerSpec{
+					Image:   "google/cloud-sdk:latest",
+					Command: []string{"sh", "-c"},
+					Args:    []string{"echo \"$0\" > $1", "{{$.inputs.parameters['input_text']}}", "{{$.outputs.parameters['output_value'].output_file}}"},
+				},
+			}},
+			"consumer": {Spec: &pb.PipelineDeploymentConfig_ExecutorSpec_Container{
+				Container: &pb.PipelineDeploymentConfig_PipelineContainerSpec{
+					Image:   "google/cloud-sdk:latest",
+					Command: []string{"sh", "-c", "echo \"$0\""},
+					Args:    []string{"{{$.inputs.parameters['input_value']}}"},
+				},
+			}},
+		},
+	}
+}
+
+func parsePipelineSpec(t *testing.T, json string) *pb.PipelineSpec {
+	spec := &pb.PipelineSpec{}
+	if err := jsonpb.UnmarshalString(json, spec); err != nil {
+		t.Fatalf("Failed to parse pipeline spec: %v", err)
+	}
+	return spec
+}
+
+func findTask(tasks []workflowapi.PipelineTask, name string) *workflowapi.PipelineTask {
+	for i := range tasks {
+		if tasks[i].Name == name {
+			return &tasks[i]
+		}
+	}
+	return nil
+}
+
+func findParam(params []workflowapi.Param, name string) string {
+	for _, param := range params {
+		if param.Name == name {
+			return param.Value.StringVal
+		}
+	}
+	return ""
+}
+
+func TestCompilePipelineSpec(t *testing.T) {
+	pipelineRun, err := CompilePipelineSpec(parsePipelineSpec(t, twoStepPipelineSpec), twoStepDeploymentConfig())
+	assert.Nil(t, err)
+	assert.Equal(t, "tekton.dev/v1beta1", pipelineRun.APIVersion)
+	assert.Equal(t, "PipelineRun", pipelineRun.Kind)
+	assert.Equal(t, "two-step-pipeline-", pipelineRun.GenerateName)
+
+	spec := pipelineRun.Spec.PipelineSpec
+	var names []string
+	for _, task := range spec.Tasks {
+		names = append(names, task.Name)
+	}
+	assert.Equal(t, []string{
+		"kfp-root-driver",
+		"producer-driver", "producer", "producer-publisher",
+		"consumer-driver", "consumer", "consumer-publisher",
+	}, names)
+	// The runtime parameters are params of the PipelineRun.
+	assert.Equal(t, []workflowapi.ParamSpec{{
+		Name:        "text",
+		Type:        workflowapi.ParamTypeString,
+		Description: "STRING",
+		Default:     workflowapi.NewArrayOrString("Hello world!"),
+	}}, spec.Params)
+	assert.Equal(t, []workflowapi.Param{stringParam("text", "Hello world!")}, pipelineRun.Spec.Params)
+
+	// The root driver records the pipeline as a DAG, with the values of the
+	// params.
+	root := findTask(spec.Tasks, "kfp-root-driver")
+	assert.Contains(t, findParam(root.Params, driverParamTaskSpec), `"text":{"runtimeValue":{"constantValue":{"stringValue":"Hello world!"}}}`)
+	assert.Equal(t, "$(params.text)", findParam(root.Params, "text"))
+	assert.Contains(t, root.TaskSpec.Steps[0].Args, "--driver_type=DAG")
+	assert.Contains(t, root.TaskSpec.Steps[0].Args, "--runtime_parameter=text=$(params.text)")
+	assert.Equal(t, []workflowapi.TaskResult{{Name: driverResultExecutionID}, {Name: driverResultCondition}, {Name: driverResultContextName}}, root.TaskSpec.Results)
+
+	// The driver resolves the inputs of the task in the context of the root DAG.
+	driver := findTask(spec.Tasks, "producer-driver")
+	assert.Equal(t, "$(tasks.kfp-root-driver.results.kfp-context-name)", findParam(driver.Params, driverParamParentContextName))
+	assert.Contains(t, findParam(driver.Params, driverParamTaskSpec), `"taskInfo":{"name":"producer"}`)
+	assert.Contains(t, driver.TaskSpec.Steps[0].Args, "--driver_type=EXECUTOR")
+	assert.Contains(t, driver.TaskSpec.Steps[0].Args, "--output_path_parameters=/tekton/results")
+	assert.Contains(t, driver.TaskSpec.Steps[0].Args, "--output_path_condition=$(results.kfp-condition.path)")
+	assert.Equal(t, []workflowapi.TaskResult{{Name: driverResultExecutionID}, {Name: driverResultCondition}, {Name: "input_text"}}, driver.TaskSpec.Results)
+	assert.Empty(t, driver.RunAfter)
+	assert.Empty(t, driver.WhenExpressions)
+
+	// The executor gets the resolved inputs and returns the outputs as results.
+	executor := findTask(spec.Tasks, "producer")
+	assert.Equal(t, []string{"producer-driver"}, executor.RunAfter)
+	assert.Equal(t, "$(tasks.producer-driver.results.input_text)", findParam(executor.Params, "input_text"))
+	step := executor.TaskSpec.Steps[0]
+	assert.Equal(t, "google/cloud-sdk:latest", step.Image)
+	assert.Equal(t, []string{"sh", "-c"}, step.Command)
+	assert.Equal(t, []string{"echo \"$0\" > $1", "$(params.input_text)", "$(results.output_value.path)"}, step.Args)
+	assert.Equal(t, []workflowapi.TaskResult{{Name: "output_value"}}, executor.TaskSpec.Results)
+	// It only runs when the task is triggered.
+	triggered := workflowapi.WhenExpressions{{
+		Input:    "$(tasks.producer-driver.results.kfp-condition)",
+		Operator: selection.In,
+		Values:   []string{"true"},
+	}}
+	assert.Equal(t, triggered, executor.WhenExpressions)
+
+	// The publisher records the outputs of the executor in its execution.
+	publisher := findTask(spec.Tasks, "producer-publisher")
+	assert.Equal(t, []string{"producer"}, publisher.RunAfter)
+	assert.Equal(t, "$(tasks.producer-driver.results.kfp-execution-id)", findParam(publisher.Params, publisherParamExecutionID))
+	assert.Equal(t, "$(tasks.producer.results.output_value)", findParam(publisher.Params, "output_value"))
+	assert.Equal(t, "$(params.output_value)", publisher.TaskSpec.Steps[0].Env[0].Value)
+	assert.True(t, strings.Contains(publisher.TaskSpec.Steps[0].Script, `> "/kfp/inputs/parameters/output_value"`))
+	assert.Contains(t, publisher.TaskSpec.Steps[1].Args, "--execution_id=$(params.kfp-execution-id)")
+	assert.Equal(t, triggered, publisher.WhenExpressions)
+
+	// The consumer reads the output of the producer from MLMD once it is published.
+	assert.Equal(t, []string{"producer-publisher"}, findTask(spec.Tasks, "consumer-driver").RunAfter)
+}
+
+func TestCompilePipelineSpec_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{"no name", `{"tasks": []}`, "Name is empty"},
+		{
+			"missing executor",
+			`{"pipelineInfo": {"name": "p"}, "tasks": [{"taskInfo": {"name": "t"}, "executorLabel": "missing"}]}`,
+			"Executor with label 'missing' cannot be found",
+		},
+		{
+			"duplicated sanitized name",
+			`{"pipelineInfo": {"name": "p"}, "tasks": [{"taskInfo": {"name": "Producer"}, "executorLabel": "producer"}, {"taskInfo": {"name": "producer"}, "executorLabel": "producer"}]}`,
+			"same sanitized name",
+		},
+		{
+			"unknown dependency",
+			`{"pipelineInfo": {"name": "p"}, "tasks": [{"taskInfo": {"name": "t"}, "executorLabel": "consumer",
+			  "inputs": {"parameters": {"input_value": {"taskOutputParameter": {"producerTask": "missing", "outputParameterKey": "o"}}}}}]}`,
+			"Failed to find dependency 'missing'",
+		},
+		{
+			"internal runtime parameter name",
+			`{"pipelineInfo": {"name": "p"}, "runtimeParameters": {"kfp-task-spec": {"type": "STRING"}}, "tasks": []}`,
+			"Parameter name 'kfp-task-spec' is not supported",
+		},
+		{
+			"internal parameter name",
+			`{"pipelineInfo": {"name": "p"}, "tasks": [{"taskInfo": {"name": "t"}, "executorLabel": "producer",
+			  "outputs": {"parameters": {"kfp-execution-id": {"type": "STRING"}}}}]}`,
+			"Parameter name 'kfp-execution-id' is not supported",
+		},
+	}
+	for _, test := range tests {
+		_, err := CompilePipelineSpec(parsePipelineSpec(t, test.spec), twoStepDeploymentConfig())
+		assert.NotNil(t, err, test.name)
+		if err != nil {
+			assert.Contains(t, err.Error(), test.wantErr, test.name)
+		}
+	}
+}
+
+// A condition and a loop, which the KFP SDK v2 compiles into DAG components.
+const controlFlowPipelineSpec = `{
+  "pipelineInfo": {"name": "control-flow"},
+  "root": {"dag": {"tasks": {
+    "flip": {"componentRef": {"name": "comp-flip"}},
+    "condition-1": {
+      "componentRef": {"name": "comp-condition-1"},
+      "inputs": {"parameters": {"flip": {"taskOutputParameter": {"producerTask": "flip", "outputParameterKey": "output_value"}}}},
+      "triggerPolicy": {"condition": "inputs.parameters['flip'].string_value == 'heads'"}
+    },
+    "for-loop-2": {
+      "componentRef": {"name": "comp-for-loop-2"},
+      "dependentTasks": ["condition-1"],
+      "parameterIterator": {"items": {"raw": "[1, 2]"}, "itemInput": "item"}
+    }
+  }}},
+  "components": {
+    "comp-flip": {"outputDefinitions": {"parameters": {"output_value": {"type": "STRING"}}}, "executorLabel": "producer"},
+    "comp-print": {"inputDefinitions": {"parameters": {"input_value": {"type": "STRING"}}}, "executorLabel": "consumer"},
+    "comp-condition-1": {
+      "inputDefinitions": {"parameters": {"flip": {"type": "STRING"}}},
+      "dag": {"tasks": {"print": {
+        "componentRef": {"name": "comp-print"},
+        "inputs": {"parameters": {"input_value": {"componentInputParameter": "flip"}}}
+      }}}
+    },
+    "comp-for-loop-2": {
+      "inputDefinitions": {"parameters": {"item": {"type": "STRING"}}},
+      "dag": {"tasks": {"print": {
+        "componentRef": {"name": "comp-print"},
+        "inputs": {"parameters": {"input_value": {"componentInputParameter": "item"}}}
+      }}}
+    }
+  }
+}`
+
+func TestCompilePipelineSpec_ControlFlow(t *testing.T) {
+	pipelineRun, err := CompilePipelineSpec(parsePipelineSpec(t, controlFlowPipelineSpec), twoStepDeploymentConfig())
+	assert.Nil(t, err)
+
+	spec := pipelineRun.Spec.PipelineSpec
+	var names []string
+	for _, task := range spec.Tasks {
+		names = append(names, task.Name)
+	}
+	assert.Equal(t, []string{
+		"kfp-root-driver",
+		"condition-1-driver",
+		"condition-1-print-driver", "condition-1-print", "condition-1-print-publisher",
+		"condition-1-publisher",
+		"flip-driver", "flip", "flip-publisher",
+		"for-loop-2-driver",
+		"for-loop-2-0-print-driver", "for-loop-2-0-print", "for-loop-2-0-print-publisher",
+		"for-loop-2-1-print-driver", "for-loop-2-1-print", "for-loop-2-1-print-publisher",
+		"for-loop-2-publisher",
+	}, names)
+
+	// The driver of the condition evaluates it, in the context of the root DAG.
+	conditionDriver := findTask(spec.Tasks, "condition-1-driver")
+	assert.Equal(t, []string{"flip-publisher"}, conditionDriver.RunAfter)
+	assert.Equal(t, "$(tasks.kfp-root-driver.results.kfp-context-name)", findParam(conditionDriver.Params, driverParamParentContextName))
+	assert.Contains(t, findParam(conditionDriver.Params, driverParamTaskSpec), `"triggerPolicy":{"condition":`)
+	assert.Contains(t, conditionDriver.TaskSpec.Steps[0].Args, "--driver_type=DAG")
+	assert.Contains(t, conditionDriver.TaskSpec.Steps[0].Args, "--output_path_condition=$(results.kfp-condition.path)")
+
+	// The tasks of the DAG run in its context, and are not triggered when it is
+	// not.
+	printDriver := findTask(spec.Tasks, "condition-1-print-driver")
+	assert.Equal(t, "$(tasks.condition-1-driver.results.kfp-context-name)", findParam(printDriver.Params, driverParamParentContextName))
+	assert.Empty(t, printDriver.WhenExpressions)
+	assert.Equal(t, "$(tasks.condition-1-print-driver.results.kfp-condition)", findTask(spec.Tasks, "condition-1-print").WhenExpressions[0].Input)
+
+	// The publisher of the DAG completes it once its tasks are done.
+	conditionPublisher := findTask(spec.Tasks, "condition-1-publisher")
+	assert.Equal(t, []string{"condition-1-driver", "condition-1-print-driver", "condition-1-print", "condition-1-print-publisher"}, conditionPublisher.RunAfter)
+	assert.Equal(t, "$(tasks.condition-1-driver.results.kfp-condition)", conditionPublisher.WhenExpressions[0].Input)
+	assert.Equal(t, "$(tasks.condition-1-driver.results.kfp-execution-id)", findParam(conditionPublisher.Params, publisherParamExecutionID))
+
+	// The loop runs after the DAG it depends on, and its tasks are fanned out
+	// into the contexts of the iterations, which only exist when it is
+	// triggered.
+	loopDriver := findTask(spec.Tasks, "for-loop-2-driver")
+	assert.Equal(t, []string{"condition-1-publisher"}, loopDriver.RunAfter)
+	assert.Contains(t, loopDriver.TaskSpec.Steps[0].Args, "--output_path_iterations=$(results.kfp-iterations.path)")
+	iterationDriver := findTask(spec.Tasks, "for-loop-2-1-print-driver")
+	assert.Equal(t, "$(tasks.for-loop-2-driver.results.kfp-context-name)-1", findParam(iterationDriver.Params, driverParamParentContextName))
+	assert.Equal(t, workflowapi.WhenExpressions{{
+		Input:    "$(tasks.for-loop-2-driver.results.kfp-condition)",
+		Operator: selection.In,
+		Values:   []string{"true"},
+	}}, iterationDriver.WhenExpressions)
+	assert.Equal(t, []string{
+		"for-loop-2-driver",
+		"for-loop-2-0-print-driver", "for-loop-2-0-print", "for-loop-2-0-print-publisher",
+		"for-loop-2-1-print-driver", "for-loop-2-1-print", "for-loop-2-1-print-publisher",
+	}, findTask(spec.Tasks, "for-loop-2-publisher").RunAfter)
+}
+
+func TestCompilePipelineSpec_ControlFlowErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		wantErr string
+	}{
+		{
+			"iterator over an input parameter",
+			`"items": {"raw": "[1, 2]"}`,
+			`"items": {"inputParameter": "item"}`,
+			"Parameter iterator of task 'for-loop-2' must have raw items",
+		},
+		{
+			"output of a DAG",
+			`"dependentTasks": ["condition-1"],`,
+			`"inputs": {"parameters": {"item": {"taskOutputParameter": {"producerTask": "condition-1", "outputParameterKey": "o"}}}},`,
+			"Output parameters of DAG task 'condition-1' are not supported",
+		},
+		{
+			"trigger strategy",
+			`"dependentTasks": ["condition-1"],`,
+			`"dependentTasks": ["condition-1"], "triggerPolicy": {"strategy": "ALL_UPSTREAM_TASKS_COMPLETED"},`,
+			"Trigger strategy ALL_UPSTREAM_TASKS_COMPLETED of task 'for-loop-2' is not supported",
+		},
+		{
+			"clashing name",
+			`"dag": {"tasks": {"print": {
+        "componentRef": {"name": "comp-print"},
+        "inputs": {"parameters": {"input_value": {"componentInputParameter": "flip"}}}`,
+			`"dag": {"tasks": {"driver": {
+        "componentRef": {"name": "comp-print"},
+        "inputs": {"parameters": {"input_value": {"componentInputParameter": "flip"}}}`,
+			"same sanitized name as another task: condition-1-driver",
+		},
+	}
+	for _, test := range tests {
+		spec := strings.Replace(controlFlowPipelineSpec, test.old, test.new, 1)
+		assert.NotEqual(t, controlFlowPipelineSpec, spec, test.name)
+		_, err := CompilePipelineSpec(parsePipelineSpec(t, spec), twoStepDeploymentConfig())
+		assert.NotNil(t, err, test.name)
+		if err != nil {
+			assert.Contains(t, err.Error(), test.wantErr, test.name)
+		}
+	}
+}
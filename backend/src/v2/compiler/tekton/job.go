@@ -0,0 +1,229 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekton
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"github.com/pkg/errors"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// CompilePipelineJob compiles the pipeline spec of a pipeline job into a
+// PipelineRun. The values of the pipeline parameters are taken from the given
+// parameters, then from the runtime config of the job, then from the defaults
+// of the pipeline spec.
+//
+// Both the flat pipeline spec with tasks and the one with a root DAG are
+// supported.
+func CompilePipelineJob(job *pb.PipelineJob, parameters map[string]string) (*workflowapi.PipelineRun, error) {
+	pipelineSpec, err := unmarshalPipelineSpec(job)
+	if err != nil {
+		return nil, err
+	}
+	deploymentConfig, err := unmarshalDeploymentConfig(pipelineSpec)
+	if err != nil {
+		return nil, err
+	}
+	runtimeParameters, err := getRuntimeParameters(pipelineSpec, job.GetRuntimeConfig())
+	if err != nil {
+		return nil, err
+	}
+	if err := setParameterValues(runtimeParameters, parameters); err != nil {
+		return nil, err
+	}
+	pipelineSpec.RuntimeParameters = runtimeParameters
+	return CompilePipelineSpec(pipelineSpec, deploymentConfig)
+}
+
+// GetPipelineJobParameters returns the parameters of a pipeline job, sorted
+// by name, with their values in the runtime config or their defaults. Tekton
+// params are strings, so the primitive type of a parameter, e.g. INT, is
+// recorded in its description.
+func GetPipelineJobParameters(job *pb.PipelineJob) ([]workflowapi.ParamSpec, error) {
+	pipelineSpec, err := unmarshalPipelineSpec(job)
+	if err != nil {
+		return nil, err
+	}
+	runtimeParameters, err := getRuntimeParameters(pipelineSpec, job.GetRuntimeConfig())
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for name := range runtimeParameters {
+		names[name] = true
+	}
+	params := make([]workflowapi.ParamSpec, 0, len(names))
+	for _, name := range sortedKeys(names) {
+		parameter := runtimeParameters[name]
+		param := workflowapi.ParamSpec{
+			Name:        name,
+			Type:        workflowapi.ParamTypeString,
+			Description: parameter.GetType().String(),
+		}
+		if parameter.GetDefaultValue() != nil {
+			param.Default = workflowapi.NewArrayOrString(formatParameterValue(parameter.GetDefaultValue()))
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func unmarshalPipelineSpec(job *pb.PipelineJob) (*pb.PipelineSpec, error) {
+	if job.GetPipelineSpec() == nil {
+		return nil, errors.New("Pipeline spec is empty")
+	}
+	specJSON, err := (&jsonpb.Marshaler{}).MarshalToString(job.GetPipelineSpec())
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal pipeline spec to JSON")
+	}
+	pipelineSpec := &pb.PipelineSpec{}
+	if err := (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewBufferString(specJSON), pipelineSpec); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse pipeline spec")
+	}
+	return pipelineSpec, nil
+}
+
+// unmarshalDeploymentConfig reads the deployment config from the deployment
+// spec of the pipeline spec, or from the deprecated deployment config.
+func unmarshalDeploymentConfig(pipelineSpec *pb.PipelineSpec) (*pb.PipelineDeploymentConfig, error) {
+	var deploymentConfig proto.Message = pipelineSpec.GetDeploymentSpec()
+	if pipelineSpec.GetDeploymentSpec() == nil {
+		if pipelineSpec.GetDeploymentConfig() == nil {
+			return nil, errors.New("Deployment spec is empty")
+		}
+		deploymentConfig = pipelineSpec.GetDeploymentConfig()
+	}
+	buffer := new(bytes.Buffer)
+	if err := (&jsonpb.Marshaler{}).Marshal(buffer, deploymentConfig); err != nil {
+		return nil, errors.Wrapf(err, "Failed to marshal deployment spec to JSON")
+	}
+	config := &pb.PipelineDeploymentConfig{}
+	// Allow unknown '@type' field in the json message.
+	if err := (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(buffer, config); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse deployment spec")
+	}
+	return config, nil
+}
+
+// getRuntimeParameters returns the runtime parameters of the pipeline, which
+// are the inputs of the root DAG if any, with the values of the runtime config
+// as defaults.
+func getRuntimeParameters(pipelineSpec *pb.PipelineSpec, runtimeConfig *pb.PipelineJob_RuntimeConfig) (map[string]*pb.PipelineSpec_RuntimeParameter, error) {
+	runtimeParameters := make(map[string]*pb.PipelineSpec_RuntimeParameter)
+	if pipelineSpec.GetRoot() != nil {
+		for name, parameter := range pipelineSpec.GetRoot().GetInputDefinitions().GetParameters() {
+			runtimeParameters[name] = &pb.PipelineSpec_RuntimeParameter{
+				Type:         parameter.GetType(),
+				DefaultValue: pipelineSpec.GetRuntimeParameters()[name].GetDefaultValue(),
+			}
+		}
+	} else {
+		for name, parameter := range pipelineSpec.GetRuntimeParameters() {
+			runtimeParameters[name] = proto.Clone(parameter).(*pb.PipelineSpec_RuntimeParameter)
+		}
+	}
+	for name, value := range runtimeConfig.GetParameters() {
+		parameter, ok := runtimeParameters[name]
+		if !ok {
+			return nil, errors.Errorf("Unrecognized input parameter in runtime config: %v", name)
+		}
+		parameter.DefaultValue = value
+	}
+	return runtimeParameters, nil
+}
+
+// setParameterValues sets the default values of the runtime parameters to the
+// string values of the parameters, parsed by their types.
+func setParameterValues(runtimeParameters map[string]*pb.PipelineSpec_RuntimeParameter, parameters map[string]string) error {
+	for _, name := range sortedParameterNames(parameters) {
+		parameter, ok := runtimeParameters[name]
+		if !ok {
+			return errors.Errorf("Unrecognized input parameter: %v", name)
+		}
+		value, err := parseParameterValue(parameter.GetType(), parameters[name])
+		if err != nil {
+			return errors.Wrapf(err, "Invalid value of input parameter '%s'", name)
+		}
+		parameter.DefaultValue = value
+	}
+	return nil
+}
+
+// parseParameterValue parses the string value of a parameter of the given
+// type.
+func parseParameterValue(parameterType pb.PrimitiveType_PrimitiveTypeEnum, value string) (*pb.Value, error) {
+	switch parameterType {
+	case pb.PrimitiveType_INT:
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("%q is not an integer", value)
+		}
+		return &pb.Value{Value: &pb.Value_IntValue{IntValue: intValue}}, nil
+	case pb.PrimitiveType_DOUBLE:
+		doubleValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.Errorf("%q is not a number", value)
+		}
+		return &pb.Value{Value: &pb.Value_DoubleValue{DoubleValue: doubleValue}}, nil
+	default:
+		return &pb.Value{Value: &pb.Value_StringValue{StringValue: value}}, nil
+	}
+}
+
+// zeroParameterValue returns the zero value of a parameter of the given type.
+func zeroParameterValue(parameterType pb.PrimitiveType_PrimitiveTypeEnum) *pb.Value {
+	switch parameterType {
+	case pb.PrimitiveType_INT:
+		return &pb.Value{Value: &pb.Value_IntValue{}}
+	case pb.PrimitiveType_DOUBLE:
+		return &pb.Value{Value: &pb.Value_DoubleValue{}}
+	default:
+		return &pb.Value{Value: &pb.Value_StringValue{}}
+	}
+}
+
+// formatParameterValue returns the string value of a parameter, which
+// parseParameterValue parses back.
+func formatParameterValue(value *pb.Value) string {
+	switch v := value.GetValue().(type) {
+	case *pb.Value_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *pb.Value_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	default:
+		return value.GetStringValue()
+	}
+}
+
+func sortedTaskNames(tasks map[string]*pb.PipelineTaskSpec) []string {
+	names := make(map[string]bool)
+	for name := range tasks {
+		names[name] = true
+	}
+	return sortedKeys(names)
+}
+
+func sortedParameterNames(parameters map[string]string) []string {
+	names := make(map[string]bool)
+	for name := range parameters {
+		names[name] = true
+	}
+	return sortedKeys(names)
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekton
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	"github.com/stretchr/testify/assert"
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+// A pipeline job with a root DAG, like the ones compiled by the KFP SDK v2.
+const rootDagPipelineJob = `{
+  "pipelineSpec": {
+    "pipelineInfo": {"name": "add-pipeline"},
+    "schemaVersion": "2.0.0",
+    "root": {
+      "inputDefinitions": {"parameters": {
+        "a": {"type": "INT"},
+        "b": {"type": "DOUBLE"},
+        "message": {"type": "STRING"}
+      }},
+      "dag": {"tasks": {
+        "add": {
+          "componentRef": {"name": "comp-add"},
+          "inputs": {"parameters": {
+            "a": {"componentInputParameter": "a"},
+            "b": {"componentInputParameter": "b"}
+          }}
+        },
+        "print": {
+          "componentRef": {"name": "comp-print"},
+          "inputs": {"parameters": {
+            "sum": {"taskOutputParameter": {"producerTask": "add", "outputParameterKey": "sum"}}
+          }}
+        }
+      }}
+    },
+    "components": {
+      "comp-add": {
+        "inputDefinitions": {"parameters": {"a": {"type": "INT"}, "b": {"type": "DOUBLE"}}},
+        "outputDefinitions": {"parameters": {"sum": {"type": "DOUBLE"}}},
+        "executorLabel": "exec-add"
+      },
+      "comp-print": {
+        "inputDefinitions": {"parameters": {"sum": {"type": "DOUBLE"}}},
+        "executorLabel": "exec-print"
+      }
+    },
+    "deploymentSpec": {"executors": {
+      "exec-add": {"container": {
+        "image": "python:3.7",
+        "command": ["sh", "-c", "python3 -c \"print($0 + $1)\" > $2"],
+        "args": ["{{$.inputs.parameters['a']}}", "{{$.inputs.parameters['b']}}", "{{$.outputs.parameters['sum'].output_file}}"]
+      }},
+      "exec-print": {"container": {
+        "image": "python:3.7",
+        "command": ["echo"],
+        "args": ["{{$.inputs.parameters['sum']}}"]
+      }}
+    }}
+  },
+  "runtimeConfig": {"parameters": {
+    "a": {"intValue": "1"},
+    "b": {"doubleValue": 2.5}
+  }}
+}`
+
+func parsePipelineJob(t *testing.T, json string) *pb.PipelineJob {
+	job := &pb.PipelineJob{}
+	if err := jsonpb.UnmarshalString(json, job); err != nil {
+		t.Fatalf("Failed to parse pipeline job: %v", err)
+	}
+	return job
+}
+
+func TestCompilePipelineJob(t *testing.T) {
+	pipelineRun, err := CompilePipelineJob(parsePipelineJob(t, rootDagPipelineJob), map[string]string{"b": "3.5", "message": "hi"})
+	assert.Nil(t, err)
+	assert.Equal(t, "add-pipeline-", pipelineRun.GenerateName)
+
+	spec := pipelineRun.Spec.PipelineSpec
+	var names []string
+	for _, task := range spec.Tasks {
+		names = append(names, task.Name)
+	}
+	assert.Equal(t, []string{
+		"kfp-root-driver",
+		"add-driver", "add", "add-publisher",
+		"print-driver", "print", "print-publisher",
+	}, names)
+
+	// The parameters override the runtime config.
+	assert.Equal(t, []workflowapi.Param{
+		stringParam("a", "1"), stringParam("b", "3.5"), stringParam("message", "hi"),
+	}, pipelineRun.Spec.Params)
+	rootTaskSpec := findParam(findTask(spec.Tasks, "kfp-root-driver").Params, driverParamTaskSpec)
+	assert.Contains(t, rootTaskSpec, `"a":{"runtimeValue":{"constantValue":{"intValue":"1"}}}`)
+	assert.Contains(t, rootTaskSpec, `"b":{"runtimeValue":{"constantValue":{"doubleValue":3.5}}}`)
+	assert.Contains(t, rootTaskSpec, `"message":{"runtimeValue":{"constantValue":{"stringValue":"hi"}}}`)
+
+	// A parameter without value is required, and its type is known to the
+	// root driver.
+	pipelineRun, err = CompilePipelineJob(parsePipelineJob(t, rootDagPipelineJob), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.Param{stringParam("a", "1"), stringParam("b", "2.5")}, pipelineRun.Spec.Params)
+	assert.Equal(t, workflowapi.ParamSpec{Name: "message", Type: workflowapi.ParamTypeString, Description: "STRING"},
+		pipelineRun.Spec.PipelineSpec.Params[2])
+	rootTaskSpec = findParam(findTask(pipelineRun.Spec.PipelineSpec.Tasks, "kfp-root-driver").Params, driverParamTaskSpec)
+	assert.Contains(t, rootTaskSpec, `"message":{"runtimeValue":{"constantValue":{"stringValue":""}}}`)
+
+	// The executor label and the outputs of the tasks come from their components.
+	assert.Contains(t, findParam(findTask(spec.Tasks, "add-driver").Params, driverParamTaskSpec), `"executorLabel":"exec-add"`)
+	add := findTask(spec.Tasks, "add")
+	assert.Equal(t, []string{"$(params.a)", "$(params.b)", "$(results.sum.path)"}, add.TaskSpec.Steps[0].Args)
+	assert.Equal(t, "$(tasks.add.results.sum)", findParam(findTask(spec.Tasks, "add-publisher").Params, "sum"))
+	assert.Equal(t, []string{"add-publisher"}, findTask(spec.Tasks, "print-driver").RunAfter)
+}
+
+func TestCompilePipelineJob_Errors(t *testing.T) {
+	job := parsePipelineJob(t, rootDagPipelineJob)
+
+	_, err := CompilePipelineJob(job, map[string]string{"c": "1"})
+	assert.Contains(t, err.Error(), "Unrecognized input parameter: c")
+
+	_, err = CompilePipelineJob(job, map[string]string{"a": "one"})
+	assert.Contains(t, err.Error(), "Invalid value of input parameter 'a'")
+
+	nestedDagJob := parsePipelineJob(t, strings.Replace(rootDagPipelineJob, `"executorLabel": "exec-add"`, `"dag": {"tasks": {}}`, 1))
+	_, err = CompilePipelineJob(nestedDagJob, nil)
+	assert.Contains(t, err.Error(), "Output parameters of DAG task 'add' are not supported")
+}
+
+func TestGetPipelineJobParameters(t *testing.T) {
+	params, err := GetPipelineJobParameters(parsePipelineJob(t, rootDagPipelineJob))
+	assert.Nil(t, err)
+	assert.Equal(t, []workflowapi.ParamSpec{
+		{Name: "a", Type: workflowapi.ParamTypeString, Description: "INT", Default: workflowapi.NewArrayOrString("1")},
+		{Name: "b", Type: workflowapi.ParamTypeString, Description: "DOUBLE", Default: workflowapi.NewArrayOrString("2.5")},
+		{Name: "message", Type: workflowapi.ParamTypeString, Description: "STRING"},
+	}, params)
+}
@@ -0,0 +1,248 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tekton
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	workflowapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	k8sv1 "k8s.io/api/core/v1"
+)
+
+// TODO(Bobgy): make images configurable
+const (
+	driverImage     = "gcr.io/gongyuan-pipeline-test/kfp-driver"
+	driverImageRef  = "@sha256:d3fa780ffc59a22253eb4b4460e89f722811dfdd2ded277d3606f1fce323af87"
+	driverImageFull = driverImage + driverImageRef
+
+	publisherImage     = "gcr.io/gongyuan-pipeline-test/kfp-publisher"
+	publisherImageRef  = "@sha256:3623052f6bd4f02da6199d1971ec948b96fb00c6d8c61725d3cfda998b304efe"
+	publisherImageFull = publisherImage + publisherImageRef
+
+	// The image of the step which writes the output parameters of an executor
+	// to files for the publisher.
+	parametersWriterImage = "busybox"
+
+	// TODO(Bobgy): make this configurable
+	mlmdURL = "metadata-grpc-service.kubeflow.svc.cluster.local:8080"
+)
+
+const (
+	paramPrefixKfpInternal = "kfp-"
+
+	// Driver params and results
+	driverParamParentContextName = paramPrefixKfpInternal + "parent-context-name"
+	driverParamTaskSpec          = paramPrefixKfpInternal + "task-spec"
+	driverResultExecutionID      = paramPrefixKfpInternal + "execution-id"
+	driverResultContextName      = paramPrefixKfpInternal + "context-name"
+	driverResultCondition        = paramPrefixKfpInternal + "condition"
+	driverResultIterations       = paramPrefixKfpInternal + "iterations"
+
+	// Publisher params
+	publisherParamExecutionID = paramPrefixKfpInternal + "execution-id"
+
+	// The pod spec patch of the executor driver is not used, because Tekton
+	// cannot patch the pod of another task. The executor task is compiled from
+	// the executor spec instead and gets the resolved inputs from the results.
+	outputPathPodSpecPatch       = "/kfp/outputs/pod-spec-patch.json"
+	tektonResultsPath            = "/tekton/results"
+	publisherInputPathParameters = "/kfp/inputs/parameters"
+	publisherVolumeName          = "kfp-parameters"
+
+	driverTypeDag      = "DAG"
+	driverTypeExecutor = "EXECUTOR"
+	publisherType      = "EXECUTOR"
+)
+
+func stringParam(name string, value string) workflowapi.Param {
+	return workflowapi.Param{Name: name, Value: *workflowapi.NewArrayOrString(value)}
+}
+
+func paramRef(name string) string {
+	return "$(params." + name + ")"
+}
+
+func taskResultRef(taskName string, result string) string {
+	return "$(tasks." + taskName + ".results." + result + ")"
+}
+
+// driverTaskSpec returns the spec of the task which records an execution of
+// a DAG or an executor in MLMD and resolves its input parameters. Executor
+// drivers write the resolved parameters as results named after them.
+func driverTaskSpec(driverType string, executorSpecJSON string, inputParameters []string) *workflowapi.TaskSpec {
+	spec := &workflowapi.TaskSpec{
+		Params: []workflowapi.ParamSpec{
+			{Name: driverParamParentContextName, Type: workflowapi.ParamTypeString},
+			{Name: driverParamTaskSpec, Type: workflowapi.ParamTypeString},
+		},
+		Results: []workflowapi.TaskResult{{Name: driverResultExecutionID}},
+	}
+	args := []string{
+		"--logtostderr",
+		"--mlmd_url=" + mlmdURL,
+		"--parent_context_name=" + paramRef(driverParamParentContextName),
+		"--execution_name=kfp-" + strings.ToLower(driverType) + "-$(context.taskRun.name)",
+		"--driver_type=" + driverType,
+		"--task_spec=" + paramRef(driverParamTaskSpec),
+		"--output_path_execution_id=$(results." + driverResultExecutionID + ".path)",
+		"--output_path_condition=$(results." + driverResultCondition + ".path)",
+	}
+	spec.Results = append(spec.Results, workflowapi.TaskResult{Name: driverResultCondition})
+	if driverType == driverTypeDag {
+		args = append(args, "--output_path_context_name=$(results."+driverResultContextName+".path)")
+		spec.Results = append(spec.Results, workflowapi.TaskResult{Name: driverResultContextName})
+	} else {
+		args = append(args,
+			"--executor_spec="+executorSpecJSON,
+			"--output_path_pod_spec_patch="+outputPathPodSpecPatch,
+			"--output_path_parameters="+tektonResultsPath,
+		)
+		for _, name := range inputParameters {
+			spec.Results = append(spec.Results, workflowapi.TaskResult{Name: name})
+		}
+	}
+	spec.Steps = []workflowapi.Step{{Container: k8sv1.Container{
+		Name:    "driver",
+		Image:   driverImageFull,
+		Command: []string{"/bin/kfp-driver"},
+		Args:    args,
+	}}}
+	return spec
+}
+
+// dagDriverTaskSpec returns the spec of the driver of a DAG task, which creates
+// the contexts of the iterations of the DAG if it has a parameter iterator.
+func dagDriverTaskSpec(hasIterator bool) *workflowapi.TaskSpec {
+	spec := driverTaskSpec(driverTypeDag, "", nil)
+	if hasIterator {
+		spec.Results = append(spec.Results, workflowapi.TaskResult{Name: driverResultIterations})
+		spec.Steps[0].Args = append(spec.Steps[0].Args, "--output_path_iterations=$(results."+driverResultIterations+".path)")
+	}
+	return spec
+}
+
+// rootDriverTaskSpec returns the spec of the driver of the root DAG, which
+// gets the values of the runtime parameters of the pipeline as params named
+// after them.
+func rootDriverTaskSpec(runtimeParameters []string) *workflowapi.TaskSpec {
+	spec := driverTaskSpec(driverTypeDag, "", nil)
+	for _, name := range runtimeParameters {
+		spec.Params = append(spec.Params, workflowapi.ParamSpec{Name: name, Type: workflowapi.ParamTypeString})
+		spec.Steps[0].Args = append(spec.Steps[0].Args, "--runtime_parameter="+name+"="+paramRef(name))
+	}
+	return spec
+}
+
+// executorTaskSpec returns the spec of the task which runs the container of a
+// component. Its input parameters are params and its output parameters are
+// results, which replace the placeholders of the command.
+func executorTaskSpec(image string, command []string, args []string, inputParameters []string, outputParameters []string) *workflowapi.TaskSpec {
+	spec := &workflowapi.TaskSpec{}
+	replacements := make(map[string]string)
+	for _, name := range inputParameters {
+		spec.Params = append(spec.Params, workflowapi.ParamSpec{Name: name, Type: workflowapi.ParamTypeString})
+		replacements[fmt.Sprintf("{{$.inputs.parameters['%s']}}", name)] = paramRef(name)
+	}
+	for _, name := range outputParameters {
+		spec.Results = append(spec.Results, workflowapi.TaskResult{Name: name})
+		replacements[fmt.Sprintf("{{$.outputs.parameters['%s'].output_file}}", name)] = "$(results." + name + ".path)"
+	}
+	spec.Steps = []workflowapi.Step{{Container: k8sv1.Container{
+		Name:    "main",
+		Image:   image,
+		Command: fillPlaceholders(command, replacements),
+		Args:    fillPlaceholders(args, replacements),
+	}}}
+	return spec
+}
+
+func fillPlaceholders(values []string, replacements map[string]string) []string {
+	if values == nil {
+		return nil
+	}
+	filled := make([]string, 0, len(values))
+	for _, value := range values {
+		for placeholder, replacement := range replacements {
+			value = strings.ReplaceAll(value, placeholder, replacement)
+		}
+		filled = append(filled, value)
+	}
+	return filled
+}
+
+// publisherTaskSpec returns the spec of the task which records the output
+// parameters of an executor in its MLMD execution and completes it.
+func publisherTaskSpec(outputsSpecJSON string, outputParameters []string) *workflowapi.TaskSpec {
+	spec := &workflowapi.TaskSpec{
+		Params: []workflowapi.ParamSpec{{Name: publisherParamExecutionID, Type: workflowapi.ParamTypeString}},
+		Volumes: []k8sv1.Volume{{
+			Name:         publisherVolumeName,
+			VolumeSource: k8sv1.VolumeSource{EmptyDir: &k8sv1.EmptyDirVolumeSource{}},
+		}},
+	}
+	volumeMounts := []k8sv1.VolumeMount{{Name: publisherVolumeName, MountPath: publisherInputPathParameters}}
+
+	// The publisher reads the parameters from files named after them. The
+	// values are passed as environment variables, so they are not parsed by
+	// the shell.
+	script := []string{"#!/bin/sh", "set -e"}
+	var env []k8sv1.EnvVar
+	for i, name := range outputParameters {
+		spec.Params = append(spec.Params, workflowapi.ParamSpec{Name: name, Type: workflowapi.ParamTypeString})
+		envName := fmt.Sprintf("KFP_PARAMETER_%d", i)
+		env = append(env, k8sv1.EnvVar{Name: envName, Value: paramRef(name)})
+		script = append(script, fmt.Sprintf(`printf '%%s' "$%s" > "%s"`, envName, path.Join(publisherInputPathParameters, name)))
+	}
+	spec.Steps = []workflowapi.Step{
+		{
+			Container: k8sv1.Container{
+				Name:         "write-parameters",
+				Image:        parametersWriterImage,
+				Env:          env,
+				VolumeMounts: volumeMounts,
+			},
+			Script: strings.Join(script, "\n") + "\n",
+		},
+		{
+			Container: k8sv1.Container{
+				Name:    "publisher",
+				Image:   publisherImageFull,
+				Command: []string{"/bin/kfp-publisher"},
+				Args: []string{
+					"--logtostderr",
+					"--mlmd_url=" + mlmdURL,
+					"--execution_id=" + paramRef(publisherParamExecutionID),
+					"--publisher_type=" + publisherType,
+					"--component_outputs_spec=" + outputsSpecJSON,
+					"--input_path_parameters=" + publisherInputPathParameters,
+				},
+				VolumeMounts: volumeMounts,
+			},
+		},
+	}
+	return spec
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
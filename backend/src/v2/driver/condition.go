@@ -0,0 +1,384 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/pkg/errors"
+)
+
+// evaluateCondition evaluates the condition of the trigger policy of a task
+// against its resolved input parameters.
+//
+// Conditions are CEL expressions, of which the subset generated by the KFP SDK
+// is supported: string, number and boolean literals, references to input
+// parameters like `inputs.parameters['name']`, optionally followed by
+// `.string_value`, `.int_value` or `.double_value`, comparisons, `!`, `&&`,
+// `||` and parentheses.
+func evaluateCondition(condition string, parameters map[string]*mlmdPb.Value) (bool, error) {
+	tokens, err := tokenizeCondition(condition)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to parse condition %q", condition)
+	}
+	p := &conditionParser{tokens: tokens, parameters: parameters}
+	value, err := p.parseOr()
+	if err == nil && p.peek().kind != tokenEOF {
+		err = errors.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to evaluate condition %q", condition)
+	}
+	result, ok := value.(bool)
+	if !ok {
+		return false, errors.Errorf("Condition %q is evaluated to %v, which is not a boolean", condition, value)
+	}
+	return result, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenIdent
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+var conditionOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]"}
+
+func tokenizeCondition(condition string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(condition); {
+		c := rune(condition[pos])
+		switch {
+		case unicode.IsSpace(c):
+			pos++
+		case c == '\'' || c == '"':
+			end := strings.IndexRune(condition[pos+1:], c)
+			if end < 0 {
+				return nil, errors.Errorf("unterminated string at position %d", pos)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: condition[pos+1 : pos+1+end], pos: pos})
+			pos += end + 2
+		case unicode.IsDigit(c) || (c == '-' && pos+1 < len(condition) && unicode.IsDigit(rune(condition[pos+1]))):
+			end := pos + 1
+			for end < len(condition) && (unicode.IsDigit(rune(condition[end])) || condition[end] == '.' || condition[end] == 'e' || condition[end] == 'E') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: condition[pos:end], pos: pos})
+			pos = end
+		case unicode.IsLetter(c) || c == '_' || c == '.':
+			end := pos + 1
+			for end < len(condition) && (unicode.IsLetter(rune(condition[end])) || unicode.IsDigit(rune(condition[end])) || condition[end] == '_' || condition[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: condition[pos:end], pos: pos})
+			pos = end
+		default:
+			operator := ""
+			for _, op := range conditionOperators {
+				if strings.HasPrefix(condition[pos:], op) {
+					operator = op
+					break
+				}
+			}
+			if operator == "" {
+				return nil, errors.Errorf("unexpected character %q at position %d", c, pos)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, pos: pos})
+			pos += len(operator)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(condition)}), nil
+}
+
+// conditionParser is a recursive descent parser evaluating the condition while
+// parsing it. The values are strings, int64s, float64s or bools.
+type conditionParser struct {
+	tokens     []token
+	next       int
+	parameters map[string]*mlmdPb.Value
+}
+
+func (p *conditionParser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *conditionParser) accept(operator string) bool {
+	if t := p.peek(); t.kind == tokenOperator && t.text == operator {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *conditionParser) expect(operator string) error {
+	if !p.accept(operator) {
+		return errors.Errorf("expected %q at position %d", operator, p.peek().pos)
+	}
+	return nil
+}
+
+func (p *conditionParser) parseOr() (interface{}, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if left, err = logical("||", left, right); err != nil {
+			return nil, err
+		}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (interface{}, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if left, err = logical("&&", left, right); err != nil {
+			return nil, err
+		}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseUnary() (interface{}, error) {
+	if p.accept("!") {
+		value, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		b, ok := value.(bool)
+		if !ok {
+			return nil, errors.Errorf("operator '!' cannot be applied to %v", value)
+		}
+		return !b, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *conditionParser) parseComparison() (interface{}, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for _, operator := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.accept(operator) {
+			right, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			return compare(operator, left, right)
+		}
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parsePrimary() (interface{}, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next++
+		return t.text, nil
+	case tokenNumber:
+		p.next++
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return f, nil
+	case tokenIdent:
+		p.next++
+		switch t.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "inputs.parameters":
+			return p.parseParameterReference()
+		}
+		return nil, errors.Errorf("unknown identifier %q at position %d", t.text, t.pos)
+	case tokenOperator:
+		if p.accept("(") {
+			value, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return value, p.expect(")")
+		}
+	}
+	if t.kind == tokenEOF {
+		return nil, errors.New("unexpected end of condition")
+	}
+	return nil, errors.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+// parseParameterReference parses the rest of a parameter reference after
+// `inputs.parameters`, e.g. `['name'].string_value`, and returns the value of
+// the parameter.
+func (p *conditionParser) parseParameterReference() (interface{}, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	if t.kind != tokenString {
+		return nil, errors.Errorf("expected parameter name at position %d", t.pos)
+	}
+	p.next++
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	value, ok := p.parameters[t.text]
+	if !ok {
+		return nil, errors.Errorf("unknown input parameter '%s'", t.text)
+	}
+	accessor := ""
+	if next := p.peek(); next.kind == tokenIdent && strings.HasPrefix(next.text, ".") {
+		p.next++
+		accessor = strings.TrimPrefix(next.text, ".")
+	}
+	return parameterValue(t.text, value, accessor)
+}
+
+// parameterValue returns the value of an input parameter, converted by the
+// given accessor. Parameters produced by tasks are stored as strings, so they
+// are parsed when they are accessed as numbers.
+func parameterValue(name string, value *mlmdPb.Value, accessor string) (interface{}, error) {
+	switch accessor {
+	case "":
+		switch v := value.GetValue().(type) {
+		case *mlmdPb.Value_IntValue:
+			return v.IntValue, nil
+		case *mlmdPb.Value_DoubleValue:
+			return v.DoubleValue, nil
+		default:
+			return value.GetStringValue(), nil
+		}
+	case "string_value":
+		return formatMlmdValue(value), nil
+	case "int_value":
+		if v, ok := value.GetValue().(*mlmdPb.Value_IntValue); ok {
+			return v.IntValue, nil
+		}
+		i, err := strconv.ParseInt(formatMlmdValue(value), 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("input parameter '%s' is not an integer: %q", name, formatMlmdValue(value))
+		}
+		return i, nil
+	case "double_value":
+		f, err := strconv.ParseFloat(formatMlmdValue(value), 64)
+		if err != nil {
+			return nil, errors.Errorf("input parameter '%s' is not a number: %q", name, formatMlmdValue(value))
+		}
+		return f, nil
+	default:
+		return nil, errors.Errorf("unknown field '%s' of input parameter '%s'", accessor, name)
+	}
+}
+
+func logical(operator string, left, right interface{}) (interface{}, error) {
+	l, lok := left.(bool)
+	r, rok := right.(bool)
+	if !lok || !rok {
+		return nil, errors.Errorf("operator '%s' cannot be applied to %v and %v", operator, left, right)
+	}
+	if operator == "&&" {
+		return l && r, nil
+	}
+	return l || r, nil
+}
+
+func compare(operator string, left, right interface{}) (bool, error) {
+	var c int
+	switch l := left.(type) {
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false, errors.Errorf("cannot compare string %q with %v", l, right)
+		}
+		c = strings.Compare(l, r)
+	case bool:
+		r, ok := right.(bool)
+		if !ok || (operator != "==" && operator != "!=") {
+			return false, errors.Errorf("operator '%s' cannot be applied to %v and %v", operator, left, right)
+		}
+		if l != r {
+			c = 1
+		}
+	default:
+		lf, lok := toFloat(left)
+		rf, rok := toFloat(right)
+		if !lok || !rok {
+			return false, errors.Errorf("cannot compare %v with %v", left, right)
+		}
+		li, lint := left.(int64)
+		ri, rint := right.(int64)
+		switch {
+		case lint && rint && li < ri, !(lint && rint) && lf < rf:
+			c = -1
+		case lint && rint && li > ri, !(lint && rint) && lf > rf:
+			c = 1
+		}
+	}
+	switch operator {
+	case "==":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateCondition(t *testing.T) {
+	parameters := map[string]*mlmdPb.Value{
+		"flip":  {Value: &mlmdPb.Value_StringValue{StringValue: "heads"}},
+		"count": {Value: &mlmdPb.Value_IntValue{IntValue: 3}},
+		"score": {Value: &mlmdPb.Value_StringValue{StringValue: "0.95"}},
+	}
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"inputs.parameters['flip'].string_value == 'heads'", true},
+		{`inputs.parameters['flip'] != "heads"`, false},
+		{"inputs.parameters['count'] > 2", true},
+		{"inputs.parameters['count'].string_value == '3'", true},
+		{"inputs.parameters['score'].double_value >= 0.9", true},
+		{"inputs.parameters['count'] < 2.5 || inputs.parameters['flip'] == 'heads'", true},
+		{"!(inputs.parameters['count'] == 3 && inputs.parameters['flip'] == 'tails')", true},
+		{"inputs.parameters['count'].int_value <= -1", false},
+		{"true", true},
+	}
+	for _, test := range tests {
+		got, err := evaluateCondition(test.condition, parameters)
+		assert.Nil(t, err, test.condition)
+		assert.Equal(t, test.want, got, test.condition)
+	}
+}
+
+func TestEvaluateCondition_Errors(t *testing.T) {
+	parameters := map[string]*mlmdPb.Value{
+		"flip": {Value: &mlmdPb.Value_StringValue{StringValue: "heads"}},
+	}
+	tests := []struct {
+		condition string
+		wantErr   string
+	}{
+		{"inputs.parameters['missing'] == 'a'", "unknown input parameter 'missing'"},
+		{"inputs.parameters['flip'] == 1", "cannot compare string"},
+		{"inputs.parameters['flip'].int_value == 1", "is not an integer"},
+		{"inputs.parameters['flip'].bytes_value == 'a'", "unknown field 'bytes_value'"},
+		{"inputs.parameters['flip'] == 'heads", "unterminated string"},
+		{"(inputs.parameters['flip'] == 'heads'", `expected ")"`},
+		{"inputs.parameters['flip']", "not a boolean"},
+		{"inputs.parameters['flip'] == 'heads' 'tails'", `unexpected "tails"`},
+		// Arithmetic is not supported.
+		{"inputs.parameters['flip'].double_value * 100 > 90", "unexpected character '*'"},
+	}
+	for _, test := range tests {
+		_, err := evaluateCondition(test.condition, parameters)
+		assert.NotNil(t, err, test.condition)
+		if err != nil {
+			assert.Contains(t, err.Error(), test.wantErr, test.condition)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/golang/glog"
 )
@@ -35,6 +36,9 @@ const (
 	argumentOutputPathParameters   = "output_path_parameters"
 	argumentOutputPathPodSpecPatch = "output_path_pod_spec_patch"
 	argumentParentContextName      = "parent_context_name"
+	argumentOutputPathCondition    = "output_path_condition"
+	argumentOutputPathIterations   = "output_path_iterations"
+	argumentRuntimeParameter       = "runtime_parameter"
 )
 
 // command line variables
@@ -49,8 +53,28 @@ var (
 	outputPathContextName  string
 	outputPathParameters   string
 	outputPathPodSpecPatch string
+	outputPathCondition    string
+	outputPathIterations   string
+	runtimeParameters      = make(runtimeParametersFlag)
 )
 
+// runtimeParametersFlag is the value of a flag which can be repeated, with the
+// value of a runtime parameter of the pipeline as <name>=<value> each time.
+type runtimeParametersFlag map[string]string
+
+func (f runtimeParametersFlag) String() string {
+	return fmt.Sprint(map[string]string(f))
+}
+
+func (f runtimeParametersFlag) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("runtime parameter %q is not <name>=<value>", value)
+	}
+	f[parts[0]] = parts[1]
+	return nil
+}
+
 // driver type enum
 const (
 	driverTypeDag      = "DAG"
@@ -65,9 +89,12 @@ func initFlags() {
 	flag.StringVar(&driverType, argumentDriverType, "", fmt.Sprintf("Driver type, can be '%s' or '%s'", driverTypeDag, driverTypeExecutor))
 	flag.StringVar(&parentContextName, argumentParentContextName, "", "Name of parent context. Required if not root DAG.")
 	flag.StringVar(&outputPathExecutionId, argumentOutputPathExecutionId, "", "Output path where execution ID should be written to")
+	flag.Var(runtimeParameters, argumentRuntimeParameter, "Value of a runtime parameter of the pipeline, as <name>=<value>, which replaces the one in the task spec. Can be repeated. Only for the root DAG.")
+	flag.StringVar(&outputPathCondition, argumentOutputPathCondition, "", "Output path where whether the task is triggered, 'true' or 'false', should be written to.")
 
 	// Required when driving a DAG.
 	flag.StringVar(&outputPathContextName, argumentOutputPathContextName, "", "Output path where context name should be written to. Required when driver type is DAG.")
+	flag.StringVar(&outputPathIterations, argumentOutputPathIterations, "", "Output path where the context names of the iterations, as a JSON array, should be written to. Required when the task has a parameter iterator.")
 
 	// Required when driving an executor.
 	flag.StringVar(&executorSpecJson, argumentExecutorSpec, "", "ExecutorSpec. Required when driver type is EXECUTOR.")
@@ -0,0 +1,135 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/pkg/errors"
+)
+
+// getIteratorItems returns the items of the parameter iterator of a task, as
+// the string values of the parameter passed to each iteration. The items are
+// either raw in the iterator spec or in an input parameter of the execution,
+// as a JSON array.
+func getIteratorItems(iterator *pb.ParameterIteratorSpec, execution *mlmdPb.Execution) ([]string, error) {
+	if iterator.GetItemInput() == "" {
+		return nil, errors.New("Item input of parameter iterator is empty")
+	}
+	itemsJson := iterator.GetItems().GetRaw()
+	if inputParameter := iterator.GetItems().GetInputParameter(); inputParameter != "" {
+		value, ok := getInputParameters(execution)[inputParameter]
+		if !ok {
+			return nil, errors.Errorf("Cannot find input parameter '%s' of parameter iterator", inputParameter)
+		}
+		itemsJson = formatMlmdValue(value)
+	}
+	var rawItems []json.RawMessage
+	if err := json.Unmarshal([]byte(itemsJson), &rawItems); err != nil {
+		return nil, errors.Wrapf(err, "Failed to parse items of parameter iterator as a JSON array: %v", itemsJson)
+	}
+	items := make([]string, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		// String items are passed as is, other items as JSON.
+		var item string
+		if err := json.Unmarshal(rawItem, &item); err != nil {
+			item = string(rawItem)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// putIterations fans a task out into one child execution per item of its
+// parameter iterator. The child executions are put into the context of the
+// task, and each of them gets its own DAG context, named after the context of
+// the task and the iteration index, in which the tasks of the iteration run.
+// The names of the contexts of the iterations are returned.
+func putIterations(
+	ctx context.Context,
+	c mlmdPb.MetadataStoreServiceClient,
+	taskSpec *pb.PipelineTaskSpec,
+	execution *mlmdPb.Execution,
+	executionTypeId int64,
+	taskContext *mlmdPb.Context,
+) ([]string, error) {
+	iterator := taskSpec.GetParameterIterator()
+	items, err := getIteratorItems(iterator, execution)
+	if err != nil {
+		return nil, err
+	}
+	contextNames := make([]string, 0, len(items))
+	for index, item := range items {
+		name := fmt.Sprintf("%s-%d", execution.GetName(), index)
+		contextName := fmt.Sprintf("%s-%d", taskContext.GetName(), index)
+		state := mlmdPb.Execution_RUNNING
+		iteration := &mlmdPb.Execution{
+			Name:             &name,
+			TypeId:           &executionTypeId,
+			LastKnownState:   &state,
+			CustomProperties: make(map[string]*mlmdPb.Value),
+		}
+		for propertyName, property := range execution.GetCustomProperties() {
+			iteration.CustomProperties[propertyName] = property
+		}
+		iteration.CustomProperties[executionParamContextName] = &mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: contextName}}
+		iteration.CustomProperties[executionParamIterationIndex] = &mlmdPb.Value{Value: &mlmdPb.Value_IntValue{IntValue: int64(index)}}
+		iteration.CustomProperties[executionParamPrefixInputProperty+iterator.GetItemInput()] =
+			&mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: item}}
+
+		putExecutionResponse, err := c.PutExecution(ctx, &mlmdPb.PutExecutionRequest{
+			Execution: iteration,
+			Contexts:  []*mlmdPb.Context{taskContext},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to put execution of iteration %d", index)
+		}
+		iterationId := putExecutionResponse.GetExecutionId()
+		if iterationId == 0 {
+			return nil, errors.Errorf("Failed to put execution of iteration %d, execution ID is 0: %v", index, putExecutionResponse.String())
+		}
+
+		contextTypeId := taskContext.GetTypeId()
+		putContextResponse, err := c.PutContexts(ctx, &mlmdPb.PutContextsRequest{
+			Contexts: []*mlmdPb.Context{
+				{
+					Name:   &contextName,
+					TypeId: &contextTypeId,
+					CustomProperties: map[string]*mlmdPb.Value{
+						contextParamDagExecutionId: {
+							Value: &mlmdPb.Value_IntValue{
+								IntValue: iterationId,
+							},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to put context of iteration %d", index)
+		}
+		if len(putContextResponse.GetContextIds()) != 1 || putContextResponse.GetContextIds()[0] == 0 {
+			return nil, errors.Errorf("Failed to put context of iteration %d: unexpected context IDs in put context response: %v", index, putContextResponse.GetContextIds())
+		}
+		glog.Infof("Put iteration %d: execution ID=%v, context name=%s", index, iterationId, contextName)
+		contextNames = append(contextNames, contextName)
+	}
+	return contextNames, nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIteratorItems(t *testing.T) {
+	execution := &mlmdPb.Execution{CustomProperties: map[string]*mlmdPb.Value{
+		"input:loop-items": {Value: &mlmdPb.Value_StringValue{StringValue: `["a", 1, {"b": 2}]`}},
+	}}
+
+	items, err := getIteratorItems(&pb.ParameterIteratorSpec{
+		Items:     &pb.ParameterIteratorSpec_ItemsSpec{Kind: &pb.ParameterIteratorSpec_ItemsSpec_InputParameter{InputParameter: "loop-items"}},
+		ItemInput: "loop-item",
+	}, execution)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "1", `{"b": 2}`}, items)
+
+	items, err = getIteratorItems(&pb.ParameterIteratorSpec{
+		Items:     &pb.ParameterIteratorSpec_ItemsSpec{Kind: &pb.ParameterIteratorSpec_ItemsSpec_Raw{Raw: `[1.5, true]`}},
+		ItemInput: "loop-item",
+	}, execution)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1.5", "true"}, items)
+}
+
+func TestGetIteratorItems_Errors(t *testing.T) {
+	execution := &mlmdPb.Execution{CustomProperties: map[string]*mlmdPb.Value{
+		"input:text": {Value: &mlmdPb.Value_StringValue{StringValue: "not a list"}},
+	}}
+	tests := []struct {
+		iterator *pb.ParameterIteratorSpec
+		wantErr  string
+	}{
+		{&pb.ParameterIteratorSpec{}, "Item input of parameter iterator is empty"},
+		{
+			&pb.ParameterIteratorSpec{
+				Items:     &pb.ParameterIteratorSpec_ItemsSpec{Kind: &pb.ParameterIteratorSpec_ItemsSpec_InputParameter{InputParameter: "missing"}},
+				ItemInput: "item",
+			},
+			"Cannot find input parameter 'missing'",
+		},
+		{
+			&pb.ParameterIteratorSpec{
+				Items:     &pb.ParameterIteratorSpec_ItemsSpec{Kind: &pb.ParameterIteratorSpec_ItemsSpec_InputParameter{InputParameter: "text"}},
+				ItemInput: "item",
+			},
+			"Failed to parse items of parameter iterator as a JSON array",
+		},
+	}
+	for _, test := range tests {
+		_, err := getIteratorItems(test.iterator, execution)
+		assert.NotNil(t, err)
+		if err != nil {
+			assert.Contains(t, err.Error(), test.wantErr)
+		}
+	}
+}
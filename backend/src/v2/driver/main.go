@@ -48,6 +48,11 @@ const (
 	// (context name, task name) is a unique key to identify a KFP execution.
 	// task name is unique among the context DAG.
 	executionParamTaskName = "taskName"
+	// Set to 1 when the task is skipped by its trigger policy.
+	executionParamNotTriggered = "notTriggered"
+	// Index of the item of the parameter iterator, for the executions of the
+	// iterations of a task.
+	executionParamIterationIndex = "iterationIndex"
 )
 
 // context custom parameters
@@ -86,7 +91,20 @@ func main() {
 	if err != nil {
 		glog.Fatal(err)
 	}
-	if driverType == driverTypeExecutor {
+	triggered := isTriggered(execution)
+	if outputPathCondition != "" {
+		err = os.MkdirAll(filepath.Dir(outputPathCondition), os.ModePerm)
+		if err != nil {
+			glog.Fatal(err)
+		}
+		err = ioutil.WriteFile(outputPathCondition, []byte(fmt.Sprint(triggered)), 0644)
+		if err != nil {
+			glog.Fatal(err)
+		}
+	}
+	// The executor of a task which is not triggered must not run, so there is
+	// no pod spec patch for it.
+	if driverType == driverTypeExecutor && triggered {
 		err = driveExecutor(executorSpec, taskSpec.GetOutputs(), execution, outputPathPodSpecPatch)
 		if err != nil {
 			glog.Fatal(err)
@@ -101,6 +119,15 @@ func putExecutionAndContext(
 	taskSpec *pb.PipelineTaskSpec,
 	createContext bool,
 ) (*mlmdPb.Execution, error) {
+	if taskSpec.GetArtifactIterator() != nil {
+		return nil, errors.New("Artifact iterator is not supported")
+	}
+	if taskSpec.GetParameterIterator() != nil && !createContext {
+		return nil, errors.Errorf("Parameter iterator is only supported when driver type is %s", driverTypeDag)
+	}
+	if taskSpec.GetParameterIterator() != nil && outputPathIterations == "" {
+		return nil, errors.Errorf("%s is empty. Required when the task has a parameter iterator.", argumentOutputPathIterations)
+	}
 	// put execution type
 	executionTypeName := kfpV2ExecutionType
 	putExecutionTypeResponse, err := c.PutExecutionType(ctx,
@@ -195,20 +222,19 @@ func putExecutionAndContext(
 
 	if createContext {
 		// put context
-		putContextResponse, err := c.PutContexts(ctx, &mlmdPb.PutContextsRequest{
-			Contexts: []*mlmdPb.Context{
-				{
-					Name:   &contextName,
-					TypeId: &contextTypeId,
-					CustomProperties: map[string]*mlmdPb.Value{
-						contextParamDagExecutionId: {
-							Value: &mlmdPb.Value_IntValue{
-								IntValue: executionId,
-							},
-						},
+		taskContext := &mlmdPb.Context{
+			Name:   &contextName,
+			TypeId: &contextTypeId,
+			CustomProperties: map[string]*mlmdPb.Value{
+				contextParamDagExecutionId: {
+					Value: &mlmdPb.Value_IntValue{
+						IntValue: executionId,
 					},
 				},
 			},
+		}
+		putContextResponse, err := c.PutContexts(ctx, &mlmdPb.PutContextsRequest{
+			Contexts: []*mlmdPb.Context{taskContext},
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "Failed to put context")
@@ -238,6 +264,26 @@ func putExecutionAndContext(
 		if err != nil {
 			glog.Fatal(err)
 		}
+
+		if taskSpec.GetParameterIterator() != nil && isTriggered(execution) {
+			taskContext.Id = &contextId
+			iterationContextNames, err := putIterations(ctx, c, taskSpec, execution, executionTypeId, taskContext)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Failed to put iterations")
+			}
+			iterationContextNamesJson, err := json.Marshal(iterationContextNames)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Failed to marshal iteration context names")
+			}
+			err = os.MkdirAll(filepath.Dir(outputPathIterations), os.ModePerm)
+			if err != nil {
+				glog.Fatal(err)
+			}
+			err = ioutil.WriteFile(outputPathIterations, iterationContextNamesJson, 0644)
+			if err != nil {
+				glog.Fatal(err)
+			}
+		}
 	}
 
 	return execution, nil
@@ -310,6 +356,19 @@ func initExecution(
 		}
 	}
 
+	// A task in a DAG which is not triggered is not triggered either.
+	triggered := true
+	if parentExecution != nil {
+		triggered = isTriggered(parentExecution)
+	}
+	if triggered {
+		var err error
+		triggered, err = checkTriggerStrategy(taskSpec, executionByTaskName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// initialize execution
 	var execution mlmdPb.Execution
 	execution.Name = &name
@@ -330,6 +389,11 @@ func initExecution(
 		execution.CustomProperties[executionParamContextName] =
 			&mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: contextName}}
 	}
+	if !triggered {
+		glog.Infof("Task '%s' is not triggered, because its parent DAG or its upstream tasks were not.", taskName)
+		setNotTriggered(&execution)
+		return &execution, nil
+	}
 	// resolve parameters
 	if outputPathParameters != "" {
 		// When driving a task, we need to expose parameters as argo parameters.
@@ -347,11 +411,18 @@ func initExecution(
 		if parameter.GetRuntimeValue() != nil {
 			runtimeValue := parameter.GetRuntimeValue()
 			if runtimeValue.GetConstantValue() != nil {
-				// TODO(Bobgy): build conversion helpers between MLMD Value and KFP Pipeline Spec Value, and use them here.
-				value = runtimeValue.GetConstantValue().GetStringValue()
-				execution.CustomProperties[executionParamPrefixInputProperty+name] =
-					&mlmdPb.Value{Value: &mlmdPb.Value_StringValue{
-						StringValue: value}}
+				constantValue := toMlmdValue(runtimeValue.GetConstantValue())
+				// The values of the runtime parameters are passed to the root
+				// DAG for each run, with the types of the constant values.
+				if runtimeParameter, ok := runtimeParameters[name]; ok && parentContext == nil {
+					var err error
+					constantValue, err = parseMlmdValue(constantValue, runtimeParameter)
+					if err != nil {
+						return nil, errors.Wrapf(err, "Invalid value of runtime parameter '%s'", name)
+					}
+				}
+				value = formatMlmdValue(constantValue)
+				execution.CustomProperties[executionParamPrefixInputProperty+name] = constantValue
 			} else {
 				return nil, errors.Errorf("Unsupported parameter type: %T", parameter.GetRuntimeValue().Value)
 			}
@@ -368,12 +439,13 @@ func initExecution(
 			if spec.GetOutputParameterKey() == "" {
 				return nil, errors.Errorf("Output parameter key is empty for parameter '%v'.", parameter.String())
 			}
-			value := producerKfpExecution.GetOutputParameter(spec.GetOutputParameterKey())
+			producerValue := producerKfpExecution.GetOutputParameter(spec.GetOutputParameterKey())
 			// All parameters are required for KFP.
-			if value == nil {
+			if producerValue == nil {
 				return nil, errors.Errorf("Producer execution does not have parameter '%v'. Producer: %v", parameter.String(), producerKfpExecution.String())
 			}
-			execution.CustomProperties[executionParamPrefixInputProperty+name] = value
+			execution.CustomProperties[executionParamPrefixInputProperty+name] = producerValue
+			value = formatMlmdValue(producerValue)
 			// TODO(Bobgy): type check using component inputs spec.
 		} else if parameter.GetComponentInputParameter() != "" {
 			if parentExecution == nil || parentContext == nil {
@@ -388,11 +460,9 @@ func initExecution(
 			if parentInputProperty == nil {
 				return nil, errors.Errorf("ComponentInputParameter '%s' specified, but parent execution does not have '%s' custom property", parameter.GetComponentInputParameter(), parentInputPropertyName)
 			}
-			value = parentInputProperty.GetStringValue()
-			// TODO(Bobgy): verify custom property type matches and support other types
-			execution.CustomProperties[executionParamPrefixInputProperty+name] =
-				&mlmdPb.Value{Value: &mlmdPb.Value_StringValue{
-					StringValue: value}}
+			value = formatMlmdValue(parentInputProperty)
+			// TODO(Bobgy): verify custom property type matches
+			execution.CustomProperties[executionParamPrefixInputProperty+name] = parentInputProperty
 		}
 
 		if outputPathParameters != "" {
@@ -404,6 +474,17 @@ func initExecution(
 			}
 		}
 	}
+
+	if condition := taskSpec.GetTriggerPolicy().GetCondition(); condition != "" {
+		triggered, err := evaluateCondition(condition, getInputParameters(&execution))
+		if err != nil {
+			return nil, err
+		}
+		if !triggered {
+			glog.Infof("Task '%s' is not triggered, because its condition %q is false.", taskName, condition)
+			setNotTriggered(&execution)
+		}
+	}
 	return &execution, nil
 }
 
@@ -468,8 +549,7 @@ func fillPlaceholders(s string, execution *mlmdPb.Execution, outputsSpec *pb.Tas
 		for propertyName, property := range *properties {
 			if strings.HasPrefix(propertyName, executionParamPrefixInputProperty) {
 				name := strings.TrimPrefix(propertyName, executionParamPrefixInputProperty)
-				// TODO(Bobgy): handle other value types
-				value := property.GetStringValue()
+				value := formatMlmdValue(property)
 				// TODO(Bobgy): figure out a more stable way to replace placeholders
 				s = strings.ReplaceAll(s, fmt.Sprintf("{{$.inputs.parameters['%s']}}", name), value)
 			}
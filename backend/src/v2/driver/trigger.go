@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"sort"
+
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/pkg/errors"
+)
+
+// getUpstreamTasks returns the names of the tasks a task depends on, either
+// explicitly or by consuming their outputs, sorted by name.
+func getUpstreamTasks(taskSpec *pb.PipelineTaskSpec) []string {
+	names := make(map[string]bool)
+	for _, name := range taskSpec.GetDependentTasks() {
+		names[name] = true
+	}
+	for _, parameter := range taskSpec.GetInputs().GetParameters() {
+		if producer := parameter.GetTaskOutputParameter().GetProducerTask(); producer != "" {
+			names[producer] = true
+		}
+	}
+	for _, artifact := range taskSpec.GetInputs().GetArtifacts() {
+		if producer := artifact.GetTaskOutputArtifact().GetProducerTask(); producer != "" {
+			names[producer] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// checkTriggerStrategy returns whether the upstream tasks of a task, whose
+// executions are found in the parent context, satisfy the trigger strategy of
+// the task.
+//
+// With ALL_UPSTREAM_TASKS_COMPLETED, e.g. for exit handlers, the task is
+// triggered once all upstream tasks are in a final state, whatever it is.
+// Otherwise, all upstream tasks must have succeeded: the task is not triggered
+// when an upstream task was not triggered either, and it fails when an
+// upstream task failed.
+func checkTriggerStrategy(taskSpec *pb.PipelineTaskSpec, executionByTaskName map[string]*mlmdPb.Execution) (bool, error) {
+	allCompleted := taskSpec.GetTriggerPolicy().GetStrategy() == pb.PipelineTaskSpec_TriggerPolicy_ALL_UPSTREAM_TASKS_COMPLETED
+	triggered := true
+	for _, name := range getUpstreamTasks(taskSpec) {
+		execution, ok := executionByTaskName[name]
+		if !ok {
+			return false, errors.Errorf("Cannot find the execution of upstream task '%s' in parent context", name)
+		}
+		state := execution.GetLastKnownState()
+		switch state {
+		case mlmdPb.Execution_COMPLETE, mlmdPb.Execution_CACHED:
+		case mlmdPb.Execution_FAILED, mlmdPb.Execution_CANCELED:
+			if allCompleted {
+				break
+			}
+			if !isTriggered(execution) {
+				triggered = false
+				break
+			}
+			return false, errors.Errorf("Upstream task '%s' did not succeed: state=%v", name, state)
+		default:
+			return false, errors.Errorf("Upstream task '%s' is not complete yet: state=%v", name, state)
+		}
+	}
+	return triggered, nil
+}
+
+// isTriggered returns whether an execution was triggered, i.e. whether it ran
+// or is running rather than being skipped by its trigger policy.
+func isTriggered(execution *mlmdPb.Execution) bool {
+	return execution.GetCustomProperties()[executionParamNotTriggered].GetIntValue() == 0
+}
+
+// setNotTriggered marks an execution as skipped by its trigger policy. MLMD
+// has no NOT_TRIGGERED state, so the execution is CANCELED with a custom
+// property telling them apart.
+func setNotTriggered(execution *mlmdPb.Execution) {
+	state := mlmdPb.Execution_CANCELED
+	execution.LastKnownState = &state
+	execution.CustomProperties[executionParamNotTriggered] = &mlmdPb.Value{Value: &mlmdPb.Value_IntValue{IntValue: 1}}
+}
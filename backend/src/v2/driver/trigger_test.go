@@ -0,0 +1,134 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func executionInState(state mlmdPb.Execution_State) *mlmdPb.Execution {
+	return &mlmdPb.Execution{LastKnownState: &state, CustomProperties: make(map[string]*mlmdPb.Value)}
+}
+
+func notTriggeredExecution() *mlmdPb.Execution {
+	execution := executionInState(mlmdPb.Execution_RUNNING)
+	setNotTriggered(execution)
+	return execution
+}
+
+func TestGetUpstreamTasks(t *testing.T) {
+	taskSpec := &pb.PipelineTaskSpec{
+		DependentTasks: []string{"train"},
+		Inputs: &pb.TaskInputsSpec{
+			Parameters: map[string]*pb.TaskInputsSpec_InputParameterSpec{
+				"accuracy": {Kind: &pb.TaskInputsSpec_InputParameterSpec_TaskOutputParameter{
+					TaskOutputParameter: &pb.TaskInputsSpec_InputParameterSpec_TaskOutputParameterSpec{ProducerTask: "evaluate", OutputParameterKey: "accuracy"},
+				}},
+				"threshold": {Kind: &pb.TaskInputsSpec_InputParameterSpec_ComponentInputParameter{ComponentInputParameter: "threshold"}},
+			},
+			Artifacts: map[string]*pb.TaskInputsSpec_InputArtifactSpec{
+				"model": {Kind: &pb.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifact{
+					TaskOutputArtifact: &pb.TaskInputsSpec_InputArtifactSpec_TaskOutputArtifactSpec{ProducerTask: "train", OutputArtifactKey: "model"},
+				}},
+			},
+		},
+	}
+	assert.Equal(t, []string{"evaluate", "train"}, getUpstreamTasks(taskSpec))
+}
+
+func TestCheckTriggerStrategy(t *testing.T) {
+	allSucceeded := &pb.PipelineTaskSpec{DependentTasks: []string{"a", "b"}}
+	allCompleted := &pb.PipelineTaskSpec{
+		DependentTasks: []string{"a", "b"},
+		TriggerPolicy:  &pb.PipelineTaskSpec_TriggerPolicy{Strategy: pb.PipelineTaskSpec_TriggerPolicy_ALL_UPSTREAM_TASKS_COMPLETED},
+	}
+	tests := []struct {
+		name       string
+		taskSpec   *pb.PipelineTaskSpec
+		executions map[string]*mlmdPb.Execution
+		want       bool
+		wantErr    string
+	}{
+		{
+			name:     "succeeded",
+			taskSpec: allSucceeded,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_COMPLETE),
+				"b": executionInState(mlmdPb.Execution_CACHED),
+			},
+			want: true,
+		},
+		{
+			name:     "upstream not triggered",
+			taskSpec: allSucceeded,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_COMPLETE),
+				"b": notTriggeredExecution(),
+			},
+			want: false,
+		},
+		{
+			name:     "upstream failed",
+			taskSpec: allSucceeded,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_FAILED),
+				"b": executionInState(mlmdPb.Execution_COMPLETE),
+			},
+			wantErr: "Upstream task 'a' did not succeed",
+		},
+		{
+			name:     "upstream running",
+			taskSpec: allCompleted,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_FAILED),
+				"b": executionInState(mlmdPb.Execution_RUNNING),
+			},
+			wantErr: "Upstream task 'b' is not complete yet",
+		},
+		{
+			name:     "upstream missing",
+			taskSpec: allSucceeded,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_COMPLETE),
+			},
+			wantErr: "Cannot find the execution of upstream task 'b'",
+		},
+		{
+			name:     "all completed",
+			taskSpec: allCompleted,
+			executions: map[string]*mlmdPb.Execution{
+				"a": executionInState(mlmdPb.Execution_FAILED),
+				"b": notTriggeredExecution(),
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		triggered, err := checkTriggerStrategy(test.taskSpec, test.executions)
+		if test.wantErr != "" {
+			assert.NotNil(t, err, test.name)
+			if err != nil {
+				assert.Contains(t, err.Error(), test.wantErr, test.name)
+			}
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.want, triggered, test.name)
+	}
+}
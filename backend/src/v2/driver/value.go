@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	pb "github.com/kubeflow/pipelines/api/v2alpha1/go"
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/pkg/errors"
+)
+
+// toMlmdValue converts a KFP pipeline spec value to an MLMD value of the same
+// type.
+func toMlmdValue(value *pb.Value) *mlmdPb.Value {
+	switch v := value.GetValue().(type) {
+	case *pb.Value_IntValue:
+		return &mlmdPb.Value{Value: &mlmdPb.Value_IntValue{IntValue: v.IntValue}}
+	case *pb.Value_DoubleValue:
+		return &mlmdPb.Value{Value: &mlmdPb.Value_DoubleValue{DoubleValue: v.DoubleValue}}
+	default:
+		return &mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: value.GetStringValue()}}
+	}
+}
+
+// formatMlmdValue returns the string representation of an MLMD value, which
+// is how parameters are passed to executors.
+func formatMlmdValue(value *mlmdPb.Value) string {
+	switch v := value.GetValue().(type) {
+	case *mlmdPb.Value_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *mlmdPb.Value_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	default:
+		return value.GetStringValue()
+	}
+}
+
+// parseMlmdValue parses the string representation of a parameter into an
+// MLMD value of the same type as the given one.
+func parseMlmdValue(typedValue *mlmdPb.Value, value string) (*mlmdPb.Value, error) {
+	switch typedValue.GetValue().(type) {
+	case *mlmdPb.Value_IntValue:
+		intValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("%q is not an integer", value)
+		}
+		return &mlmdPb.Value{Value: &mlmdPb.Value_IntValue{IntValue: intValue}}, nil
+	case *mlmdPb.Value_DoubleValue:
+		doubleValue, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errors.Errorf("%q is not a number", value)
+		}
+		return &mlmdPb.Value{Value: &mlmdPb.Value_DoubleValue{DoubleValue: doubleValue}}, nil
+	default:
+		return &mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: value}}, nil
+	}
+}
+
+// getInputParameters returns the resolved input parameters of an execution,
+// keyed by parameter name.
+func getInputParameters(execution *mlmdPb.Execution) map[string]*mlmdPb.Value {
+	parameters := make(map[string]*mlmdPb.Value)
+	for propertyName, property := range execution.GetCustomProperties() {
+		if strings.HasPrefix(propertyName, executionParamPrefixInputProperty) {
+			parameters[strings.TrimPrefix(propertyName, executionParamPrefixInputProperty)] = property
+		}
+	}
+	return parameters
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	mlmdPb "github.com/kubeflow/pipelines/third_party/ml-metadata/go_client/ml_metadata/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMlmdValue(t *testing.T) {
+	intValue := &mlmdPb.Value{Value: &mlmdPb.Value_IntValue{IntValue: 0}}
+	doubleValue := &mlmdPb.Value{Value: &mlmdPb.Value_DoubleValue{DoubleValue: 0}}
+	stringValue := &mlmdPb.Value{Value: &mlmdPb.Value_StringValue{StringValue: ""}}
+
+	value, err := parseMlmdValue(intValue, "42")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), value.GetIntValue())
+	value, err = parseMlmdValue(doubleValue, "2.5")
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, value.GetDoubleValue())
+	value, err = parseMlmdValue(stringValue, "20210101")
+	assert.Nil(t, err)
+	assert.Equal(t, "20210101", value.GetStringValue())
+
+	_, err = parseMlmdValue(intValue, "[[Index]]")
+	assert.Contains(t, err.Error(), `"[[Index]]" is not an integer`)
+	_, err = parseMlmdValue(doubleValue, "high")
+	assert.Contains(t, err.Error(), `"high" is not a number`)
+}
+
+func TestRuntimeParametersFlag(t *testing.T) {
+	parameters := make(runtimeParametersFlag)
+	assert.Nil(t, parameters.Set("message=a=b"))
+	assert.Nil(t, parameters.Set("empty="))
+	assert.Equal(t, runtimeParametersFlag{"message": "a=b", "empty": ""}, parameters)
+	assert.NotNil(t, parameters.Set("message"))
+	assert.NotNil(t, parameters.Set("=value"))
+}
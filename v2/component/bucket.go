@@ -0,0 +1,154 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	"gocloud.dev/blob/s3blob"
+)
+
+const (
+	gcsScheme   = "gs://"
+	s3Scheme    = "s3://"
+	minioScheme = "minio://"
+	fileScheme  = "file:///"
+
+	// The endpoint of an S3-compatible service used for s3:// roots instead of
+	// AWS, e.g. http://minio-service.kubeflow:9000.
+	s3EndpointEnv = "KFP_S3_ENDPOINT"
+	// The endpoint of MinIO used for minio:// roots.
+	minioEndpointEnv     = "KFP_MINIO_ENDPOINT"
+	defaultMinioEndpoint = "http://minio-service.kubeflow:9000"
+	// The directory a secret with the keys "accesskey" and "secretkey" is
+	// mounted at, like the mlpipeline-minio-artifact secret of KFP. When it
+	// is not set, the credentials are searched like the AWS SDK does, e.g.
+	// in AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+	bucketSecretPathEnv = "KFP_BUCKET_SECRET_PATH"
+	accessKeyFile       = "accesskey"
+	secretKeyFile       = "secretkey"
+	// MinIO ignores the region, but the AWS SDK requires one.
+	defaultS3Region = "us-east-1"
+)
+
+var supportedBucketSchemes = []string{gcsScheme, s3Scheme, minioScheme, fileScheme}
+
+func isSupportedBucketScheme(scheme string) bool {
+	for _, s := range supportedBucketSchemes {
+		if s == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// openBucket opens the bucket of a pipeline root, restricted to its prefix.
+func openBucket(ctx context.Context, config *bucketConfig) (*blob.Bucket, error) {
+	var bucket *blob.Bucket
+	var err error
+	switch config.scheme {
+	case s3Scheme:
+		bucket, err = openS3Bucket(ctx, config.bucketName, os.Getenv(s3EndpointEnv))
+	case minioScheme:
+		endpoint := os.Getenv(minioEndpointEnv)
+		if endpoint == "" {
+			endpoint = defaultMinioEndpoint
+		}
+		bucket, err = openS3Bucket(ctx, config.bucketName, endpoint)
+	case fileScheme:
+		bucket, err = fileblob.OpenBucket("/"+config.bucketName, &fileblob.Options{CreateDir: true})
+	default:
+		return blob.OpenBucket(ctx, config.bucketURL())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(config.prefix) > 0 {
+		bucket = blob.PrefixedBucket(bucket, config.prefix)
+	}
+	return bucket, nil
+}
+
+// openS3Bucket opens a bucket of S3, or of an S3-compatible service such as
+// MinIO when an endpoint is given.
+func openS3Bucket(ctx context.Context, bucketName string, endpoint string) (*blob.Bucket, error) {
+	config := aws.NewConfig()
+	region := os.Getenv("AWS_REGION")
+	if endpoint != "" {
+		// S3-compatible services usually lack virtual-hosted buckets and TLS.
+		config = config.
+			WithEndpoint(endpoint).
+			WithS3ForcePathStyle(true).
+			WithDisableSSL(strings.HasPrefix(endpoint, "http://"))
+		if region == "" {
+			region = defaultS3Region
+		}
+	}
+	if region != "" {
+		config = config.WithRegion(region)
+	}
+	creds, err := bucketSecretCredentials()
+	if err != nil {
+		return nil, err
+	}
+	if creds != nil {
+		config = config.WithCredentials(creds)
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *config,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create session for bucket %q: %v", bucketName, err)
+	}
+	return s3blob.OpenBucket(ctx, sess, bucketName, nil)
+}
+
+// bucketSecretCredentials reads the credentials of the secret mounted at
+// KFP_BUCKET_SECRET_PATH. It returns nil if the path is not set, so that the
+// default credentials are used.
+func bucketSecretCredentials() (*credentials.Credentials, error) {
+	secretPath := os.Getenv(bucketSecretPathEnv)
+	if secretPath == "" {
+		return nil, nil
+	}
+	readKey := func(name string) (string, error) {
+		b, err := ioutil.ReadFile(path.Join(secretPath, name))
+		if err != nil {
+			return "", fmt.Errorf("Failed to read bucket credentials from %q: %v", secretPath, err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	accessKey, err := readKey(accessKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	secretKey, err := readKey(secretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewStaticCredentials(accessKey, secretKey, ""), nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package component
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	pb "github.com/kubeflow/pipelines/v2/third_party/ml_metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// newFileBucketConfig returns the config of a pipeline root in a temporary
+// directory, which is removed by the returned function.
+func newFileBucketConfig(t *testing.T) (*bucketConfig, string, func()) {
+	dir, err := ioutil.TempDir("", "pipeline-root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := "file://" + path.Join(dir, "root")
+	bc, err := parseBucketConfig(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bc, root, func() { os.RemoveAll(dir) }
+}
+
+func Test_openBucket_File(t *testing.T) {
+	bc, root, cleanup := newFileBucketConfig(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	bucket, err := openBucket(ctx, bc)
+	if err != nil {
+		t.Fatalf("openBucket() error = %v", err)
+	}
+	defer bucket.Close()
+	if err := bucket.WriteAll(ctx, "pipeline/run/task/data", []byte("content"), nil); err != nil {
+		t.Fatalf("bucket.WriteAll() error = %v", err)
+	}
+
+	// The key is relative to the prefix of the pipeline root.
+	uri := bc.uriFromKey("pipeline/run/task/data")
+	if want := root + "/pipeline/run/task/data"; uri != want {
+		t.Errorf("uriFromKey() = %q, want %q", uri, want)
+	}
+	b, err := ioutil.ReadFile(uri[len("file://"):])
+	if err != nil {
+		t.Fatalf("Failed to read the written file: %v", err)
+	}
+	if string(b) != "content" {
+		t.Errorf("Written file content = %q, want %q", b, "content")
+	}
+}
+
+func Test_prepareInputsAndOutputs_File(t *testing.T) {
+	bc, root, cleanup := newFileBucketConfig(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	bucket, err := openBucket(ctx, bc)
+	if err != nil {
+		t.Fatalf("openBucket() error = %v", err)
+	}
+	defer bucket.Close()
+	if err := bucket.WriteAll(ctx, "upstream/data", []byte("input"), nil); err != nil {
+		t.Fatalf("bucket.WriteAll() error = %v", err)
+	}
+
+	uri := root + "/upstream/data"
+	metadataFile, err := ioutil.TempFile("", "input-metadata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(metadataFile.Name())
+	b, err := protojson.Marshal(&pb.Artifact{Uri: &uri})
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadataFile.Write(b)
+	metadataFile.Close()
+
+	l := &Launcher{
+		options: &LauncherOptions{
+			PipelineName:   "pipeline",
+			PipelineRunID:  "run",
+			PipelineTaskID: "task",
+		},
+		runtimeInfo: &runtimeInfo{
+			InputArtifacts:  map[string]*inputArtifact{"examples": {FileInputPath: metadataFile.Name()}},
+			OutputArtifacts: map[string]*outputArtifact{"model": {ArtifactSchema: "title: kfp.Model"}},
+		},
+		placeholderReplacements: make(map[string]string),
+		bucketConfig:            bc,
+	}
+	if err := l.prepareInputs(ctx); err != nil {
+		t.Fatalf("prepareInputs() error = %v", err)
+	}
+	if err := l.prepareOutputs(ctx); err != nil {
+		t.Fatalf("prepareOutputs() error = %v", err)
+	}
+
+	localPath := l.runtimeInfo.InputArtifacts["examples"].LocalArtifactFilePath
+	b, err = ioutil.ReadFile(localPath)
+	if err != nil {
+		t.Fatalf("Failed to read the copied input: %v", err)
+	}
+	if string(b) != "input" {
+		t.Errorf("Copied input content = %q, want %q", b, "input")
+	}
+	if got := l.placeholderReplacements[`{{$.inputs.artifacts['examples'].path}}`]; got != localPath {
+		t.Errorf("Input path placeholder = %q, want %q", got, localPath)
+	}
+	wantURI := root + "/pipeline/run/task/data"
+	if got := l.placeholderReplacements[`{{$.outputs.artifacts['model'].uri}}`]; got != wantURI {
+		t.Errorf("Output URI placeholder = %q, want %q", got, wantURI)
+	}
+}
+
+func Test_bucketSecretCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bucket-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(path.Join(dir, accessKeyFile), []byte("minio"), 0600)
+	ioutil.WriteFile(path.Join(dir, secretKeyFile), []byte("minio123\n"), 0600)
+
+	creds, err := bucketSecretCredentials()
+	if err != nil || creds != nil {
+		t.Errorf("bucketSecretCredentials() without secret = %v, %v, want nil, nil", creds, err)
+	}
+
+	os.Setenv(bucketSecretPathEnv, dir)
+	defer os.Unsetenv(bucketSecretPathEnv)
+	creds, err = bucketSecretCredentials()
+	if err != nil {
+		t.Fatalf("bucketSecretCredentials() error = %v", err)
+	}
+	value, err := creds.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.AccessKeyID != "minio" || value.SecretAccessKey != "minio123" {
+		t.Errorf("bucketSecretCredentials() = %q, %q, want %q, %q", value.AccessKeyID, value.SecretAccessKey, "minio", "minio123")
+	}
+
+	os.Remove(path.Join(dir, secretKeyFile))
+	if _, err := bucketSecretCredentials(); err == nil {
+		t.Errorf("bucketSecretCredentials() without secret key succeeded, want error")
+	}
+}
@@ -32,9 +32,6 @@ import (
 	"github.com/kubeflow/pipelines/v2/metadata"
 	pb "github.com/kubeflow/pipelines/v2/third_party/ml_metadata"
 	"google.golang.org/protobuf/encoding/protojson"
-
-	"gocloud.dev/blob"
-	_ "gocloud.dev/blob/gcsblob"
 )
 
 // Launcher is used to launch KFP components. It handles the recording of the
@@ -99,8 +96,7 @@ func parseBucketConfig(path string) (*bucketConfig, error) {
 		return nil, fmt.Errorf("Unrecognized pipeline root format: %q", path)
 	}
 
-	// TODO: Verify/add support for s3:// and file:///.
-	if ms[1] != "gs://" {
+	if !isSupportedBucketScheme(ms[1]) {
 		return nil, fmt.Errorf("Unsupported Cloud bucket: %q", path)
 	}
 
@@ -179,7 +175,7 @@ func NewLauncher(runtimeInfo string, options *LauncherOptions) (*Launcher, error
 }
 
 func (l *Launcher) prepareInputs(ctx context.Context) error {
-	bucket, err := blob.OpenBucket(context.Background(), l.bucketConfig.bucketURL())
+	bucket, err := openBucket(ctx, l.bucketConfig)
 	if err != nil {
 		return fmt.Errorf("Failed to open bucket %q: %v", l.bucketConfig.bucketName, err)
 	}
@@ -347,7 +343,7 @@ func (l *Launcher) RunComponent(ctx context.Context, cmd string, args ...string)
 		return err
 	}
 
-	bucket, err := blob.OpenBucket(context.Background(), l.bucketConfig.bucketURL())
+	bucket, err := openBucket(ctx, l.bucketConfig)
 	if err != nil {
 		return fmt.Errorf("Failed to open bucket %q: %v", l.bucketConfig.bucketName, err)
 	}
@@ -87,6 +87,46 @@ func Test_parseCloudBucket(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Parses S3 - Bucket with prefix",
+			path: "s3://my-bucket/my-path",
+			want: &bucketConfig{
+				scheme:     "s3://",
+				bucketName: "my-bucket",
+				prefix:     "my-path/",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Parses MinIO - Bucket with prefix",
+			path: "minio://my-bucket/my-path/123/",
+			want: &bucketConfig{
+				scheme:     "minio://",
+				bucketName: "my-bucket",
+				prefix:     "my-path/123/",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Parses file - Directory with subdirectories",
+			path: "file:///tmp/my-path/123",
+			want: &bucketConfig{
+				scheme:     "file:///",
+				bucketName: "tmp",
+				prefix:     "my-path/123/",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Fails for unsupported scheme",
+			path:    "http://my-bucket/my-path",
+			wantErr: true,
+		},
+		{
+			name:    "Fails for relative file path",
+			path:    "file://tmp/my-path",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -95,6 +135,9 @@ func Test_parseCloudBucket(t *testing.T) {
 				t.Errorf("%q: parseCloudBucket() error = %v, wantErr %v", tt.name, err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("%q: parseCloudBucket() = %v, want %v", tt.name, got, tt.want)
 			}
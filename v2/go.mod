@@ -3,6 +3,7 @@ module github.com/kubeflow/pipelines/v2
 go 1.15
 
 require (
+	github.com/aws/aws-sdk-go v1.36.1
 	github.com/davecgh/go-spew v1.1.1
 	github.com/golang/glog v0.0.0-20160126235308-23def4e6c14b
 	github.com/golang/protobuf v1.4.3